@@ -29,7 +29,8 @@ import (
 	"time"
 )
 
-const threshold = 667
+// legacyThreshold is the multisig threshold of the signer sets created before the hub started to compute it
+const legacyThreshold = 667
 
 var cfg = config.Get()
 
@@ -278,24 +279,7 @@ func relayValsets(ctx context.Context) {
 
 		var confirms []sdk.Msg
 		for _, valset := range response.GetSignerSets() {
-			txData := transaction.NewEditMultisigData()
-			txData.Threshold = threshold
-
-			totalPower := uint64(0)
-			for _, val := range valset.Signers {
-				totalPower += val.Power
-			}
-
-			for _, val := range valset.Signers {
-				var addr transaction.Address
-				bytes, _ := wallet.AddressToHex("Mx" + val.ExternalAddress[2:])
-				copy(addr[:], bytes)
-
-				weight := uint32(sdk.NewUint(val.Power).MulUint64(1000).QuoUint64(totalPower).Uint64())
-
-				txData.Addresses = append(txData.Addresses, addr)
-				txData.Weights = append(txData.Weights, weight)
-			}
+			txData := newEditMultisigData(valset)
 
 			tx, _ := transaction.NewBuilder(cfg.Minter.ChainID).NewTransaction(txData)
 			tx.SetPayload([]byte(strconv.Itoa(int(valset.Nonce))))
@@ -374,24 +358,7 @@ func relayValsets(ctx context.Context) {
 
 	ctx.Logger.Info("Sending valset to Minter")
 
-	txData := transaction.NewEditMultisigData()
-	txData.Threshold = threshold
-
-	totalPower := uint64(0)
-	for _, val := range oldestSignedValset.GetSigners() {
-		totalPower += val.Power
-	}
-
-	for _, val := range oldestSignedValset.GetSigners() {
-		var addr transaction.Address
-		bytes, _ := wallet.AddressToHex("Mx" + val.ExternalAddress[2:])
-		copy(addr[:], bytes)
-
-		weight := uint32(sdk.NewUint(val.Power).MulUint64(1000).QuoUint64(totalPower).Uint64())
-
-		txData.Addresses = append(txData.Addresses, addr)
-		txData.Weights = append(txData.Weights, weight)
-	}
+	txData := newEditMultisigData(oldestSignedValset)
 
 	tx, _ := transaction.NewBuilder(cfg.Minter.ChainID).NewTransaction(txData)
 	tx.SetNonce(oldestSignedValset.Sequence).SetGasPrice(1).SetGasCoin(0).SetSignatureType(transaction.SignatureTypeMulti)
@@ -545,6 +512,7 @@ func relayMinterEvents(ctx context.Context) context.Context {
 						for n := range editMultisigData.Addresses {
 							members = append(members, &types.ExternalSigner{
 								Power:           editMultisigData.Weights[n],
+								Weight:          editMultisigData.Weights[n],
 								ExternalAddress: "0x" + editMultisigData.Addresses[n][2:],
 							})
 						}
@@ -555,6 +523,7 @@ func relayMinterEvents(ctx context.Context) context.Context {
 							Height:      block.Height,
 							TxHash:      tx.Hash,
 							Members:     members,
+							Threshold:   uint64(editMultisigData.Threshold),
 						})
 
 						ctx.SetLastEventNonce(ctx.LastEventNonce() + 1)
@@ -576,3 +545,36 @@ func relayMinterEvents(ctx context.Context) context.Context {
 
 	return ctx
 }
+
+// newEditMultisigData builds the multisig update from the weights and threshold of the signer set, so all the
+// connectors sign identical transactions. The weights of the signer sets created before the hub started to compute
+// them are derived from the powers.
+func newEditMultisigData(valset *types.SignerSetTx) *transaction.EditMultisigData {
+	txData := transaction.NewEditMultisigData()
+	txData.Threshold = uint32(valset.Threshold)
+
+	totalPower := uint64(0)
+	for _, val := range valset.Signers {
+		totalPower += val.Power
+	}
+
+	for _, val := range valset.Signers {
+		var addr transaction.Address
+		bytes, _ := wallet.AddressToHex("Mx" + val.ExternalAddress[2:])
+		copy(addr[:], bytes)
+
+		weight := uint32(val.Weight)
+		if valset.Threshold == 0 {
+			weight = uint32(sdk.NewUint(val.Power).MulUint64(1000).QuoUint64(totalPower).Uint64())
+		}
+
+		txData.Addresses = append(txData.Addresses, addr)
+		txData.Weights = append(txData.Weights, weight)
+	}
+
+	if valset.Threshold == 0 {
+		txData.Threshold = legacyThreshold
+	}
+
+	return txData
+}
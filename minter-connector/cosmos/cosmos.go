@@ -41,6 +41,7 @@ type Valset struct {
 	TxHash      string
 	Height      uint64
 	Members     []*mhub.ExternalSigner
+	Threshold   uint64
 }
 
 type Deposit struct {
@@ -141,6 +142,7 @@ func CreateClaims(orcAddress sdk.AccAddress, deposits []Deposit, batches []Batch
 			ExternalHeight:   valset.Height,
 			Members:          valset.Members,
 			TxHash:           valset.TxHash,
+			Threshold:        valset.Threshold,
 		})
 		if err != nil {
 			panic(err)
@@ -140,6 +140,9 @@ var (
 			upgradeclient.CancelProposalHandler,
 			mhub2client.ProposalColdStorageHandler,
 			mhub2client.ProposalTokensChangeHandler,
+			mhub2client.ProposalBlocklistChangeHandler,
+			mhub2client.ProposalQuarantinedDepositHandler,
+			mhub2client.ProposalGuardianSetChangeHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -156,14 +159,15 @@ var (
 	// module account permissions
 	// NOTE: We believe that this is giving various modules access to functions of the supply module? We will probably need to use this.
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		mhub2types.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:       nil,
+		distrtypes.ModuleName:            nil,
+		minttypes.ModuleName:             {authtypes.Minter},
+		stakingtypes.BondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:   {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:              {authtypes.Burner},
+		ibctransfertypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+		mhub2types.ModuleName:            {authtypes.Minter, authtypes.Burner},
+		mhub2types.QuarantineAccountName: nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -229,6 +233,9 @@ type Mhub2 struct {
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// module configurator
+	configurator module.Configurator
 }
 
 func init() {
@@ -397,7 +404,7 @@ func NewMhub2App(
 		appCodec,
 		keys[oracletypes.StoreKey],
 		app.GetSubspace(oracletypes.ModuleName),
-		stakingKeeper,
+		&stakingKeeper,
 	)
 
 	mhub2Keeper := keeper.NewKeeper(
@@ -415,7 +422,7 @@ func NewMhub2App(
 		stakingtypes.NewMultiStakingHooks(
 			app.distrKeeper.Hooks(),
 			app.slashingKeeper.Hooks(),
-			app.mhub2Keeper.Hooks(),
+			mhub2Keeper.Hooks(),
 		),
 	)
 
@@ -508,10 +515,12 @@ func NewMhub2App(
 		transferModule,
 		mhub2.NewAppModule(
 			app.mhub2Keeper,
+			app.accountKeeper,
 			app.bankKeeper,
 		),
 		oracle.NewAppModule(
 			app.oracleKeeper,
+			app.accountKeeper,
 			app.bankKeeper,
 		),
 	)
@@ -582,7 +591,8 @@ func NewMhub2App(
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.mm.RegisterServices(module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	app.mm.RegisterServices(app.configurator)
 
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts),
@@ -597,6 +607,8 @@ func NewMhub2App(
 		evidence.NewAppModule(app.evidenceKeeper),
 		ibc.NewAppModule(app.ibcKeeper),
 		transferModule,
+		mhub2.NewAppModule(app.mhub2Keeper, app.accountKeeper, app.bankKeeper),
+		oracle.NewAppModule(app.oracleKeeper, app.accountKeeper, app.bankKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -795,6 +807,23 @@ func NewMhub2App(
 		return fromVM, nil
 	})
 
+	app.upgradeKeeper.SetUpgradeHandler("v2", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// the chain has not stored the module versions before this upgrade, the migrated modules start from
+		// version 1 and the rest are already at their current versions
+		for _, name := range []string{mhub2types.ModuleName, oracletypes.ModuleName} {
+			if _, ok := fromVM[name]; !ok {
+				fromVM[name] = 1
+			}
+		}
+		for name, version := range app.mm.GetVersionMap() {
+			if _, ok := fromVM[name]; !ok {
+				fromVM[name] = version
+			}
+		}
+
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
 	return app
 }
 
@@ -825,6 +854,7 @@ func (app *Mhub2) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.R
 	if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	app.upgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -2,10 +2,12 @@ package app
 
 import (
 	"encoding/json"
+	"errors"
 	"log"
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -23,7 +25,9 @@ func (app *Mhub2) ExportAppStateAndValidators(
 	height := app.LastBlockHeight() + 1
 	if forZeroHeight {
 		height = 0
-		app.prepForZeroHeightGenesis(ctx, jailWhiteList)
+		if err := app.prepForZeroHeightGenesis(ctx, jailWhiteList); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
 	}
 
 	genState := app.mm.ExportGenesis(ctx, app.appCodec)
@@ -44,7 +48,7 @@ func (app *Mhub2) ExportAppStateAndValidators(
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //      in favour of export at a block height
-func (app *Mhub2) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []string) {
+func (app *Mhub2) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []string) error {
 	applyWhiteList := false
 
 	//Check if there is a whitelist
@@ -67,14 +71,18 @@ func (app *Mhub2) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []stri
 
 	/* Handle fee distribution state. */
 
-	// withdraw all validator commission
+	// withdraw all validator commission, the validators without commission have nothing to withdraw
+	var err error
 	app.stakingKeeper.IterateValidators(ctx, func(_ int64, val stakingtypes.ValidatorI) (stop bool) {
-		_, err := app.distrKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
-		if err != nil {
-			log.Fatal(err)
+		_, err = app.distrKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
+		if errors.Is(err, distrtypes.ErrNoValidatorCommission) {
+			err = nil
 		}
-		return false
+		return err != nil
 	})
+	if err != nil {
+		return err
+	}
 
 	// withdraw all delegator rewards
 	dels := app.stakingKeeper.GetAllDelegations(ctx)
@@ -89,8 +97,10 @@ func (app *Mhub2) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []stri
 			panic(err)
 		}
 
-		if _, err = app.distrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr); err != nil {
-			log.Fatal(err)
+		// the delegations without the distribution info have no rewards to withdraw
+		_, err = app.distrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil && !errors.Is(err, distrtypes.ErrEmptyDelegationDistInfo) {
+			return err
 		}
 	}
 
@@ -160,7 +170,7 @@ func (app *Mhub2) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []stri
 	counter := int16(0)
 
 	for ; iter.Valid(); iter.Next() {
-		addr := sdk.ValAddress(iter.Key()[1:])
+		addr := sdk.ValAddress(stakingtypes.AddressFromValidatorsKey(iter.Key()))
 		validator, found := app.stakingKeeper.GetValidator(ctx, addr)
 		if !found {
 			panic("expected validator, not found")
@@ -177,7 +187,7 @@ func (app *Mhub2) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []stri
 
 	iter.Close()
 
-	_, err := app.stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	_, err = app.stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -193,4 +203,6 @@ func (app *Mhub2) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []stri
 			return false
 		},
 	)
+
+	return nil
 }
@@ -8,8 +8,8 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
-	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -30,10 +30,13 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+
+	mhub2types "github.com/MinterTeam/mhub2/module/x/mhub2/types"
+	oracletypes "github.com/MinterTeam/mhub2/module/x/oracle/types"
 )
 
 func init() {
-	simapp.GetSimulatorFlags()
+	GetSimulatorFlags()
 }
 
 type StoreKeysPrefixes struct {
@@ -49,7 +52,7 @@ func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
 }
 
 func TestFullAppSimulation(t *testing.T) {
-	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	config, db, dir, logger, skip, err := SetupSimulation("leveldb-app-sim", "Simulation")
 	if skip {
 		t.Skip("skipping application simulation")
 	}
@@ -130,7 +133,7 @@ func TestAppImportExport(t *testing.T) {
 
 	fmt.Printf("importing genesis...\n")
 
-	_, newDB, newDir, _, _, err := simapp.SetupSimulation("leveldb-app-sim-2", "Simulation-2")
+	_, newDB, newDir, _, _, err := SetupSimulation("leveldb-app-sim-2", "Simulation-2")
 	require.NoError(t, err, "simulation setup failed")
 
 	defer func() {
@@ -138,7 +141,7 @@ func TestAppImportExport(t *testing.T) {
 		require.NoError(t, os.RemoveAll(newDir))
 	}()
 
-	newApp := NewMhub2App(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, fauxMerkleModeOpt)
+	newApp := NewMhub2App(log.NewNopLogger(), newDB, nil, true, map[int64]bool{}, DefaultNodeHome, FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, fauxMerkleModeOpt)
 	require.Equal(t, appName, newApp.Name())
 
 	var genesisState GenesisState
@@ -148,6 +151,7 @@ func TestAppImportExport(t *testing.T) {
 	ctxA := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
 	ctxB := newApp.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
 	newApp.mm.InitGenesis(ctxB, app.AppCodec(), genesisState)
+	newApp.StoreConsensusParams(ctxB, appState.ConsensusParams)
 
 	fmt.Printf("comparing stores...\n")
 
@@ -168,7 +172,7 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
 		{app.keys[ibchost.StoreKey], newApp.keys[ibchost.StoreKey], [][]byte{}},
 		{app.keys[ibctransfertypes.StoreKey], newApp.keys[ibctransfertypes.StoreKey], [][]byte{}},
-		// TODO: Add mhub2 module once simulation code is write
+		{app.keys[oracletypes.StoreKey], newApp.keys[oracletypes.StoreKey], [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
@@ -181,10 +185,31 @@ func TestAppImportExport(t *testing.T) {
 		fmt.Printf("compared %d key/value pairs between %s and %s\n", len(failedKVAs), skp.A, skp.B)
 		require.Equal(t, len(failedKVAs), 0, GetSimulationLog(skp.A.Name(), app.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
 	}
+
+	// the mhub2 genesis does not carry the in-flight bridge state (outgoing txs, confirmations, event vote
+	// records and their indexes), so only the exported parts of the store are compared
+	mhub2Prefixes := []byte{
+		mhub2types.ValidatorExternalAddressKey, mhub2types.OrchestratorValidatorAddressKey,
+		mhub2types.ExternalOrchestratorAddressKey, mhub2types.LastEventNonceByValidatorKey,
+		mhub2types.LastObservedEventNonceKey, mhub2types.LastOutgoingBatchNonceKey, mhub2types.OutgoingSequence,
+		mhub2types.TokenInfosKey, mhub2types.LastObservedSignerSetKey, mhub2types.BlockedAddressKey,
+		mhub2types.QuarantinedDepositKey, mhub2types.LastQuarantinedDepositIDKey, mhub2types.GuardianKey,
+		mhub2types.TimelockedTransferKey, mhub2types.TimelockedTransferReleaseKey, mhub2types.DelegateKeysHistoryKey,
+	}
+	for _, p := range mhub2Prefixes {
+		storeA := prefix.NewStore(ctxA.KVStore(app.keys[mhub2types.StoreKey]), []byte{p})
+		storeB := prefix.NewStore(ctxB.KVStore(newApp.keys[mhub2types.StoreKey]), []byte{p})
+
+		failedKVAs, failedKVBs := sdk.DiffKVStores(storeA, storeB, nil)
+		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare")
+
+		fmt.Printf("compared %d key/value pairs of mhub2 prefix %X\n", len(failedKVAs), p)
+		require.Equal(t, len(failedKVAs), 0, GetSimulationLog(mhub2types.StoreKey, app.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
+	}
 }
 
 func TestAppSimulationAfterImport(t *testing.T) {
-	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	config, db, dir, logger, skip, err := SetupSimulation("leveldb-app-sim", "Simulation")
 	if skip {
 		t.Skip("skipping application simulation after import")
 	}
@@ -240,7 +265,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		require.NoError(t, os.RemoveAll(newDir))
 	}()
 
-	newApp := NewMhub2App(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, fauxMerkleModeOpt)
+	newApp := NewMhub2App(log.NewNopLogger(), newDB, nil, true, map[int64]bool{}, DefaultNodeHome, FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, fauxMerkleModeOpt)
 	require.Equal(t, appName, newApp.Name())
 
 	newApp.InitChain(abci.RequestInitChain{
@@ -14,9 +14,12 @@ import (
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // TODO: audit this code when we hook up simulations
@@ -70,10 +73,65 @@ func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simty
 			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams)
 		}
 
+		appState = addNotBondedPoolBalance(cdc, appState)
+
 		return appState, simAccs, chainID, genesisTimestamp
 	}
 }
 
+// addNotBondedPoolBalance funds the not bonded pool with the tokens of the unbonded genesis validators, so
+// the bank genesis supply matches the staking genesis
+func addNotBondedPoolBalance(cdc codec.JSONCodec, appState json.RawMessage) json.RawMessage {
+	rawState := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(appState, &rawState); err != nil {
+		panic(err)
+	}
+
+	stakingStateBz, ok := rawState[stakingtypes.ModuleName]
+	if !ok {
+		panic("staking genesis state is missing")
+	}
+
+	stakingState := new(stakingtypes.GenesisState)
+	cdc.MustUnmarshalJSON(stakingStateBz, stakingState)
+
+	notBondedTokens := sdk.ZeroInt()
+	for _, val := range stakingState.Validators {
+		if val.Status != stakingtypes.Unbonded {
+			continue
+		}
+		notBondedTokens = notBondedTokens.Add(val.GetTokens())
+	}
+
+	bankStateBz, ok := rawState[banktypes.ModuleName]
+	if !ok {
+		panic("bank genesis state is missing")
+	}
+
+	bankState := new(banktypes.GenesisState)
+	cdc.MustUnmarshalJSON(bankStateBz, bankState)
+
+	stakingAddr := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+	for _, balance := range bankState.Balances {
+		if balance.Address == stakingAddr {
+			return appState
+		}
+	}
+
+	bankState.Balances = append(bankState.Balances, banktypes.Balance{
+		Address: stakingAddr,
+		Coins:   sdk.NewCoins(sdk.NewCoin(stakingState.Params.BondDenom, notBondedTokens)),
+	})
+	rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
+
+	appState, err := json.Marshal(rawState)
+	if err != nil {
+		panic(err)
+	}
+
+	return appState
+}
+
 // AppStateRandomizedFn creates calls each module's GenesisState generator function
 // and creates the simulation params
 func AppStateRandomizedFn(
@@ -21,6 +21,7 @@ import (
 	tmos "github.com/tendermint/tendermint/libs/os"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	mhub2utils "github.com/MinterTeam/mhub2/module/x/mhub2/client/utils"
 	mhub2types "github.com/MinterTeam/mhub2/module/x/mhub2/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -39,6 +40,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+const flagDelegateKeys = "delegate-keys"
+
 // GenTxCmd builds the application's gentx command.
 func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalIterator types.GenesisBalancesIterator, defaultNodeHome string) *cobra.Command {
 	ipDefault, _ := server.ExternalIP()
@@ -51,7 +54,9 @@ func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalI
 		Long: fmt.Sprintf(`Generate a genesis transaction that creates a validator with a self-delegation, oracle key 
 delegation and orchestrator key delegation that is signed by the key in the Keyring referenced by a given name. A node 
 ID and Bech32 consensus pubkey may optionally be provided. If they are omitted, they will be retrieved from the 
-priv_validator.json file. The following default parameters are included:
+priv_validator.json file. The eth-address and eth-sig are delegated for ethereum, minter and bsc, use
+--delegate-keys to set another external address for a chain or to add a chain. All the keys are delegated
+with a single MsgDelegateKeysMulti. The following default parameters are included:
     %s
 
 Example:
@@ -62,7 +67,8 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
     --commission-rate=0.07 \
     --details="..." \
     --security-contact="..." \
-    --website="..."
+    --website="..." \
+    --delegate-keys=bsc:0x6A0B2bd4B1e6B4b13BDaE0c3a0D3d8C1A4fA7a1e:0x34...BC
 `, defaultsDesc, version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -185,19 +191,41 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return err
 			}
 
-			msgs := []sdk.Msg{msg}
-
-			chains := []mhub2types.ChainID{"ethereum", "minter", "bsc"}
-			for _, chain := range chains {
-				msgs = append(msgs, &mhub2types.MsgDelegateKeys{
-					ValidatorAddress:    sdk.ValAddress(key.GetAddress()).String(),
-					OrchestratorAddress: orchAddress.String(),
-					ExternalAddress:     ethAddress,
-					EthSignature:        ethSig,
-					ChainId:             chain.String(),
+			var entries []mhub2types.DelegateKeysMultiEntry
+			for _, chain := range []mhub2types.ChainID{"ethereum", "minter", "bsc"} {
+				entries = append(entries, mhub2types.DelegateKeysMultiEntry{
+					ChainId:         chain.String(),
+					ExternalAddress: ethAddress,
+					EthSignature:    ethSig,
 				})
 			}
 
+			// per-chain external addresses replace the default one
+			delegateKeys, _ := cmd.Flags().GetStringArray(flagDelegateKeys)
+			for _, keys := range delegateKeys {
+				entry, err := mhub2utils.ParseDelegateKeysMultiEntry(keys)
+				if err != nil {
+					return err
+				}
+
+				replaced := false
+				for i := range entries {
+					if entries[i].ChainId == entry.ChainId {
+						entries[i], replaced = entry, true
+					}
+				}
+				if !replaced {
+					entries = append(entries, entry)
+				}
+			}
+
+			delegateKeysMsg := mhub2types.NewMsgDelegateKeysMulti(sdk.ValAddress(key.GetAddress()), orchAddress, entries)
+			if err := delegateKeysMsg.ValidateBasic(); err != nil {
+				return errors.Wrap(err, "invalid delegate keys")
+			}
+
+			msgs := []sdk.Msg{msg, delegateKeysMsg}
+
 			if key.GetType() == keyring.TypeOffline || key.GetType() == keyring.TypeMulti {
 				cmd.PrintErrln("Offline key passed in. Use `tx sign` command to sign.")
 				return authclient.PrintUnsignedStdTx(txBldr, clientCtx, msgs)
@@ -248,6 +276,7 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the genesis transaction JSON document to the given file instead of the default location")
 	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().StringArray(flagDelegateKeys, nil, "Delegate keys of a chain as <chain-id>:<external-address>:<eth-sig>, can be repeated")
 	cmd.Flags().AddFlagSet(fsCreateValidator)
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -524,7 +553,18 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 			)
 		}
 
-		// TODO: validate msgdelegatekeys
+		for _, m := range msgs[1:] {
+			delegateKeys, ok := m.(*mhub2types.MsgDelegateKeysMulti)
+			if !ok {
+				continue
+			}
+			if delegateKeys.ValidatorAddress != msg.ValidatorAddress {
+				return appGenTxs, persistentPeers, fmt.Errorf("delegate keys of %s in the gentx of %s", delegateKeys.ValidatorAddress, msg.ValidatorAddress)
+			}
+			if err := delegateKeys.ValidateBasic(); err != nil {
+				return appGenTxs, persistentPeers, errors.Wrapf(err, "invalid delegate keys in %s", fo.Name())
+			}
+		}
 
 		// exclude itself from persistent peers
 		if msg.Description.Moniker != moniker {
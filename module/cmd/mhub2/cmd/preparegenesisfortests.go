@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
+	oracletypes "github.com/MinterTeam/mhub2/module/x/oracle/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/spf13/cobra"
@@ -56,6 +57,18 @@ func AddPrepareGenesisForTestsCmd(defaultNodeHome string) *cobra.Command {
 
 			appState[types.ModuleName] = genStateJson
 
+			// the holders are relayed in every epoch of the tests
+			oracleState := oracletypes.GenesisState{}
+			cdc.MustUnmarshalJSON(appState[oracletypes.ModuleName], &oracleState)
+			oracleState.Params.HoldersUpdatePeriod = 1
+
+			oracleStateJson, err := cdc.MarshalJSON(&oracleState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal oracle genesis state: %w", err)
+			}
+
+			appState[oracletypes.ModuleName] = oracleStateJson
+
 			govState := govtypes.GenesisState{}
 			cdc.MustUnmarshalJSON(appState[govtypes.ModuleName], &govState)
 			govState.VotingParams.VotingPeriod = time.Second * 30
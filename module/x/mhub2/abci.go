@@ -2,7 +2,6 @@ package mhub2
 
 import (
 	"sort"
-	"time"
 
 	"github.com/MinterTeam/mhub2/module/x/mhub2/keeper"
 	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
@@ -39,16 +38,14 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		eventVoteRecordTally(ctx, chainId, k)
 		refundExpiredTxs(ctx, chainId, k)
 	}
+	k.ReleaseTimelockedTransfers(ctx)
 }
 
 func refundExpiredTxs(ctx sdk.Context, chainId types.ChainID, k keeper.Keeper) {
 	if ctx.BlockHeight()%1 == 0 { // todo: make the period configurable ?
-		k.IterateUnbatchedSendToExternals(ctx, chainId, func(ste *types.SendToExternal) bool {
-			if time.Unix(int64(ste.CreatedAt), 0).Add(k.GetOutgoingTxTimeout(ctx)).Before(ctx.BlockTime()) {
-				k.OnOutgoingTransactionTimeouts(ctx, chainId, ste.Id, ste.Sender)
-			}
-			return false
-		})
+		for _, ste := range k.GetExpiredUnbatchedSendToExternals(ctx, chainId) {
+			k.OnOutgoingTransactionTimeouts(ctx, chainId, ste.Id, ste.Sender)
+		}
 	}
 }
 
@@ -77,26 +74,41 @@ func createBatchTxs(ctx sdk.Context, chainId types.ChainID, k keeper.Keeper) {
 func createSignerSetTxs(ctx sdk.Context, chainId types.ChainID, k keeper.Keeper) {
 	// Auto signerset tx creation.
 	// 1. If there are no signer set requests, create a new one.
-	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
+	// 2. If there is at least one validator who started unbonding since the latest signer set request. (we persist
+	//      last unbonded block height in hooks.go)
 	//      This will make sure the unbonding validator has to provide an ethereum signature to a new signer set tx
 	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of Current signer set and latest signer set request is > 5%
+	// 3. If the min signer set interval of the chain has passed since the latest signer set request and
+	//      a. a validator has delegated or rotated its external address since the latest signer set request, or
+	//      b. power change between validators of Current signer set and latest signer set request exceeds the
+	//         power diff threshold of the chain, or
+	//      c. the latest signer set request is older than the max signer set age of the chain
 	latestSignerSetTx := k.GetLatestSignerSetTx(ctx, chainId)
 	if latestSignerSetTx == nil {
 		k.CreateSignerSetTx(ctx, chainId)
 		return
 	}
 
+	params := k.GetSignerSetParams(ctx, chainId)
 	lastUnbondingHeight := k.GetLastUnbondingBlockHeight(ctx)
+	lastDelegateKeysChangeHeight := k.GetLastDelegateKeysChangeHeight(ctx, chainId)
 	blockHeight := uint64(ctx.BlockHeight())
+	age := blockHeight - latestSignerSetTx.Height
 	powerDiff := k.CurrentSignerSet(ctx, chainId).PowerDiff(latestSignerSetTx.Signers)
 
-	shouldCreate := (lastUnbondingHeight == blockHeight) || (powerDiff > 0.05) // todo?
+	unbonding := lastUnbondingHeight >= latestSignerSetTx.Height && lastUnbondingHeight != 0
+	keysChanged := lastDelegateKeysChangeHeight > latestSignerSetTx.Height
+	powerChanged := powerDiff > params.PowerDiffThreshold.MustFloat64()
+	expired := params.MaxSignerSetAge > 0 && age >= params.MaxSignerSetAge
+
+	shouldCreate := unbonding || (age >= params.MinSignerSetInterval && (keysChanged || powerChanged || expired))
 	k.Logger(ctx).Info(
 		"considering signer set tx creation",
 		"blockHeight", blockHeight,
 		"lastUnbondingHeight", lastUnbondingHeight,
+		"lastDelegateKeysChangeHeight", lastDelegateKeysChangeHeight,
 		"latestSignerSetTx.Nonce", latestSignerSetTx.Nonce,
+		"latestSignerSetTx.Height", latestSignerSetTx.Height,
 		"powerDiff", powerDiff,
 		"chainId", chainId,
 		"shouldCreate", shouldCreate,
@@ -128,47 +140,31 @@ func pruneSignerSetTxs(ctx sdk.Context, chainId types.ChainID, k keeper.Keeper)
 	}
 }
 
-// Iterate over all attestations currently being voted on in order of nonce and
-// "Observe" those who have passed the threshold. Break the loop once we see
-// an attestation that has not passed the threshold
+// Iterate over the attestations at the next expected event nonce and "Observe" the one
+// which has passed the threshold. Repeat for the following nonce until we reach a nonce
+// which is not pending or where no attestation has passed the threshold yet
 func eventVoteRecordTally(ctx sdk.Context, chainId types.ChainID, k keeper.Keeper) {
-	attmap := k.GetExternalEventVoteRecordMapping(ctx, chainId)
+	for {
+		// Only attestations at exactly lastObservedEventNonce+1 can be observed. Already observed
+		// attestations are never loaded, so the cost of this function does not grow with the history.
+		nonce := k.GetLastObservedEventNonce(ctx, chainId) + 1
+		if !k.HasPendingEventNonce(ctx, chainId, nonce) {
+			return
+		}
 
-	// We make a slice with all the event nonces that are in the attestation mapping
-	keys := make([]uint64, 0, len(attmap))
-	for k := range attmap {
-		keys = append(keys, k)
-	}
-	// Then we sort it
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
-
-	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
-	// a slice with one or more attestations at that event nonce. There can be multiple attestations
-	// at one event nonce when validators disagree about what event happened at that nonce.
-	for _, nonce := range keys {
-		// This iterates over all attestations at a particular event nonce.
-		// They are ordered by when the first attestation at the event nonce was received.
-		// This order is not important.
-		for _, att := range attmap[nonce] {
-			// We check if the event nonce is exactly 1 higher than the last attestation that was
-			// observed. If it is not, we just move on to the next nonce. This will skip over all
-			// attestations that have already been observed.
-			//
-			// Once we hit an event nonce that is one higher than the last observed event, we stop
-			// skipping over this conditional and start calling tryAttestation (counting votes)
-			// Once an attestation at a given event nonce has enough votes and becomes observed,
-			// every other attestation at that nonce will be skipped, since the lastObservedEventNonce
-			// will be incremented.
-			//
-			// Then we go to the next event nonce in the attestation mapping, if there is one. This
-			// nonce will once again be one higher than the lastObservedEventNonce.
-			// If there is an attestation at this event nonce which has enough votes to be observed,
-			// we skip the other attestations and move on to the next nonce again.
-			// If no attestation becomes observed, when we get to the next nonce, every attestation in
-			// it will be skipped. The same will happen for every nonce after that.
-			if nonce == uint64(k.GetLastObservedEventNonce(ctx, chainId))+1 {
-				k.TryEventVoteRecord(ctx, chainId, att)
+		// There can be multiple attestations at one event nonce when validators disagree about
+		// what event happened at that nonce. Once one of them becomes observed, the rest are
+		// skipped since the lastObservedEventNonce is incremented.
+		for _, att := range k.GetExternalEventVoteRecordsByNonce(ctx, chainId, nonce) {
+			if nonce != k.GetLastObservedEventNonce(ctx, chainId)+1 {
+				break
 			}
+			k.TryEventVoteRecord(ctx, chainId, att)
+		}
+
+		// If no attestation became observed there is nothing to do with the following nonces
+		if k.GetLastObservedEventNonce(ctx, chainId) != nonce {
+			return
 		}
 	}
 }
@@ -2,13 +2,16 @@ package mhub2_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	"github.com/MinterTeam/mhub2/module/x/mhub2"
@@ -236,11 +239,143 @@ func TestSignerSetTxEmission(t *testing.T) {
 	require.EqualValues(t, 2, len(mhub2Keeper.GetSignerSetTxs(ctx, chainId)))
 }
 
+func TestSignerSetTxCreationPowerDiffThreshold(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	mhub2Keeper := input.Mhub2Keeper
+
+	// Store a validator set with a 5% power change as the most recent validator set
+	sstx := mhub2Keeper.CreateSignerSetTx(ctx, chainId)
+	delta := float64(types.ExternalSigners(sstx.Signers).TotalPower()) * 0.05
+	sstx.Signers[0].Power = uint64(float64(sstx.Signers[0].Power) - delta/2)
+	sstx.Signers[1].Power = uint64(float64(sstx.Signers[1].Power) + delta/2)
+	mhub2Keeper.SetOutgoingTx(ctx, chainId, sstx)
+
+	input.SetSignerSetParams(ctx, types.SignerSetParams{ChainId: chainId.String(), PowerDiffThreshold: sdk.NewDecWithPrec(10, 2)})
+	mhub2.BeginBlocker(ctx, mhub2Keeper)
+	require.EqualValues(t, 1, mhub2Keeper.GetLatestSignerSetTxNonce(ctx, chainId))
+
+	// params of another chain don't affect this one
+	input.SetSignerSetParams(ctx, types.SignerSetParams{ChainId: "bsc", PowerDiffThreshold: sdk.NewDecWithPrec(10, 2)})
+	mhub2.BeginBlocker(ctx, mhub2Keeper)
+	require.EqualValues(t, 2, mhub2Keeper.GetLatestSignerSetTxNonce(ctx, chainId))
+}
+
+func TestSignerSetTxCreationMaxAge(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	mhub2Keeper := input.Mhub2Keeper
+	input.SetSignerSetParams(ctx, types.SignerSetParams{ChainId: chainId.String(), PowerDiffThreshold: sdk.NewDecWithPrec(5, 2), MaxSignerSetAge: 10})
+
+	mhub2Keeper.CreateSignerSetTx(ctx, chainId)
+
+	mhub2.BeginBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+9), mhub2Keeper)
+	require.EqualValues(t, 1, mhub2Keeper.GetLatestSignerSetTxNonce(ctx, chainId))
+
+	mhub2.BeginBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+10), mhub2Keeper)
+	require.EqualValues(t, 2, mhub2Keeper.GetLatestSignerSetTxNonce(ctx, chainId))
+}
+
+func TestSignerSetTxCreationMinInterval(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	mhub2Keeper := input.Mhub2Keeper
+	input.SetSignerSetParams(ctx, types.SignerSetParams{ChainId: chainId.String(), PowerDiffThreshold: sdk.NewDecWithPrec(5, 2), MinSignerSetInterval: 10})
+
+	mhub2Keeper.CreateSignerSetTx(ctx, chainId)
+
+	// the power change is held back until the interval passes
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(ctx, stakingtypes.NewMsgDelegate(keeper.AccAddrs[0], keeper.ValAddrs[0], sdk.NewCoin("stake", keeper.StakingAmount)))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	mhub2.BeginBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+8), mhub2Keeper)
+	require.EqualValues(t, 1, mhub2Keeper.GetLatestSignerSetTxNonce(ctx, chainId))
+
+	mhub2.BeginBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+9), mhub2Keeper)
+	require.EqualValues(t, 2, mhub2Keeper.GetLatestSignerSetTxNonce(ctx, chainId))
+
+	// unbonding validators are not held back by the interval
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	_, err = sh(ctx, keeper.NewTestMsgUnDelegateValidator(keeper.ValAddrs[1], keeper.StakingAmount))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	mhub2.BeginBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+1), mhub2Keeper)
+	require.EqualValues(t, 3, mhub2Keeper.GetLatestSignerSetTxNonce(ctx, chainId))
+}
+
+func TestSignerSetTxCreationUponDelegateKeysChange(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	mhub2Keeper := input.Mhub2Keeper
+	msgServer := keeper.NewMsgServerImpl(mhub2Keeper)
+
+	// power changes never trigger a signer set tx
+	input.SetSignerSetParams(ctx, types.SignerSetParams{ChainId: chainId.String(), PowerDiffThreshold: sdk.OneDec()})
+
+	mhub2Keeper.CreateSignerSetTx(ctx, chainId)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	mhub2.BeginBlocker(ctx, mhub2Keeper)
+	require.EqualValues(t, 1, mhub2Keeper.GetLatestSignerSetTxNonce(ctx, chainId))
+
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	signMsgBz := input.Marshaler.MustMarshal(&types.DelegateKeysSignMsg{ValidatorAddress: keeper.ValAddrs[0].String(), Nonce: 0})
+	sig, err := types.NewEthereumSignature(ethCrypto.Keccak256Hash(signMsgBz).Bytes(), ethPrivKey)
+	require.NoError(t, err)
+
+	orchAddr := sdk.AccAddress(ethCrypto.PubkeyToAddress(ethPrivKey.PublicKey).Bytes())
+	ethAddr := ethCrypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgDelegateKeys(keeper.ValAddrs[0], chainId, orchAddr, ethAddr.Hex(), sig))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	mhub2.BeginBlocker(ctx, mhub2Keeper)
+	require.EqualValues(t, 2, mhub2Keeper.GetLatestSignerSetTxNonce(ctx, chainId))
+
+	var signers []string
+	for _, signer := range mhub2Keeper.GetLatestSignerSetTx(ctx, chainId).Signers {
+		signers = append(signers, signer.ExternalAddress)
+	}
+	require.Contains(t, signers, ethAddr.Hex())
+}
+
+func TestSignerSetTxCreationUponSignerPowerCap(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	mhub2Keeper := input.Mhub2Keeper
+
+	// the first validator gets a third of the power
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(ctx, stakingtypes.NewMsgDelegate(keeper.AccAddrs[0], keeper.ValAddrs[0], sdk.NewCoin("stake", keeper.StakingAmount)))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	sstx := mhub2Keeper.CreateSignerSetTx(ctx, chainId)
+	require.Equal(t, keeper.EthAddrs[0].Hex(), sstx.Signers[0].ExternalAddress)
+	require.Greater(t, float64(sstx.Signers[0].Power)/math.MaxUint32, 0.33)
+
+	input.SetSignerSetParams(ctx, types.SignerSetParams{ChainId: chainId.String(), PowerDiffThreshold: sdk.NewDecWithPrec(5, 2), MaxSignerPower: sdk.NewDecWithPrec(25, 2)})
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), mhub2Keeper.GetSignerSetParams(ctx, chainId).MaxSignerPower)
+
+	mhub2.BeginBlocker(ctx, mhub2Keeper)
+	require.EqualValues(t, 2, mhub2Keeper.GetLatestSignerSetTxNonce(ctx, chainId))
+
+	signers := mhub2Keeper.GetLatestSignerSetTx(ctx, chainId).Signers
+	require.EqualValues(t, sdk.NewDecWithPrec(25, 2).MulInt64(math.MaxUint32).TruncateInt64(), signers[0].Power)
+	for _, signer := range signers[1:] {
+		require.InDelta(t, sdk.NewDecWithPrec(1875, 4).MulInt64(math.MaxUint32).TruncateInt64(), signer.Power, 1)
+	}
+}
+
 func TestSignerSetTxSetting(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gk := input.Mhub2Keeper
-	gk.CreateSignerSetTx(ctx, chainId)
+	sstx := gk.CreateSignerSetTx(ctx, chainId)
 	require.EqualValues(t, 1, len(gk.GetSignerSetTxs(ctx, chainId)))
+
+	// five equal validators share the multisig weight equally
+	require.EqualValues(t, types.SignerSetThreshold, sstx.Threshold)
+	require.Equal(t, []uint64{200, 200, 200, 200, 200}, types.ExternalSigners(sstx.Signers).GetWeights())
 }
 
 /// Test batch timeout
@@ -318,6 +453,56 @@ func TestBatchTxTimeout(t *testing.T) {
 	require.NotNil(t, gotThirdBatch)
 }
 
+// BenchmarkEventVoteRecordTally measures the end blocker tally with a long history of
+// already observed events in the store and a single pending event which lacks votes
+func BenchmarkEventVoteRecordTally(b *testing.B) {
+	const historical = 100000
+
+	input, ctx := keeper.SetupFiveValChain(b)
+	mhub2Keeper := input.Mhub2Keeper
+
+	genesis := keeper.ExportGenesis(ctx, mhub2Keeper)
+	for i, state := range genesis.ExternalStates {
+		if state.ChainId != chainId.String() {
+			continue
+		}
+
+		for nonce := uint64(1); nonce <= historical+1; nonce++ {
+			event, err := types.PackEvent(&types.SendToHubEvent{
+				EventNonce:     nonce,
+				ExternalCoinId: keeper.TokenContractAddrs[0],
+				Amount:         sdk.NewInt(100),
+				Sender:         keeper.EthAddrs[0].String(),
+				CosmosReceiver: keeper.AccAddrs[0].String(),
+				ExternalHeight: nonce,
+			})
+			require.NoError(b, err)
+
+			evr := &types.ExternalEventVoteRecord{Event: event, Accepted: true}
+			if nonce > historical {
+				evr.Accepted = false
+				evr.Votes = []string{keeper.ValAddrs[0].String()}
+			}
+			genesis.ExternalStates[i].ExternalEventVoteRecords = append(genesis.ExternalStates[i].ExternalEventVoteRecords, evr)
+		}
+		genesis.ExternalStates[i].LastObservedEventNonce = historical
+	}
+	keeper.InitGenesis(ctx, mhub2Keeper, genesis)
+
+	b.Run("indexed tally", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			mhub2.EndBlocker(ctx, mhub2Keeper)
+		}
+		require.EqualValues(b, historical, mhub2Keeper.GetLastObservedEventNonce(ctx, chainId))
+	})
+
+	b.Run("full vote record mapping", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			mhub2Keeper.GetExternalEventVoteRecordMapping(ctx, chainId)
+		}
+	})
+}
+
 func fundAccount(ctx sdk.Context, bankKeeper types.BankKeeper, addr sdk.AccAddress, amounts sdk.Coins) error {
 	if err := bankKeeper.MintCoins(ctx, types.ModuleName, amounts); err != nil {
 		return err
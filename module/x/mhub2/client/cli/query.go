@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -41,6 +42,15 @@ func GetQueryCmd() *cobra.Command {
 		CmdDelegateKeysByExternalSigner(),
 		CmdDelegateKeysByOrchestrator(),
 		CmdDelegateKeys(),
+		CmdDelegateKeysHistory(),
+		CmdSignerSetParams(),
+		CmdTransferExpiry(),
+		CmdTransferMinimums(),
+		CmdEstimateSendToExternal(),
+		CmdBlocklist(),
+		CmdQuarantinedDeposits(),
+		CmdTimelockedTransfers(),
+		CmdGuardians(),
 	)
 
 	return queryCmd
@@ -745,6 +755,186 @@ func CmdDelegateKeys() *cobra.Command {
 	return cmd
 }
 
+func CmdDelegateKeysHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-history [chain-id] [validator-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query delegate keys rotations of the validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainId, err := parseChainId(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegateKeysHistory(cmd.Context(), &types.DelegateKeysHistoryRequest{
+				ChainId:          chainId,
+				ValidatorAddress: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSignerSetParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set-params [chain-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query signer set tx creation triggers and signer power cap of the chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainId, err := parseChainId(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SignerSetParams(cmd.Context(), &types.SignerSetParamsRequest{
+				ChainId: chainId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdTransferExpiry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-expiry [chain-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query when a pending transfer to the chain is refunded if it is still not batched",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainId, err := parseChainId(args[0])
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id %s not a valid uint, please input a valid id", args[1])
+			}
+
+			res, err := queryClient.TransferExpiry(cmd.Context(), &types.TransferExpiryRequest{
+				ChainId: chainId,
+				Id:      id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdTransferMinimums() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-minimums [chain-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query minimal amount and bridge fee of a transfer of the denom to the chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainId, err := parseChainId(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TransferMinimums(cmd.Context(), &types.TransferMinimumsRequest{
+				ChainId: chainId,
+				Denom:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdEstimateSendToExternal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-send-to-external [chain-id] [sender] [external-recipient] [amount] [bridge-fee]",
+		Args:  cobra.RangeArgs(4, 5),
+		Short: "query the commission, the suggested bridge fees and the received amount of a transfer to the chain, the suggested min fee is used if the bridge fee is omitted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainId, err := parseChainId(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return sdkerrors.Wrap(err, "amount")
+			}
+
+			var bridgeFee sdk.Coin
+			if len(args) == 5 {
+				bridgeFee, err = sdk.ParseCoinNormalized(args[4])
+				if err != nil {
+					return sdkerrors.Wrap(err, "bridge fee")
+				}
+			}
+
+			res, err := queryClient.EstimateSendToExternal(cmd.Context(), &types.EstimateSendToExternalRequest{
+				ChainId:           chainId,
+				Sender:            args[1],
+				ExternalRecipient: args[2],
+				Amount:            amount,
+				BridgeFee:         bridgeFee,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func newContextAndQueryClient(cmd *cobra.Command) (client.Context, types.QueryClient, error) {
 	clientCtx, err := client.GetClientQueryContext(cmd)
 	if err != nil {
@@ -781,3 +971,104 @@ func parseNonce(s string) (uint64, error) {
 	}
 	return nonce, nil
 }
+
+func CmdBlocklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocklist",
+		Args:  cobra.NoArgs,
+		Short: "query addresses blocked by governance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Blocklist(cmd.Context(), &types.BlocklistRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQuarantinedDeposits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantined-deposits",
+		Args:  cobra.NoArgs,
+		Short: "query deposits held in quarantine until governance releases or returns them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QuarantinedDeposits(cmd.Context(), &types.QuarantinedDepositsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdTimelockedTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timelocked-transfers [chain-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query transfers held by the withdrawal timelock and their release heights",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainId, err := parseChainId(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TimelockedTransfers(cmd.Context(), &types.TimelockedTransfersRequest{ChainId: chainId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGuardians() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "guardians",
+		Args:  cobra.NoArgs,
+		Short: "query guardians which are able to cancel timelocked transfers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Guardians(cmd.Context(), &types.GuardiansRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
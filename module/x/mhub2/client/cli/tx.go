@@ -34,6 +34,9 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdCancelSendToExternal(),
 		CmdRequestBatchTx(),
 		CmdSetDelegateKeys(),
+		CmdSetDelegateKeysMulti(),
+		CmdRotateDelegateKeys(),
+		CmdCancelTimelockedTransfer(),
 	)
 
 	return txCmd
@@ -123,6 +126,40 @@ func CmdCancelSendToExternal() *cobra.Command {
 	return cmd
 }
 
+func CmdCancelTimelockedTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-timelocked-transfer [chain-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel and refund a transfer held by the withdrawal timelock, only allowed for guardians",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelTimelockedTransfer(id, types.ChainID(args[0]), from)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdRequestBatchTx() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "request-batch-tx [denom] [signer]",
@@ -201,6 +238,110 @@ the validator's address and operator account current nonce.`,
 	return cmd
 }
 
+func CmdSetDelegateKeysMulti() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-delegate-keys-multi [validator-address] [orchestrator-address] [chain-id:ethereum-address:ethereum-signature]...",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "Set mhub2 delegate keys for several chains at once",
+		Long: fmt.Sprintf(`Set a validator's orchestrator address and per-chain Ethereum addresses in a single
+transaction. Every Ethereum address must sign over a binary Proto-encoded DelegateKeysSignMsg
+message. Either the keys of all the chains are set or none of them.
+
+Example:
+$ %s tx mhub2 set-delegate-keys-multi <validator_address> <orchestrator_address> ethereum:0x033030FEeBd93E3178487c35A9c8cA80874353C9:0x12...AF bsc:0x033030FEeBd93E3178487c35A9c8cA80874353C9:0x12...AF`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			orcAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			var entries []types.DelegateKeysMultiEntry
+			for _, arg := range args[2:] {
+				entry, err := utils.ParseDelegateKeysMultiEntry(arg)
+				if err != nil {
+					return err
+				}
+				entries = append(entries, entry)
+			}
+
+			msg := types.NewMsgDelegateKeysMulti(valAddr, orcAddr, entries)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRotateDelegateKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-delegate-keys [chain-id] [validator-address] [orchestrator-address] [ethereum-address] [ethereum-signature]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Rotate mhub2 delegate keys",
+		Long: `Replace the orchestrator and/or Ethereum address of an already registered validator.
+Pass an empty string to keep the current key. The signature over a binary Proto-encoded
+DelegateKeysSignMsg message is only required when the Ethereum address changes.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainId, err := parseChainId(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			var orcAddr sdk.AccAddress
+			if args[2] != "" {
+				if orcAddr, err = sdk.AccAddressFromBech32(args[2]); err != nil {
+					return err
+				}
+			}
+
+			ethAddr := args[3]
+
+			var ethSig []byte
+			if args[4] != "" {
+				if ethSig, err = hexutil.Decode(args[4]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgRotateDelegateKeys(valAddr, types.ChainID(chainId), orcAddr, ethAddr, ethSig)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewSubmitColdStorageTransferProposalTxCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "cold-storage-transfer [proposal-file]",
@@ -319,3 +460,178 @@ Where proposal.json contains:
 		},
 	}
 }
+
+func NewSubmitBlocklistChangeProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "blocklist-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a blocklist change proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to add addresses to or remove them from the blocklist
+along with an initial deposit. Both hub (bech32) and external (hex) addresses
+are accepted. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal blocklist-change <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "add_addresses": ["0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"],
+  "remove_addresses": [],
+  "deposit": "1000hub"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := utils.ParseBlocklistChangeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewBlocklistChangeProposal(
+				proposal.AddAddresses,
+				proposal.RemoveAddresses,
+			)
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func NewSubmitQuarantinedDepositProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "quarantined-deposit [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to release or return a quarantined deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to resolve a quarantined deposit along with an initial deposit.
+"release" delivers the funds to the original receiver, "return" sends them back
+to the sender on the origin chain. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal quarantined-deposit <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "deposit_id": "1",
+  "resolution": "return",
+  "deposit": "1000hub"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := utils.ParseQuarantinedDepositProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			resolution, err := utils.ParseQuarantineResolution(proposal.Resolution)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewQuarantinedDepositProposal(
+				proposal.DepositId,
+				resolution,
+			)
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func NewSubmitGuardianSetChangeProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "guardian-set-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a guardian set change proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to replace the guardian set along with an initial deposit.
+Guardians are able to cancel transfers held by the withdrawal timelock.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal guardian-set-change <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "guardians": ["<guardian_bech32_address>"],
+  "deposit": "1000hub"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := utils.ParseGuardianSetChangeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewGuardianSetChangeProposal(proposal.Guardians)
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
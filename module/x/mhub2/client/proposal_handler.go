@@ -9,3 +9,6 @@ import (
 // ProposalColdStorageHandler is the param change proposal handler.
 var ProposalColdStorageHandler = govclient.NewProposalHandler(cli.NewSubmitColdStorageTransferProposalTxCmd, rest.ColdStorageTransferProposalRESTHandler)
 var ProposalTokensChangeHandler = govclient.NewProposalHandler(cli.NewSubmitTokenInfosChangeProposalTxCmd, rest.TokenInfosChangeProposalRESTHandler)
+var ProposalBlocklistChangeHandler = govclient.NewProposalHandler(cli.NewSubmitBlocklistChangeProposalTxCmd, rest.BlocklistChangeProposalRESTHandler)
+var ProposalQuarantinedDepositHandler = govclient.NewProposalHandler(cli.NewSubmitQuarantinedDepositProposalTxCmd, rest.QuarantinedDepositProposalRESTHandler)
+var ProposalGuardianSetChangeHandler = govclient.NewProposalHandler(cli.NewSubmitGuardianSetChangeProposalTxCmd, rest.GuardianSetChangeProposalRESTHandler)
@@ -81,3 +81,113 @@ func postProposalTokenInfosChangeHandlerFn(clientCtx client.Context) http.Handle
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+// BlocklistChangeProposalRESTHandler returns a ProposalRESTHandler that exposes the blocklist
+// change REST handler with a given sub-route.
+func BlocklistChangeProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "blocklist_change",
+		Handler:  postProposalBlocklistChangeHandlerFn(clientCtx),
+	}
+}
+
+func postProposalBlocklistChangeHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req utils.BlocklistChangeProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewBlocklistChangeProposal(req.AddAddresses, req.RemoveAddresses)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// QuarantinedDepositProposalRESTHandler returns a ProposalRESTHandler that exposes the
+// quarantined deposit REST handler with a given sub-route.
+func QuarantinedDepositProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "quarantined_deposit",
+		Handler:  postProposalQuarantinedDepositHandlerFn(clientCtx),
+	}
+}
+
+func postProposalQuarantinedDepositHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req utils.QuarantinedDepositProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		resolution, err := utils.ParseQuarantineResolution(req.Resolution)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewQuarantinedDepositProposal(req.DepositId, resolution)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// GuardianSetChangeProposalRESTHandler returns a ProposalRESTHandler that exposes the guardian
+// set change REST handler with a given sub-route.
+func GuardianSetChangeProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "guardian_set_change",
+		Handler:  postProposalGuardianSetChangeHandlerFn(clientCtx),
+	}
+}
+
+func postProposalGuardianSetChangeHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req utils.GuardianSetChangeProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewGuardianSetChangeProposal(req.Guardians)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
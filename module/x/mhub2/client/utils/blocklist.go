@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+type (
+	// BlocklistChangeProposalJSON defines a BlocklistChangeProposal with a deposit used
+	// to parse blocklist change proposals from a JSON file.
+	BlocklistChangeProposalJSON struct {
+		AddAddresses    []string `json:"add_addresses" yaml:"add_addresses"`
+		RemoveAddresses []string `json:"remove_addresses" yaml:"remove_addresses"`
+		Deposit         string   `json:"deposit" yaml:"deposit"`
+	}
+
+	// BlocklistChangeProposalReq defines a blocklist change proposal request body.
+	BlocklistChangeProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		AddAddresses    []string       `json:"add_addresses" yaml:"add_addresses"`
+		RemoveAddresses []string       `json:"remove_addresses" yaml:"remove_addresses"`
+		Proposer        sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit         sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// QuarantinedDepositProposalJSON defines a QuarantinedDepositProposal with a deposit used
+	// to parse quarantined deposit proposals from a JSON file.
+	QuarantinedDepositProposalJSON struct {
+		DepositId  uint64 `json:"deposit_id" yaml:"deposit_id"`
+		Resolution string `json:"resolution" yaml:"resolution"`
+		Deposit    string `json:"deposit" yaml:"deposit"`
+	}
+
+	// QuarantinedDepositProposalReq defines a quarantined deposit proposal request body.
+	QuarantinedDepositProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		DepositId  uint64         `json:"deposit_id" yaml:"deposit_id"`
+		Resolution string         `json:"resolution" yaml:"resolution"`
+		Proposer   sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit    sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseQuarantineResolution converts "release" or "return" to a QuarantineResolution.
+func ParseQuarantineResolution(resolution string) (types.QuarantineResolution, error) {
+	switch strings.ToLower(resolution) {
+	case "release":
+		return types.QUARANTINE_RESOLUTION_RELEASE, nil
+	case "return":
+		return types.QUARANTINE_RESOLUTION_RETURN, nil
+	}
+
+	return types.QUARANTINE_RESOLUTION_UNSPECIFIED, fmt.Errorf("unknown resolution %q: should be release or return", resolution)
+}
+
+// ParseBlocklistChangeProposalJSON reads and parses a BlocklistChangeProposalJSON from
+// file.
+func ParseBlocklistChangeProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (BlocklistChangeProposalJSON, error) {
+	proposal := BlocklistChangeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseQuarantinedDepositProposalJSON reads and parses a QuarantinedDepositProposalJSON from
+// file.
+func ParseQuarantinedDepositProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (QuarantinedDepositProposalJSON, error) {
+	proposal := QuarantinedDepositProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
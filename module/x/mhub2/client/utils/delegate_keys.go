@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
+)
+
+// ParseDelegateKeysMultiEntry converts a "<chain-id>:<external-address>:<eth-signature>" triple to a
+// DelegateKeysMultiEntry.
+func ParseDelegateKeysMultiEntry(s string) (types.DelegateKeysMultiEntry, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return types.DelegateKeysMultiEntry{}, fmt.Errorf("invalid delegate keys %q: should be <chain-id>:<external-address>:<eth-signature>", s)
+	}
+
+	if !common.IsHexAddress(parts[1]) {
+		return types.DelegateKeysMultiEntry{}, fmt.Errorf("invalid external address %q of chain %s", parts[1], parts[0])
+	}
+
+	ethSig, err := hexutil.Decode(parts[2])
+	if err != nil {
+		return types.DelegateKeysMultiEntry{}, fmt.Errorf("invalid signature of chain %s: %w", parts[0], err)
+	}
+
+	return types.DelegateKeysMultiEntry{
+		ChainId:         parts[0],
+		ExternalAddress: parts[1],
+		EthSignature:    ethSig,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+type (
+	// GuardianSetChangeProposalJSON defines a GuardianSetChangeProposal with a deposit used
+	// to parse guardian set change proposals from a JSON file.
+	GuardianSetChangeProposalJSON struct {
+		Guardians []string `json:"guardians" yaml:"guardians"`
+		Deposit   string   `json:"deposit" yaml:"deposit"`
+	}
+
+	// GuardianSetChangeProposalReq defines a guardian set change proposal request body.
+	GuardianSetChangeProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Guardians []string       `json:"guardians" yaml:"guardians"`
+		Proposer  sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit   sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseGuardianSetChangeProposalJSON reads and parses a GuardianSetChangeProposalJSON from
+// file.
+func ParseGuardianSetChangeProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (GuardianSetChangeProposalJSON, error) {
+	proposal := GuardianSetChangeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -41,6 +41,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgDelegateKeysMulti:
+			res, err := msgServer.SetDelegateKeysMulti(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRotateDelegateKeys:
+			res, err := msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCancelTimelockedTransfer:
+			res, err := msgServer.CancelTimelockedTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -55,6 +67,12 @@ func NewProposalsHandler(k keeper.Keeper) govtypes.Handler {
 		case *types.TokenInfosChangeProposal:
 			k.SetTokenInfos(ctx, c.NewInfos)
 			return nil
+		case *types.BlocklistChangeProposal:
+			return k.ChangeBlocklist(ctx, c)
+		case *types.QuarantinedDepositProposal:
+			return k.ResolveQuarantinedDeposit(ctx, c)
+		case *types.GuardianSetChangeProposal:
+			return k.SetGuardians(ctx, c.Guardians)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized proposal content type: %T", c)
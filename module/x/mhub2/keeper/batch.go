@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
@@ -31,7 +33,7 @@ func (k Keeper) BuildBatchTx(ctx sdk.Context, chainId types.ChainID, externalTok
 	var selectedStes []*types.SendToExternal
 	k.iterateUnbatchedSendToExternalsByCoin(ctx, chainId, externalTokenId, func(ste *types.SendToExternal) bool {
 		selectedStes = append(selectedStes, ste)
-		k.deleteUnbatchedSendToExternal(ctx, chainId, ste.Id, ste.Fee)
+		k.deleteUnbatchedSendToExternal(ctx, chainId, ste)
 		k.SetTxStatus(ctx, ste.TxHash, types.TX_STATUS_BATCH_CREATED, "")
 		return len(selectedStes) == maxElements
 	})
@@ -95,14 +97,17 @@ func (k Keeper) batchTxExecuted(ctx sdk.Context, chainId types.ChainID, external
 	}
 	batchTx, _ := otx.(*types.BatchTx)
 	if chainId != "minter" {
-		k.IterateOutgoingTxsByType(ctx, chainId, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
-			// If the iterated batches nonce is lower than the one that was just executed, cancel it
-			btx, _ := otx.(*types.BatchTx)
-			if (btx.BatchNonce < batchTx.BatchNonce) && (btx.ExternalTokenId == batchTx.ExternalTokenId) {
-				k.CancelBatchTx(ctx, chainId, btx.ExternalTokenId, btx.BatchNonce)
+		// Cancel all batches of the same token with a nonce lower than the one that was just executed
+		var lowerNonces []uint64
+		k.iterateBatchTxNoncesByTokenType(ctx, chainId, batchTx.ExternalTokenId, func(nonce uint64) bool {
+			if nonce < batchTx.BatchNonce {
+				lowerNonces = append(lowerNonces, nonce)
 			}
 			return false
 		})
+		for _, lowerNonce := range lowerNonces {
+			k.CancelBatchTx(ctx, chainId, batchTx.ExternalTokenId, lowerNonce)
+		}
 	}
 	k.DeleteOutgoingTx(ctx, chainId, batchTx.GetStoreIndex(chainId))
 
@@ -151,25 +156,24 @@ func (k Keeper) batchTxExecuted(ctx sdk.Context, chainId types.ChainID, external
 	}
 
 	if totalFee.IsPositive() {
-		var externalBaseCoin string
-
-		switch chainId {
-		case "ethereum":
-			externalBaseCoin = "eth"
-		case "bsc":
-			externalBaseCoin = "bnb"
-		default:
+		// the fees are paid only on the chains the oracle has the gas estimates of
+		externalBaseCoin, err := k.oracleKeeper.GetBaseCoinFeed(ctx, chainId.String())
+		if err != nil {
 			return
 		}
 
-		amount := feePaid.ToDec().
-			Mul(k.oracleKeeper.MustGetTokenPrice(ctx, externalBaseCoin)).
-			Quo(k.oracleKeeper.MustGetTokenPrice(ctx, tokenInfo.Denom)).
-			MulInt64(150).
-			QuoInt64(100).
-			TruncateInt()
-		fee := sdk.NewCoin(tokenInfo.Denom, amount)
-		if fee.IsGTE(totalFee) {
+		// without the prices the paid fee can not be valued, so the whole fee of the batch is refunded to the users
+		fee := sdk.NewInt64Coin(tokenInfo.Denom, 0)
+		if amount, err := k.convertFeePaid(ctx, externalBaseCoin, tokenInfo.Denom, feePaid); err != nil {
+			k.Logger(ctx).Error(
+				"batch fee paid can not be valued, refunding the whole batch fee",
+				"cause", err.Error(),
+				"chain id", chainId,
+				"nonce", fmt.Sprint(nonce),
+			)
+		} else if amount.LT(totalFee.Amount) {
+			fee = sdk.NewCoin(tokenInfo.Denom, amount)
+		} else {
 			fee = totalFee
 		}
 
@@ -185,52 +189,70 @@ func (k Keeper) batchTxExecuted(ctx sdk.Context, chainId types.ChainID, external
 			if err != nil {
 				panic(err)
 			}
+		}
 
-			feeLeft := totalFee.Sub(fee)
-			if feeLeft.IsPositive() {
-				if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{feeLeft}); err != nil {
-					panic(sdkerrors.Wrapf(err, "mint vouchers coins: %s", sdk.Coins{feeLeft}))
-				}
-				if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, types.TempAddress, sdk.Coins{feeLeft}); err != nil {
-					panic(err)
+		feeLeft := totalFee.Sub(fee)
+		if feeLeft.IsPositive() {
+			if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{feeLeft}); err != nil {
+				panic(sdkerrors.Wrapf(err, "mint vouchers coins: %s", sdk.Coins{feeLeft}))
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, types.TempAddress, sdk.Coins{feeLeft}); err != nil {
+				panic(err)
+			}
+
+			averageFeePaid := fee.Amount.QuoRaw(int64(len(batchTx.Transactions)))
+			totalGoodFeePaid := sdk.NewInt(0)
+			for _, tx := range batchTx.Transactions {
+				convertedTxFee := k.ConvertFromExternalValue(ctx, chainId, tokenInfo.ExternalTokenId, tx.Fee.Amount)
+				if convertedTxFee.GTE(averageFeePaid) {
+					totalGoodFeePaid = totalGoodFeePaid.Add(convertedTxFee)
 				}
+			}
 
-				averageFeePaid := fee.Amount.QuoRaw(int64(len(batchTx.Transactions)))
-				totalGoodFeePaid := sdk.NewInt(0)
-				for _, tx := range batchTx.Transactions {
-					convertedTxFee := k.ConvertFromExternalValue(ctx, chainId, tokenInfo.ExternalTokenId, tx.Fee.Amount)
-					if convertedTxFee.GTE(averageFeePaid) {
-						totalGoodFeePaid = totalGoodFeePaid.Add(convertedTxFee)
-					}
+			for _, tx := range batchTx.Transactions {
+				convertedTxFee := k.ConvertFromExternalValue(ctx, chainId, tokenInfo.ExternalTokenId, tx.Fee.Amount)
+				if convertedTxFee.LT(averageFeePaid) {
+					continue
 				}
 
-				for _, tx := range batchTx.Transactions {
-					convertedTxFee := k.ConvertFromExternalValue(ctx, chainId, tokenInfo.ExternalTokenId, tx.Fee.Amount)
-					if convertedTxFee.LT(averageFeePaid) {
-						continue
-					}
+				toRefund := feeLeft.Amount.Mul(convertedTxFee).Quo(totalGoodFeePaid)
+				if tx.RefundChainId != "minter" { // we only can refund fee to minter
+					continue
+				}
 
-					toRefund := feeLeft.Amount.Mul(convertedTxFee).Quo(totalGoodFeePaid)
-					if tx.RefundChainId != "minter" { // we only can refund fee to minter
-						continue
+				if toRefund.IsPositive() {
+					_, err = k.createSendToExternal(ctx, "minter", types.TempAddress, tx.RefundAddress, sdk.NewCoin(fee.Denom, toRefund), sdk.NewInt64Coin(fee.Denom, 0), sdk.NewInt64Coin(fee.Denom, 0), "#fee", "", "")
+					if err != nil {
+						panic(err)
 					}
 
-					if toRefund.IsPositive() {
-						_, err = k.createSendToExternal(ctx, "minter", types.TempAddress, tx.RefundAddress, sdk.NewCoin(fee.Denom, toRefund), sdk.NewInt64Coin(fee.Denom, 0), sdk.NewInt64Coin(fee.Denom, 0), "#fee", "", "")
-						if err != nil {
-							panic(err)
-						}
-
-						record := k.GetTxFeeRecord(ctx, tx.TxHash)
-						record.ExternalFee = record.ExternalFee.Sub(toRefund)
-						k.SetTxFeeRecord(ctx, tx.TxHash, *record)
-					}
+					record := k.GetTxFeeRecord(ctx, tx.TxHash)
+					record.ExternalFee = record.ExternalFee.Sub(toRefund)
+					k.SetTxFeeRecord(ctx, tx.TxHash, *record)
 				}
 			}
 		}
 	}
 }
 
+// convertFeePaid values the fee paid in the base coin of the external chain in the denom, with a 50% markup
+func (k Keeper) convertFeePaid(ctx sdk.Context, baseCoin string, denom string, feePaid sdk.Int) (sdk.Int, error) {
+	basePrice, err := k.getFeePrice(ctx, baseCoin)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	price, err := k.getFeePrice(ctx, denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if !price.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "price of %s is not positive", denom)
+	}
+
+	return feePaid.ToDec().Mul(basePrice).Quo(price).MulInt64(150).QuoInt64(100).TruncateInt(), nil
+}
+
 // getBatchFeesByTokenType gets the fees the next batch of a given token type would
 // have if created. This info is both presented to relayers for the purpose of determining
 // when to request batches and also used by the batch creation process to decide not to create
@@ -291,18 +313,29 @@ func (k Keeper) CancelBatchTx(ctx sdk.Context, chainId types.ChainID, externalTo
 // getLastOutgoingBatchByTokenType gets the latest outgoing tx batch by token type
 func (k Keeper) getLastOutgoingBatchByTokenType(ctx sdk.Context, chainId types.ChainID, externalTokenId string) *types.BatchTx {
 	var lastBatch *types.BatchTx = nil
-	lastNonce := uint64(0)
-	k.IterateOutgoingTxsByType(ctx, chainId, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
-		btx, _ := otx.(*types.BatchTx)
-		if btx.ExternalTokenId == externalTokenId && btx.BatchNonce > lastNonce {
-			lastBatch = btx
-			lastNonce = btx.BatchNonce
-		}
-		return false
+	k.iterateBatchTxNoncesByTokenType(ctx, chainId, externalTokenId, func(nonce uint64) bool {
+		lastBatch, _ = k.GetOutgoingTx(ctx, chainId, types.MakeBatchTxKey(chainId, externalTokenId, nonce)).(*types.BatchTx)
+		return true
 	})
 	return lastBatch
 }
 
+// iterateBatchTxNoncesByTokenType iterates over the nonces of the stored batches of the given token, highest first
+func (k Keeper) iterateBatchTxNoncesByTokenType(ctx sdk.Context, chainId types.ChainID, externalTokenId string, cb func(nonce uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), bytes.Join([][]byte{{types.BatchTxByTokenKey}, chainId.Bytes(), []byte(externalTokenId)}, []byte{}))
+	iter := prefixStore.ReverseIterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// token ids are not fixed length, skip the keys of tokens which have this id as a prefix
+		if len(iter.Key()) != 8 {
+			continue
+		}
+		if cb(binary.BigEndian.Uint64(iter.Key())) {
+			break
+		}
+	}
+}
+
 // SetLastSlashedOutgoingTxBlockHeight sets the latest slashed Batch block height
 func (k Keeper) SetLastSlashedOutgoingTxBlockHeight(ctx sdk.Context, chainId types.ChainID, blockHeight uint64) {
 	ctx.KVStore(k.storeKey).Set(append([]byte{types.LastSlashedOutgoingTxBlockKey}, chainId.Bytes()...), sdk.Uint64ToBigEndian(blockHeight))
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -56,8 +57,8 @@ func TestBatches(t *testing.T) {
 	expFirstBatch := &types.BatchTx{
 		BatchNonce: 1,
 		Transactions: []*types.SendToExternal{
-			types.NewSendToExternalTx(2, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 101, 3, 0, "#", 1),
-			types.NewSendToExternalTx(3, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 102, 2, 0, "#", 1),
+			types.NewSendToExternalTx(2, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 101, 3, 0, "#", 1, 61),
+			types.NewSendToExternalTx(3, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 102, 2, 0, "#", 1, 61),
 		},
 		ExternalTokenId: myTokenContractAddr,
 		Height:          1234567,
@@ -73,8 +74,8 @@ func TestBatches(t *testing.T) {
 		return false
 	})
 	expUnbatchedTx := []*types.SendToExternal{
-		types.NewSendToExternalTx(1, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 100, 2, 0, "#", 1),
-		types.NewSendToExternalTx(4, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 103, 1, 0, "#", 1),
+		types.NewSendToExternalTx(1, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 100, 2, 0, "#", 1, 61),
+		types.NewSendToExternalTx(4, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 103, 1, 0, "#", 1, 61),
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
 
@@ -93,8 +94,8 @@ func TestBatches(t *testing.T) {
 	expSecondBatch := &types.BatchTx{
 		BatchNonce: 2,
 		Transactions: []*types.SendToExternal{
-			types.NewSendToExternalTx(6, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 101, 5, 0, "#", 1),
-			types.NewSendToExternalTx(5, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 100, 4, 0, "#", 1),
+			types.NewSendToExternalTx(6, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 101, 5, 0, "#", 1, 61),
+			types.NewSendToExternalTx(5, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 100, 4, 0, "#", 1, 61),
 		},
 		ExternalTokenId: myTokenContractAddr,
 		Height:          1234567,
@@ -120,10 +121,10 @@ func TestBatches(t *testing.T) {
 		return false
 	})
 	expUnbatchedTx = []*types.SendToExternal{
-		types.NewSendToExternalTx(2, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 101, 3, 0, "#", 1),
-		types.NewSendToExternalTx(3, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 102, 2, 0, "#", 1),
-		types.NewSendToExternalTx(1, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 100, 2, 0, "#", 1),
-		types.NewSendToExternalTx(4, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 103, 1, 0, "#", 1),
+		types.NewSendToExternalTx(2, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 101, 3, 0, "#", 1, 61),
+		types.NewSendToExternalTx(3, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 102, 2, 0, "#", 1, 61),
+		types.NewSendToExternalTx(1, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 100, 2, 0, "#", 1, 61),
+		types.NewSendToExternalTx(4, chainId, tokenId, myTokenContractAddr, mySender, myReceiver, 103, 1, 0, "#", 1, 61),
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
 }
@@ -188,6 +189,7 @@ func TestBatchesFullCoins(t *testing.T) {
 				ExternalRecipient: myReceiver.Hex(),
 				Token:             types.NewSDKIntExternalToken(oneEth.Mul(sdk.NewIntFromUint64(300)), tokenId, myTokenContractAddr),
 				ChainId:           chainId.String(),
+				ExpiresAt:         60,
 				ValCommission:     types.NewSDKIntExternalToken(sdk.NewInt(0), tokenId, myTokenContractAddr),
 			},
 			{
@@ -197,6 +199,7 @@ func TestBatchesFullCoins(t *testing.T) {
 				ExternalRecipient: myReceiver.Hex(),
 				Token:             types.NewSDKIntExternalToken(oneEth.Mul(sdk.NewIntFromUint64(25)), tokenId, myTokenContractAddr),
 				ChainId:           chainId.String(),
+				ExpiresAt:         60,
 				ValCommission:     types.NewSDKIntExternalToken(sdk.NewInt(0), tokenId, myTokenContractAddr),
 			},
 		},
@@ -218,6 +221,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			ExternalRecipient: myReceiver.Hex(),
 			ChainId:           chainId.String(),
+			ExpiresAt:         60,
 			Token:             types.NewSDKIntExternalToken(oneEth.Mul(sdk.NewIntFromUint64(20)), tokenId, myTokenContractAddr),
 			Fee:               types.NewSDKIntExternalToken(oneEth.Mul(sdk.NewIntFromUint64(20)), tokenId, myTokenContractAddr),
 			TxHash:            "",
@@ -230,6 +234,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			ExternalRecipient: myReceiver.Hex(),
 			Token:             types.NewSDKIntExternalToken(oneEth.Mul(sdk.NewIntFromUint64(10)), tokenId, myTokenContractAddr),
 			ChainId:           chainId.String(),
+			ExpiresAt:         60,
 			TxHash:            "",
 			ValCommission:     types.NewSDKIntExternalToken(sdk.NewInt(0), tokenId, myTokenContractAddr),
 		},
@@ -266,6 +271,7 @@ func TestBatchesFullCoins(t *testing.T) {
 				ExternalRecipient: myReceiver.Hex(),
 				Token:             types.NewSDKIntExternalToken(oneEth.Mul(sdk.NewIntFromUint64(20)), tokenId, myTokenContractAddr),
 				ChainId:           chainId.String(),
+				ExpiresAt:         60,
 				TxHash:            "",
 				ValCommission:     types.NewSDKIntExternalToken(sdk.NewInt(0), tokenId, myTokenContractAddr),
 			},
@@ -276,6 +282,7 @@ func TestBatchesFullCoins(t *testing.T) {
 				ExternalRecipient: myReceiver.Hex(),
 				Token:             types.NewSDKIntExternalToken(oneEth.Mul(sdk.NewIntFromUint64(10)), tokenId, myTokenContractAddr),
 				ChainId:           chainId.String(),
+				ExpiresAt:         60,
 				TxHash:            "",
 				ValCommission:     types.NewSDKIntExternalToken(sdk.NewInt(0), tokenId, myTokenContractAddr),
 			},
@@ -311,6 +318,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			ExternalRecipient: myReceiver.Hex(),
 			Token:             types.NewSDKIntExternalToken(oneEth.Mul(sdk.NewIntFromUint64(300)), tokenId, myTokenContractAddr),
 			ChainId:           chainId.String(),
+			ExpiresAt:         60,
 			TxHash:            "",
 			ValCommission:     types.NewSDKIntExternalToken(sdk.NewInt(0), tokenId, myTokenContractAddr),
 		},
@@ -321,6 +329,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			ExternalRecipient: myReceiver.Hex(),
 			Token:             types.NewSDKIntExternalToken(oneEth.Mul(sdk.NewIntFromUint64(25)), tokenId, myTokenContractAddr),
 			ChainId:           chainId.String(),
+			ExpiresAt:         60,
 			TxHash:            "",
 			ValCommission:     types.NewSDKIntExternalToken(sdk.NewInt(0), tokenId, myTokenContractAddr),
 		},
@@ -331,6 +340,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			ExternalRecipient: myReceiver.Hex(),
 			Token:             types.NewSDKIntExternalToken(oneEth.Mul(sdk.NewIntFromUint64(5)), tokenId, myTokenContractAddr),
 			ChainId:           chainId.String(),
+			ExpiresAt:         60,
 			TxHash:            "",
 			ValCommission:     types.NewSDKIntExternalToken(sdk.NewInt(0), tokenId, myTokenContractAddr),
 		},
@@ -341,6 +351,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			ExternalRecipient: myReceiver.Hex(),
 			Token:             types.NewSDKIntExternalToken(oneEth.Mul(sdk.NewIntFromUint64(4)), tokenId, myTokenContractAddr),
 			ChainId:           chainId.String(),
+			ExpiresAt:         60,
 			TxHash:            "",
 			ValCommission:     types.NewSDKIntExternalToken(sdk.NewInt(0), tokenId, myTokenContractAddr),
 		},
@@ -400,3 +411,127 @@ func TestPoolTxRefund(t *testing.T) {
 	balances := input.BankKeeper.GetAllBalances(ctx, mySender)
 	require.Equal(t, sdk.NewInt(104), balances.AmountOf(myDenom))
 }
+
+func TestBatchTxNoncesByTokenType(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+
+	// token "1" is a prefix of token "12", their batches must not be mixed up
+	for _, batch := range []struct {
+		token string
+		nonce uint64
+	}{{"1", 1}, {"12", 2}, {"1", 3}, {"12", 4}, {"1", 5}, {"12", 6}} {
+		k.SetOutgoingTx(ctx, chainId, &types.BatchTx{BatchNonce: batch.nonce, ExternalTokenId: batch.token})
+	}
+
+	require.EqualValues(t, 5, k.getLastOutgoingBatchByTokenType(ctx, chainId, "1").BatchNonce)
+	require.EqualValues(t, 6, k.getLastOutgoingBatchByTokenType(ctx, chainId, "12").BatchNonce)
+	require.Nil(t, k.getLastOutgoingBatchByTokenType(ctx, chainId, "2"))
+
+	k.DeleteOutgoingTx(ctx, chainId, types.MakeBatchTxKey(chainId, "1", 5))
+
+	var nonces []uint64
+	k.iterateBatchTxNoncesByTokenType(ctx, chainId, "1", func(nonce uint64) bool {
+		nonces = append(nonces, nonce)
+		return false
+	})
+	require.Equal(t, []uint64{3, 1}, nonces)
+	require.EqualValues(t, 3, k.getLastOutgoingBatchByTokenType(ctx, chainId, "1").BatchNonce)
+}
+
+func BenchmarkLastOutgoingBatchByTokenType(b *testing.B) {
+	const (
+		tokens          = 100
+		batchesPerToken = 100
+	)
+
+	input := CreateTestEnv(b)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+
+	nonce := uint64(0)
+	for i := 0; i < batchesPerToken; i++ {
+		for token := 0; token < tokens; token++ {
+			nonce++
+			k.SetOutgoingTx(ctx, chainId, &types.BatchTx{BatchNonce: nonce, ExternalTokenId: fmt.Sprintf("token%03d", token)})
+		}
+	}
+
+	b.Run(fmt.Sprintf("indexed %d batches", nonce), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			require.NotNil(b, k.getLastOutgoingBatchByTokenType(ctx, chainId, "token050"))
+		}
+	})
+
+	b.Run(fmt.Sprintf("full scan %d batches", nonce), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var lastBatch *types.BatchTx
+			k.IterateOutgoingTxsByType(ctx, chainId, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
+				btx, _ := otx.(*types.BatchTx)
+				if btx.ExternalTokenId == "token050" && (lastBatch == nil || btx.BatchNonce > lastBatch.BatchNonce) {
+					lastBatch = btx
+				}
+				return false
+			})
+			require.NotNil(b, lastBatch)
+		}
+	})
+}
+
+// stalePricesOracleKeeper has no valid prices, as when the oracle prices are outdated
+type stalePricesOracleKeeper struct {
+	MockOracleKeeper
+}
+
+func (stalePricesOracleKeeper) GetTokenPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	return sdk.Dec{}, fmt.Errorf("price of %s is outdated", denom)
+}
+
+func (stalePricesOracleKeeper) TWAP(ctx sdk.Context, denom string, window uint64) (sdk.Dec, error) {
+	return sdk.Dec{}, fmt.Errorf("price of %s is outdated", denom)
+}
+
+func TestBatchTxExecutedFee(t *testing.T) {
+	specs := map[string]struct {
+		oracleKeeper types.OracleKeeper
+		exp          map[string]int64
+	}{
+		"fee payer is paid the fee paid with a markup and the rest is refunded": {
+			oracleKeeper: MockOracleKeeper{},
+			exp:          map[string]int64{"Mxpayer": 7, "Mx01": 8, "Mx02": 24},
+		},
+		"whole fee is refunded without the prices": {
+			oracleKeeper: stalePricesOracleKeeper{},
+			exp:          map[string]int64{"Mx01": 10, "Mx02": 30},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			input := CreateTestEnv(t)
+			ctx := input.Context
+			k := input.Mhub2Keeper
+			k.oracleKeeper = spec.oracleKeeper
+
+			tokenInfo, err := k.DenomToTokenInfoLookup(ctx, chainId, "eth")
+			require.NoError(t, err)
+
+			var txs []*types.SendToExternal
+			for i, fee := range []uint64{10, 30} {
+				tx := types.NewSendToExternalTx(uint64(i+1), chainId, tokenInfo.Id, tokenInfo.ExternalTokenId, AccAddrs[0], EthAddrs[1], 100, fee, 0, fmt.Sprintf("#%d", i), 1, 61)
+				tx.RefundChainId = "minter"
+				tx.RefundAddress = fmt.Sprintf("Mx0%d", i+1)
+				txs = append(txs, tx)
+			}
+			k.SetOutgoingTx(ctx, chainId, &types.BatchTx{BatchNonce: 1, ExternalTokenId: tokenInfo.ExternalTokenId, Transactions: txs})
+
+			k.batchTxExecuted(ctx, chainId, tokenInfo.ExternalTokenId, 1, "0x01", sdk.NewInt(5), "Mxpayer")
+
+			paid := map[string]int64{}
+			for _, tx := range k.getUnbatchedSendToExternals(ctx, "minter") {
+				paid[tx.ExternalRecipient] += tx.Token.Amount.Int64()
+			}
+			require.Equal(t, spec.exp, paid)
+		})
+	}
+}
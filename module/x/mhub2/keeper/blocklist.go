@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
+)
+
+// IsAddressBlocked reports whether the hub or external address is on the blocklist
+func (k Keeper) IsAddressBlocked(ctx sdk.Context, address string) bool {
+	normalized, err := types.NormalizeBlocklistAddress(address)
+	if err != nil {
+		return false
+	}
+
+	return ctx.KVStore(k.storeKey).Has(types.MakeBlockedAddressKey(normalized))
+}
+
+func (k Keeper) setAddressBlocked(ctx sdk.Context, address string, blocked bool) error {
+	normalized, err := types.NormalizeBlocklistAddress(address)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if blocked {
+		store.Set(types.MakeBlockedAddressKey(normalized), []byte{1})
+	} else {
+		store.Delete(types.MakeBlockedAddressKey(normalized))
+	}
+
+	return nil
+}
+
+// GetBlocklist returns all blocked addresses in their normalized form
+func (k Keeper) GetBlocklist(ctx sdk.Context) []string {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.BlockedAddressKey})
+	defer iter.Close()
+
+	var addresses []string
+	for ; iter.Valid(); iter.Next() {
+		addresses = append(addresses, string(iter.Key()[1:]))
+	}
+
+	return addresses
+}
+
+// ChangeBlocklist applies a BlocklistChangeProposal. Removals are applied after additions.
+func (k Keeper) ChangeBlocklist(ctx sdk.Context, p *types.BlocklistChangeProposal) error {
+	for _, address := range p.AddAddresses {
+		if err := k.setAddressBlocked(ctx, address, true); err != nil {
+			return err
+		}
+	}
+
+	for _, address := range p.RemoveAddresses {
+		if err := k.setAddressBlocked(ctx, address, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// quarantineDeposit mints the deposit amount to the quarantine account instead of delivering it
+// and keeps the record until governance resolves it
+func (k Keeper) quarantineDeposit(ctx sdk.Context, deposit types.QuarantinedDeposit) error {
+	coins := sdk.Coins{deposit.Amount}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.QuarantineAccountName, coins); err != nil {
+		return err
+	}
+
+	deposit.Id = k.incrementLastQuarantinedDepositID(ctx)
+	k.setQuarantinedDeposit(ctx, deposit)
+	k.SetTxStatus(ctx, deposit.TxHash, types.TX_STATUS_QUARANTINED, "")
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDepositQuarantined,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyQuarantinedDepositID, fmt.Sprint(deposit.Id)),
+	))
+
+	return nil
+}
+
+// ResolveQuarantinedDeposit applies a QuarantinedDepositProposal. Released funds go to the original receiver,
+// returned funds go back to the sender on the origin chain. Both go without fee and commission.
+func (k Keeper) ResolveQuarantinedDeposit(ctx sdk.Context, p *types.QuarantinedDepositProposal) error {
+	deposit, found := k.GetQuarantinedDeposit(ctx, p.DepositId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "quarantined deposit %d", p.DepositId)
+	}
+
+	coins := sdk.Coins{deposit.Amount}
+	zero := sdk.NewInt64Coin(deposit.Amount.Denom, 0)
+
+	switch p.Resolution {
+	case types.QUARANTINE_RESOLUTION_RELEASE:
+		if deposit.ReceiverChainId == "hub" {
+			receiver, err := sdk.AccAddressFromBech32(deposit.Receiver)
+			if err != nil {
+				return err
+			}
+
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.QuarantineAccountName, receiver, coins); err != nil {
+				return err
+			}
+			k.SetTxStatus(ctx, deposit.TxHash, types.TX_STATUS_DEPOSIT_RECEIVED, "")
+			break
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.QuarantineAccountName, types.TempAddress, coins); err != nil {
+			return err
+		}
+
+		if _, err := k.createSendToExternal(ctx, types.ChainID(deposit.ReceiverChainId), types.TempAddress, deposit.Receiver, deposit.Amount, zero, zero, deposit.TxHash, types.ChainID(deposit.ChainId), deposit.Sender); err != nil {
+			return err
+		}
+		k.SetTxStatus(ctx, deposit.TxHash, types.TX_STATUS_DEPOSIT_RECEIVED, "")
+
+	case types.QUARANTINE_RESOLUTION_RETURN:
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.QuarantineAccountName, types.TempAddress, coins); err != nil {
+			return err
+		}
+
+		if _, err := k.createSendToExternal(ctx, types.ChainID(deposit.ChainId), types.TempAddress, deposit.Sender, deposit.Amount, zero, zero, "#", "", ""); err != nil {
+			return err
+		}
+		k.SetTxStatus(ctx, deposit.TxHash, types.TX_STATUS_REFUNDED, "")
+
+	default:
+		return fmt.Errorf("invalid resolution %s", p.Resolution)
+	}
+
+	k.deleteQuarantinedDeposit(ctx, deposit.Id)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeQuarantineResolved,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyQuarantinedDepositID, fmt.Sprint(deposit.Id)),
+		sdk.NewAttribute(types.AttributeKeyQuarantineResolution, p.Resolution.String()),
+	))
+
+	return nil
+}
+
+func (k Keeper) GetQuarantinedDeposit(ctx sdk.Context, id uint64) (types.QuarantinedDeposit, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeQuarantinedDepositKey(id))
+	if len(bz) == 0 {
+		return types.QuarantinedDeposit{}, false
+	}
+
+	var deposit types.QuarantinedDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+
+	return deposit, true
+}
+
+// GetQuarantinedDeposits returns all unresolved quarantined deposits ordered by id
+func (k Keeper) GetQuarantinedDeposits(ctx sdk.Context) []types.QuarantinedDeposit {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.QuarantinedDepositKey})
+	defer iter.Close()
+
+	var deposits []types.QuarantinedDeposit
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.QuarantinedDeposit
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+func (k Keeper) setQuarantinedDeposit(ctx sdk.Context, deposit types.QuarantinedDeposit) {
+	ctx.KVStore(k.storeKey).Set(types.MakeQuarantinedDepositKey(deposit.Id), k.cdc.MustMarshal(&deposit))
+}
+
+func (k Keeper) deleteQuarantinedDeposit(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeQuarantinedDepositKey(id))
+}
+
+func (k Keeper) getLastQuarantinedDepositID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastQuarantinedDepositIDKey})
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLastQuarantinedDepositID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastQuarantinedDepositIDKey}, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) incrementLastQuarantinedDepositID(ctx sdk.Context) uint64 {
+	id := k.getLastQuarantinedDepositID(ctx) + 1
+	k.setLastQuarantinedDepositID(ctx, id)
+	return id
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
+)
+
+// rotateDelegateKeys replaces the orchestrator and the external address of the validator on the chain and
+// records the previous keys in the validator's history. Last event nonce and signatures are tracked by the
+// validator address, so they are carried over to the new keys as is.
+func (k Keeper) rotateDelegateKeys(ctx sdk.Context, chainId types.ChainID, valAddr sdk.ValAddress, orchAddr sdk.AccAddress, ethAddr common.Address) {
+	store := ctx.KVStore(k.storeKey)
+
+	prevEthAddr := k.GetValidatorExternalAddress(ctx, chainId, valAddr)
+	prevOrchAddr := k.GetExternalOrchestratorAddress(ctx, chainId, prevEthAddr)
+
+	store.Delete(types.MakeOrchestratorValidatorAddressKey(chainId, prevOrchAddr))
+	store.Delete(types.MakeExternalOrchestratorAddressKey(chainId, prevEthAddr))
+
+	k.SetOrchestratorValidatorAddress(ctx, chainId, valAddr, orchAddr)
+	k.setValidatorExternalAddress(ctx, chainId, valAddr, ethAddr)
+	k.setExternalOrchestratorAddress(ctx, chainId, ethAddr, orchAddr)
+	if ethAddr != prevEthAddr {
+		k.setLastDelegateKeysChangeHeight(ctx, chainId, uint64(ctx.BlockHeight()))
+	}
+
+	history := k.GetDelegateKeysHistory(ctx, chainId, valAddr)
+	history.Rotations = append(history.Rotations, types.DelegateKeysRotation{
+		PreviousOrchestratorAddress: prevOrchAddr.String(),
+		PreviousExternalAddress:     prevEthAddr.Hex(),
+		OrchestratorAddress:         orchAddr.String(),
+		ExternalAddress:             ethAddr.Hex(),
+		Height:                      uint64(ctx.BlockHeight()),
+	})
+	k.setDelegateKeysHistory(ctx, chainId, history)
+}
+
+// GetDelegateKeysHistory returns the delegate keys rotations of the validator on the chain
+func (k Keeper) GetDelegateKeysHistory(ctx sdk.Context, chainId types.ChainID, valAddr sdk.ValAddress) types.DelegateKeysHistory {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeDelegateKeysHistoryKey(chainId, valAddr))
+	if len(bz) == 0 {
+		return types.DelegateKeysHistory{ValidatorAddress: valAddr.String()}
+	}
+
+	var history types.DelegateKeysHistory
+	k.cdc.MustUnmarshal(bz, &history)
+
+	return history
+}
+
+func (k Keeper) getDelegateKeysHistories(ctx sdk.Context, chainId types.ChainID) []types.DelegateKeysHistory {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), append([]byte{types.DelegateKeysHistoryKey}, chainId.Bytes()...))
+	defer iter.Close()
+
+	var histories []types.DelegateKeysHistory
+	for ; iter.Valid(); iter.Next() {
+		var history types.DelegateKeysHistory
+		k.cdc.MustUnmarshal(iter.Value(), &history)
+
+		// skip chains which have this chain id as a prefix
+		valAddr, _ := sdk.ValAddressFromBech32(history.ValidatorAddress)
+		if !bytes.Equal(iter.Key(), types.MakeDelegateKeysHistoryKey(chainId, valAddr)) {
+			continue
+		}
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+func (k Keeper) setDelegateKeysHistory(ctx sdk.Context, chainId types.ChainID, history types.DelegateKeysHistory) {
+	valAddr, err := sdk.ValAddressFromBech32(history.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.MakeDelegateKeysHistoryKey(chainId, valAddr), k.cdc.MustMarshal(&history))
+}
+
+// GetLastDelegateKeysChangeHeight returns the last block height in which a validator delegated or rotated
+// its external address on the chain
+func (k Keeper) GetLastDelegateKeysChangeHeight(ctx sdk.Context, chainId types.ChainID) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeLastDelegateKeysChangeHeightKey(chainId))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLastDelegateKeysChangeHeight(ctx sdk.Context, chainId types.ChainID, height uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MakeLastDelegateKeysChangeHeightKey(chainId), sdk.Uint64ToBigEndian(height))
+}
@@ -28,6 +28,58 @@ func (a ExternalEventProcessor) DetectMaliciousSupply(ctx sdk.Context, denom str
 	return nil
 }
 
+// refundTransferToChain sends the deposit of the TransferToChainEvent which is already minted to the TempAddress
+// back to the sender on the origin chain. Refund goes without fee and commission.
+func (a ExternalEventProcessor) refundTransferToChain(ctx sdk.Context, chainId types.ChainID, event *types.TransferToChainEvent, deposit sdk.Coin, reason error) error {
+	zero := sdk.NewInt64Coin(deposit.Denom, 0)
+	txID, err := a.keeper.createSendToExternal(ctx, chainId, types.TempAddress, event.Sender, deposit, zero, zero, "#", "", "")
+	if err != nil {
+		return err
+	}
+	a.keeper.SetTxStatus(ctx, event.TxHash, types.TX_STATUS_REFUNDED, "")
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferRefunded,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+		sdk.NewAttribute(types.AttributeKeyReason, reason.Error()),
+	))
+
+	return nil
+}
+
+// quarantineTransferToChain holds the whole deposit of a TransferToChainEvent which involves a blocked address
+// in the quarantine account. Hub receivers are recorded in bech32 so the deposit can be released as is.
+func (a ExternalEventProcessor) quarantineTransferToChain(ctx sdk.Context, chainId types.ChainID, event *types.TransferToChainEvent) error {
+	tokenInfo, err := a.keeper.ExternalIdToTokenInfoLookup(ctx, chainId, event.ExternalCoinId)
+	if err != nil {
+		return err
+	}
+
+	convertedAmount := a.keeper.ConvertFromExternalValue(ctx, chainId, event.ExternalCoinId, event.Amount.Add(event.Fee))
+	if err := a.DetectMaliciousSupply(ctx, tokenInfo.Denom, convertedAmount); err != nil {
+		return err
+	}
+
+	receiver := event.ExternalReceiver
+	if event.ReceiverChainId == "hub" {
+		addr, err := sdk.AccAddressFromHex(event.ExternalReceiver[2:])
+		if err != nil {
+			return err
+		}
+		receiver = addr.String()
+	}
+
+	return a.keeper.quarantineDeposit(ctx, types.QuarantinedDeposit{
+		ChainId:         chainId.String(),
+		Sender:          event.Sender,
+		ReceiverChainId: event.ReceiverChainId,
+		Receiver:        receiver,
+		Amount:          sdk.NewCoin(tokenInfo.Denom, convertedAmount),
+		TxHash:          event.TxHash,
+	})
+}
+
 // Handle is the entry point for ExternalEvent processing
 func (a ExternalEventProcessor) Handle(ctx sdk.Context, chainId types.ChainID, eve types.ExternalEvent) (err error) {
 	switch event := eve.(type) {
@@ -36,6 +88,10 @@ func (a ExternalEventProcessor) Handle(ctx sdk.Context, chainId types.ChainID, e
 			return err
 		}
 
+		if a.keeper.IsAddressBlocked(ctx, event.Sender) || a.keeper.IsAddressBlocked(ctx, event.ExternalReceiver) {
+			return a.quarantineTransferToChain(ctx, chainId, event)
+		}
+
 		if event.ReceiverChainId == "hub" {
 			receiver, err := sdk.AccAddressFromHex(event.ExternalReceiver[2:])
 			if err != nil {
@@ -89,10 +145,25 @@ func (a ExternalEventProcessor) Handle(ctx sdk.Context, chainId types.ChainID, e
 		}
 		amount = amount.Sub(fee)
 
+		// the shortfall of a fee which does not cover the transfer cost is taken from the commission
+		if minFee := a.keeper.GetMinBridgeFee(ctx, receiverChainTokenInfo); fee.Amount.LT(minFee) {
+			shortfall := sdk.NewCoin(fee.Denom, minFee.Sub(fee.Amount))
+			if commission.IsLT(shortfall) {
+				return a.refundTransferToChain(ctx, chainId, event, sdk.NewCoin(receiverChainTokenInfo.Denom, convertedAmount.Add(convertedFee)), a.keeper.ValidateBridgeFee(ctx, receiverChainTokenInfo, fee.Amount))
+			}
+			commission = commission.Sub(shortfall)
+			fee = fee.Add(shortfall)
+		}
+
+		if err := receiverChainTokenInfo.ValidateTransferMinimums(amount.Amount, fee.Amount); err != nil {
+			return a.refundTransferToChain(ctx, chainId, event, sdk.NewCoin(receiverChainTokenInfo.Denom, convertedAmount.Add(convertedFee)), err)
+		}
+
 		txID, err := a.keeper.createSendToExternal(ctx, types.ChainID(event.ReceiverChainId), types.TempAddress, event.ExternalReceiver, amount, fee, commission, event.TxHash, chainId, event.Sender)
 		if err != nil {
 			return err
 		}
+		a.keeper.holdLargeSendToExternal(ctx, types.ChainID(event.ReceiverChainId), txID)
 
 		ctx.EventManager().EmitEvents([]sdk.Event{
 			sdk.NewEvent(
@@ -124,6 +195,17 @@ func (a ExternalEventProcessor) Handle(ctx sdk.Context, chainId types.ChainID, e
 			return err
 		}
 
+		if a.keeper.IsAddressBlocked(ctx, event.CosmosReceiver) {
+			return a.keeper.quarantineDeposit(ctx, types.QuarantinedDeposit{
+				ChainId:         chainId.String(),
+				Sender:          event.Sender,
+				ReceiverChainId: "hub",
+				Receiver:        event.CosmosReceiver,
+				Amount:          coins[0],
+				TxHash:          event.TxHash,
+			})
+		}
+
 		if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
 		}
@@ -147,9 +229,17 @@ func (a ExternalEventProcessor) Handle(ctx sdk.Context, chainId types.ChainID, e
 		return nil
 
 	case *types.SignerSetTxExecutedEvent:
-		// TODO here we should check the contents of the validator set against
-		// the store, if they differ we should take some action to indicate to the
-		// user that bridge highjacking has occurred
+		// the multisig weights and threshold reported by the chains which have them are checked against the
+		// store, TODO the contents of the other validator sets should be checked as well and some action should
+		// be taken to indicate to the user that bridge highjacking has occurred
+		if event.Threshold != 0 {
+			otx := a.keeper.GetOutgoingTx(ctx, chainId, types.MakeSignerSetTxKey(chainId, event.SignerSetTxNonce))
+			if sstx, ok := otx.(*types.SignerSetTx); ok {
+				if err := sstx.ValidateMultisig(event.Threshold, event.Members); err != nil {
+					return sdkerrors.Wrapf(types.ErrInvalid, "signer set %d: %s", event.SignerSetTxNonce, err)
+				}
+			}
+		}
 		a.keeper.setLastObservedSignerSetTx(ctx, chainId, types.SignerSetTx{
 			Nonce:   event.SignerSetTxNonce,
 			Signers: event.Members,
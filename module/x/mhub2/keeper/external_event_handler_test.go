@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"math/big"
+	"strings"
 	"testing"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+
+	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
 )
 
 func TestEthereumEventProcessor_DetectMaliciousSupply(t *testing.T) {
@@ -20,3 +24,185 @@ func TestEthereumEventProcessor_DetectMaliciousSupply(t *testing.T) {
 	err := eep.DetectMaliciousSupply(input.Context, "stake", bigCoinAmount)
 	require.Error(t, err, "didn't error out on too much added supply")
 }
+
+func TestEthereumEventProcessor_TransferToChainBelowMinimum(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+	eep := ExternalEventProcessor{keeper: k, bankKeeper: input.BankKeeper}
+
+	tokenInfos := k.GetTokenInfos(ctx)
+	tokenInfo := tokenInfos.TokenInfos[0]
+	tokenInfo.MinAmount = sdktypes.NewInt(1000)
+	k.SetTokenInfos(ctx, tokenInfos)
+
+	event := &types.TransferToChainEvent{
+		EventNonce:       1,
+		ExternalCoinId:   tokenInfo.ExternalTokenId,
+		Amount:           sdktypes.NewInt(900),
+		Fee:              sdktypes.NewInt(10),
+		Sender:           EthAddrs[0].Hex(),
+		ReceiverChainId:  chainId.String(),
+		ExternalReceiver: EthAddrs[1].Hex(),
+		ExternalHeight:   10,
+		TxHash:           "0x01",
+	}
+	require.NoError(t, eep.Handle(ctx, chainId, event))
+
+	// whole deposit goes back to the sender without fee and commission
+	var pool []*types.SendToExternal
+	k.IterateUnbatchedSendToExternals(ctx, chainId, func(ste *types.SendToExternal) bool {
+		pool = append(pool, ste)
+		return false
+	})
+	require.Len(t, pool, 1)
+	require.Equal(t, EthAddrs[0].Hex(), pool[0].ExternalRecipient)
+	require.Equal(t, sdktypes.NewInt(910), pool[0].Token.Amount)
+	require.True(t, pool[0].Fee.Amount.IsZero())
+	require.True(t, pool[0].ValCommission.Amount.IsZero())
+	require.Equal(t, types.TX_STATUS_REFUNDED, k.GetTxStatus(ctx, "0x01").Status)
+
+	// transfer above the minimum is routed to the receiver
+	event.EventNonce = 2
+	event.Amount = sdktypes.NewInt(2000)
+	event.TxHash = "0x02"
+	require.NoError(t, eep.Handle(ctx, chainId, event))
+	require.NotNil(t, k.getUnbatchedSendToExternal(ctx, chainId, 2))
+	require.Equal(t, EthAddrs[1].Hex(), k.getUnbatchedSendToExternal(ctx, chainId, 2).ExternalRecipient)
+}
+
+func TestEthereumEventProcessor_TransferToChainBelowBridgeFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+	eep := ExternalEventProcessor{keeper: k, bankKeeper: input.BankKeeper}
+
+	// the min bridge fee is 5e15 at the mocked oracle prices
+	input.SetMinBridgeFeeRatio(ctx, sdktypes.OneDec())
+	tokenInfo := k.GetTokenInfos(ctx).TokenInfos[0]
+
+	event := &types.TransferToChainEvent{
+		EventNonce:       1,
+		ExternalCoinId:   tokenInfo.ExternalTokenId,
+		Amount:           sdktypes.NewIntWithDecimal(1, 18),
+		Fee:              sdktypes.NewIntWithDecimal(1, 15),
+		Sender:           EthAddrs[0].Hex(),
+		ReceiverChainId:  chainId.String(),
+		ExternalReceiver: EthAddrs[1].Hex(),
+		ExternalHeight:   10,
+		TxHash:           "0x01",
+	}
+	require.NoError(t, eep.Handle(ctx, chainId, event))
+
+	// the commission of 1e16 covers the shortfall of the fee
+	ste := k.getUnbatchedSendToExternal(ctx, chainId, 1)
+	require.NotNil(t, ste)
+	require.Equal(t, EthAddrs[1].Hex(), ste.ExternalRecipient)
+	require.Equal(t, sdktypes.NewIntWithDecimal(5, 15), ste.Fee.Amount)
+	require.Equal(t, sdktypes.NewIntWithDecimal(6, 15), ste.ValCommission.Amount)
+
+	// the commission of 1e15 does not, so the deposit is refunded
+	event.EventNonce = 2
+	event.Amount = sdktypes.NewIntWithDecimal(1, 17)
+	event.TxHash = "0x02"
+	require.NoError(t, eep.Handle(ctx, chainId, event))
+
+	ste = k.getUnbatchedSendToExternal(ctx, chainId, 2)
+	require.NotNil(t, ste)
+	require.Equal(t, EthAddrs[0].Hex(), ste.ExternalRecipient)
+	require.Equal(t, sdktypes.NewIntWithDecimal(101, 15), ste.Token.Amount)
+	require.Equal(t, types.TX_STATUS_REFUNDED, k.GetTxStatus(ctx, "0x02").Status)
+}
+
+func TestEthereumEventProcessor_QuarantineBlockedDeposits(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+	eep := ExternalEventProcessor{keeper: k, bankKeeper: input.BankKeeper}
+	tokenInfo := k.GetTokenInfos(ctx).TokenInfos[0]
+	quarantine := input.AccountKeeper.GetModuleAddress(types.QuarantineAccountName)
+
+	require.NoError(t, k.ChangeBlocklist(ctx, types.NewBlocklistChangeProposal([]string{AccAddrs[0].String(), EthAddrs[2].Hex()}, nil)))
+
+	// deposit to a blocked hub address
+	require.NoError(t, eep.Handle(ctx, chainId, &types.SendToHubEvent{
+		EventNonce:     1,
+		ExternalCoinId: tokenInfo.ExternalTokenId,
+		Amount:         sdktypes.NewInt(500),
+		Sender:         EthAddrs[0].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		ExternalHeight: 10,
+		TxHash:         "0x01",
+	}))
+	require.True(t, input.BankKeeper.GetBalance(ctx, AccAddrs[0], tokenInfo.Denom).IsZero())
+	require.Equal(t, types.TX_STATUS_QUARANTINED, k.GetTxStatus(ctx, "0x01").Status)
+
+	// transfer from a blocked external address, blocklist is case-insensitive for hex addresses
+	require.NoError(t, eep.Handle(ctx, chainId, &types.TransferToChainEvent{
+		EventNonce:       2,
+		ExternalCoinId:   tokenInfo.ExternalTokenId,
+		Amount:           sdktypes.NewInt(900),
+		Fee:              sdktypes.NewInt(10),
+		Sender:           strings.ToLower(EthAddrs[2].Hex()),
+		ReceiverChainId:  chainId.String(),
+		ExternalReceiver: EthAddrs[1].Hex(),
+		ExternalHeight:   11,
+		TxHash:           "0x02",
+	}))
+	require.Empty(t, k.getUnbatchedSendToExternals(ctx, chainId))
+
+	deposits := k.GetQuarantinedDeposits(ctx)
+	require.Len(t, deposits, 2)
+	require.Equal(t, uint64(1), deposits[0].Id)
+	require.Equal(t, "hub", deposits[0].ReceiverChainId)
+	require.Equal(t, sdktypes.NewInt(910), deposits[1].Amount.Amount)
+	require.Equal(t, sdktypes.NewInt(1410), input.BankKeeper.GetBalance(ctx, quarantine, tokenInfo.Denom).Amount)
+
+	// release delivers the deposit to the receiver
+	require.NoError(t, k.ResolveQuarantinedDeposit(ctx, types.NewQuarantinedDepositProposal(1, types.QUARANTINE_RESOLUTION_RELEASE)))
+	require.Equal(t, sdktypes.NewInt(500), input.BankKeeper.GetBalance(ctx, AccAddrs[0], tokenInfo.Denom).Amount)
+
+	// return sends the whole deposit back to the sender without fee and commission
+	require.NoError(t, k.ResolveQuarantinedDeposit(ctx, types.NewQuarantinedDepositProposal(2, types.QUARANTINE_RESOLUTION_RETURN)))
+	pool := k.getUnbatchedSendToExternals(ctx, chainId)
+	require.Len(t, pool, 1)
+	require.Equal(t, EthAddrs[2].Hex(), common.HexToAddress(pool[0].ExternalRecipient).Hex())
+	require.Equal(t, sdktypes.NewInt(910), pool[0].Token.Amount)
+	require.True(t, pool[0].Fee.Amount.IsZero())
+	require.Equal(t, types.TX_STATUS_REFUNDED, k.GetTxStatus(ctx, "0x02").Status)
+
+	require.Empty(t, k.GetQuarantinedDeposits(ctx))
+	require.True(t, input.BankKeeper.GetBalance(ctx, quarantine, tokenInfo.Denom).IsZero())
+	require.Error(t, k.ResolveQuarantinedDeposit(ctx, types.NewQuarantinedDepositProposal(2, types.QUARANTINE_RESOLUTION_RETURN)))
+}
+
+func TestEthereumEventProcessor_SignerSetTxExecutedMultisig(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+	eep := ExternalEventProcessor{keeper: k, bankKeeper: input.BankKeeper}
+
+	sstx := types.NewSignerSetTx(1, 1, types.ExternalSigners{
+		{Power: 2, ExternalAddress: EthAddrs[0].Hex()},
+		{Power: 1, ExternalAddress: EthAddrs[1].Hex()},
+	})
+	k.SetOutgoingTx(ctx, chainId, sstx)
+
+	event := func(threshold uint64) *types.SignerSetTxExecutedEvent {
+		var members []*types.ExternalSigner
+		for _, s := range sstx.Signers {
+			members = append(members, &types.ExternalSigner{
+				Power:           s.Weight,
+				Weight:          s.Weight,
+				ExternalAddress: strings.ToLower(s.ExternalAddress),
+			})
+		}
+		return &types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: sstx.Nonce, Members: members, Threshold: threshold}
+	}
+
+	require.Error(t, eep.Handle(ctx, chainId, event(types.SignerSetThreshold-1)))
+	require.Nil(t, k.GetLastObservedSignerSetTx(ctx, chainId))
+
+	require.NoError(t, eep.Handle(ctx, chainId, event(types.SignerSetThreshold)))
+	require.Equal(t, sstx.Nonce, k.GetLastObservedSignerSetTx(ctx, chainId).Nonce)
+}
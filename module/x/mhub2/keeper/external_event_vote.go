@@ -129,9 +129,47 @@ func (k Keeper) processExternalEvent(ctx sdk.Context, chainId types.ChainID, eve
 	}
 }
 
-// setExternalEventVoteRecord sets the attestation in the store
+// setExternalEventVoteRecord sets the attestation in the store and keeps the pending nonce index in sync:
+// a nonce stays pending until one of its vote records is accepted
 func (k Keeper) setExternalEventVoteRecord(ctx sdk.Context, chainId types.ChainID, eventNonce uint64, claimHash []byte, eventVoteRecord *types.ExternalEventVoteRecord) {
-	ctx.KVStore(k.storeKey).Set(types.MakeExternalEventVoteRecordKey(chainId, eventNonce, claimHash), k.cdc.MustMarshal(eventVoteRecord))
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MakeExternalEventVoteRecordKey(chainId, eventNonce, claimHash), k.cdc.MustMarshal(eventVoteRecord))
+
+	if eventVoteRecord.Accepted {
+		store.Delete(types.MakePendingEventNonceKey(chainId, eventNonce))
+	} else if eventNonce > k.GetLastObservedEventNonce(ctx, chainId) {
+		store.Set(types.MakePendingEventNonceKey(chainId, eventNonce), []byte{1})
+	}
+}
+
+// HasPendingEventNonce returns true if there are vote records at the given nonce waiting to be accepted
+func (k Keeper) HasPendingEventNonce(ctx sdk.Context, chainId types.ChainID, eventNonce uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakePendingEventNonceKey(chainId, eventNonce))
+}
+
+// GetPendingEventNonces returns all event nonces which are waiting to be accepted, in ascending order
+func (k Keeper) GetPendingEventNonces(ctx sdk.Context, chainId types.ChainID) (out []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.PendingEventNonceKey}, chainId.Bytes()...))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, binary.BigEndian.Uint64(iter.Key()))
+	}
+	return
+}
+
+// GetExternalEventVoteRecordsByNonce returns all vote records at the given nonce,
+// without touching records at any other nonce
+func (k Keeper) GetExternalEventVoteRecordsByNonce(ctx sdk.Context, chainId types.ChainID, eventNonce uint64) (out []*types.ExternalEventVoteRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeExternalEventVoteRecordKey(chainId, eventNonce, nil))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		att := &types.ExternalEventVoteRecord{}
+		k.cdc.MustUnmarshal(iter.Value(), att)
+		out = append(out, att)
+	}
+	return
 }
 
 // GetExternalEventVoteRecord return a vote record given a nonce
@@ -12,6 +12,27 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.setParams(ctx, *data.Params)
 	k.SetTokenInfos(ctx, data.TokenInfos)
 
+	for _, address := range data.Blocklist {
+		if err := k.setAddressBlocked(ctx, address, true); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.SetGuardians(ctx, data.Guardians); err != nil {
+		panic(err)
+	}
+
+	for _, transfer := range data.TimelockedTransfers {
+		k.setTimelockedTransfer(ctx, transfer)
+	}
+
+	for _, deposit := range data.QuarantinedDeposits {
+		k.setQuarantinedDeposit(ctx, deposit)
+		if deposit.Id > k.getLastQuarantinedDepositID(ctx) {
+			k.setLastQuarantinedDepositID(ctx, deposit.Id)
+		}
+	}
+
 	for _, externalState := range data.ExternalStates {
 		chainId := types.ChainID(externalState.ChainId)
 
@@ -22,6 +43,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 			k.setUnbatchedSendToExternal(ctx, chainId, tx)
 		}
 
+		// reset last observed event nonce, it has to be known before
+		// the vote records so that only unobserved nonces get into the pending index
+		k.setLastObservedEventNonce(ctx, chainId, externalState.LastObservedEventNonce)
+
 		// reset external event vote records in state
 		for _, evr := range externalState.ExternalEventVoteRecords {
 			event, err := types.UnpackEvent(evr.Event)
@@ -34,9 +59,6 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 			k.setExternalEventVoteRecord(ctx, chainId, event.GetEventNonce(), event.Hash(), evr)
 		}
 
-		// reset last observed event nonce
-		k.setLastObservedEventNonce(ctx, chainId, externalState.LastObservedEventNonce)
-
 		// reset attestation state of all validators
 		for _, eventVoteRecord := range externalState.ExternalEventVoteRecords {
 			event, _ := types.UnpackEvent(eventVoteRecord.Event)
@@ -69,6 +91,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 			k.setExternalOrchestratorAddress(ctx, chainId, eth, orch)
 		}
 
+		for _, history := range externalState.DelegateKeysHistory {
+			k.setDelegateKeysHistory(ctx, chainId, history)
+		}
+
 		// reset outgoing txs in state
 		for _, ota := range externalState.OutgoingTxs {
 			otx, err := types.UnpackOutgoingTx(ota)
@@ -111,8 +137,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	chains := k.GetChains(ctx)
 	tokenInfos := k.GetTokenInfos(ctx)
 	state := types.GenesisState{
-		Params:     &params,
-		TokenInfos: tokenInfos,
+		Params:              &params,
+		TokenInfos:          tokenInfos,
+		Blocklist:           k.GetBlocklist(ctx),
+		QuarantinedDeposits: k.GetQuarantinedDeposits(ctx),
+		Guardians:           k.GetGuardians(ctx),
 	}
 
 	for _, chainId := range chains {
@@ -133,7 +162,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 			LastObservedValset:       lastobservedvalset,
 			LastOutgoingBatchTxNonce: lastoutgoingbatchnonce,
 			LatestBlockHeight:        k.GetLastObservedExternalBlockHeight(ctx, chainId),
+			DelegateKeysHistory:      k.getDelegateKeysHistories(ctx, chainId),
 		})
+		state.TimelockedTransfers = append(state.TimelockedTransfers, k.GetTimelockedTransfers(ctx, chainId)...)
 	}
 
 	return state
@@ -43,6 +43,38 @@ func (k Keeper) TokenInfos(ctx context.Context, _ *types.TokenInfosRequest) (*ty
 	return &types.TokenInfosResponse{List: *k.GetTokenInfos(sdk.UnwrapSDKContext(ctx))}, nil
 }
 
+func (k Keeper) TransferMinimums(c context.Context, req *types.TransferMinimumsRequest) (*types.TransferMinimumsResponse, error) {
+	tokenInfo, err := k.DenomToTokenInfoLookup(sdk.UnwrapSDKContext(c), types.ChainID(req.ChainId), req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.TransferMinimumsResponse{MinAmount: sdk.ZeroInt(), MinFee: sdk.ZeroInt()}
+	if !tokenInfo.MinAmount.IsNil() {
+		res.MinAmount = tokenInfo.MinAmount
+	}
+	if !tokenInfo.MinFee.IsNil() {
+		res.MinFee = tokenInfo.MinFee
+	}
+	return res, nil
+}
+
+func (k Keeper) Blocklist(c context.Context, _ *types.BlocklistRequest) (*types.BlocklistResponse, error) {
+	return &types.BlocklistResponse{Addresses: k.GetBlocklist(sdk.UnwrapSDKContext(c))}, nil
+}
+
+func (k Keeper) QuarantinedDeposits(c context.Context, _ *types.QuarantinedDepositsRequest) (*types.QuarantinedDepositsResponse, error) {
+	return &types.QuarantinedDepositsResponse{Deposits: k.GetQuarantinedDeposits(sdk.UnwrapSDKContext(c))}, nil
+}
+
+func (k Keeper) TimelockedTransfers(c context.Context, req *types.TimelockedTransfersRequest) (*types.TimelockedTransfersResponse, error) {
+	return &types.TimelockedTransfersResponse{Transfers: k.GetTimelockedTransfers(sdk.UnwrapSDKContext(c), types.ChainID(req.ChainId))}, nil
+}
+
+func (k Keeper) Guardians(c context.Context, _ *types.GuardiansRequest) (*types.GuardiansResponse, error) {
+	return &types.GuardiansResponse{Guardians: k.GetGuardians(sdk.UnwrapSDKContext(c))}, nil
+}
+
 func (k Keeper) Params(c context.Context, _ *types.ParamsRequest) (*types.ParamsResponse, error) {
 	params := k.GetParams(sdk.UnwrapSDKContext(c))
 	return &types.ParamsResponse{Params: params}, nil
@@ -467,3 +499,105 @@ func (k Keeper) DelegateKeys(c context.Context, req *types.DelegateKeysRequest)
 	}
 	return res, nil
 }
+
+func (k Keeper) DelegateKeysHistory(c context.Context, req *types.DelegateKeysHistoryRequest) (*types.DelegateKeysHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainId := types.ChainID(req.ChainId)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DelegateKeysHistoryResponse{Rotations: k.GetDelegateKeysHistory(ctx, chainId, valAddr).Rotations}, nil
+}
+
+func (k Keeper) SignerSetParams(c context.Context, req *types.SignerSetParamsRequest) (*types.SignerSetParamsResponse, error) {
+	return &types.SignerSetParamsResponse{Params: k.GetSignerSetParams(sdk.UnwrapSDKContext(c), types.ChainID(req.ChainId))}, nil
+}
+
+func (k Keeper) TransferExpiry(c context.Context, req *types.TransferExpiryRequest) (*types.TransferExpiryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainId := types.ChainID(req.ChainId)
+
+	if ste := k.getUnbatchedSendToExternal(ctx, chainId, req.Id); ste != nil {
+		return &types.TransferExpiryResponse{Transfer: ste, ExpiresAt: ste.ExpiresAt}, nil
+	}
+
+	// the expiry of a timelocked transfer is only known once it is released into the pool
+	if transfer, found := k.GetTimelockedTransfer(ctx, chainId, req.Id); found {
+		return &types.TransferExpiryResponse{Transfer: &transfer.Transfer}, nil
+	}
+
+	return nil, status.Errorf(codes.NotFound, "unbatched transfer %d not found", req.Id)
+}
+
+// EstimateSendToExternal goes through the checks and the math of MsgSendToExternal without sending anything.
+// The checks the transfer would fail are reported as violations.
+func (k Keeper) EstimateSendToExternal(c context.Context, req *types.EstimateSendToExternalRequest) (*types.EstimateSendToExternalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainId := types.ChainID(req.ChainId)
+	if err := k.CheckChainID(ctx, chainId); err != nil {
+		return nil, err
+	}
+
+	if req.Amount.Amount.IsNil() || !req.Amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	tokenInfo, err := k.DenomToTokenInfoLookup(ctx, chainId, req.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	minFee, fastFee := k.GetSuggestedBridgeFees(ctx, tokenInfo)
+	fee := req.BridgeFee
+	if fee.Denom == "" {
+		fee = sdk.NewCoin(tokenInfo.Denom, minFee)
+	}
+
+	res := &types.EstimateSendToExternalResponse{
+		BridgeFee:      fee,
+		MinFee:         sdk.NewCoin(tokenInfo.Denom, minFee),
+		FastFee:        sdk.NewCoin(tokenInfo.Denom, fastFee),
+		AmountReceived: sdk.ZeroInt(),
+	}
+
+	msg := types.MsgSendToExternal{
+		Sender:            req.Sender,
+		ExternalRecipient: req.ExternalRecipient,
+		Amount:            req.Amount,
+		BridgeFee:         fee,
+		ChainId:           req.ChainId,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		res.Violations = append(res.Violations, err.Error())
+	}
+
+	for _, address := range []string{req.Sender, req.ExternalRecipient} {
+		if k.IsAddressBlocked(ctx, address) {
+			res.Violations = append(res.Violations, sdkerrors.Wrap(types.ErrBlockedAddress, address).Error())
+		}
+	}
+
+	commission := k.GetCommissionForHolder(ctx, []string{req.Sender, req.ExternalRecipient}, tokenInfo.Commission).Mul(req.Amount.Amount.Add(fee.Amount).ToDec()).TruncateInt()
+	res.Commission = sdk.NewCoin(tokenInfo.Denom, commission)
+
+	amount := req.Amount.Amount.Sub(commission)
+	if !amount.IsPositive() {
+		res.Violations = append(res.Violations, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "amount %s does not cover commission %s", req.Amount, res.Commission).Error())
+		return res, nil
+	}
+
+	if err := tokenInfo.ValidateTransferMinimums(amount, fee.Amount); err != nil {
+		res.Violations = append(res.Violations, err.Error())
+	}
+	if err := k.ValidateBridgeFee(ctx, tokenInfo, fee.Amount); err != nil {
+		res.Violations = append(res.Violations, err.Error())
+	}
+
+	res.AmountReceived = k.ConvertToExternalValue(ctx, chainId, tokenInfo.ExternalTokenId, amount)
+	res.Timelocked = k.GetWithdrawalTimelockBlocks(ctx) > 0 && tokenInfo.RequiresTimelock(amount)
+
+	return res, nil
+}
@@ -236,3 +236,52 @@ func TestKeeper_ContractCallTxs(t *testing.T) {
 // DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
 // DelegateKeysByEthereumSigner(context.Context, *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error)
 // DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
+
+func TestKeeper_EstimateSendToExternal(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.Mhub2Keeper
+
+	sender := AccAddrs[0].String()
+	recipient := EthAddrs[1].Hex()
+
+	tokenInfos := gk.GetTokenInfos(ctx)
+	tokenInfos.TokenInfos[0].ExternalDecimals = 6
+	gk.SetTokenInfos(ctx, tokenInfos)
+
+	estimate := func(amount sdk.Int, fee sdk.Coin) *types.EstimateSendToExternalResponse {
+		res, err := gk.EstimateSendToExternal(sdk.WrapSDKContext(ctx), &types.EstimateSendToExternalRequest{
+			ChainId:           chainId.String(),
+			Sender:            sender,
+			ExternalRecipient: recipient,
+			Amount:            sdk.NewCoin("hub", amount),
+			BridgeFee:         fee,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	// the mocked oracle fees of 1$ and 2$ at the hub price of 100$
+	res := estimate(sdk.NewIntWithDecimal(1000, 18), sdk.Coin{})
+	require.Equal(t, sdk.NewCoin("hub", sdk.NewIntWithDecimal(1, 16)), res.MinFee)
+	require.Equal(t, sdk.NewCoin("hub", sdk.NewIntWithDecimal(2, 16)), res.FastFee)
+	require.Equal(t, res.MinFee, res.BridgeFee)
+	require.Equal(t, sdk.NewCoin("hub", sdk.NewIntWithDecimal(100001, 14)), res.Commission)
+	require.Equal(t, sdk.NewInt(989999900), res.AmountReceived)
+	require.False(t, res.Timelocked)
+	require.Empty(t, res.Violations)
+
+	// the min fee of the token is suggested if it is above the oracle fee
+	tokenInfos.TokenInfos[0].MinFee = sdk.NewIntWithDecimal(5, 16)
+	tokenInfos.TokenInfos[0].TimelockThreshold = sdk.NewIntWithDecimal(500, 18)
+	gk.SetTokenInfos(ctx, tokenInfos)
+	require.NoError(t, gk.setAddressBlocked(ctx, recipient, true))
+
+	res = estimate(sdk.NewIntWithDecimal(1000, 18), sdk.NewCoin("hub", sdk.NewIntWithDecimal(1, 16)))
+	require.Equal(t, sdk.NewCoin("hub", sdk.NewIntWithDecimal(5, 16)), res.MinFee)
+	require.Equal(t, sdk.NewCoin("hub", sdk.NewIntWithDecimal(5, 16)), res.FastFee)
+	require.True(t, res.Timelocked)
+	require.Len(t, res.Violations, 2)
+	require.Contains(t, res.Violations[0], types.ErrBlockedAddress.Error())
+	require.Contains(t, res.Violations[1], types.ErrBelowMinimum.Error())
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"math"
 	"math/big"
 	"sort"
 	"strconv"
@@ -204,6 +203,17 @@ func (k Keeper) GetOrchestratorValidatorAddress(ctx sdk.Context, chainId types.C
 	return store.Get(key)
 }
 
+// GetOrchestratorValidator returns the validator of the orchestrator key delegated for any of the chains
+func (k Keeper) GetOrchestratorValidator(ctx sdk.Context, orchAddr sdk.AccAddress) sdk.ValAddress {
+	for _, chainId := range k.GetChains(ctx) {
+		if val := k.GetOrchestratorValidatorAddress(ctx, chainId, orchAddr); val != nil {
+			return val
+		}
+	}
+
+	return nil
+}
+
 ////////////////////////
 // VAL -> ETH ADDRESS //
 ////////////////////////
@@ -225,18 +235,12 @@ func (k Keeper) GetValidatorExternalAddress(ctx sdk.Context, chainId types.Chain
 }
 
 func (k Keeper) getValidatorsByExternalAddress(ctx sdk.Context, chainId types.ChainID, ethAddr common.Address) (vals []sdk.ValAddress) {
-	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.ValidatorExternalAddressKey}, chainId.Bytes()...)).Iterator(nil, nil)
+	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
 		if common.BytesToAddress(iter.Value()) == ethAddr {
-			valBs := bytes.TrimPrefix(iter.Key(), []byte{types.ValidatorExternalAddressKey})
-			if !bytes.HasPrefix(valBs, chainId.Bytes()) {
-				continue
-			}
-			valBs = bytes.TrimPrefix(valBs, chainId.Bytes())
-
-			val := sdk.ValAddress(valBs)
-			vals = append(vals, val)
+			vals = append(vals, sdk.ValAddress(iter.Key()))
 		}
 	}
 
@@ -264,18 +268,12 @@ func (k Keeper) GetExternalOrchestratorAddress(ctx sdk.Context, chainId types.Ch
 }
 
 func (k Keeper) getExternalAddressesByOrchestrator(ctx sdk.Context, chainId types.ChainID, orch sdk.AccAddress) (ethAddrs []common.Address) {
-	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.ExternalOrchestratorAddressKey}, chainId.Bytes()...)).Iterator(nil, nil)
+	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
-		if sdk.AccAddress(iter.Value()).String() == orch.String() {
-			ethBs := bytes.TrimPrefix(iter.Key(), []byte{types.ExternalOrchestratorAddressKey})
-			if !bytes.HasPrefix(ethBs, chainId.Bytes()) {
-				continue
-			}
-			ethBs = bytes.TrimPrefix(ethBs, chainId.Bytes())
-
-			ethAddr := common.BytesToAddress(ethBs)
-			ethAddrs = append(ethAddrs, ethAddr)
+		if orch.Equals(sdk.AccAddress(iter.Value())) {
+			ethAddrs = append(ethAddrs, common.BytesToAddress(iter.Key()))
 		}
 	}
 
@@ -318,11 +316,11 @@ func (k Keeper) CreateSignerSetTx(ctx sdk.Context, chainId types.ChainID) *types
 // Cosmos power / total cosmos power ratio, leaving us at uint32 Max - 1
 // total voting power. This is an acceptable rounding error since floating
 // point may cause consensus problems if different floating point unit
-// implementations are involved.
+// implementations are involved. The max signer power of the chain, if set,
+// limits the 'mhub2 power' of a single validator.
 func (k Keeper) CurrentSignerSet(ctx sdk.Context, chainId types.ChainID) types.ExternalSigners {
 	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	externalSigners := make([]*types.ExternalSigner, 0)
-	var totalPower uint64
 	for _, validator := range validators {
 		val := validator.GetOperator()
 
@@ -331,13 +329,10 @@ func (k Keeper) CurrentSignerSet(ctx sdk.Context, chainId types.ChainID) types.E
 		if extAddr := k.GetValidatorExternalAddress(ctx, chainId, val); extAddr.Hex() != "0x0000000000000000000000000000000000000000" {
 			es := &types.ExternalSigner{Power: p, ExternalAddress: extAddr.Hex()}
 			externalSigners = append(externalSigners, es)
-			totalPower += p
 		}
 	}
 	// normalize power values
-	for i := range externalSigners {
-		externalSigners[i].Power = sdk.NewUint(externalSigners[i].Power).MulUint64(math.MaxUint32).QuoUint64(totalPower).Uint64()
-	}
+	types.ExternalSigners(externalSigners).NormalizePower(k.GetSignerSetParams(ctx, chainId).MaxSignerPower)
 
 	return externalSigners
 }
@@ -492,15 +487,26 @@ func (k Keeper) SetOutgoingTx(ctx sdk.Context, chainId types.ChainID, outgoing t
 	if err != nil {
 		panic(err)
 	}
-	ctx.KVStore(k.storeKey).Set(
+	store := ctx.KVStore(k.storeKey)
+	store.Set(
 		types.MakeOutgoingTxKey(chainId, outgoing.GetStoreIndex(chainId)),
 		k.cdc.MustMarshal(any),
 	)
+
+	if btx, ok := outgoing.(*types.BatchTx); ok {
+		store.Set(types.MakeBatchTxByTokenKey(chainId, btx.ExternalTokenId, btx.BatchNonce), []byte{1})
+	}
 }
 
 // DeleteOutgoingTx deletes a given outgoingtx
 func (k Keeper) DeleteOutgoingTx(ctx sdk.Context, chainId types.ChainID, storeIndex []byte) {
-	ctx.KVStore(k.storeKey).Delete(types.MakeOutgoingTxKey(chainId, storeIndex))
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MakeOutgoingTxKey(chainId, storeIndex))
+
+	// batch store index has the same [chain][token][nonce] layout as the batch by token index
+	if len(storeIndex) > 0 && storeIndex[0] == types.BatchTxPrefixByte {
+		store.Delete(append([]byte{types.BatchTxByTokenKey}, storeIndex[1:]...))
+	}
 }
 
 func (k Keeper) PaginateOutgoingTxsByType(ctx sdk.Context, chainId types.ChainID, pageReq *query.PageRequest, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) bool) (*query.PageResponse, error) {
@@ -721,6 +727,77 @@ func (k Keeper) ConvertToExternalValue(ctx sdk.Context, chainId types.ChainID, e
 	return convertDecimals(HubDecimals, coin.ExternalDecimals, amount)
 }
 
+// GetSuggestedBridgeFees returns the bridge fees in the denom of the token which cover the gas of a min and
+// of a fast transfer to its chain at the oracle prices. The fees are never less than the min fee of the
+// token, which is all that is suggested for the chains the oracle has no gas price of, nor than GetMinBridgeFee.
+func (k Keeper) GetSuggestedBridgeFees(ctx sdk.Context, tokenInfo *types.TokenInfo) (min sdk.Int, fast sdk.Int) {
+	min, fast = sdk.ZeroInt(), sdk.ZeroInt()
+	if !tokenInfo.MinFee.IsNil() {
+		min, fast = tokenInfo.MinFee, tokenInfo.MinFee
+	}
+
+	minUsd, fastUsd, err := k.oracleKeeper.GetExternalFee(ctx, tokenInfo.ChainId)
+	if err != nil {
+		return min, fast
+	}
+
+	price, err := k.getFeePrice(ctx, tokenInfo.Denom)
+	if err != nil || !price.IsPositive() {
+		return min, fast
+	}
+
+	min = sdk.MaxInt(min, sdk.MaxInt(usdToHub(minUsd, price), k.GetMinBridgeFee(ctx, tokenInfo)))
+	fast = sdk.MaxInt(fast, usdToHub(fastUsd, price))
+	return min, sdk.MaxInt(min, fast)
+}
+
+// GetMinBridgeFee returns the least bridge fee in the denom of the token a transfer to its chain is accepted
+// with. It is the governance set share of the transfer cost in a full batch at the oracle prices, zero if the
+// check is disabled or the oracle has no prices for the chain.
+func (k Keeper) GetMinBridgeFee(ctx sdk.Context, tokenInfo *types.TokenInfo) sdk.Int {
+	ratio := k.GetMinBridgeFeeRatio(ctx)
+	if !ratio.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	batchFee, err := k.oracleKeeper.GetBatchFee(ctx, tokenInfo.ChainId)
+	if err != nil {
+		return sdk.ZeroInt()
+	}
+
+	price, err := k.getFeePrice(ctx, tokenInfo.Denom)
+	if err != nil || !price.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	return usdToHub(batchFee.QuoInt64(BatchTxSize).Mul(ratio), price)
+}
+
+// ValidateBridgeFee checks the bridge fee of a transfer against the oracle derived minimum of GetMinBridgeFee
+func (k Keeper) ValidateBridgeFee(ctx sdk.Context, tokenInfo *types.TokenInfo, fee sdk.Int) error {
+	if minFee := k.GetMinBridgeFee(ctx, tokenInfo); fee.LT(minFee) {
+		return errors2.Wrapf(types.ErrBelowMinimum, "fee %s%s does not cover the transfer cost, at least %s%s is required", fee, tokenInfo.Denom, minFee, tokenInfo.Denom)
+	}
+	return nil
+}
+
+// getFeePrice returns the price in usd the fees in the denom are valued at: the oracle price averaged over
+// FeePriceTwapWindow epochs, or the spot price if the window is 0, so a short spike of the price does not
+// move the fees
+func (k Keeper) getFeePrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	window := k.GetFeePriceTwapWindow(ctx)
+	if window == 0 {
+		return k.oracleKeeper.GetTokenPrice(ctx, denom)
+	}
+
+	return k.oracleKeeper.TWAP(ctx, denom, window)
+}
+
+// usdToHub converts the usd value to the amount of the token with the price in usd, rounding up
+func usdToHub(usd sdk.Dec, price sdk.Dec) sdk.Int {
+	return usd.MulInt(sdk.NewIntWithDecimal(1, HubDecimals)).Quo(price).Ceil().TruncateInt()
+}
+
 func (k Keeper) GetCommissionForHolder(ctx sdk.Context, addresses []string, commission sdk.Dec) sdk.Dec {
 	maxValue := sdk.NewInt(0)
 	for _, address := range addresses {
@@ -827,6 +904,43 @@ func (k Keeper) GetOutgoingTxTimeout(ctx sdk.Context) time.Duration {
 	return time.Duration(a) * time.Millisecond
 }
 
+// GetOutgoingTxTimeoutOf returns the outgoing tx timeout of the token on the chain, taking the per-chain and
+// per-token overrides into account
+func (k Keeper) GetOutgoingTxTimeoutOf(ctx sdk.Context, chainId types.ChainID, tokenId uint64) time.Duration {
+	var params types.Params
+	k.paramSpace.Get(ctx, types.ParamOutgoingTxTimeout, &params.OutgoingTxTimeout)
+	k.paramSpace.Get(ctx, types.ParamChainOutgoingTxTimeouts, &params.ChainOutgoingTxTimeouts)
+	return time.Duration(params.OutgoingTxTimeoutOf(chainId, tokenId)) * time.Millisecond
+}
+
+// GetWithdrawalTimelockBlocks returns the number of blocks large transfers are held before entering the batch pool
+func (k Keeper) GetWithdrawalTimelockBlocks(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamWithdrawalTimelockBlocks, &a)
+	return a
+}
+
+// GetMinBridgeFeeRatio returns the share of the oracle estimated transfer cost the bridge fee has to cover
+func (k Keeper) GetMinBridgeFeeRatio(ctx sdk.Context) sdk.Dec {
+	var a sdk.Dec
+	k.paramSpace.Get(ctx, types.ParamMinBridgeFeeRatio, &a)
+	return a
+}
+
+// GetFeePriceTwapWindow returns the number of the oracle epochs the token prices of the fees are averaged over
+func (k Keeper) GetFeePriceTwapWindow(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamFeePriceTwapWindow, &a)
+	return a
+}
+
+// GetSignerSetParams returns the signer set tx creation triggers of the chain
+func (k Keeper) GetSignerSetParams(ctx sdk.Context, chainId types.ChainID) types.SignerSetParams {
+	var a []types.SignerSetParams
+	k.paramSpace.Get(ctx, types.ParamSignerSetParams, &a)
+	return types.Params{SignerSetParams: a}.SignerSetParamsOf(chainId)
+}
+
 func (k Keeper) CheckChainID(ctx sdk.Context, id types.ChainID) error {
 	for _, c := range k.GetChains(ctx) {
 		if c.String() == id.String() {
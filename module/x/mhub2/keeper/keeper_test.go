@@ -210,6 +210,50 @@ func TestStoreEventVoteRecord(t *testing.T) {
 	require.EqualValues(t, cctxe.Hash(), eve2.Hash())
 }
 
+func TestPendingEventNonces(t *testing.T) {
+	input := CreateTestEnv(t)
+	gk := input.Mhub2Keeper
+	ctx := input.Context
+
+	var events []*types.SendToHubEvent
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		stce := &types.SendToHubEvent{
+			EventNonce:     nonce,
+			ExternalCoinId: EthAddrs[0].Hex(),
+			Sender:         EthAddrs[0].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			ExternalHeight: 10,
+			Amount:         sdk.NewInt(int64(nonce)),
+		}
+		stcea, err := types.PackEvent(stce)
+		require.NoError(t, err)
+
+		gk.setExternalEventVoteRecord(ctx, chainId, nonce, stce.Hash(), &types.ExternalEventVoteRecord{
+			Event: stcea,
+			Votes: []string{ValAddrs[0].String()},
+		})
+		events = append(events, stce)
+	}
+
+	require.Equal(t, []uint64{1, 2, 3}, gk.GetPendingEventNonces(ctx, chainId))
+	require.True(t, gk.HasPendingEventNonce(ctx, chainId, 2))
+	require.Len(t, gk.GetExternalEventVoteRecordsByNonce(ctx, chainId, 2), 1)
+
+	// accepting a record removes its nonce from the pending index
+	evr := gk.GetExternalEventVoteRecord(ctx, chainId, 1, events[0].Hash())
+	evr.Accepted = true
+	gk.setExternalEventVoteRecord(ctx, chainId, 1, events[0].Hash(), evr)
+	gk.setLastObservedEventNonce(ctx, chainId, 1)
+
+	require.Equal(t, []uint64{2, 3}, gk.GetPendingEventNonces(ctx, chainId))
+	require.False(t, gk.HasPendingEventNonce(ctx, chainId, 1))
+
+	// late votes for an already observed nonce do not put it back
+	gk.setExternalEventVoteRecord(ctx, chainId, 1, []byte{0x1}, &types.ExternalEventVoteRecord{Event: evr.Event})
+	require.Equal(t, []uint64{2, 3}, gk.GetPendingEventNonces(ctx, chainId))
+	require.Len(t, gk.GetExternalEventVoteRecordsByNonce(ctx, chainId, 1), 2)
+}
+
 func TestLastSlashedValsetNonce(t *testing.T) {
 	input := CreateTestEnv(t)
 	k := input.Mhub2Keeper
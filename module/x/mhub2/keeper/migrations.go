@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
+)
+
+// Migrator migrates the mhub2 store of the chains upgraded in place
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the params added in version 2 to their defaults and builds the store indexes added in
+// version 2
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.setDefaultParam(ctx, types.ParamWithdrawalTimelockBlocks, &defaults.WithdrawalTimelockBlocks)
+	m.setDefaultParam(ctx, types.ParamSignerSetParams, &defaults.SignerSetParams)
+	m.setDefaultParam(ctx, types.ParamChainOutgoingTxTimeouts, &defaults.ChainOutgoingTxTimeouts)
+	m.setDefaultParam(ctx, types.ParamMinBridgeFeeRatio, &defaults.MinBridgeFeeRatio)
+	m.setDefaultParam(ctx, types.ParamFeePriceTwapWindow, &defaults.FeePriceTwapWindow)
+
+	for _, chainId := range m.keeper.GetChains(ctx) {
+		m.reindexUnbatchedSendToExternals(ctx, chainId)
+		m.indexBatchTxsByToken(ctx, chainId)
+		m.indexPendingEventNonces(ctx, chainId)
+	}
+
+	return nil
+}
+
+// reindexUnbatchedSendToExternals sets the expiry time and the id and expiry time indexes of the pooled txs,
+// which the pool lookups and the refund of the expired txs rely on
+func (m Migrator) reindexUnbatchedSendToExternals(ctx sdk.Context, chainId types.ChainID) {
+	for _, ste := range m.keeper.getUnbatchedSendToExternals(ctx, chainId) {
+		m.keeper.setUnbatchedSendToExternal(ctx, chainId, ste)
+	}
+}
+
+// setDefaultParam sets the param unless the subspace already has it, the getters of the params panic on the
+// missing keys
+func (m Migrator) setDefaultParam(ctx sdk.Context, key []byte, value interface{}) {
+	if !m.keeper.paramSpace.Has(ctx, key) {
+		m.keeper.paramSpace.Set(ctx, key, value)
+	}
+}
+
+// indexBatchTxsByToken builds the batch by token index, the last batch lookup and the pruning of the batches
+// see only the indexed batches
+func (m Migrator) indexBatchTxsByToken(ctx sdk.Context, chainId types.ChainID) {
+	store := ctx.KVStore(m.keeper.storeKey)
+	m.keeper.IterateOutgoingTxsByType(ctx, chainId, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		btx := otx.(*types.BatchTx)
+		store.Set(types.MakeBatchTxByTokenKey(chainId, btx.ExternalTokenId, btx.BatchNonce), []byte{1})
+		return false
+	})
+}
+
+// indexPendingEventNonces builds the pending event nonce index from the stored vote records, the tally only
+// looks at the indexed nonces
+func (m Migrator) indexPendingEventNonces(ctx sdk.Context, chainId types.ChainID) {
+	store := ctx.KVStore(m.keeper.storeKey)
+	lastObservedNonce := m.keeper.GetLastObservedEventNonce(ctx, chainId)
+	for nonce, records := range m.keeper.GetExternalEventVoteRecordMapping(ctx, chainId) {
+		if nonce <= lastObservedNonce || hasAcceptedRecord(records) {
+			continue
+		}
+		store.Set(types.MakePendingEventNonceKey(chainId, nonce), []byte{1})
+	}
+}
+
+func hasAcceptedRecord(records []*types.ExternalEventVoteRecord) bool {
+	for _, record := range records {
+		if record.Accepted {
+			return true
+		}
+	}
+	return false
+}
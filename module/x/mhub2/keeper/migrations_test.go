@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
+)
+
+// deleteParams removes the params from the subspace as if the chain started before they were added
+func deleteParams(input TestInput, keys ...[]byte) {
+	store := prefix.NewStore(input.Context.KVStore(input.ParamsKey), append([]byte(types.DefaultParamspace), '/'))
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func TestMigrate1to2Params(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+
+	deleteParams(input,
+		types.ParamWithdrawalTimelockBlocks, types.ParamSignerSetParams, types.ParamChainOutgoingTxTimeouts,
+		types.ParamMinBridgeFeeRatio, types.ParamFeePriceTwapWindow,
+	)
+	require.Panics(t, func() { k.GetSignerSetParams(ctx, "ethereum") })
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+	require.Equal(t, types.Params{}.SignerSetParamsOf("ethereum"), k.GetSignerSetParams(ctx, "ethereum"))
+	require.Equal(t, types.DefaultParams().WithdrawalTimelockBlocks, k.GetWithdrawalTimelockBlocks(ctx))
+	require.Equal(t, k.GetOutgoingTxTimeout(ctx), k.GetOutgoingTxTimeoutOf(ctx, "ethereum", 1))
+	require.Equal(t, types.DefaultParams().MinBridgeFeeRatio, k.GetMinBridgeFeeRatio(ctx))
+	require.Equal(t, types.DefaultParams().FeePriceTwapWindow, k.GetFeePriceTwapWindow(ctx))
+
+	// the params set before the upgrade are kept
+	input.SetSignerSetParams(ctx, types.SignerSetParams{ChainId: "ethereum", MaxSignerSetAge: 10})
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+	require.Equal(t, uint64(10), k.GetSignerSetParams(ctx, "ethereum").MaxSignerSetAge)
+}
+
+func TestMigrate1to2Pool(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+	tokenInfo := k.GetTokenInfos(ctx).TokenInfos[0]
+	var (
+		mySender, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver  = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	)
+
+	// the pool of version 1 has neither the expiry time nor the indexes
+	store := ctx.KVStore(k.storeKey)
+	for id, createdAt := range map[uint64]uint64{1: 300, 2: 100} {
+		ste := types.NewSendToExternalTx(id, chainId, tokenInfo.Id, tokenInfo.ExternalTokenId, mySender, myReceiver, 100, id, 0, "#", createdAt, 0)
+		store.Set(types.MakeSendToExternalKey(chainId, ste.Id, ste.Fee), k.cdc.MustMarshal(ste))
+	}
+	require.Nil(t, k.getUnbatchedSendToExternal(ctx, chainId, 1))
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	ste := k.getUnbatchedSendToExternal(ctx, chainId, 1)
+	require.NotNil(t, ste)
+	require.Equal(t, k.outgoingTxExpiresAt(ctx, chainId, tokenInfo.Id, 300), ste.ExpiresAt)
+
+	ctx = ctx.WithBlockTime(time.Unix(300, 0).Add(k.GetOutgoingTxTimeout(ctx)).Add(time.Millisecond))
+	var ids []uint64
+	for _, ste := range k.GetExpiredUnbatchedSendToExternals(ctx, chainId) {
+		ids = append(ids, ste.Id)
+	}
+	require.Equal(t, []uint64{2, 1}, ids)
+	require.Len(t, k.getUnbatchedSendToExternals(ctx, chainId), 2)
+}
+
+func TestMigrate1to2BatchIndex(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+	tokenInfo := k.GetTokenInfos(ctx).TokenInfos[0]
+
+	for _, nonce := range []uint64{1, 2} {
+		k.SetOutgoingTx(ctx, chainId, &types.BatchTx{BatchNonce: nonce, ExternalTokenId: tokenInfo.ExternalTokenId})
+	}
+
+	// the batches of version 1 are not indexed by token
+	store := ctx.KVStore(k.storeKey)
+	for _, nonce := range []uint64{1, 2} {
+		store.Delete(types.MakeBatchTxByTokenKey(chainId, tokenInfo.ExternalTokenId, nonce))
+	}
+	require.Nil(t, k.getLastOutgoingBatchByTokenType(ctx, chainId, tokenInfo.ExternalTokenId))
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+	require.Equal(t, uint64(2), k.getLastOutgoingBatchByTokenType(ctx, chainId, tokenInfo.ExternalTokenId).BatchNonce)
+}
+
+func TestMigrate1to2PendingEventNonces(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		stce := &types.SendToHubEvent{
+			EventNonce:     nonce,
+			ExternalCoinId: EthAddrs[0].Hex(),
+			Sender:         EthAddrs[0].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			ExternalHeight: 10,
+			Amount:         sdk.NewInt(int64(nonce)),
+		}
+		stcea, err := types.PackEvent(stce)
+		require.NoError(t, err)
+
+		k.setExternalEventVoteRecord(ctx, chainId, nonce, stce.Hash(), &types.ExternalEventVoteRecord{
+			Event:    stcea,
+			Votes:    []string{ValAddrs[0].String()},
+			Accepted: nonce == 1,
+		})
+	}
+	k.setLastObservedEventNonce(ctx, chainId, 1)
+
+	// the vote records of version 1 are not indexed by the pending nonce
+	store := ctx.KVStore(k.storeKey)
+	for _, nonce := range k.GetPendingEventNonces(ctx, chainId) {
+		store.Delete(types.MakePendingEventNonceKey(chainId, nonce))
+	}
+	require.Empty(t, k.GetPendingEventNonces(ctx, chainId))
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+	require.Equal(t, []uint64{2, 3}, k.GetPendingEventNonces(ctx, chainId))
+}
@@ -63,10 +63,53 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "orchestrator address %s in use", orchAddr)
 	}
 
+	if err := k.verifyDelegateKeysSignature(ctx, valAddr, ethAddr, msg.EthSignature); err != nil {
+		return nil, err
+	}
+
+	k.SetOrchestratorValidatorAddress(ctx, chainId, valAddr, orchAddr)
+	k.setValidatorExternalAddress(ctx, chainId, valAddr, ethAddr)
+	k.setExternalOrchestratorAddress(ctx, chainId, ethAddr, orchAddr)
+	k.setLastDelegateKeysChangeHeight(ctx, chainId, uint64(ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeySetOrchestratorAddr, orchAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySetEthereumAddr, ethAddr.Hex()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+		),
+	)
+
+	return &types.MsgDelegateKeysResponse{}, nil
+
+}
+
+// SetDelegateKeysMulti delegates the keys of the validator for all the chains of the message. The entries are
+// applied on a cached context, so a failure of any of them leaves the keys of all the chains untouched.
+func (k msgServer) SetDelegateKeysMulti(c context.Context, msg *types.MsgDelegateKeysMulti) (*types.MsgDelegateKeysMultiResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	xCtx, commit := ctx.CacheContext()
+	for _, keys := range msg.DelegateKeys() {
+		if _, err := k.SetDelegateKeys(sdk.WrapSDKContext(xCtx), keys); err != nil {
+			return nil, sdkerrors.Wrapf(err, "chain %s", keys.ChainId)
+		}
+	}
+
+	commit()
+	ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+
+	return &types.MsgDelegateKeysMultiResponse{}, nil
+}
+
+// verifyDelegateKeysSignature checks that the external key has signed the DelegateKeysSignMsg of the validator
+func (k msgServer) verifyDelegateKeysSignature(ctx sdk.Context, valAddr sdk.ValAddress, ethAddr common.Address, ethSignature []byte) error {
 	valAccAddr := sdk.AccAddress(valAddr)
 	valAccSeq, err := k.accountKeeper.GetSequence(ctx, valAccAddr)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "failed to get sequence for validator account %s", valAccAddr)
+		return sdkerrors.Wrapf(types.ErrDelegateKeys, "failed to get sequence for validator account %s", valAccAddr)
 	}
 
 	var nonce uint64
@@ -82,17 +125,71 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 	})
 
 	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
-	if err = types.ValidateEthereumSignature(hash, msg.EthSignature, ethAddr); err != nil {
-		return nil, sdkerrors.Wrapf(
+	if err = types.ValidateEthereumSignature(hash, ethSignature, ethAddr); err != nil {
+		return sdkerrors.Wrapf(
 			types.ErrDelegateKeys,
 			"failed to validate delegate keys signature for Ethereum address %X; %s",
 			ethAddr, err,
 		)
 	}
 
-	k.SetOrchestratorValidatorAddress(ctx, chainId, valAddr, orchAddr)
-	k.setValidatorExternalAddress(ctx, chainId, valAddr, ethAddr)
-	k.setExternalOrchestratorAddress(ctx, chainId, ethAddr, orchAddr)
+	return nil
+}
+
+func (k msgServer) RotateDelegateKeys(c context.Context, msg *types.MsgRotateDelegateKeys) (*types.MsgRotateDelegateKeysResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainId := types.ChainID(msg.ChainId)
+	if err := k.CheckChainID(ctx, chainId); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	prevEthAddr := k.GetValidatorExternalAddress(ctx, chainId, valAddr)
+	if prevEthAddr == (common.Address{}) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "validator %s has no delegate keys on %s", valAddr, chainId)
+	}
+	prevOrchAddr := k.GetExternalOrchestratorAddress(ctx, chainId, prevEthAddr)
+
+	orchAddr := prevOrchAddr
+	if msg.OrchestratorAddress != "" {
+		if orchAddr, err = sdk.AccAddressFromBech32(msg.OrchestratorAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	ethAddr := prevEthAddr
+	if msg.ExternalAddress != "" {
+		ethAddr = common.HexToAddress(msg.ExternalAddress)
+	}
+
+	if orchAddr.Equals(prevOrchAddr) && ethAddr == prevEthAddr {
+		return nil, sdkerrors.Wrap(types.ErrDelegateKeys, "keys are not changed")
+	}
+
+	if !orchAddr.Equals(prevOrchAddr) && len(k.getExternalAddressesByOrchestrator(ctx, chainId, orchAddr)) > 0 {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "orchestrator address %s in use", orchAddr)
+	}
+
+	if ethAddr != prevEthAddr {
+		if len(k.getValidatorsByExternalAddress(ctx, chainId, ethAddr)) > 0 {
+			return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "external address %s in use", ethAddr)
+		}
+
+		if err := k.verifyDelegateKeysSignature(ctx, valAddr, ethAddr, msg.EthSignature); err != nil {
+			return nil, err
+		}
+	}
+
+	k.rotateDelegateKeys(ctx, chainId, valAddr, orchAddr, ethAddr)
+
+	// the external contract has to learn the new external address before it signs anything
+	if ethAddr != prevEthAddr {
+		k.CreateSignerSetTx(ctx, chainId)
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -104,8 +201,7 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 		),
 	)
 
-	return &types.MsgDelegateKeysResponse{}, nil
-
+	return &types.MsgRotateDelegateKeysResponse{}, nil
 }
 
 // SubmitTxConfirmation handles MsgSubmitTxConfirmation
@@ -191,6 +287,12 @@ func (k msgServer) SubmitExternalEvent(c context.Context, msg *types.MsgSubmitEx
 		return nil, err
 	}
 
+	if event, ok := event.(*types.SignerSetTxExecutedEvent); ok {
+		if err := k.normalizeReportedMultisig(ctx, chainId, event); err != nil {
+			return nil, err
+		}
+	}
+
 	// Add the claim to the store
 	_, err = k.recordEventVote(ctx, chainId, event, val)
 	if err != nil {
@@ -210,6 +312,23 @@ func (k msgServer) SubmitExternalEvent(c context.Context, msg *types.MsgSubmitEx
 	return &types.MsgSubmitExternalEventResponse{}, nil
 }
 
+// normalizeReportedMultisig drops the multisig threshold and weights of the event unless the multisig check
+// is enabled for the chain, so the orchestrators which report them and the ones which don't vote for the same event
+func (k msgServer) normalizeReportedMultisig(ctx sdk.Context, chainId types.ChainID, event *types.SignerSetTxExecutedEvent) error {
+	if k.GetSignerSetParams(ctx, chainId).CheckMultisig {
+		if event.Threshold == 0 {
+			return sdkerrors.Wrap(types.ErrInvalid, "multisig threshold is not reported")
+		}
+		return nil
+	}
+
+	event.Threshold = 0
+	for _, m := range event.Members {
+		m.Weight = 0
+	}
+	return nil
+}
+
 // SendToExternal handles MsgSendToExternal
 func (k msgServer) SendToExternal(c context.Context, msg *types.MsgSendToExternal) (*types.MsgSendToExternalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -223,16 +342,29 @@ func (k msgServer) SendToExternal(c context.Context, msg *types.MsgSendToExterna
 		return nil, err
 	}
 
+	for _, address := range []string{msg.Sender, msg.ExternalRecipient} {
+		if k.IsAddressBlocked(ctx, address) {
+			return nil, sdkerrors.Wrap(types.ErrBlockedAddress, address)
+		}
+	}
+
 	tokenInfo, err := k.DenomToTokenInfoLookup(ctx, chainId, msg.Amount.Denom)
 	if err != nil {
 		return nil, err
 	}
 	commission := k.GetCommissionForHolder(ctx, []string{sender.String(), msg.ExternalRecipient}, tokenInfo.Commission).Mul(msg.Amount.Amount.Add(msg.BridgeFee.Amount).ToDec()).TruncateInt()
+	if err := tokenInfo.ValidateTransferMinimums(msg.Amount.Amount.Sub(commission), msg.BridgeFee.Amount); err != nil {
+		return nil, err
+	}
+	if err := k.ValidateBridgeFee(ctx, tokenInfo, msg.BridgeFee.Amount); err != nil {
+		return nil, err
+	}
 
 	txID, err := k.createSendToExternal(ctx, chainId, sender, msg.ExternalRecipient, msg.Amount.SubAmount(commission), msg.BridgeFee, sdk.NewCoin(msg.Amount.Denom, commission), fmt.Sprintf("%x", sha256.Sum256(ctx.TxBytes())), "hub", sender.String())
 	if err != nil {
 		return nil, err
 	}
+	k.holdLargeSendToExternal(ctx, chainId, txID)
 
 	ctx.EventManager().EmitEvents([]sdk.Event{
 		sdk.NewEvent(
@@ -311,6 +443,34 @@ func (k msgServer) CancelSendToExternal(c context.Context, msg *types.MsgCancelS
 	return &types.MsgCancelSendToExternalResponse{}, nil
 }
 
+func (k msgServer) CancelTimelockedTransfer(c context.Context, msg *types.MsgCancelTimelockedTransfer) (*types.MsgCancelTimelockedTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chainId := types.ChainID(msg.ChainId)
+	if err := k.CheckChainID(ctx, chainId); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.cancelTimelockedTransfer(ctx, chainId, msg.Id, msg.Guardian); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeTimelockedTransferCanceled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(msg.Id)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(msg.Id)),
+		),
+	})
+
+	return &types.MsgCancelTimelockedTransferResponse{}, nil
+}
+
 // getSignerValidator takes an sdk.AccAddress that represents either a validator or orchestrator address and returns
 // the assoicated validator address
 func (k Keeper) getSignerValidator(ctx sdk.Context, chainId types.ChainID, signerString string) (sdk.ValAddress, error) {
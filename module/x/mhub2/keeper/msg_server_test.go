@@ -5,8 +5,11 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
@@ -137,6 +140,99 @@ func TestMsgServer_SendToExternal(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_SendToExternalBelowMinimum(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.Mhub2Keeper
+
+		sender, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		recipient = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	)
+	require.NoError(t, env.AddBalanceToBank(ctx, sender, sdk.Coins{sdk.NewInt64Coin("hub", 10000)}))
+
+	tokenInfos := gk.GetTokenInfos(ctx)
+	tokenInfos.TokenInfos[0].MinAmount = sdk.NewInt(500)
+	tokenInfos.TokenInfos[0].MinFee = sdk.NewInt(10)
+	gk.SetTokenInfos(ctx, tokenInfos)
+
+	msgServer := NewMsgServerImpl(gk)
+	send := func(amount, fee int64) error {
+		_, err := msgServer.SendToExternal(sdk.WrapSDKContext(ctx), types.NewMsgSendToExternal(chainId, sender, recipient, sdk.NewInt64Coin("hub", amount), sdk.NewInt64Coin("hub", fee)))
+		return err
+	}
+
+	require.ErrorIs(t, send(400, 10), types.ErrBelowMinimum)
+	require.ErrorIs(t, send(1000, 5), types.ErrBelowMinimum)
+	require.NoError(t, send(1000, 10))
+
+	res, err := gk.TransferMinimums(sdk.WrapSDKContext(ctx), &types.TransferMinimumsRequest{ChainId: chainId.String(), Denom: "hub"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500), res.MinAmount)
+	require.Equal(t, sdk.NewInt(10), res.MinFee)
+}
+
+func TestMsgServer_SendToExternalBelowBridgeFee(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.Mhub2Keeper
+
+		sender, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		recipient = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	)
+	require.NoError(t, env.AddBalanceToBank(ctx, sender, sdk.Coins{sdk.NewCoin("hub", sdk.NewIntWithDecimal(10, 18))}))
+
+	msgServer := NewMsgServerImpl(gk)
+	send := func(fee sdk.Int) error {
+		_, err := msgServer.SendToExternal(sdk.WrapSDKContext(ctx), types.NewMsgSendToExternal(chainId, sender, recipient, sdk.NewCoin("hub", sdk.NewIntWithDecimal(1, 18)), sdk.NewCoin("hub", fee)))
+		return err
+	}
+
+	// disabled by default
+	require.NoError(t, send(sdk.NewInt(1)))
+
+	// the mocked batch fee of 50$ split between 100 transfers at the hub price of 100$
+	env.SetMinBridgeFeeRatio(ctx, sdk.NewDecWithPrec(5, 1))
+	tokenInfo, err := gk.DenomToTokenInfoLookup(ctx, chainId, "hub")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewIntWithDecimal(25, 14), gk.GetMinBridgeFee(ctx, tokenInfo))
+
+	require.ErrorIs(t, send(sdk.NewIntWithDecimal(24, 14)), types.ErrBelowMinimum)
+	require.NoError(t, send(sdk.NewIntWithDecimal(25, 14)))
+}
+
+func TestMsgServer_SendToExternalBlocked(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.Mhub2Keeper
+
+		sender, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		recipient = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	)
+	require.NoError(t, env.AddBalanceToBank(ctx, sender, sdk.Coins{sdk.NewInt64Coin("hub", 10000)}))
+
+	msgServer := NewMsgServerImpl(gk)
+	send := func() error {
+		_, err := msgServer.SendToExternal(sdk.WrapSDKContext(ctx), types.NewMsgSendToExternal(chainId, sender, recipient, sdk.NewInt64Coin("hub", 1000), sdk.NewInt64Coin("hub", 10)))
+		return err
+	}
+
+	require.NoError(t, gk.ChangeBlocklist(ctx, types.NewBlocklistChangeProposal([]string{"0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7"}, nil)))
+	require.ErrorIs(t, send(), types.ErrBlockedAddress)
+
+	require.NoError(t, gk.ChangeBlocklist(ctx, types.NewBlocklistChangeProposal([]string{sender.String()}, []string{recipient})))
+	require.ErrorIs(t, send(), types.ErrBlockedAddress)
+
+	res, err := gk.Blocklist(sdk.WrapSDKContext(ctx), &types.BlocklistRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{sender.String()}, res.Addresses)
+
+	require.NoError(t, gk.ChangeBlocklist(ctx, types.NewBlocklistChangeProposal(nil, []string{sender.String()})))
+	require.NoError(t, send())
+}
+
 func TestMsgServer_CancelSendToExternal(t *testing.T) {
 	ethPrivKey, err := ethCrypto.GenerateKey()
 	require.NoError(t, err)
@@ -297,6 +393,57 @@ func TestMsgServer_SubmitEthereumEvent(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_SubmitSignerSetTxExecutedEvent(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.Mhub2Keeper
+
+		orcAddrs = []sdk.AccAddress{AccAddrs[0], AccAddrs[1], AccAddrs[2]}
+		valAddrs = []sdk.ValAddress{ValAddrs[0], ValAddrs[1], ValAddrs[2]}
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddrs...)
+	for i := range orcAddrs {
+		gk.SetOrchestratorValidatorAddress(ctx, chainId, valAddrs[i], orcAddrs[i])
+	}
+
+	msgServer := NewMsgServerImpl(gk)
+	submit := func(orcAddr sdk.AccAddress, threshold uint64) error {
+		members := types.ExternalSigners{{Power: 1, ExternalAddress: EthAddrs[0].Hex()}}
+		if threshold != 0 {
+			members.SetWeights()
+		}
+		event, err := types.PackEvent(&types.SignerSetTxExecutedEvent{
+			EventNonce:       1,
+			SignerSetTxNonce: 1,
+			ExternalHeight:   200,
+			Members:          members,
+			Threshold:        threshold,
+		})
+		require.NoError(t, err)
+
+		_, err = msgServer.SubmitExternalEvent(sdk.WrapSDKContext(ctx), &types.MsgSubmitExternalEvent{
+			Event:   event,
+			Signer:  orcAddr.String(),
+			ChainId: chainId.String(),
+		})
+		return err
+	}
+
+	// the multisig is dropped until the check is enabled, so the orchestrators vote for the same event
+	require.NoError(t, submit(orcAddrs[0], types.SignerSetThreshold))
+	require.NoError(t, submit(orcAddrs[1], 0))
+	records := gk.GetExternalEventVoteRecordsByNonce(ctx, chainId, 1)
+	require.Len(t, records, 1)
+	require.Len(t, records[0].Votes, 2)
+
+	env.SetSignerSetParams(ctx, types.SignerSetParams{ChainId: chainId.String(), PowerDiffThreshold: sdk.NewDecWithPrec(5, 2), MaxSignerPower: sdk.ZeroDec(), CheckMultisig: true})
+	require.Error(t, submit(orcAddrs[2], 0))
+	require.NoError(t, submit(orcAddrs[2], types.SignerSetThreshold))
+	require.Len(t, gk.GetExternalEventVoteRecordsByNonce(ctx, chainId, 1), 2)
+}
+
 func TestMsgServer_SetDelegateKeys(t *testing.T) {
 	ethPrivKey, err := ethCrypto.GenerateKey()
 	require.NoError(t, err)
@@ -342,6 +489,142 @@ func TestMsgServer_SetDelegateKeys(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_SetDelegateKeysMulti(t *testing.T) {
+	ethPrivKey1, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethPrivKey2, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env         = CreateTestEnv(t)
+		ctx         = env.Context
+		gk          = env.Mhub2Keeper
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		ethAddr1    = crypto.PubkeyToAddress(ethPrivKey1.PublicKey)
+		ethAddr2    = crypto.PubkeyToAddress(ethPrivKey2.PublicKey)
+		bscChainId  = types.ChainID("bsc")
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1)
+
+	acc := env.AccountKeeper.NewAccountWithAddress(ctx, orcAddr1)
+	acc.SetSequence(1)
+	env.AccountKeeper.SetAccount(ctx, acc)
+
+	msgServer := NewMsgServerImpl(gk)
+
+	signDelegateKeys := func(privKey *ecdsa.PrivateKey) []byte {
+		signMsgBz := env.Marshaler.MustMarshal(&types.DelegateKeysSignMsg{
+			ValidatorAddress: valAddr1.String(),
+			Nonce:            0,
+		})
+		sig, err := types.NewEthereumSignature(crypto.Keccak256Hash(signMsgBz).Bytes(), privKey)
+		require.NoError(t, err)
+		return sig
+	}
+
+	// a bad signature of any chain rejects the keys of all the chains
+	msg := types.NewMsgDelegateKeysMulti(valAddr1, orcAddr1, []types.DelegateKeysMultiEntry{
+		{ChainId: chainId.String(), ExternalAddress: ethAddr1.Hex(), EthSignature: signDelegateKeys(ethPrivKey1)},
+		{ChainId: bscChainId.String(), ExternalAddress: ethAddr2.Hex(), EthSignature: signDelegateKeys(ethPrivKey1)},
+	})
+	require.NoError(t, msg.ValidateBasic())
+	_, err = msgServer.SetDelegateKeysMulti(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrDelegateKeys)
+	require.Equal(t, common.Address{}, gk.GetValidatorExternalAddress(ctx, chainId, valAddr1))
+	require.Empty(t, gk.GetOrchestratorValidatorAddress(ctx, chainId, orcAddr1))
+
+	msg.Entries[1].EthSignature = signDelegateKeys(ethPrivKey2)
+	_, err = msgServer.SetDelegateKeysMulti(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, ethAddr1, gk.GetValidatorExternalAddress(ctx, chainId, valAddr1))
+	require.Equal(t, ethAddr2, gk.GetValidatorExternalAddress(ctx, bscChainId, valAddr1))
+	require.Equal(t, valAddr1, gk.GetOrchestratorValidatorAddress(ctx, chainId, orcAddr1))
+	require.Equal(t, valAddr1, gk.GetOrchestratorValidatorAddress(ctx, bscChainId, orcAddr1))
+
+	// the same chain can't be delegated twice in a message
+	msg.Entries[1].ChainId = chainId.String()
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrDelegateKeys)
+}
+
+func TestMsgServer_RotateDelegateKeys(t *testing.T) {
+	ethPrivKey1, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethPrivKey2, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env         = CreateTestEnv(t)
+		ctx         = env.Context
+		gk          = env.Mhub2Keeper
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		orcAddr2    = AccAddrs[1]
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		ethAddr1    = crypto.PubkeyToAddress(ethPrivKey1.PublicKey)
+		ethAddr2    = crypto.PubkeyToAddress(ethPrivKey2.PublicKey)
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1)
+
+	acc := env.AccountKeeper.NewAccountWithAddress(ctx, orcAddr1)
+	acc.SetSequence(1)
+	env.AccountKeeper.SetAccount(ctx, acc)
+
+	msgServer := NewMsgServerImpl(gk)
+
+	signDelegateKeys := func(privKey *ecdsa.PrivateKey, nonce uint64) []byte {
+		signMsgBz := env.Marshaler.MustMarshal(&types.DelegateKeysSignMsg{
+			ValidatorAddress: valAddr1.String(),
+			Nonce:            nonce,
+		})
+		sig, err := types.NewEthereumSignature(crypto.Keccak256Hash(signMsgBz).Bytes(), privKey)
+		require.NoError(t, err)
+		return sig
+	}
+
+	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgDelegateKeys(valAddr1, chainId, orcAddr1, ethAddr1.Hex(), signDelegateKeys(ethPrivKey1, 0)))
+	require.NoError(t, err)
+
+	gk.setLastEventNonceByValidator(ctx, chainId, valAddr1, 5)
+	signerSetNonce := gk.GetLatestSignerSetTxNonce(ctx, chainId)
+
+	// nothing to rotate
+	_, err = msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgRotateDelegateKeys(valAddr1, chainId, orcAddr1, "", nil))
+	require.ErrorIs(t, err, types.ErrDelegateKeys)
+
+	// rotating the orchestrator only requires no signature and keeps the signer set
+	_, err = msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgRotateDelegateKeys(valAddr1, chainId, orcAddr2, "", nil))
+	require.NoError(t, err)
+	require.Empty(t, gk.GetOrchestratorValidatorAddress(ctx, chainId, orcAddr1))
+	require.Equal(t, valAddr1, gk.GetOrchestratorValidatorAddress(ctx, chainId, orcAddr2))
+	require.Equal(t, orcAddr2, gk.GetExternalOrchestratorAddress(ctx, chainId, ethAddr1))
+	require.Equal(t, signerSetNonce, gk.GetLatestSignerSetTxNonce(ctx, chainId))
+
+	// rotating the external address has to be signed by the new key
+	acc = env.AccountKeeper.GetAccount(ctx, orcAddr1)
+	require.NoError(t, acc.SetSequence(2))
+	env.AccountKeeper.SetAccount(ctx, acc)
+
+	_, err = msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgRotateDelegateKeys(valAddr1, chainId, nil, ethAddr2.Hex(), signDelegateKeys(ethPrivKey1, 1)))
+	require.ErrorIs(t, err, types.ErrDelegateKeys)
+
+	_, err = msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgRotateDelegateKeys(valAddr1, chainId, nil, ethAddr2.Hex(), signDelegateKeys(ethPrivKey2, 1)))
+	require.NoError(t, err)
+	require.Equal(t, ethAddr2, gk.GetValidatorExternalAddress(ctx, chainId, valAddr1))
+	require.Empty(t, gk.GetExternalOrchestratorAddress(ctx, chainId, ethAddr1))
+	require.Equal(t, orcAddr2, gk.GetExternalOrchestratorAddress(ctx, chainId, ethAddr2))
+	require.Greater(t, gk.GetLatestSignerSetTxNonce(ctx, chainId), signerSetNonce)
+	require.Equal(t, uint64(5), gk.getLastEventNonceByValidator(ctx, chainId, valAddr1))
+
+	history := gk.GetDelegateKeysHistory(ctx, chainId, valAddr1)
+	require.Len(t, history.Rotations, 2)
+	require.Equal(t, orcAddr1.String(), history.Rotations[0].PreviousOrchestratorAddress)
+	require.Equal(t, orcAddr2.String(), history.Rotations[0].OrchestratorAddress)
+	require.Equal(t, ethAddr1.Hex(), history.Rotations[1].PreviousExternalAddress)
+	require.Equal(t, ethAddr2.Hex(), history.Rotations[1].ExternalAddress)
+}
+
 func TestEthVerify(t *testing.T) {
 	// Replace privKeyHexStr and addrHexStr with your own private key and address
 	// HEX values.
@@ -396,3 +679,64 @@ func TestEthVerify(t *testing.T) {
 	gorcSig := "0xbda7037e448ca07ac91f5f386b72df37b6bbacf102b2c8f5acb58b5e053d68d96875ce9e442433bea55ac083230f492670ca2c07a8303c332dca06b1c0758c661b"
 	require.Equal(t, hexutil.Encode(sig), gorcSig)
 }
+
+func TestMsgServer_TimelockedTransfer(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.Mhub2Keeper
+
+		sender, _   = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		guardian, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		recipient   = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	)
+	require.NoError(t, env.AddBalanceToBank(ctx, sender, sdk.Coins{sdk.NewInt64Coin("hub", 100000)}))
+	require.NoError(t, gk.SetGuardians(ctx, []string{guardian.String()}))
+
+	tokenInfos := gk.GetTokenInfos(ctx)
+	tokenInfos.TokenInfos[0].TimelockThreshold = sdk.NewInt(5000)
+	gk.SetTokenInfos(ctx, tokenInfos)
+
+	msgServer := NewMsgServerImpl(gk)
+	send := func(amount int64) uint64 {
+		res, err := msgServer.SendToExternal(sdk.WrapSDKContext(ctx), types.NewMsgSendToExternal(chainId, sender, recipient, sdk.NewInt64Coin("hub", amount), sdk.NewInt64Coin("hub", 10)))
+		require.NoError(t, err)
+		return res.Id
+	}
+
+	// transfers below the threshold go straight to the batch pool
+	require.NotNil(t, gk.getUnbatchedSendToExternal(ctx, chainId, send(1000)))
+
+	held := send(10000)
+	require.Nil(t, gk.getUnbatchedSendToExternal(ctx, chainId, held))
+	res, err := gk.TimelockedTransfers(sdk.WrapSDKContext(ctx), &types.TimelockedTransfersRequest{ChainId: chainId.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Transfers, 1)
+	require.Equal(t, held, res.Transfers[0].Transfer.Id)
+	require.Equal(t, uint64(ctx.BlockHeight())+gk.GetWithdrawalTimelockBlocks(ctx), res.Transfers[0].ReleaseHeight)
+
+	// only guardians are able to cancel
+	balance := env.BankKeeper.GetBalance(ctx, sender, "hub")
+	_, err = msgServer.CancelTimelockedTransfer(sdk.WrapSDKContext(ctx), types.NewMsgCancelTimelockedTransfer(held, chainId, sender))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.CancelTimelockedTransfer(sdk.WrapSDKContext(ctx), types.NewMsgCancelTimelockedTransfer(held, chainId, guardian))
+	require.NoError(t, err)
+	require.Empty(t, gk.GetTimelockedTransfers(ctx, chainId))
+	require.Equal(t, balance.AddAmount(sdk.NewInt(10010)), env.BankKeeper.GetBalance(ctx, sender, "hub"))
+
+	// transfers enter the batch pool at the release height, keeping their creation time
+	held = send(10000)
+	createdAt := uint64(ctx.BlockTime().Unix())
+	releaseHeight := ctx.BlockHeight() + int64(gk.GetWithdrawalTimelockBlocks(ctx))
+
+	gk.ReleaseTimelockedTransfers(ctx.WithBlockHeight(releaseHeight - 1))
+	require.Nil(t, gk.getUnbatchedSendToExternal(ctx, chainId, held))
+
+	ctx = ctx.WithBlockHeight(releaseHeight).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	gk.ReleaseTimelockedTransfers(ctx)
+	released := gk.getUnbatchedSendToExternal(ctx, chainId, held)
+	require.NotNil(t, released)
+	require.Equal(t, createdAt, released.CreatedAt)
+	require.Equal(t, uint64(ctx.BlockTime().Add(gk.GetOutgoingTxTimeoutOf(ctx, chainId, released.Token.TokenId)).Unix()), released.ExpiresAt)
+	require.Empty(t, gk.GetTimelockedTransfers(ctx, chainId))
+}
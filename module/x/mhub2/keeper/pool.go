@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -42,6 +43,7 @@ func (k Keeper) createSendToExternal(ctx sdk.Context, chainId types.ChainID, sen
 	convertedValCommission := k.ConvertToExternalValue(ctx, chainId, tokenInfo.ExternalTokenId, valCommission.Amount)
 
 	// set the outgoing tx in the pool index
+	createdAt := uint64(ctx.BlockTime().Unix())
 	k.setUnbatchedSendToExternal(ctx, chainId, &types.SendToExternal{
 		Id:                nextID,
 		Sender:            sender.String(),
@@ -51,9 +53,10 @@ func (k Keeper) createSendToExternal(ctx sdk.Context, chainId types.ChainID, sen
 		ValCommission:     types.NewSDKIntExternalToken(convertedValCommission, tokenInfo.Id, tokenInfo.ExternalTokenId),
 		ChainId:           chainId.String(),
 		TxHash:            txHash,
-		CreatedAt:         uint64(ctx.BlockTime().Unix()),
+		CreatedAt:         createdAt,
 		RefundAddress:     refundAddress,
 		RefundChainId:     refundChain.String(),
+		ExpiresAt:         k.outgoingTxExpiresAt(ctx, chainId, tokenInfo.Id, createdAt),
 	})
 
 	return nextID, nil
@@ -66,12 +69,7 @@ func (k Keeper) createSendToExternal(ctx sdk.Context, chainId types.ChainID, sen
 func (k Keeper) cancelSendToExternal(ctx sdk.Context, chainId types.ChainID, id uint64, s string) error {
 	sender, _ := sdk.AccAddressFromBech32(s)
 
-	var send *types.SendToExternal
-	for _, ste := range k.getUnbatchedSendToExternals(ctx, chainId) {
-		if ste.Id == id {
-			send = ste
-		}
-	}
+	send := k.getUnbatchedSendToExternal(ctx, chainId, id)
 	if send == nil {
 		// NOTE: this case will also be hit if the transaction is in a batch
 		return sdkerrors.Wrap(types.ErrInvalid, "id not found in send to external pool")
@@ -81,6 +79,19 @@ func (k Keeper) cancelSendToExternal(ctx sdk.Context, chainId types.ChainID, id
 		return fmt.Errorf("can't cancel a message you didn't send")
 	}
 
+	if err := k.refundSendToExternal(ctx, chainId, send); err != nil {
+		return err
+	}
+
+	k.deleteUnbatchedSendToExternal(ctx, chainId, send)
+	return nil
+}
+
+// refundSendToExternal issues the amount, fee and commission of the tx back to the sender. Txs created by
+// TransferToChainEvent are sent back to the origin chain.
+func (k Keeper) refundSendToExternal(ctx sdk.Context, chainId types.ChainID, send *types.SendToExternal) error {
+	sender, _ := sdk.AccAddressFromBech32(send.Sender)
+
 	totalToRefund := send.Token.HubCoin(func(id uint64) (string, error) {
 		info, err := k.TokenIdToTokenInfoLookup(ctx, id)
 		if err != nil {
@@ -117,16 +128,65 @@ func (k Keeper) cancelSendToExternal(ctx sdk.Context, chainId types.ChainID, id
 
 	k.SetTxStatus(ctx, send.TxHash, types.TX_STATUS_REFUNDED, "")
 
-	k.deleteUnbatchedSendToExternal(ctx, chainId, send.Id, send.Fee)
 	return nil
 }
 
+// outgoingTxExpiresAt returns the unix time after which a tx of the token created at createdAt is refunded
+func (k Keeper) outgoingTxExpiresAt(ctx sdk.Context, chainId types.ChainID, tokenId uint64, createdAt uint64) uint64 {
+	return uint64(time.Unix(int64(createdAt), 0).Add(k.GetOutgoingTxTimeoutOf(ctx, chainId, tokenId)).Unix())
+}
+
+// setUnbatchedSendToExternal adds the tx to the pool together with its id and expiry time indexes. Txs without
+// an expiry time, e.g. imported from an older genesis, get one derived from their creation time.
 func (k Keeper) setUnbatchedSendToExternal(ctx sdk.Context, chainId types.ChainID, ste *types.SendToExternal) {
-	ctx.KVStore(k.storeKey).Set(types.MakeSendToExternalKey(chainId, ste.Id, ste.Fee), k.cdc.MustMarshal(ste))
+	if ste.ExpiresAt == 0 {
+		ste.ExpiresAt = k.outgoingTxExpiresAt(ctx, chainId, ste.Token.TokenId, ste.CreatedAt)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.MakeSendToExternalKey(chainId, ste.Id, ste.Fee)
+	store.Set(key, k.cdc.MustMarshal(ste))
+	store.Set(types.MakeSendToExternalIdKey(chainId, ste.Id), key)
+	store.Set(types.MakeSendToExternalExpiresAtKey(chainId, ste.ExpiresAt, ste.Id), []byte{1})
+}
+
+func (k Keeper) deleteUnbatchedSendToExternal(ctx sdk.Context, chainId types.ChainID, ste *types.SendToExternal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MakeSendToExternalKey(chainId, ste.Id, ste.Fee))
+	store.Delete(types.MakeSendToExternalIdKey(chainId, ste.Id))
+	store.Delete(types.MakeSendToExternalExpiresAtKey(chainId, ste.ExpiresAt, ste.Id))
+}
+
+// getUnbatchedSendToExternal returns the unbatched tx with the given id or nil if it is not in the pool
+func (k Keeper) getUnbatchedSendToExternal(ctx sdk.Context, chainId types.ChainID, id uint64) *types.SendToExternal {
+	store := ctx.KVStore(k.storeKey)
+	key := store.Get(types.MakeSendToExternalIdKey(chainId, id))
+	if key == nil {
+		return nil
+	}
+
+	var ste types.SendToExternal
+	k.cdc.MustUnmarshal(store.Get(key), &ste)
+	return &ste
 }
 
-func (k Keeper) deleteUnbatchedSendToExternal(ctx sdk.Context, chainId types.ChainID, id uint64, fee types.ExternalToken) {
-	ctx.KVStore(k.storeKey).Delete(types.MakeSendToExternalKey(chainId, id, fee))
+// GetExpiredUnbatchedSendToExternals returns the unbatched txs which have reached their outgoing tx timeout,
+// earliest expiry first. Only the expired part of the expiry time index is visited.
+func (k Keeper) GetExpiredUnbatchedSendToExternals(ctx sdk.Context, chainId types.ChainID) (out []*types.SendToExternal) {
+	store := ctx.KVStore(k.storeKey)
+	iter := prefix.NewStore(store, types.MakeSendToExternalExpiresAtPrefix(chainId)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		expiresAt := binary.BigEndian.Uint64(iter.Key()[:8])
+		if !time.Unix(int64(expiresAt), 0).Before(ctx.BlockTime()) {
+			break
+		}
+
+		if ste := k.getUnbatchedSendToExternal(ctx, chainId, binary.BigEndian.Uint64(iter.Key()[8:])); ste != nil {
+			out = append(out, ste)
+		}
+	}
+	return out
 }
 
 func (k Keeper) iterateUnbatchedSendToExternalsByCoin(ctx sdk.Context, chainId types.ChainID, externalTokenId string, cb func(external *types.SendToExternal) bool) {
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -40,10 +42,10 @@ func TestAddToOutgoingPool(t *testing.T) {
 	})
 
 	exp := []*types.SendToExternal{
-		types.NewSendToExternalTx(2, chainId, tokenId, myTokenContractAddr.String(), mySender, myReceiver, 101, 3, 0, "#", uint64(ctx.BlockTime().Unix())),
-		types.NewSendToExternalTx(3, chainId, tokenId, myTokenContractAddr.String(), mySender, myReceiver, 102, 2, 0, "#", uint64(ctx.BlockTime().Unix())),
-		types.NewSendToExternalTx(1, chainId, tokenId, myTokenContractAddr.String(), mySender, myReceiver, 100, 2, 0, "#", uint64(ctx.BlockTime().Unix())),
-		types.NewSendToExternalTx(4, chainId, tokenId, myTokenContractAddr.String(), mySender, myReceiver, 103, 1, 0, "#", uint64(ctx.BlockTime().Unix())),
+		types.NewSendToExternalTx(2, chainId, tokenId, myTokenContractAddr.String(), mySender, myReceiver, 101, 3, 0, "#", uint64(ctx.BlockTime().Unix()), uint64(ctx.BlockTime().Unix())+60),
+		types.NewSendToExternalTx(3, chainId, tokenId, myTokenContractAddr.String(), mySender, myReceiver, 102, 2, 0, "#", uint64(ctx.BlockTime().Unix()), uint64(ctx.BlockTime().Unix())+60),
+		types.NewSendToExternalTx(1, chainId, tokenId, myTokenContractAddr.String(), mySender, myReceiver, 100, 2, 0, "#", uint64(ctx.BlockTime().Unix()), uint64(ctx.BlockTime().Unix())+60),
+		types.NewSendToExternalTx(4, chainId, tokenId, myTokenContractAddr.String(), mySender, myReceiver, 103, 1, 0, "#", uint64(ctx.BlockTime().Unix()), uint64(ctx.BlockTime().Unix())+60),
 	}
 
 	require.Equal(t, exp, got)
@@ -53,3 +55,132 @@ func TestAddToOutgoingPool(t *testing.T) {
 	require.EqualValues(t, exp[3], got[3])
 	require.Len(t, got, 4)
 }
+
+func TestExpiredUnbatchedSendToExternals(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+	tokenInfo := k.GetTokenInfos(ctx).TokenInfos[0]
+	var (
+		mySender, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver  = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	)
+
+	// ids are intentionally not in creation time order
+	for id, createdAt := range map[uint64]uint64{1: 300, 2: 100, 3: 200, 4: 1000} {
+		k.setUnbatchedSendToExternal(ctx, chainId, types.NewSendToExternalTx(id, chainId, tokenInfo.Id, tokenInfo.ExternalTokenId, mySender, myReceiver, 100, id, 0, "#", createdAt, 0))
+	}
+
+	timeout := k.GetOutgoingTxTimeout(ctx)
+	ctx = ctx.WithBlockTime(time.Unix(300, 0).Add(timeout).Add(time.Millisecond))
+
+	var ids []uint64
+	for _, ste := range k.GetExpiredUnbatchedSendToExternals(ctx, chainId) {
+		ids = append(ids, ste.Id)
+	}
+	require.Equal(t, []uint64{2, 3, 1}, ids)
+
+	// removing a tx from the pool removes it from the indexes as well
+	k.deleteUnbatchedSendToExternal(ctx, chainId, k.getUnbatchedSendToExternal(ctx, chainId, 3))
+	require.Nil(t, k.getUnbatchedSendToExternal(ctx, chainId, 3))
+	require.Len(t, k.GetExpiredUnbatchedSendToExternals(ctx, chainId), 2)
+	require.Equal(t, uint64(4), k.getUnbatchedSendToExternal(ctx, chainId, 4).Id)
+}
+
+func TestExpiredUnbatchedSendToExternalsWithTimeoutOverrides(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+	tokenInfo := k.GetTokenInfos(ctx).TokenInfos[0]
+	var (
+		mySender, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver  = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		otherToken  = tokenInfo.Id + 1000
+	)
+
+	input.SetChainOutgoingTxTimeouts(ctx, types.ChainOutgoingTxTimeout{
+		ChainId:       chainId.String(),
+		Timeout:       30000,
+		TokenTimeouts: []types.TokenOutgoingTxTimeout{{TokenId: tokenInfo.Id, Timeout: 10000}},
+	})
+	require.Equal(t, 10*time.Second, k.GetOutgoingTxTimeoutOf(ctx, chainId, tokenInfo.Id))
+	require.Equal(t, 30*time.Second, k.GetOutgoingTxTimeoutOf(ctx, chainId, otherToken))
+	require.Equal(t, k.GetOutgoingTxTimeout(ctx), k.GetOutgoingTxTimeoutOf(ctx, "bsc", tokenInfo.Id))
+
+	// the tx created first expires last because of the longer chain-wide timeout
+	k.setUnbatchedSendToExternal(ctx, chainId, types.NewSendToExternalTx(1, chainId, otherToken, "0x01", mySender, myReceiver, 100, 1, 0, "#", 100, 0))
+	k.setUnbatchedSendToExternal(ctx, chainId, types.NewSendToExternalTx(2, chainId, tokenInfo.Id, tokenInfo.ExternalTokenId, mySender, myReceiver, 100, 1, 0, "#", 110, 0))
+
+	res, err := k.TransferExpiry(sdk.WrapSDKContext(ctx), &types.TransferExpiryRequest{ChainId: chainId.String(), Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(130), res.ExpiresAt)
+	res, err = k.TransferExpiry(sdk.WrapSDKContext(ctx), &types.TransferExpiryRequest{ChainId: chainId.String(), Id: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(120), res.ExpiresAt)
+	_, err = k.TransferExpiry(sdk.WrapSDKContext(ctx), &types.TransferExpiryRequest{ChainId: chainId.String(), Id: 3})
+	require.Error(t, err)
+
+	ctx = ctx.WithBlockTime(time.Unix(121, 0))
+	expired := k.GetExpiredUnbatchedSendToExternals(ctx, chainId)
+	require.Len(t, expired, 1)
+	require.Equal(t, uint64(2), expired[0].Id)
+
+	ctx = ctx.WithBlockTime(time.Unix(131, 0))
+	require.Len(t, k.GetExpiredUnbatchedSendToExternals(ctx, chainId), 2)
+}
+
+func BenchmarkUnbatchedSendToExternalLookups(b *testing.B) {
+	const poolSize = 10000
+
+	input := CreateTestEnv(b)
+	ctx := input.Context
+	k := input.Mhub2Keeper
+	tokenInfo := k.GetTokenInfos(ctx).TokenInfos[0]
+	var (
+		mySender, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver  = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		createdAt   = uint64(ctx.BlockTime().Unix())
+	)
+
+	for id := uint64(1); id <= poolSize; id++ {
+		k.setUnbatchedSendToExternal(ctx, chainId, types.NewSendToExternalTx(id, chainId, tokenInfo.Id, tokenInfo.ExternalTokenId, mySender, myReceiver, 100, id%100, 0, fmt.Sprintf("#%d", id), createdAt+id, 0))
+	}
+
+	b.Run(fmt.Sprintf("by id indexed %d", poolSize), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			require.NotNil(b, k.getUnbatchedSendToExternal(ctx, chainId, poolSize/2))
+		}
+	})
+
+	b.Run(fmt.Sprintf("by id full scan %d", poolSize), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var found *types.SendToExternal
+			for _, ste := range k.getUnbatchedSendToExternals(ctx, chainId) {
+				if ste.Id == poolSize/2 {
+					found = ste
+				}
+			}
+			require.NotNil(b, found)
+		}
+	})
+
+	b.Run(fmt.Sprintf("expired indexed %d", poolSize), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			require.Empty(b, k.GetExpiredUnbatchedSendToExternals(ctx, chainId))
+		}
+	})
+
+	b.Run(fmt.Sprintf("expired full scan %d", poolSize), func(b *testing.B) {
+		timeout := k.GetOutgoingTxTimeout(ctx)
+		for i := 0; i < b.N; i++ {
+			var expired []*types.SendToExternal
+			k.IterateUnbatchedSendToExternals(ctx, chainId, func(ste *types.SendToExternal) bool {
+				if time.Unix(int64(ste.CreatedAt), 0).Add(timeout).Before(ctx.BlockTime()) {
+					expired = append(expired, ste)
+				}
+				return false
+			})
+			require.Empty(b, expired)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
 	"time"
 
@@ -211,6 +212,9 @@ var (
 		UnbondSlashingSignerSetTxsWindow:          15,
 		Chains:                                    []string{"ethereum", "hub"},
 		OutgoingTxTimeout:                         60001,
+		WithdrawalTimelockBlocks:                  100,
+		MinBridgeFeeRatio:                         sdk.ZeroDec(),
+		FeePriceTwapWindow:                        12,
 	}
 )
 
@@ -226,6 +230,21 @@ type TestInput struct {
 	Context        sdk.Context
 	Marshaler      codec.Codec
 	LegacyAmino    *codec.LegacyAmino
+	ParamsKey      sdk.StoreKey
+}
+
+// SetSignerSetParams replaces the signer set tx creation triggers of the test chains
+func (input TestInput) SetSignerSetParams(ctx sdk.Context, params ...types.SignerSetParams) {
+	input.Mhub2Keeper.paramSpace.Set(ctx, types.ParamSignerSetParams, params)
+}
+
+func (input TestInput) SetChainOutgoingTxTimeouts(ctx sdk.Context, timeouts ...types.ChainOutgoingTxTimeout) {
+	input.Mhub2Keeper.paramSpace.Set(ctx, types.ParamChainOutgoingTxTimeouts, timeouts)
+}
+
+// SetMinBridgeFeeRatio replaces the share of the oracle estimated transfer cost the bridge fee has to cover
+func (input TestInput) SetMinBridgeFeeRatio(ctx sdk.Context, ratio sdk.Dec) {
+	input.Mhub2Keeper.paramSpace.Set(ctx, types.ParamMinBridgeFeeRatio, ratio)
 }
 
 func (input TestInput) AddSendToEthTxsToPool(t *testing.T, ctx sdk.Context, chainId types.ChainID, tokenId uint64, externalTokenId string, sender sdk.AccAddress, receiver gethcommon.Address, ids ...uint64) {
@@ -242,7 +261,7 @@ func (input TestInput) AddBalanceToBank(ctx sdk.Context, addr sdk.AccAddress, ba
 }
 
 // SetupFiveValChain does all the initialization for a 5 Validator chain using the keys here
-func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
+func SetupFiveValChain(t testing.TB) (TestInput, sdk.Context) {
 	t.Helper()
 	input := CreateTestEnv(t)
 
@@ -293,11 +312,11 @@ func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
 type MockOracleKeeper struct {
 }
 
-func (m MockOracleKeeper) MustGetTokenPrice(ctx sdk.Context, denom string) sdk.Dec {
-	return sdk.NewDec(100)
+func (m MockOracleKeeper) GetTokenPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	return sdk.NewDec(100), nil
 }
 
-func (m MockOracleKeeper) GetTokenPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+func (m MockOracleKeeper) TWAP(ctx sdk.Context, denom string, window uint64) (sdk.Dec, error) {
 	return sdk.NewDec(100), nil
 }
 
@@ -305,8 +324,26 @@ func (m MockOracleKeeper) GetHolderValue(ctx sdk.Context, address string) sdk.In
 	return sdk.NewInt(100)
 }
 
+func (m MockOracleKeeper) GetExternalFee(ctx sdk.Context, chainId string) (sdk.Dec, sdk.Dec, error) {
+	return sdk.NewDec(1), sdk.NewDec(2), nil
+}
+
+func (m MockOracleKeeper) GetBatchFee(ctx sdk.Context, chainId string) (sdk.Dec, error) {
+	return sdk.NewDec(50), nil
+}
+
+func (m MockOracleKeeper) GetBaseCoinFeed(ctx sdk.Context, chainId string) (string, error) {
+	switch chainId {
+	case "ethereum":
+		return "eth", nil
+	case "bsc":
+		return "bnb", nil
+	}
+	return "", fmt.Errorf("fee of chain %s is not supported", chainId)
+}
+
 // CreateTestEnv creates the keeper testing environment for mhub2
-func CreateTestEnv(t *testing.T) TestInput {
+func CreateTestEnv(t testing.TB) TestInput {
 	t.Helper()
 
 	// Initialize store keys
@@ -361,6 +398,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		types.ModuleName:               {authtypes.Minter, authtypes.Burner},
+		types.QuarantineAccountName:    nil,
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -477,6 +515,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		Context:        ctx,
 		Marshaler:      marshaler,
 		LegacyAmino:    cdc,
+		ParamsKey:      keyParams,
 	}
 }
 
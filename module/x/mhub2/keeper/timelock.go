@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
+)
+
+// GetGuardians returns the guardian set elected by governance
+func (k Keeper) GetGuardians(ctx sdk.Context) []string {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.GuardianKey})
+	defer iter.Close()
+
+	var guardians []string
+	for ; iter.Valid(); iter.Next() {
+		guardians = append(guardians, sdk.AccAddress(iter.Key()[1:]).String())
+	}
+
+	return guardians
+}
+
+// SetGuardians replaces the guardian set
+func (k Keeper) SetGuardians(ctx sdk.Context, guardians []string) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, guardian := range k.GetGuardians(ctx) {
+		addr, _ := sdk.AccAddressFromBech32(guardian)
+		store.Delete(types.MakeGuardianKey(addr))
+	}
+
+	for _, guardian := range guardians {
+		addr, err := sdk.AccAddressFromBech32(guardian)
+		if err != nil {
+			return err
+		}
+		store.Set(types.MakeGuardianKey(addr), []byte{1})
+	}
+
+	return nil
+}
+
+func (k Keeper) IsGuardian(ctx sdk.Context, address string) bool {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return false
+	}
+
+	return ctx.KVStore(k.storeKey).Has(types.MakeGuardianKey(addr))
+}
+
+// holdLargeSendToExternal moves a tx which has just entered the batch pool to the withdrawal timelock queue
+// if its amount reaches the timelock threshold of the token
+func (k Keeper) holdLargeSendToExternal(ctx sdk.Context, chainId types.ChainID, id uint64) {
+	blocks := k.GetWithdrawalTimelockBlocks(ctx)
+	if blocks == 0 {
+		return
+	}
+
+	ste := k.getUnbatchedSendToExternal(ctx, chainId, id)
+	if ste == nil {
+		return
+	}
+
+	tokenInfo, err := k.TokenIdToTokenInfoLookup(ctx, ste.Token.TokenId)
+	if err != nil {
+		return
+	}
+
+	amount := k.ConvertFromExternalValue(ctx, chainId, ste.Token.ExternalTokenId, ste.Token.Amount)
+	if !tokenInfo.RequiresTimelock(amount) {
+		return
+	}
+
+	k.deleteUnbatchedSendToExternal(ctx, chainId, ste)
+
+	releaseHeight := uint64(ctx.BlockHeight()) + blocks
+	k.setTimelockedTransfer(ctx, types.TimelockedTransfer{Transfer: *ste, ReleaseHeight: releaseHeight})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferTimelocked,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(ste.Id)),
+		sdk.NewAttribute(types.AttributeKeyReleaseHeight, fmt.Sprint(releaseHeight)),
+	))
+}
+
+// ReleaseTimelockedTransfers moves the transfers whose timelock has ended into the batch pool. The outgoing
+// timeout of a released transfer is counted from its release.
+func (k Keeper) ReleaseTimelockedTransfers(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	end := append([]byte{types.TimelockedTransferReleaseKey}, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1)...)
+	iter := store.Iterator([]byte{types.TimelockedTransferReleaseKey}, end)
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		chainId := types.ChainID(key[9 : len(key)-8])
+		id := binary.BigEndian.Uint64(key[len(key)-8:])
+
+		transfer, found := k.GetTimelockedTransfer(ctx, chainId, id)
+		if !found {
+			store.Delete(key)
+			continue
+		}
+
+		k.deleteTimelockedTransfer(ctx, transfer)
+		transfer.Transfer.ExpiresAt = k.outgoingTxExpiresAt(ctx, chainId, transfer.Transfer.Token.TokenId, uint64(ctx.BlockTime().Unix()))
+		k.setUnbatchedSendToExternal(ctx, chainId, &transfer.Transfer)
+	}
+}
+
+// cancelTimelockedTransfer refunds a transfer held by the withdrawal timelock on behalf of a guardian
+func (k Keeper) cancelTimelockedTransfer(ctx sdk.Context, chainId types.ChainID, id uint64, guardian string) error {
+	if !k.IsGuardian(ctx, guardian) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a guardian", guardian)
+	}
+
+	transfer, found := k.GetTimelockedTransfer(ctx, chainId, id)
+	if !found {
+		return sdkerrors.Wrap(types.ErrInvalid, "id not found in timelocked transfers")
+	}
+
+	if err := k.refundSendToExternal(ctx, chainId, &transfer.Transfer); err != nil {
+		return err
+	}
+
+	k.deleteTimelockedTransfer(ctx, transfer)
+	return nil
+}
+
+func (k Keeper) GetTimelockedTransfer(ctx sdk.Context, chainId types.ChainID, id uint64) (types.TimelockedTransfer, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeTimelockedTransferKey(chainId, id))
+	if len(bz) == 0 {
+		return types.TimelockedTransfer{}, false
+	}
+
+	var transfer types.TimelockedTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+
+	return transfer, true
+}
+
+// GetTimelockedTransfers returns the transfers held by the withdrawal timelock on the chain ordered by id
+func (k Keeper) GetTimelockedTransfers(ctx sdk.Context, chainId types.ChainID) []types.TimelockedTransfer {
+	prefix := append([]byte{types.TimelockedTransferKey}, chainId.Bytes()...)
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+
+	var transfers []types.TimelockedTransfer
+	for ; iter.Valid(); iter.Next() {
+		// skip chains which have this chain id as a prefix
+		if len(iter.Key()) != len(prefix)+8 {
+			continue
+		}
+
+		var transfer types.TimelockedTransfer
+		k.cdc.MustUnmarshal(iter.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers
+}
+
+func (k Keeper) setTimelockedTransfer(ctx sdk.Context, transfer types.TimelockedTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	chainId := types.ChainID(transfer.Transfer.ChainId)
+	store.Set(types.MakeTimelockedTransferKey(chainId, transfer.Transfer.Id), k.cdc.MustMarshal(&transfer))
+	store.Set(types.MakeTimelockedTransferReleaseKey(transfer.ReleaseHeight, chainId, transfer.Transfer.Id), []byte{1})
+}
+
+func (k Keeper) deleteTimelockedTransfer(ctx sdk.Context, transfer types.TimelockedTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	chainId := types.ChainID(transfer.Transfer.ChainId)
+	store.Delete(types.MakeTimelockedTransferKey(chainId, transfer.Transfer.Id))
+	store.Delete(types.MakeTimelockedTransferReleaseKey(transfer.ReleaseHeight, chainId, transfer.Transfer.Id))
+}
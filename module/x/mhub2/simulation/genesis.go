@@ -0,0 +1,266 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
+)
+
+// Simulation parameter constants
+const (
+	TokenInfos               = "token_infos"
+	OutgoingTxTimeout        = "outgoing_tx_timeout"
+	WithdrawalTimelockBlocks = "withdrawal_timelock_blocks"
+	DelegatedValidators      = "delegated_validators"
+	MinBridgeFeeRatio        = "min_bridge_fee_ratio"
+	FeePriceTwapWindow       = "fee_price_twap_window"
+)
+
+var (
+	// ExternalChains are the chains the simulated token infos and orchestrators are bridged to
+	ExternalChains = []types.ChainID{"ethereum", "bsc", "minter"}
+
+	simulatedDenoms = []string{"hub", "eth", "bnb", "usdt", "usdc", "dai"}
+)
+
+// GenTokenInfos randomized TokenInfos. Every denom is bridged to a random non-empty subset of the
+// external chains, ids are sequential and (chain, denom) and (chain, external id) pairs are unique.
+func GenTokenInfos(r *rand.Rand) *types.TokenInfos {
+	infos := &types.TokenInfos{}
+	denoms := simulatedDenoms[:2+r.Intn(len(simulatedDenoms)-1)]
+
+	for _, denom := range denoms {
+		perm := r.Perm(len(ExternalChains))
+		for _, i := range perm[:1+r.Intn(len(perm))] {
+			chainId := ExternalChains[i]
+			info := &types.TokenInfo{
+				Id:                uint64(len(infos.TokenInfos) + 1),
+				Denom:             denom,
+				ChainId:           chainId.String(),
+				ExternalDecimals:  18,
+				Commission:        sdk.NewDecWithPrec(int64(r.Intn(11)), 2),
+				MinAmount:         sdk.ZeroInt(),
+				MinFee:            sdk.ZeroInt(),
+				TimelockThreshold: sdk.ZeroInt(),
+			}
+
+			if chainId == "minter" {
+				info.ExternalTokenId = strconv.Itoa(len(infos.TokenInfos) + 1)
+			} else {
+				info.ExternalTokenId = randomExternalAddress(r)
+				info.ExternalDecimals = []uint64{6, 8, 18}[r.Intn(3)]
+			}
+
+			if r.Intn(2) == 0 {
+				info.MinAmount = sdk.NewInt(r.Int63n(1e6)).Mul(sdk.NewInt(1e12))
+			}
+			if r.Intn(2) == 0 {
+				info.MinFee = sdk.NewInt(r.Int63n(1e6)).Mul(sdk.NewInt(1e12))
+			}
+			if r.Intn(4) == 0 {
+				info.TimelockThreshold = sdk.NewInt(1 + r.Int63n(1e6)).Mul(sdk.NewInt(1e18))
+			}
+
+			infos.TokenInfos = append(infos.TokenInfos, info)
+		}
+	}
+
+	return infos
+}
+
+// GenOutgoingTxTimeout randomized OutgoingTxTimeout, in milliseconds. The simulated blocks are hours apart,
+// so the timeout is between one and thirty days for the txs to live for a few blocks.
+func GenOutgoingTxTimeout(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 86400000, 30*86400000))
+}
+
+// GenWithdrawalTimelockBlocks randomized WithdrawalTimelockBlocks
+func GenWithdrawalTimelockBlocks(r *rand.Rand) uint64 {
+	return uint64(r.Intn(50))
+}
+
+// GenMinBridgeFeeRatio randomized MinBridgeFeeRatio, disabled in half of the cases
+func GenMinBridgeFeeRatio(r *rand.Rand) sdk.Dec {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// GenFeePriceTwapWindow randomized FeePriceTwapWindow, spot prices in a quarter of the cases
+func GenFeePriceTwapWindow(r *rand.Rand) uint64 {
+	if r.Intn(4) == 0 {
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 1, 50))
+}
+
+// GenDelegatedValidators randomized share of the initially bonded validators, in percents, which have
+// their delegate keys set for the external chains in genesis
+func GenDelegatedValidators(r *rand.Rand) int {
+	return simtypes.RandIntBetween(r, 50, 101)
+}
+
+// RandomizedGenState generates a random GenesisState for mhub2. The simulated accounts are funded with
+// the bridged denoms and a share of the initially bonded validators gets delegate keys, so the transfers
+// to the external chains and the orchestrator messages can be simulated from the first block.
+func RandomizedGenState(simState *module.SimulationState) {
+	var tokenInfos *types.TokenInfos
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TokenInfos, &tokenInfos, simState.Rand,
+		func(r *rand.Rand) { tokenInfos = GenTokenInfos(r) },
+	)
+
+	var outgoingTxTimeout uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OutgoingTxTimeout, &outgoingTxTimeout, simState.Rand,
+		func(r *rand.Rand) { outgoingTxTimeout = GenOutgoingTxTimeout(r) },
+	)
+
+	var withdrawalTimelockBlocks uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, WithdrawalTimelockBlocks, &withdrawalTimelockBlocks, simState.Rand,
+		func(r *rand.Rand) { withdrawalTimelockBlocks = GenWithdrawalTimelockBlocks(r) },
+	)
+
+	var minBridgeFeeRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinBridgeFeeRatio, &minBridgeFeeRatio, simState.Rand,
+		func(r *rand.Rand) { minBridgeFeeRatio = GenMinBridgeFeeRatio(r) },
+	)
+
+	var feePriceTwapWindow uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeePriceTwapWindow, &feePriceTwapWindow, simState.Rand,
+		func(r *rand.Rand) { feePriceTwapWindow = GenFeePriceTwapWindow(r) },
+	)
+
+	var delegatedValidators int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DelegatedValidators, &delegatedValidators, simState.Rand,
+		func(r *rand.Rand) { delegatedValidators = GenDelegatedValidators(r) },
+	)
+
+	mhub2Genesis := types.DefaultGenesisState()
+	mhub2Genesis.Params.OutgoingTxTimeout = outgoingTxTimeout
+	mhub2Genesis.Params.WithdrawalTimelockBlocks = withdrawalTimelockBlocks
+	mhub2Genesis.Params.MinBridgeFeeRatio = minBridgeFeeRatio
+	mhub2Genesis.Params.FeePriceTwapWindow = feePriceTwapWindow
+	mhub2Genesis.TokenInfos = tokenInfos
+
+	// the staking simulation bonds the validators of the first NumBonded accounts. Every chain gets its
+	// external state, as in an exported genesis, but only the external chains get orchestrators.
+	bonded := simState.Accounts[:simState.NumBonded]
+	for _, chain := range mhub2Genesis.Params.Chains {
+		chainId := types.ChainID(chain)
+		externalState := &types.ExternalState{ChainId: chainId.String()}
+		if isExternalChain(chainId) {
+			for _, i := range simState.Rand.Perm(len(bonded))[:len(bonded)*delegatedValidators/100] {
+				externalState.DelegateKeys = append(externalState.DelegateKeys, genesisDelegateKeys(bonded[i], chainId))
+			}
+		}
+		mhub2Genesis.ExternalStates = append(mhub2Genesis.ExternalStates, externalState)
+	}
+
+	fundAccounts(simState, tokenInfos)
+
+	bz, err := json.MarshalIndent(&mhub2Genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated mhub2 parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(mhub2Genesis)
+}
+
+// genesisDelegateKeys makes the validator account its own orchestrator, with the external key derived
+// from the account private key
+func genesisDelegateKeys(acc simtypes.Account, chainId types.ChainID) *types.MsgDelegateKeys {
+	privKey, err := ethCrypto.ToECDSA(acc.PrivKey.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	signMsgBz, err := (&types.DelegateKeysSignMsg{ValidatorAddress: sdk.ValAddress(acc.Address).String()}).Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	signature, err := types.NewEthereumSignature(ethCrypto.Keccak256(signMsgBz), privKey)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewMsgDelegateKeys(
+		sdk.ValAddress(acc.Address),
+		chainId,
+		acc.Address,
+		ethCrypto.PubkeyToAddress(privKey.PublicKey).Hex(),
+		signature,
+	)
+}
+
+// fundAccounts gives every simulated account a random balance of each bridged denom, as if it has
+// already been transferred to the hub, and adds it to the bank genesis supply
+func fundAccounts(simState *module.SimulationState, tokenInfos *types.TokenInfos) {
+	bankGenesis := new(banktypes.GenesisState)
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], bankGenesis)
+
+	var denoms []string
+	seen := map[string]bool{}
+	for _, info := range tokenInfos.TokenInfos {
+		if !seen[info.Denom] {
+			seen[info.Denom] = true
+			denoms = append(denoms, info.Denom)
+		}
+	}
+
+	balances := map[string]int{}
+	for i, balance := range bankGenesis.Balances {
+		balances[balance.Address] = i
+	}
+
+	for _, acc := range simState.Accounts {
+		var coins sdk.Coins
+		for _, denom := range denoms {
+			coins = coins.Add(sdk.NewCoin(denom, sdk.NewInt(simState.Rand.Int63n(1e6)).Mul(sdk.NewInt(1e18))))
+		}
+
+		i, ok := balances[acc.Address.String()]
+		if !ok {
+			i = len(bankGenesis.Balances)
+			bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{Address: acc.Address.String()})
+		}
+		bankGenesis.Balances[i].Coins = bankGenesis.Balances[i].Coins.Add(coins...)
+		bankGenesis.Supply = bankGenesis.Supply.Add(coins...)
+	}
+
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(bankGenesis)
+}
+
+func isExternalChain(chainId types.ChainID) bool {
+	for _, c := range ExternalChains {
+		if c == chainId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func randomExternalAddress(r *rand.Rand) string {
+	bz := make([]byte, common.AddressLength)
+	r.Read(bz)
+	return common.BytesToAddress(bz).Hex()
+}
@@ -0,0 +1,508 @@
+package simulation
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/MinterTeam/mhub2/module/x/mhub2/keeper"
+	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSendToExternal               = "op_weight_msg_send_to_external"
+	OpWeightMsgCancelSendToExternal         = "op_weight_msg_cancel_send_to_external"
+	OpWeightMsgRequestBatchTx               = "op_weight_msg_request_batch_tx"
+	OpWeightMsgDelegateKeys                 = "op_weight_msg_delegate_keys"
+	OpWeightMsgSubmitExternalEvent          = "op_weight_msg_submit_external_event"
+	OpWeightMsgSubmitExternalTxConfirmation = "op_weight_msg_submit_external_tx_confirmation"
+
+	DefaultWeightMsgSendToExternal               = 50
+	DefaultWeightMsgCancelSendToExternal         = 10
+	DefaultWeightMsgRequestBatchTx               = 20
+	DefaultWeightMsgDelegateKeys                 = 10
+	DefaultWeightMsgSubmitExternalEvent          = 50
+	DefaultWeightMsgSubmitExternalTxConfirmation = 50
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgSendToExternal int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendToExternal, &weightMsgSendToExternal, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendToExternal = DefaultWeightMsgSendToExternal
+		},
+	)
+
+	var weightMsgCancelSendToExternal int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelSendToExternal, &weightMsgCancelSendToExternal, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelSendToExternal = DefaultWeightMsgCancelSendToExternal
+		},
+	)
+
+	var weightMsgRequestBatchTx int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRequestBatchTx, &weightMsgRequestBatchTx, nil,
+		func(_ *rand.Rand) {
+			weightMsgRequestBatchTx = DefaultWeightMsgRequestBatchTx
+		},
+	)
+
+	var weightMsgDelegateKeys int
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegateKeys, &weightMsgDelegateKeys, nil,
+		func(_ *rand.Rand) {
+			weightMsgDelegateKeys = DefaultWeightMsgDelegateKeys
+		},
+	)
+
+	var weightMsgSubmitExternalEvent int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitExternalEvent, &weightMsgSubmitExternalEvent, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitExternalEvent = DefaultWeightMsgSubmitExternalEvent
+		},
+	)
+
+	var weightMsgSubmitExternalTxConfirmation int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitExternalTxConfirmation, &weightMsgSubmitExternalTxConfirmation, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitExternalTxConfirmation = DefaultWeightMsgSubmitExternalTxConfirmation
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSendToExternal,
+			SimulateMsgSendToExternal(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelSendToExternal,
+			SimulateMsgCancelSendToExternal(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRequestBatchTx,
+			SimulateMsgRequestBatchTx(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDelegateKeys,
+			SimulateMsgDelegateKeys(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSubmitExternalEvent,
+			SimulateMsgSubmitExternalEvent(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSubmitExternalTxConfirmation,
+			SimulateMsgSubmitExternalTxConfirmation(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgSendToExternal generates a MsgSendToExternal of a random bridged coin of a random account,
+// paying the minimal bridge fee of the token
+func SimulateMsgSendToExternal(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgSendToExternal{}).Type()
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+		var infos []*types.TokenInfo
+		for _, info := range k.GetTokenInfos(ctx).TokenInfos {
+			if spendable.AmountOf(info.Denom).IsPositive() {
+				infos = append(infos, info)
+			}
+		}
+		if len(infos) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bridged coins"), nil, nil
+		}
+		info := infos[r.Intn(len(infos))]
+
+		recipient := randomExternalAddress(r)
+		if k.IsAddressBlocked(ctx, simAccount.Address.String()) || k.IsAddressBlocked(ctx, recipient) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "blocked address"), nil, nil
+		}
+
+		fee := k.GetMinBridgeFee(ctx, info)
+		if !info.MinFee.IsNil() {
+			fee = sdk.MaxInt(fee, info.MinFee)
+		}
+
+		balance := spendable.AmountOf(info.Denom)
+		if balance.LTE(fee) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "balance is less than the bridge fee"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance.Sub(fee))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+
+		commission := k.GetCommissionForHolder(ctx, []string{simAccount.Address.String(), recipient}, info.Commission).Mul(amount.Add(fee).ToDec()).TruncateInt()
+		if err := info.ValidateTransferMinimums(amount.Sub(commission), fee); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "transfer is below the minimums"), nil, nil
+		}
+
+		msg := &types.MsgSendToExternal{
+			Sender:            simAccount.Address.String(),
+			ExternalRecipient: recipient,
+			Amount:            sdk.NewCoin(info.Denom, amount),
+			BridgeFee:         sdk.NewCoin(info.Denom, fee),
+			ChainId:           info.ChainId,
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(sdk.NewCoin(info.Denom, amount.Add(fee))))
+	}
+}
+
+// SimulateMsgCancelSendToExternal generates a MsgCancelSendToExternal of a random unbatched tx sent by
+// one of the simulated accounts
+func SimulateMsgCancelSendToExternal(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgCancelSendToExternal{}).Type()
+		chainId := ExternalChains[r.Intn(len(ExternalChains))]
+
+		var sends []*types.SendToExternal
+		k.IterateUnbatchedSendToExternals(ctx, chainId, func(ste *types.SendToExternal) bool {
+			sends = append(sends, ste)
+			return false
+		})
+
+		perm := r.Perm(len(sends))
+		for _, i := range perm {
+			sender, err := sdk.AccAddressFromBech32(sends[i].Sender)
+			if err != nil {
+				continue
+			}
+
+			simAccount, found := simtypes.FindAccount(accs, sender)
+			if !found {
+				continue
+			}
+
+			msg := &types.MsgCancelSendToExternal{
+				Id:      sends[i].Id,
+				Sender:  sends[i].Sender,
+				ChainId: chainId.String(),
+			}
+
+			return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no unbatched txs of simulated accounts"), nil, nil
+	}
+}
+
+// SimulateMsgRequestBatchTx generates a MsgRequestBatchTx of a random token which has unbatched txs
+func SimulateMsgRequestBatchTx(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgRequestBatchTx{}).Type()
+		chainId := ExternalChains[r.Intn(len(ExternalChains))]
+
+		var externalTokenIds []string
+		seen := map[string]bool{}
+		k.IterateUnbatchedSendToExternals(ctx, chainId, func(ste *types.SendToExternal) bool {
+			if !seen[ste.Token.ExternalTokenId] {
+				seen[ste.Token.ExternalTokenId] = true
+				externalTokenIds = append(externalTokenIds, ste.Token.ExternalTokenId)
+			}
+			return false
+		})
+		if len(externalTokenIds) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unbatched txs"), nil, nil
+		}
+
+		info, err := k.ExternalIdToTokenInfoLookup(ctx, chainId, externalTokenIds[r.Intn(len(externalTokenIds))])
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unknown token"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgRequestBatchTx{
+			Denom:   info.Denom,
+			Signer:  simAccount.Address.String(),
+			ChainId: chainId.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgDelegateKeys generates a MsgDelegateKeys of a random bonded validator without keys on a
+// random external chain. The validator account becomes its own orchestrator.
+func SimulateMsgDelegateKeys(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgDelegateKeys{}).Type()
+		chainId := ExternalChains[r.Intn(len(ExternalChains))]
+
+		validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+		for _, i := range r.Perm(len(validators)) {
+			valAddr := validators[i].GetOperator()
+			if k.GetValidatorExternalAddress(ctx, chainId, valAddr) != (common.Address{}) {
+				continue
+			}
+
+			simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddr))
+			if !found || k.GetOrchestratorValidatorAddress(ctx, chainId, simAccount.Address) != nil {
+				continue
+			}
+
+			privKey := externalPrivKey(simAccount)
+			ethAddr := ethCrypto.PubkeyToAddress(privKey.PublicKey)
+			if !k.GetExternalOrchestratorAddress(ctx, chainId, ethAddr).Empty() {
+				continue
+			}
+
+			signMsgBz, err := (&types.DelegateKeysSignMsg{
+				ValidatorAddress: valAddr.String(),
+				Nonce:            ak.GetAccount(ctx, simAccount.Address).GetSequence(),
+			}).Marshal()
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to marshal sign msg"), nil, err
+			}
+
+			signature, err := types.NewEthereumSignature(ethCrypto.Keccak256(signMsgBz), privKey)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign delegate keys"), nil, err
+			}
+
+			msg := types.NewMsgDelegateKeys(valAddr, chainId, simAccount.Address, ethAddr.Hex(), signature)
+
+			return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no validators without keys"), nil, nil
+	}
+}
+
+// SimulateMsgSubmitExternalEvent generates a MsgSubmitExternalEvent of the next event of a random
+// orchestrator. The event of every nonce is derived from the chain and the nonce only, so the
+// orchestrators vote for the same events and they get observed.
+func SimulateMsgSubmitExternalEvent(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgSubmitExternalEvent{}).Type()
+		chainId := ExternalChains[r.Intn(len(ExternalChains))]
+
+		orchAccount, found := randomOrchestrator(r, ctx, k, chainId, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no orchestrators"), nil, nil
+		}
+
+		var infos []*types.TokenInfo
+		for _, info := range k.GetTokenInfos(ctx).TokenInfos {
+			if info.ChainId == chainId.String() {
+				infos = append(infos, info)
+			}
+		}
+		if len(infos) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no tokens on the chain"), nil, nil
+		}
+
+		res, err := k.LastSubmittedExternalEvent(sdk.WrapSDKContext(ctx), &types.LastSubmittedExternalEventRequest{
+			Address: orchAccount.Address.String(),
+			ChainId: chainId.String(),
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get last submitted event"), nil, err
+		}
+
+		nonce := res.EventNonce + 1
+		if res.EventNonce == 0 {
+			nonce = k.GetLastObservedEventNonce(ctx, chainId) + 1
+		}
+
+		info := infos[nonce%uint64(len(infos))]
+		receiver := accs[nonce%uint64(len(accs))]
+		event := &types.SendToHubEvent{
+			EventNonce:     nonce,
+			ExternalCoinId: info.ExternalTokenId,
+			Amount:         sdk.NewIntWithDecimal(int64(nonce%100+1), int(info.ExternalDecimals)),
+			Sender:         common.BytesToAddress(ethCrypto.Keccak256([]byte(fmt.Sprintf("%s/%d", chainId, nonce)))).Hex(),
+			CosmosReceiver: receiver.Address.String(),
+			ExternalHeight: nonce,
+			TxHash:         fmt.Sprintf("0x%x", sha256.Sum256([]byte(fmt.Sprintf("%s/%d", chainId, nonce)))),
+		}
+
+		eventAny, err := types.PackEvent(event)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack event"), nil, err
+		}
+
+		msg := &types.MsgSubmitExternalEvent{
+			Event:   eventAny,
+			Signer:  orchAccount.Address.String(),
+			ChainId: chainId.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, orchAccount, msg, nil)
+	}
+}
+
+// SimulateMsgSubmitExternalTxConfirmation generates a MsgSubmitExternalTxConfirmation of a random
+// orchestrator for a random signer set or batch it has not signed yet
+func SimulateMsgSubmitExternalTxConfirmation(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgSubmitExternalTxConfirmation{}).Type()
+		chainId := ExternalChains[r.Intn(len(ExternalChains))]
+
+		orchAccount, found := randomOrchestrator(r, ctx, k, chainId, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no orchestrators"), nil, nil
+		}
+
+		wctx := sdk.WrapSDKContext(ctx)
+		signerSets, err := k.UnsignedSignerSetTxs(wctx, &types.UnsignedSignerSetTxsRequest{
+			Address: orchAccount.Address.String(),
+			ChainId: chainId.String(),
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get unsigned signer sets"), nil, err
+		}
+
+		batches, err := k.UnsignedBatchTxs(wctx, &types.UnsignedBatchTxsRequest{
+			Address: orchAccount.Address.String(),
+			ChainId: chainId.String(),
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get unsigned batches"), nil, err
+		}
+
+		var otxs []types.OutgoingTx
+		for _, signerSet := range signerSets.SignerSets {
+			otxs = append(otxs, signerSet)
+		}
+		for _, batch := range batches.Batches {
+			otxs = append(otxs, batch)
+		}
+		if len(otxs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unsigned outgoing txs"), nil, nil
+		}
+
+		privKey := externalPrivKey(orchAccount)
+		ethAddr := ethCrypto.PubkeyToAddress(privKey.PublicKey)
+
+		otx := otxs[r.Intn(len(otxs))]
+		signature, err := types.NewEthereumSignature(otx.GetCheckpoint([]byte(k.GetParams(ctx).GravityId)), privKey)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign outgoing tx"), nil, err
+		}
+
+		var confirmation types.ExternalTxConfirmation
+		switch otx := otx.(type) {
+		case *types.SignerSetTx:
+			confirmation = &types.SignerSetTxConfirmation{
+				SignerSetNonce: otx.Nonce,
+				ExternalSigner: ethAddr.Hex(),
+				Signature:      signature,
+			}
+		case *types.BatchTx:
+			confirmation = &types.BatchTxConfirmation{
+				ExternalTokenId: otx.ExternalTokenId,
+				BatchNonce:      otx.BatchNonce,
+				ExternalSigner:  ethAddr.Hex(),
+				Signature:       signature,
+			}
+		}
+
+		confirmationAny, err := types.PackConfirmation(confirmation)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack confirmation"), nil, err
+		}
+
+		msg := &types.MsgSubmitExternalTxConfirmation{
+			Confirmation: confirmationAny,
+			Signer:       orchAccount.Address.String(),
+			ChainId:      chainId.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, orchAccount, msg, nil)
+	}
+}
+
+// randomOrchestrator returns the account of a random bonded validator which has delegated its keys on the
+// chain to itself, as the simulated validators do
+func randomOrchestrator(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, chainId types.ChainID, accs []simtypes.Account) (simtypes.Account, bool) {
+	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	for _, i := range r.Perm(len(validators)) {
+		if validators[i].GetStatus() != stakingtypes.Bonded {
+			continue
+		}
+
+		valAddr := validators[i].GetOperator()
+		ethAddr := k.GetValidatorExternalAddress(ctx, chainId, valAddr)
+		if ethAddr == (common.Address{}) {
+			continue
+		}
+
+		orchAddr := k.GetExternalOrchestratorAddress(ctx, chainId, ethAddr)
+		if !orchAddr.Equals(sdk.AccAddress(valAddr)) {
+			continue
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, orchAddr)
+		if !found || ethCrypto.PubkeyToAddress(externalPrivKey(simAccount).PublicKey) != ethAddr {
+			continue
+		}
+
+		return simAccount, true
+	}
+
+	return simtypes.Account{}, false
+}
+
+// externalPrivKey derives the key the simulated orchestrator signs for the external chains with
+func externalPrivKey(acc simtypes.Account) *ecdsa.PrivateKey {
+	privKey, err := ethCrypto.ToECDSA(acc.PrivKey.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	return privKey
+}
+
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+	simAccount simtypes.Account, msg legacytx.LegacyMsg, spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Msg:             msg,
+		MsgType:         msg.Type(),
+		CoinsSpentInMsg: spent,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	})
+}
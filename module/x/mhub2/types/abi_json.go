@@ -44,7 +44,9 @@ const (
 			{ "internalType": "bytes32",   "name": "_checkpoint",  "type": "bytes32"   },
 			{ "internalType": "uint256",   "name": "_valsetNonce", "type": "uint256"   },
 			{ "internalType": "address[]", "name": "_validators",  "type": "address[]" },
-			{ "internalType": "uint256[]", "name": "_powers",      "type": "uint256[]" }
+			{ "internalType": "uint256[]", "name": "_powers",      "type": "uint256[]" },
+			{ "internalType": "uint256[]", "name": "_weights",     "type": "uint256[]" },
+			{ "internalType": "uint256",   "name": "_threshold",   "type": "uint256"   }
 		],
 		"outputs": [
 			{ "internalType": "bytes32", "name": "", "type": "bytes32" }
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// QuarantineAccountName is the module account holding deposits which involved a blocked address
+const QuarantineAccountName = "mhub2_quarantine"
+
+// NormalizeBlocklistAddress returns the canonical form under which an address is stored in the blocklist.
+// External addresses are compared case-insensitively, hub addresses are kept in bech32.
+func NormalizeBlocklistAddress(address string) (string, error) {
+	address = strings.TrimSpace(address)
+	if common.IsHexAddress(address) {
+		return strings.ToLower(common.HexToAddress(address).Hex()), nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return "", fmt.Errorf("invalid address %q: should be hex or bech32", address)
+	}
+
+	return strings.ToLower(address), nil
+}
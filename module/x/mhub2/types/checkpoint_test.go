@@ -82,6 +82,6 @@ func TestValsetCheckpoint(t *testing.T) {
 	ourHash := src.GetCheckpoint([]byte("foo"))
 
 	// hash from bridge contract
-	goldHash := "0xf024ab7404464494d3919e5a7f0d8ac40804fb9bd39ad5d16cdb3e66aa219b64"[2:]
+	goldHash := "0x7ef69d606bb464fa45e2cac840eb0ee1b2a907e4263ffda669fed42d1d06ba60"[2:]
 	assert.Equal(t, goldHash, hex.EncodeToString(ourHash))
 }
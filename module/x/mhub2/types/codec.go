@@ -12,10 +12,37 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-// RegisterLegacyAminoCodec registers the vesting interfaces and concrete types on the
-// provided LegacyAmino codec. These types are used for Amino JSON serialization
+// RegisterLegacyAminoCodec registers the mhub2 interfaces and concrete types on the
+// provided LegacyAmino codec. These types are used for Amino JSON serialization, so the names
+// are part of the sign bytes and must not change. They are kept under 40 characters for Ledger.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
-	cdc.RegisterConcrete(&MsgDelegateKeys{}, "mhub2-bridge/", nil)
+	cdc.RegisterConcrete(&MsgSendToExternal{}, "mhub2/MsgSendToExternal", nil)
+	cdc.RegisterConcrete(&MsgCancelSendToExternal{}, "mhub2/MsgCancelSendToExternal", nil)
+	cdc.RegisterConcrete(&MsgRequestBatchTx{}, "mhub2/MsgRequestBatchTx", nil)
+	cdc.RegisterConcrete(&MsgSubmitExternalEvent{}, "mhub2/MsgSubmitExternalEvent", nil)
+	cdc.RegisterConcrete(&MsgSubmitExternalTxConfirmation{}, "mhub2/MsgSubmitExternalTxConfirmation", nil)
+	cdc.RegisterConcrete(&MsgDelegateKeys{}, "mhub2/MsgDelegateKeys", nil)
+	cdc.RegisterConcrete(&MsgCancelTimelockedTransfer{}, "mhub2/MsgCancelTimelockedTransfer", nil)
+	cdc.RegisterConcrete(&MsgRotateDelegateKeys{}, "mhub2/MsgRotateDelegateKeys", nil)
+	cdc.RegisterConcrete(&MsgDelegateKeysMulti{}, "mhub2/MsgDelegateKeysMulti", nil)
+
+	cdc.RegisterConcrete(&ColdStorageTransferProposal{}, "mhub2/ColdStorageTransferProposal", nil)
+	cdc.RegisterConcrete(&TokenInfosChangeProposal{}, "mhub2/TokenInfosChangeProposal", nil)
+	cdc.RegisterConcrete(&BlocklistChangeProposal{}, "mhub2/BlocklistChangeProposal", nil)
+	cdc.RegisterConcrete(&QuarantinedDepositProposal{}, "mhub2/QuarantinedDepositProposal", nil)
+	cdc.RegisterConcrete(&GuardianSetChangeProposal{}, "mhub2/GuardianSetChangeProposal", nil)
+
+	cdc.RegisterInterface((*ExternalEvent)(nil), nil)
+	cdc.RegisterConcrete(&SendToHubEvent{}, "mhub2/SendToHubEvent", nil)
+	cdc.RegisterConcrete(&TransferToChainEvent{}, "mhub2/TransferToChainEvent", nil)
+	cdc.RegisterConcrete(&BatchExecutedEvent{}, "mhub2/BatchExecutedEvent", nil)
+	cdc.RegisterConcrete(&ContractCallExecutedEvent{}, "mhub2/ContractCallExecutedEvent", nil)
+	cdc.RegisterConcrete(&SignerSetTxExecutedEvent{}, "mhub2/SignerSetTxExecutedEvent", nil)
+
+	cdc.RegisterInterface((*ExternalTxConfirmation)(nil), nil)
+	cdc.RegisterConcrete(&BatchTxConfirmation{}, "mhub2/BatchTxConfirmation", nil)
+	cdc.RegisterConcrete(&ContractCallTxConfirmation{}, "mhub2/ContractCallTxConfirmation", nil)
+	cdc.RegisterConcrete(&SignerSetTxConfirmation{}, "mhub2/SignerSetTxConfirmation", nil)
 }
 
 var (
@@ -45,12 +72,18 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSubmitExternalEvent{},
 		&MsgSubmitExternalTxConfirmation{},
 		&MsgDelegateKeys{},
+		&MsgCancelTimelockedTransfer{},
+		&MsgRotateDelegateKeys{},
+		&MsgDelegateKeysMulti{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&ColdStorageTransferProposal{},
 		&TokenInfosChangeProposal{},
+		&BlocklistChangeProposal{},
+		&QuarantinedDepositProposal{},
+		&GuardianSetChangeProposal{},
 	)
 
 	registry.RegisterInterface(
@@ -0,0 +1,146 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MinterTeam/mhub2/module/app"
+	"github.com/MinterTeam/mhub2/module/x/mhub2/types"
+)
+
+func TestLegacyAminoJSONRoundTrip(t *testing.T) {
+	var (
+		accAddress = sdk.AccAddress(bytes.Repeat([]byte{0x2}, app.MaxAddrLen)).String()
+		valAddress = sdk.ValAddress(bytes.Repeat([]byte{0x2}, app.MaxAddrLen)).String()
+		ethAddress = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
+		coin       = sdk.NewCoin("hub", sdk.NewInt(100))
+	)
+
+	sendToHub, err := types.PackEvent(&types.SendToHubEvent{
+		EventNonce:     1,
+		ExternalCoinId: ethAddress,
+		Amount:         sdk.NewInt(100),
+		Sender:         ethAddress,
+		CosmosReceiver: accAddress,
+		ExternalHeight: 10,
+		TxHash:         "0x01",
+	})
+	require.NoError(t, err)
+
+	batchConfirmation, err := types.PackConfirmation(&types.BatchTxConfirmation{
+		ExternalTokenId: ethAddress,
+		BatchNonce:      1,
+		ExternalSigner:  ethAddress,
+		Signature:       []byte{0x1},
+	})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		msg      legacytx.LegacyMsg
+		typeName string
+	}{
+		"send to external": {
+			msg:      types.NewMsgSendToExternal("ethereum", sdk.AccAddress(bytes.Repeat([]byte{0x2}, app.MaxAddrLen)), ethAddress, coin, coin),
+			typeName: "mhub2/MsgSendToExternal",
+		},
+		"cancel send to external": {
+			msg:      &types.MsgCancelSendToExternal{Id: 1, Sender: accAddress, ChainId: "ethereum"},
+			typeName: "mhub2/MsgCancelSendToExternal",
+		},
+		"request batch tx": {
+			msg:      &types.MsgRequestBatchTx{Denom: "hub", Signer: accAddress, ChainId: "ethereum"},
+			typeName: "mhub2/MsgRequestBatchTx",
+		},
+		"submit external event": {
+			msg:      &types.MsgSubmitExternalEvent{Event: sendToHub, Signer: accAddress, ChainId: "ethereum"},
+			typeName: "mhub2/MsgSubmitExternalEvent",
+		},
+		"submit external tx confirmation": {
+			msg:      &types.MsgSubmitExternalTxConfirmation{Confirmation: batchConfirmation, Signer: accAddress, ChainId: "ethereum"},
+			typeName: "mhub2/MsgSubmitExternalTxConfirmation",
+		},
+		"delegate keys": {
+			msg:      &types.MsgDelegateKeys{ValidatorAddress: valAddress, OrchestratorAddress: accAddress, ExternalAddress: ethAddress, EthSignature: []byte{0x1}, ChainId: "ethereum"},
+			typeName: "mhub2/MsgDelegateKeys",
+		},
+		"cancel timelocked transfer": {
+			msg:      &types.MsgCancelTimelockedTransfer{Id: 1, Guardian: accAddress, ChainId: "ethereum"},
+			typeName: "mhub2/MsgCancelTimelockedTransfer",
+		},
+		"rotate delegate keys": {
+			msg:      &types.MsgRotateDelegateKeys{ValidatorAddress: valAddress, OrchestratorAddress: accAddress, ExternalAddress: ethAddress, EthSignature: []byte{0x1}, ChainId: "ethereum"},
+			typeName: "mhub2/MsgRotateDelegateKeys",
+		},
+		"delegate keys multi": {
+			msg: &types.MsgDelegateKeysMulti{
+				ValidatorAddress:    valAddress,
+				OrchestratorAddress: accAddress,
+				Entries:             []types.DelegateKeysMultiEntry{{ChainId: "ethereum", ExternalAddress: ethAddress, EthSignature: []byte{0x1}}},
+			},
+			typeName: "mhub2/MsgDelegateKeysMulti",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			signBytes := spec.msg.GetSignBytes()
+
+			var wrapped struct {
+				Type string `json:"type"`
+			}
+			require.NoError(t, json.Unmarshal(signBytes, &wrapped))
+			require.Equal(t, spec.typeName, wrapped.Type)
+			require.Less(t, len(wrapped.Type), 40, "amino names longer than 39 characters can't be signed with Ledger")
+
+			decoded := reflect.New(reflect.TypeOf(spec.msg).Elem()).Interface().(legacytx.LegacyMsg)
+			require.NoError(t, types.ModuleCdc.UnmarshalJSON(types.ModuleCdc.MustMarshalJSON(spec.msg), decoded))
+			require.Equal(t, signBytes, decoded.GetSignBytes())
+			require.NoError(t, decoded.ValidateBasic())
+		})
+	}
+}
+
+func TestLegacyAminoJSONProposals(t *testing.T) {
+	specs := map[string]struct {
+		proposal interface{}
+		typeName string
+	}{
+		"cold storage transfer": {
+			proposal: &types.ColdStorageTransferProposal{ChainId: "ethereum", Amount: sdk.NewCoins(sdk.NewInt64Coin("hub", 1))},
+			typeName: "mhub2/ColdStorageTransferProposal",
+		},
+		"token infos change": {
+			proposal: &types.TokenInfosChangeProposal{NewInfos: types.DefaultGenesisState().TokenInfos},
+			typeName: "mhub2/TokenInfosChangeProposal",
+		},
+		"blocklist change": {
+			proposal: &types.BlocklistChangeProposal{AddAddresses: []string{"0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"}},
+			typeName: "mhub2/BlocklistChangeProposal",
+		},
+		"quarantined deposit": {
+			proposal: &types.QuarantinedDepositProposal{DepositId: 1},
+			typeName: "mhub2/QuarantinedDepositProposal",
+		},
+		"guardian set change": {
+			proposal: &types.GuardianSetChangeProposal{Guardians: []string{"guardian"}},
+			typeName: "mhub2/GuardianSetChangeProposal",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			bz, err := types.ModuleCdc.LegacyAmino.MarshalJSON(spec.proposal)
+			require.NoError(t, err)
+
+			var wrapped struct {
+				Type string `json:"type"`
+			}
+			require.NoError(t, json.Unmarshal(bz, &wrapped))
+			require.Equal(t, spec.typeName, wrapped.Type)
+		})
+	}
+}
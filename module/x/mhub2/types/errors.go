@@ -10,4 +10,6 @@ var (
 	ErrDelegateKeys      = sdkerrors.Register(ModuleName, 5, "failed to delegate keys")
 	ErrEmptyEthSig       = sdkerrors.Register(ModuleName, 6, "empty Ethereum signature")
 	ErrInvalidERC20Event = sdkerrors.Register(ModuleName, 7, "invalid ERC20 deployed event")
+	ErrBelowMinimum      = sdkerrors.Register(ModuleName, 8, "transfer is below minimum")
+	ErrBlockedAddress    = sdkerrors.Register(ModuleName, 9, "address is blocked")
 )
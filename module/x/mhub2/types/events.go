@@ -1,14 +1,19 @@
 package types
 
 const (
-	EventTypeObservation              = "observation"
-	EventTypeOutgoingBatch            = "outgoing_batch"
-	EventTypeMultisigUpdateRequest    = "multisig_update_request"
-	EventTypeOutgoingBatchCanceled    = "outgoing_batch_canceled"
-	EventTypeContractCallTxCanceled   = "outgoing_logic_call_canceled"
-	EventTypeBridgeWithdrawalReceived = "withdrawal_received"
-	EventTypeBridgeDepositReceived    = "deposit_received"
-	EventTypeBridgeWithdrawCanceled   = "withdraw_canceled"
+	EventTypeObservation                = "observation"
+	EventTypeOutgoingBatch              = "outgoing_batch"
+	EventTypeMultisigUpdateRequest      = "multisig_update_request"
+	EventTypeOutgoingBatchCanceled      = "outgoing_batch_canceled"
+	EventTypeContractCallTxCanceled     = "outgoing_logic_call_canceled"
+	EventTypeBridgeWithdrawalReceived   = "withdrawal_received"
+	EventTypeBridgeDepositReceived      = "deposit_received"
+	EventTypeBridgeWithdrawCanceled     = "withdraw_canceled"
+	EventTypeTransferRefunded           = "transfer_refunded"
+	EventTypeDepositQuarantined         = "deposit_quarantined"
+	EventTypeQuarantineResolved         = "quarantine_resolved"
+	EventTypeTransferTimelocked         = "transfer_timelocked"
+	EventTypeTimelockedTransferCanceled = "timelocked_transfer_canceled"
 
 	AttributeKeyEthereumEventVoteRecordID     = "ethereum_event_vote_record_id"
 	AttributeKeyBatchConfirmKey               = "batch_confirm_key"
@@ -30,4 +35,8 @@ const (
 	AttributeKeyContractCallTokens            = "contract_call_tokens"
 	AttributeKeyContractCallFees              = "contract_call_fees"
 	AttributeKeyEthTxTimeout                  = "eth_tx_timeout"
+	AttributeKeyReason                        = "reason"
+	AttributeKeyQuarantinedDepositID          = "quarantined_deposit_id"
+	AttributeKeyQuarantineResolution          = "quarantine_resolution"
+	AttributeKeyReleaseHeight                 = "release_height"
 )
@@ -50,7 +50,10 @@ type AccountKeeper interface {
 }
 
 type OracleKeeper interface {
-	MustGetTokenPrice(ctx sdk.Context, denom string) sdk.Dec
 	GetTokenPrice(ctx sdk.Context, denom string) (sdk.Dec, error)
+	TWAP(ctx sdk.Context, denom string, window uint64) (sdk.Dec, error)
 	GetHolderValue(ctx sdk.Context, address string) sdk.Int
+	GetExternalFee(ctx sdk.Context, chainId string) (min sdk.Dec, fast sdk.Dec, err error)
+	GetBatchFee(ctx sdk.Context, chainId string) (sdk.Dec, error)
+	GetBaseCoinFeed(ctx sdk.Context, chainId string) (string, error)
 }
@@ -45,7 +45,7 @@ func (e ExternalToken) HubCoin(denomResolver func(id uint64) (string, error)) sd
 }
 
 func NewSendToExternalTx(id uint64, chainId ChainID, tokenId uint64, externalTokenId string, sender sdk.AccAddress,
-	recipient common.Address, amount, feeAmount, valCommission uint64, txHash string, createdAt uint64) *SendToExternal {
+	recipient common.Address, amount, feeAmount, valCommission uint64, txHash string, createdAt, expiresAt uint64) *SendToExternal {
 	return &SendToExternal{
 		Id:                id,
 		Sender:            sender.String(),
@@ -56,6 +56,7 @@ func NewSendToExternalTx(id uint64, chainId ChainID, tokenId uint64, externalTok
 		TxHash:            txHash,
 		ValCommission:     NewExternalToken(valCommission, tokenId, externalTokenId),
 		CreatedAt:         createdAt,
+		ExpiresAt:         expiresAt,
 		RefundAddress:     sender.String(),
 		RefundChainId:     "hub",
 	}
@@ -103,6 +103,12 @@ func (sse *SignerSetTxExecutedEvent) Hash() tmbytes.HexBytes {
 		},
 		[]byte{},
 	)
+	// the events without the multisig keep the hash they had before it was added, the events are recorded
+	// without it until the check is enabled in the signer set params of the chain
+	if sse.Threshold != 0 {
+		path = append(path, sdk.Uint64ToBigEndian(sse.Threshold)...)
+		path = append(path, ExternalSigners(sse.Members).WeightsHash()...)
+	}
 	hash := sha256.Sum256(([]byte(path)))
 	return hash[:]
 }
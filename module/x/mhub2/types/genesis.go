@@ -73,6 +73,20 @@ var (
 	ParamChains            = []byte("Chains")
 	ParamOutgoingTxTimeout = []byte("OutgoingTxTimeout")
 
+	ParamWithdrawalTimelockBlocks = []byte("WithdrawalTimelockBlocks")
+
+	// ParamSignerSetParams stores the per-chain signer set tx creation triggers
+	ParamSignerSetParams = []byte("SignerSetParams")
+
+	// ParamChainOutgoingTxTimeouts stores the per-chain and per-token overrides of the outgoing tx timeout
+	ParamChainOutgoingTxTimeouts = []byte("ChainOutgoingTxTimeouts")
+
+	// ParamMinBridgeFeeRatio stores the share of the oracle estimated transfer cost the bridge fee has to cover
+	ParamMinBridgeFeeRatio = []byte("MinBridgeFeeRatio")
+
+	// ParamFeePriceTwapWindow stores the number of the oracle epochs the token prices of the fees are averaged over
+	ParamFeePriceTwapWindow = []byte("FeePriceTwapWindow")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -110,6 +124,16 @@ func (s GenesisState) ValidateBasic() error {
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	for _, guardian := range s.Guardians {
+		if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+			return sdkerrors.Wrap(err, "guardians")
+		}
+	}
+	for _, address := range s.Blocklist {
+		if _, err := NormalizeBlocklistAddress(address); err != nil {
+			return sdkerrors.Wrap(err, "blocklist")
+		}
+	}
 	return nil
 }
 
@@ -197,6 +221,9 @@ func DefaultParams() *Params {
 		UnbondSlashingSignerSetTxsWindow:          10000,
 		Chains:                                    []string{"ethereum", "minter", "bsc", "hub"},
 		OutgoingTxTimeout:                         86400000 - 1,
+		WithdrawalTimelockBlocks:                  720,
+		MinBridgeFeeRatio:                         sdk.ZeroDec(),
+		FeePriceTwapWindow:                        12,
 	}
 }
 
@@ -250,6 +277,18 @@ func (p Params) ValidateBasic() error {
 	if err := validateChains(p.Chains); err != nil {
 		return sdkerrors.Wrap(err, "chains")
 	}
+	if err := validateSignerSetParams(p.SignerSetParams); err != nil {
+		return sdkerrors.Wrap(err, "signer set params")
+	}
+	if err := validateChainOutgoingTxTimeouts(p.ChainOutgoingTxTimeouts); err != nil {
+		return sdkerrors.Wrap(err, "chain outgoing tx timeouts")
+	}
+	if err := validateMinBridgeFeeRatio(p.MinBridgeFeeRatio); err != nil {
+		return sdkerrors.Wrap(err, "min bridge fee ratio")
+	}
+	if err := validateFeePriceTwapWindow(p.FeePriceTwapWindow); err != nil {
+		return sdkerrors.Wrap(err, "fee price twap window")
+	}
 
 	return nil
 }
@@ -281,7 +320,56 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreUnbondSlashingSignerSetTxsWindow, &p.UnbondSlashingSignerSetTxsWindow, validateUnbondSlashingSignerSetTxsWindow),
 		paramtypes.NewParamSetPair(ParamOutgoingTxTimeout, &p.OutgoingTxTimeout, validateOutgoingTxTimeout),
 		paramtypes.NewParamSetPair(ParamChains, &p.Chains, validateChains),
+		paramtypes.NewParamSetPair(ParamWithdrawalTimelockBlocks, &p.WithdrawalTimelockBlocks, validateWithdrawalTimelockBlocks),
+		paramtypes.NewParamSetPair(ParamSignerSetParams, &p.SignerSetParams, validateSignerSetParams),
+		paramtypes.NewParamSetPair(ParamChainOutgoingTxTimeouts, &p.ChainOutgoingTxTimeouts, validateChainOutgoingTxTimeouts),
+		paramtypes.NewParamSetPair(ParamMinBridgeFeeRatio, &p.MinBridgeFeeRatio, validateMinBridgeFeeRatio),
+		paramtypes.NewParamSetPair(ParamFeePriceTwapWindow, &p.FeePriceTwapWindow, validateFeePriceTwapWindow),
+	}
+}
+
+// OutgoingTxTimeoutOf returns the outgoing tx timeout in milliseconds of the token on the chain. A token override
+// takes precedence over the chain override, which takes precedence over the global timeout.
+func (p Params) OutgoingTxTimeoutOf(chainId ChainID, tokenId uint64) uint64 {
+	for _, chain := range p.ChainOutgoingTxTimeouts {
+		if chain.ChainId != chainId.String() {
+			continue
+		}
+
+		for _, token := range chain.TokenTimeouts {
+			if token.TokenId == tokenId {
+				return token.Timeout
+			}
+		}
+
+		if chain.Timeout != 0 {
+			return chain.Timeout
+		}
+	}
+
+	return p.OutgoingTxTimeout
+}
+
+// DefaultSignerSetParams returns the signer set params of a chain which is not listed in the params: a new
+// signer set tx is created once the power changes by 5%, without a maximal age, a minimal interval and a signer
+// power cap
+func DefaultSignerSetParams(chainId ChainID) SignerSetParams {
+	return SignerSetParams{
+		ChainId:            chainId.String(),
+		PowerDiffThreshold: sdk.NewDecWithPrec(5, 2),
+		MaxSignerPower:     sdk.ZeroDec(),
+	}
+}
+
+// SignerSetParamsOf returns the signer set params of the chain
+func (p Params) SignerSetParamsOf(chainId ChainID) SignerSetParams {
+	for _, params := range p.SignerSetParams {
+		if params.ChainId == chainId.String() {
+			return params
+		}
 	}
+
+	return DefaultSignerSetParams(chainId)
 }
 
 // Equal returns a boolean determining if two Params types are identical.
@@ -397,6 +485,71 @@ func validateOutgoingTxTimeout(i interface{}) error {
 	return nil
 }
 
+func validateWithdrawalTimelockBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSignerSetParams(i interface{}) error {
+	v, ok := i.([]SignerSetParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, params := range v {
+		if params.ChainId == "" {
+			return fmt.Errorf("empty chain id")
+		}
+		if seen[params.ChainId] {
+			return fmt.Errorf("duplicate params of chain %s", params.ChainId)
+		}
+		seen[params.ChainId] = true
+
+		if params.PowerDiffThreshold.IsNil() || params.PowerDiffThreshold.IsNegative() || params.PowerDiffThreshold.GT(sdk.OneDec()) {
+			return fmt.Errorf("power diff threshold of chain %s should be within [0, 1]", params.ChainId)
+		}
+		if !params.MaxSignerPower.IsNil() && (params.MaxSignerPower.IsNegative() || params.MaxSignerPower.GT(sdk.OneDec())) {
+			return fmt.Errorf("max signer power of chain %s should be within [0, 1]", params.ChainId)
+		}
+	}
+
+	return nil
+}
+
+func validateChainOutgoingTxTimeouts(i interface{}) error {
+	v, ok := i.([]ChainOutgoingTxTimeout)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, chain := range v {
+		if chain.ChainId == "" {
+			return fmt.Errorf("empty chain id")
+		}
+		if seen[chain.ChainId] {
+			return fmt.Errorf("duplicate timeouts of chain %s", chain.ChainId)
+		}
+		seen[chain.ChainId] = true
+
+		seenTokens := make(map[uint64]bool, len(chain.TokenTimeouts))
+		for _, token := range chain.TokenTimeouts {
+			if token.Timeout == 0 {
+				return fmt.Errorf("zero timeout of token %d on chain %s", token.TokenId, chain.ChainId)
+			}
+			if seenTokens[token.TokenId] {
+				return fmt.Errorf("duplicate timeout of token %d on chain %s", token.TokenId, chain.ChainId)
+			}
+			seenTokens[token.TokenId] = true
+		}
+	}
+
+	return nil
+}
+
 func validateSlashFractionSignerSetTx(i interface{}) error {
 	// TODO: do we want to set some bounds on this value?
 	if _, ok := i.(sdk.Dec); !ok {
@@ -445,6 +598,24 @@ func validateSlashFractionConflictingEthereumSignature(i interface{}) error {
 	return nil
 }
 
+func validateMinBridgeFeeRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min bridge fee ratio should not be negative")
+	}
+	return nil
+}
+
+func validateFeePriceTwapWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
@@ -106,6 +106,15 @@ type Params struct {
 	UnbondSlashingSignerSetTxsWindow          uint64                                 `protobuf:"varint,18,opt,name=unbond_slashing_signer_set_txs_window,json=unbondSlashingSignerSetTxsWindow,proto3" json:"unbond_slashing_signer_set_txs_window,omitempty"`
 	Chains                                    []string                               `protobuf:"bytes,19,rep,name=chains,proto3" json:"chains,omitempty"`
 	OutgoingTxTimeout                         uint64                                 `protobuf:"varint,20,opt,name=outgoing_tx_timeout,json=outgoingTxTimeout,proto3" json:"outgoing_tx_timeout,omitempty"`
+	WithdrawalTimelockBlocks                  uint64                                 `protobuf:"varint,21,opt,name=withdrawal_timelock_blocks,json=withdrawalTimelockBlocks,proto3" json:"withdrawal_timelock_blocks,omitempty"`
+	SignerSetParams                           []SignerSetParams                      `protobuf:"bytes,22,rep,name=signer_set_params,json=signerSetParams,proto3" json:"signer_set_params"`
+	ChainOutgoingTxTimeouts                   []ChainOutgoingTxTimeout               `protobuf:"bytes,23,rep,name=chain_outgoing_tx_timeouts,json=chainOutgoingTxTimeouts,proto3" json:"chain_outgoing_tx_timeouts"`
+	// share of the oracle estimated cost of a transfer in a full batch which the
+	// bridge fee of the transfer has to cover, 0 disables the check
+	MinBridgeFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,24,opt,name=min_bridge_fee_ratio,json=minBridgeFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_bridge_fee_ratio"`
+	// number of the oracle epochs the token prices of the fees are averaged
+	// over, 0 uses the spot prices
+	FeePriceTwapWindow uint64 `protobuf:"varint,25,opt,name=fee_price_twap_window,json=feePriceTwapWindow,proto3" json:"fee_price_twap_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -239,20 +248,249 @@ func (m *Params) GetOutgoingTxTimeout() uint64 {
 	return 0
 }
 
+func (m *Params) GetWithdrawalTimelockBlocks() uint64 {
+	if m != nil {
+		return m.WithdrawalTimelockBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSignerSetParams() []SignerSetParams {
+	if m != nil {
+		return m.SignerSetParams
+	}
+	return nil
+}
+
+func (m *Params) GetChainOutgoingTxTimeouts() []ChainOutgoingTxTimeout {
+	if m != nil {
+		return m.ChainOutgoingTxTimeouts
+	}
+	return nil
+}
+
+func (m *Params) GetFeePriceTwapWindow() uint64 {
+	if m != nil {
+		return m.FeePriceTwapWindow
+	}
+	return 0
+}
+
+// ChainOutgoingTxTimeout overrides the outgoing tx timeout of the params for a
+// chain and, optionally, for some of its tokens. Timeouts are in milliseconds.
+type ChainOutgoingTxTimeout struct {
+	ChainId       string                   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Timeout       uint64                   `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	TokenTimeouts []TokenOutgoingTxTimeout `protobuf:"bytes,3,rep,name=token_timeouts,json=tokenTimeouts,proto3" json:"token_timeouts"`
+}
+
+func (m *ChainOutgoingTxTimeout) Reset()         { *m = ChainOutgoingTxTimeout{} }
+func (m *ChainOutgoingTxTimeout) String() string { return proto.CompactTextString(m) }
+func (*ChainOutgoingTxTimeout) ProtoMessage()    {}
+func (*ChainOutgoingTxTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fae696fa24230542, []int{1}
+}
+func (m *ChainOutgoingTxTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainOutgoingTxTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainOutgoingTxTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainOutgoingTxTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainOutgoingTxTimeout.Merge(m, src)
+}
+func (m *ChainOutgoingTxTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainOutgoingTxTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainOutgoingTxTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainOutgoingTxTimeout proto.InternalMessageInfo
+
+func (m *ChainOutgoingTxTimeout) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainOutgoingTxTimeout) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *ChainOutgoingTxTimeout) GetTokenTimeouts() []TokenOutgoingTxTimeout {
+	if m != nil {
+		return m.TokenTimeouts
+	}
+	return nil
+}
+
+type TokenOutgoingTxTimeout struct {
+	TokenId uint64 `protobuf:"varint,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Timeout uint64 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *TokenOutgoingTxTimeout) Reset()         { *m = TokenOutgoingTxTimeout{} }
+func (m *TokenOutgoingTxTimeout) String() string { return proto.CompactTextString(m) }
+func (*TokenOutgoingTxTimeout) ProtoMessage()    {}
+func (*TokenOutgoingTxTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fae696fa24230542, []int{2}
+}
+func (m *TokenOutgoingTxTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenOutgoingTxTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenOutgoingTxTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenOutgoingTxTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenOutgoingTxTimeout.Merge(m, src)
+}
+func (m *TokenOutgoingTxTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenOutgoingTxTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenOutgoingTxTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenOutgoingTxTimeout proto.InternalMessageInfo
+
+func (m *TokenOutgoingTxTimeout) GetTokenId() uint64 {
+	if m != nil {
+		return m.TokenId
+	}
+	return 0
+}
+
+func (m *TokenOutgoingTxTimeout) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+// SignerSetParams controls when a new signer set tx is created for a chain.
+// Chains which are not listed in the params use DefaultSignerSetParams.
+type SignerSetParams struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// relative power change between the current validator set and the latest
+	// signer set tx which triggers a new signer set tx
+	PowerDiffThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=power_diff_threshold,json=powerDiffThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"power_diff_threshold"`
+	// number of blocks after which a new signer set tx is created even if the
+	// power did not change, 0 disables the refresh
+	MaxSignerSetAge uint64 `protobuf:"varint,3,opt,name=max_signer_set_age,json=maxSignerSetAge,proto3" json:"max_signer_set_age,omitempty"`
+	// minimal number of blocks between two signer set txs, it does not delay
+	// the signer set txs created for unbonding validators
+	MinSignerSetInterval uint64 `protobuf:"varint,4,opt,name=min_signer_set_interval,json=minSignerSetInterval,proto3" json:"min_signer_set_interval,omitempty"`
+	// maximal share of the bridge power of a single signer, the power above the
+	// cap is redistributed between the rest of the signers, 0 disables the cap
+	MaxSignerPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_signer_power,json=maxSignerPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_signer_power"`
+	// check the multisig threshold and weights reported in the executed signer
+	// set events, it should be enabled once all the orchestrators of the chain
+	// report them, otherwise the events are recorded without them
+	CheckMultisig bool `protobuf:"varint,6,opt,name=check_multisig,json=checkMultisig,proto3" json:"check_multisig,omitempty"`
+}
+
+func (m *SignerSetParams) Reset()         { *m = SignerSetParams{} }
+func (m *SignerSetParams) String() string { return proto.CompactTextString(m) }
+func (*SignerSetParams) ProtoMessage()    {}
+func (*SignerSetParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fae696fa24230542, []int{3}
+}
+func (m *SignerSetParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerSetParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetParams.Merge(m, src)
+}
+func (m *SignerSetParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSetParams proto.InternalMessageInfo
+
+func (m *SignerSetParams) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *SignerSetParams) GetMaxSignerSetAge() uint64 {
+	if m != nil {
+		return m.MaxSignerSetAge
+	}
+	return 0
+}
+
+func (m *SignerSetParams) GetMinSignerSetInterval() uint64 {
+	if m != nil {
+		return m.MinSignerSetInterval
+	}
+	return 0
+}
+
+func (m *SignerSetParams) GetCheckMultisig() bool {
+	if m != nil {
+		return m.CheckMultisig
+	}
+	return false
+}
+
 // GenesisState struct
 // TODO: this need to be audited and potentially simplified using the new
 // interfaces
 type GenesisState struct {
-	Params         *Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	ExternalStates []*ExternalState `protobuf:"bytes,5,rep,name=external_states,json=externalStates,proto3" json:"external_states,omitempty"`
-	TokenInfos     *TokenInfos      `protobuf:"bytes,6,opt,name=token_infos,json=tokenInfos,proto3" json:"token_infos,omitempty"`
+	Params              *Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	ExternalStates      []*ExternalState     `protobuf:"bytes,5,rep,name=external_states,json=externalStates,proto3" json:"external_states,omitempty"`
+	TokenInfos          *TokenInfos          `protobuf:"bytes,6,opt,name=token_infos,json=tokenInfos,proto3" json:"token_infos,omitempty"`
+	Blocklist           []string             `protobuf:"bytes,7,rep,name=blocklist,proto3" json:"blocklist,omitempty"`
+	QuarantinedDeposits []QuarantinedDeposit `protobuf:"bytes,8,rep,name=quarantined_deposits,json=quarantinedDeposits,proto3" json:"quarantined_deposits"`
+	Guardians           []string             `protobuf:"bytes,9,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	TimelockedTransfers []TimelockedTransfer `protobuf:"bytes,10,rep,name=timelocked_transfers,json=timelockedTransfers,proto3" json:"timelocked_transfers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fae696fa24230542, []int{1}
+	return fileDescriptor_fae696fa24230542, []int{4}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,6 +540,34 @@ func (m *GenesisState) GetTokenInfos() *TokenInfos {
 	return nil
 }
 
+func (m *GenesisState) GetBlocklist() []string {
+	if m != nil {
+		return m.Blocklist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQuarantinedDeposits() []QuarantinedDeposit {
+	if m != nil {
+		return m.QuarantinedDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGuardians() []string {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelockedTransfers() []TimelockedTransfer {
+	if m != nil {
+		return m.TimelockedTransfers
+	}
+	return nil
+}
+
 type Nonce struct {
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	LastEventNonce   uint64 `protobuf:"varint,2,opt,name=last_event_nonce,json=lastEventNonce,proto3" json:"last_event_nonce,omitempty"`
@@ -311,7 +577,7 @@ func (m *Nonce) Reset()         { *m = Nonce{} }
 func (m *Nonce) String() string { return proto.CompactTextString(m) }
 func (*Nonce) ProtoMessage()    {}
 func (*Nonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fae696fa24230542, []int{2}
+	return fileDescriptor_fae696fa24230542, []int{5}
 }
 func (m *Nonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,13 +633,14 @@ type ExternalState struct {
 	LastObservedValset         *SignerSetTx               `protobuf:"bytes,10,opt,name=last_observed_valset,json=lastObservedValset,proto3" json:"last_observed_valset,omitempty"`
 	LastOutgoingBatchTxNonce   uint64                     `protobuf:"varint,11,opt,name=last_outgoing_batch_tx_nonce,json=lastOutgoingBatchTxNonce,proto3" json:"last_outgoing_batch_tx_nonce,omitempty"`
 	LatestBlockHeight          LatestBlockHeight          `protobuf:"bytes,12,opt,name=latest_block_height,json=latestBlockHeight,proto3" json:"latest_block_height"`
+	DelegateKeysHistory        []DelegateKeysHistory      `protobuf:"bytes,13,rep,name=delegate_keys_history,json=delegateKeysHistory,proto3" json:"delegate_keys_history"`
 }
 
 func (m *ExternalState) Reset()         { *m = ExternalState{} }
 func (m *ExternalState) String() string { return proto.CompactTextString(m) }
 func (*ExternalState) ProtoMessage()    {}
 func (*ExternalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fae696fa24230542, []int{3}
+	return fileDescriptor_fae696fa24230542, []int{6}
 }
 func (m *ExternalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,8 +753,18 @@ func (m *ExternalState) GetLatestBlockHeight() LatestBlockHeight {
 	return LatestBlockHeight{}
 }
 
+func (m *ExternalState) GetDelegateKeysHistory() []DelegateKeysHistory {
+	if m != nil {
+		return m.DelegateKeysHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "mhub2.v1.Params")
+	proto.RegisterType((*ChainOutgoingTxTimeout)(nil), "mhub2.v1.ChainOutgoingTxTimeout")
+	proto.RegisterType((*TokenOutgoingTxTimeout)(nil), "mhub2.v1.TokenOutgoingTxTimeout")
+	proto.RegisterType((*SignerSetParams)(nil), "mhub2.v1.SignerSetParams")
 	proto.RegisterType((*GenesisState)(nil), "mhub2.v1.GenesisState")
 	proto.RegisterType((*Nonce)(nil), "mhub2.v1.Nonce")
 	proto.RegisterType((*ExternalState)(nil), "mhub2.v1.ExternalState")
@@ -496,78 +773,105 @@ func init() {
 func init() { proto.RegisterFile("mhub2/v1/genesis.proto", fileDescriptor_fae696fa24230542) }
 
 var fileDescriptor_fae696fa24230542 = []byte{
-	// 1128 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x51, 0x6f, 0x1b, 0xc5,
-	0x13, 0x8f, 0x9b, 0xc4, 0x4d, 0xd6, 0x49, 0x93, 0x6c, 0x9c, 0xf4, 0xe2, 0xf6, 0xef, 0xba, 0x91,
-	0xfe, 0xc5, 0x08, 0x6a, 0xb7, 0xae, 0x00, 0x51, 0x41, 0x45, 0xd3, 0x86, 0xb6, 0x40, 0x29, 0x9c,
-	0xad, 0x22, 0x21, 0xc4, 0x76, 0x7d, 0x37, 0xb9, 0x3b, 0xe5, 0xbc, 0x5b, 0x6e, 0xf7, 0x5c, 0xfb,
-	0x8d, 0x8f, 0xd0, 0xaf, 0xc2, 0x27, 0xe0, 0xb5, 0x8f, 0x7d, 0x44, 0x08, 0x55, 0x28, 0xf9, 0x18,
-	0xbc, 0xa0, 0x9d, 0x3d, 0xdf, 0xd9, 0x49, 0xc4, 0x43, 0x9e, 0xec, 0xdd, 0xdf, 0xef, 0x37, 0x33,
-	0x9e, 0xd9, 0x99, 0x31, 0xd9, 0x1e, 0x84, 0x69, 0xbf, 0xd3, 0x1e, 0xde, 0x6e, 0x07, 0x20, 0x40,
-	0x45, 0xaa, 0xf5, 0x32, 0x91, 0x5a, 0xd2, 0x25, 0xbc, 0x6f, 0x0d, 0x6f, 0xd7, 0xaa, 0x81, 0x0c,
-	0x24, 0x5e, 0xb6, 0xcd, 0x37, 0x8b, 0xd7, 0xaa, 0xb9, 0xce, 0x12, 0xed, 0xed, 0x66, 0x71, 0xab,
-	0x82, 0xcc, 0x54, 0x6d, 0x27, 0x90, 0x32, 0x88, 0xa1, 0x8d, 0xa7, 0x7e, 0x7a, 0xd0, 0xe6, 0x62,
-	0x6c, 0xa1, 0xdd, 0xdf, 0x97, 0x49, 0xf9, 0x3b, 0x9e, 0xf0, 0x81, 0xa2, 0xff, 0x23, 0x24, 0x48,
-	0xf8, 0x30, 0xd2, 0x63, 0x16, 0xf9, 0x4e, 0xa9, 0x51, 0x6a, 0x2e, 0xbb, 0xcb, 0xd9, 0xcd, 0x13,
-	0x9f, 0xde, 0x22, 0x55, 0x4f, 0x0a, 0x9d, 0x70, 0x4f, 0x33, 0x25, 0xd3, 0xc4, 0x03, 0x16, 0x72,
-	0x15, 0x3a, 0x17, 0x90, 0x48, 0x27, 0x58, 0x17, 0xa1, 0xc7, 0x5c, 0x85, 0xf4, 0x63, 0x72, 0xb9,
-	0x9f, 0x44, 0x7e, 0x00, 0x0c, 0x74, 0x08, 0x09, 0xa4, 0x03, 0xc6, 0x7d, 0x3f, 0x01, 0xa5, 0x9c,
-	0x05, 0x14, 0x6d, 0x59, 0x78, 0x3f, 0x43, 0xef, 0x5b, 0x90, 0xde, 0x20, 0x6b, 0x99, 0xce, 0x0b,
-	0x79, 0x24, 0x4c, 0x34, 0x8b, 0x8d, 0x52, 0x73, 0xc1, 0x5d, 0xb5, 0xd7, 0x0f, 0xcc, 0xed, 0x13,
-	0x9f, 0xde, 0x23, 0x57, 0x55, 0x14, 0x08, 0xf0, 0x19, 0x7e, 0x24, 0x4c, 0x81, 0x66, 0x7a, 0xa4,
-	0xd8, 0xab, 0x48, 0xf8, 0xf2, 0x95, 0x53, 0x46, 0x91, 0x63, 0x39, 0x5d, 0xa4, 0x74, 0x41, 0xf7,
-	0x46, 0xea, 0x07, 0xc4, 0x69, 0x87, 0x6c, 0x65, 0xfa, 0x3e, 0xd7, 0x5e, 0x08, 0xb9, 0xf0, 0x22,
-	0x0a, 0x37, 0x2d, 0xb8, 0x67, 0xb1, 0x4c, 0xf3, 0x19, 0xa9, 0xe5, 0x3f, 0xc6, 0xe0, 0x5c, 0xa7,
-	0x49, 0x21, 0x5c, 0xb2, 0x1e, 0x27, 0x8c, 0x6e, 0x4e, 0xc8, 0xd4, 0xb7, 0xc9, 0x96, 0xe6, 0x49,
-	0x00, 0xda, 0x64, 0x84, 0xe9, 0x11, 0xd3, 0xd1, 0x00, 0x64, 0xaa, 0x1d, 0x82, 0x42, 0x6a, 0xc1,
-	0x7d, 0x1d, 0xf6, 0x46, 0x3d, 0x8b, 0xd0, 0x0f, 0x09, 0xe5, 0x43, 0x48, 0x78, 0x00, 0xac, 0x1f,
-	0x4b, 0xef, 0x10, 0x25, 0x4e, 0x05, 0xf9, 0xeb, 0x19, 0xb2, 0x67, 0x00, 0x23, 0xa0, 0x9f, 0x93,
-	0x2b, 0x13, 0x76, 0x1e, 0xe6, 0x94, 0x6c, 0xc5, 0xc6, 0x97, 0x51, 0x26, 0x79, 0x2f, 0xe4, 0x77,
-	0xc8, 0x76, 0xee, 0x4c, 0x79, 0xd3, 0xca, 0x55, 0x9b, 0x92, 0x89, 0x43, 0xe5, 0x15, 0x22, 0x41,
-	0xae, 0xaa, 0x98, 0xab, 0x90, 0x1d, 0x98, 0xfa, 0x47, 0x52, 0xcc, 0x96, 0xc3, 0xb9, 0xd4, 0x28,
-	0x35, 0x57, 0xf6, 0x5a, 0x6f, 0xde, 0x5d, 0x9b, 0xfb, 0xf3, 0xdd, 0xb5, 0x1b, 0x41, 0xa4, 0xc3,
-	0xb4, 0xdf, 0xf2, 0xe4, 0xa0, 0xed, 0x49, 0x35, 0x90, 0x2a, 0xfb, 0xb8, 0xa9, 0xfc, 0xc3, 0xb6,
-	0x1e, 0xbf, 0x04, 0xd5, 0x7a, 0x08, 0x9e, 0xeb, 0xa0, 0xcd, 0x2f, 0x33, 0x93, 0x53, 0xd5, 0xa3,
-	0x2f, 0x48, 0xf5, 0x84, 0x3f, 0x2c, 0x9f, 0xb3, 0x76, 0x2e, 0x3f, 0x74, 0xc6, 0x0f, 0x16, 0x9b,
-	0x8e, 0xc9, 0xf5, 0x13, 0x1e, 0x4e, 0xd7, 0xdc, 0x59, 0x3f, 0x97, 0xbb, 0xfa, 0x8c, 0xbb, 0xfd,
-	0x93, 0x0f, 0x85, 0xbe, 0x2e, 0x91, 0x9b, 0x27, 0x7c, 0x7b, 0x52, 0x1c, 0xc4, 0x91, 0xa7, 0x23,
-	0x11, 0x9c, 0x15, 0xc7, 0xc6, 0xb9, 0xe2, 0x78, 0x7f, 0x26, 0x8e, 0x07, 0x85, 0x8b, 0xd3, 0x21,
-	0x3d, 0x23, 0xff, 0x4f, 0x45, 0x5f, 0x0a, 0x9f, 0xa1, 0xc6, 0x84, 0x71, 0x76, 0xbf, 0x51, 0x7c,
-	0x23, 0x0d, 0x4b, 0xee, 0x66, 0xdc, 0x33, 0xfa, 0x6e, 0x9b, 0x94, 0xb1, 0xb1, 0x95, 0xb3, 0xd9,
-	0x98, 0x6f, 0x2e, 0xbb, 0xd9, 0x89, 0xb6, 0xc8, 0xa6, 0x4c, 0x75, 0x20, 0x8d, 0x87, 0xa9, 0xde,
-	0xa8, 0xa2, 0xd9, 0x8d, 0x09, 0x94, 0xb7, 0xc6, 0xdd, 0x85, 0x5f, 0xff, 0x6a, 0xcc, 0xed, 0xfe,
-	0x56, 0x22, 0x2b, 0x8f, 0xec, 0xe4, 0xec, 0x6a, 0xae, 0x81, 0x36, 0x49, 0xf9, 0x25, 0x4e, 0x34,
-	0x9c, 0x61, 0x95, 0xce, 0x7a, 0x6b, 0x32, 0x49, 0x5b, 0x76, 0xd2, 0xb9, 0x19, 0x4e, 0xbf, 0x20,
-	0x6b, 0x30, 0xd2, 0x90, 0x08, 0x1e, 0x33, 0x65, 0xb4, 0xca, 0x59, 0x6c, 0xcc, 0x37, 0x2b, 0x9d,
-	0xcb, 0x85, 0x64, 0x3f, 0x23, 0xa0, 0x6d, 0xf7, 0x12, 0x4c, 0x1f, 0x15, 0xfd, 0x88, 0x54, 0xb4,
-	0x3c, 0x04, 0xc1, 0x22, 0x71, 0x20, 0x15, 0x4e, 0x9c, 0x4a, 0xa7, 0x5a, 0xa8, 0x7b, 0x06, 0x7c,
-	0x62, 0x30, 0x97, 0xe8, 0xfc, 0xfb, 0xee, 0xcf, 0x64, 0xf1, 0x5b, 0x29, 0x3c, 0xa0, 0x1f, 0x90,
-	0x8d, 0x21, 0x8f, 0x23, 0x9f, 0x6b, 0x99, 0xe4, 0xc3, 0xd1, 0x8e, 0xde, 0xf5, 0x1c, 0x98, 0xcc,
-	0xc5, 0x26, 0x59, 0x8f, 0xb9, 0xd2, 0x0c, 0x86, 0x20, 0x34, 0x13, 0xc6, 0x00, 0x4e, 0xdf, 0x05,
-	0xf7, 0x92, 0xb9, 0xdf, 0x37, 0xd7, 0x68, 0x76, 0xf7, 0x9f, 0x45, 0xb2, 0x3a, 0x13, 0x38, 0xdd,
-	0x21, 0x4b, 0xf9, 0x30, 0xb5, 0xf6, 0x2f, 0x7a, 0xd9, 0x18, 0x7d, 0x41, 0xae, 0xe4, 0x59, 0xb0,
-	0xa6, 0x87, 0x52, 0x03, 0x4b, 0xc0, 0x93, 0x89, 0xaf, 0x9c, 0x0b, 0x98, 0x91, 0xeb, 0xa7, 0x33,
-	0x82, 0xfe, 0x9e, 0x4b, 0x0d, 0x2e, 0x32, 0x5d, 0x07, 0xce, 0x06, 0x14, 0xbd, 0x47, 0x56, 0x7d,
-	0x88, 0x21, 0xe0, 0x1a, 0xd8, 0x21, 0x8c, 0x95, 0x33, 0x8f, 0x36, 0x77, 0x0a, 0x9b, 0x4f, 0x55,
-	0xf0, 0x30, 0x63, 0x7c, 0x0d, 0x63, 0xe5, 0xae, 0xf8, 0x53, 0x27, 0xfa, 0x13, 0xa9, 0xa7, 0xc2,
-	0xce, 0x68, 0x9f, 0x29, 0x10, 0x3e, 0xd3, 0x92, 0xe5, 0x31, 0xeb, 0x91, 0xd9, 0x27, 0xc6, 0xa0,
-	0x53, 0x18, 0xec, 0x82, 0xf0, 0x7b, 0x72, 0x12, 0xaa, 0x5b, 0xcb, 0xf5, 0xb3, 0x40, 0x6f, 0xa4,
-	0xe8, 0xa7, 0x64, 0x07, 0xd3, 0x2a, 0xfb, 0x0a, 0x92, 0x21, 0xf8, 0x33, 0xf9, 0xb5, 0x8b, 0x67,
-	0xdb, 0x10, 0x9e, 0x65, 0x78, 0x91, 0x67, 0xfa, 0x09, 0x59, 0x99, 0x7a, 0xb1, 0xa6, 0xfe, 0xf3,
-	0x58, 0x7f, 0xbb, 0x6f, 0x5b, 0x93, 0x7d, 0xdb, 0xba, 0x2f, 0xc6, 0x6e, 0xa5, 0x78, 0xc0, 0x8a,
-	0xde, 0x25, 0xab, 0xa6, 0xad, 0xa3, 0x64, 0xc0, 0x4d, 0xff, 0x29, 0xe7, 0xe2, 0x7f, 0x28, 0x67,
-	0xa9, 0xb4, 0x46, 0x96, 0x14, 0xfc, 0x92, 0x82, 0x09, 0xcf, 0x2e, 0x9c, 0xfc, 0x4c, 0xdf, 0x23,
-	0x65, 0x8c, 0x5b, 0x39, 0xcb, 0x68, 0x70, 0xad, 0xc8, 0x08, 0x46, 0xec, 0x66, 0x30, 0x7d, 0x44,
-	0xaa, 0xb3, 0x3f, 0x7a, 0xc8, 0x63, 0x05, 0x76, 0x11, 0x55, 0x3a, 0x5b, 0x53, 0x89, 0x2c, 0xfa,
-	0xd7, 0xa5, 0xd3, 0x69, 0x78, 0x8e, 0x02, 0xb3, 0x84, 0xad, 0xa1, 0x49, 0x1e, 0x30, 0xcf, 0xa6,
-	0x7f, 0x6d, 0x02, 0xed, 0xa6, 0x72, 0x50, 0x99, 0x51, 0x70, 0xc8, 0xf6, 0x46, 0x36, 0x85, 0xdf,
-	0x93, 0xcd, 0xd8, 0xb4, 0x92, 0xce, 0xb6, 0x4d, 0x08, 0x51, 0x10, 0x6a, 0xdc, 0x54, 0x95, 0xce,
-	0x95, 0x22, 0x8e, 0x6f, 0x90, 0x84, 0x5b, 0xe7, 0x31, 0x52, 0xf6, 0x16, 0xcc, 0xc8, 0x73, 0x37,
-	0xe2, 0x53, 0xc0, 0x57, 0x6f, 0x8e, 0xea, 0xa5, 0xb7, 0x47, 0xf5, 0xd2, 0xdf, 0x47, 0xf5, 0xd2,
-	0xeb, 0xe3, 0xfa, 0xdc, 0xdb, 0xe3, 0xfa, 0xdc, 0x1f, 0xc7, 0xf5, 0xb9, 0x1f, 0x6f, 0x4d, 0x4d,
-	0xc7, 0xa7, 0x91, 0xd0, 0x90, 0xf4, 0x80, 0x0f, 0xec, 0x1f, 0xa8, 0xf6, 0x40, 0xfa, 0x69, 0x0c,
-	0xed, 0x51, 0x76, 0xc4, 0x59, 0xd9, 0x2f, 0x63, 0x25, 0xee, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x7a, 0xd2, 0xb3, 0xcc, 0xa6, 0x09, 0x00, 0x00,
+	// 1561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6e, 0x1b, 0xbb,
+	0x15, 0xb6, 0xfc, 0x6f, 0xca, 0xf2, 0x0f, 0x2d, 0xdb, 0xb4, 0xe2, 0xab, 0xab, 0x6b, 0xe0, 0xde,
+	0xba, 0xb8, 0x8d, 0x94, 0x28, 0x48, 0x8b, 0x06, 0x69, 0xd0, 0x38, 0x76, 0x12, 0x37, 0x75, 0x93,
+	0x8c, 0xd5, 0xa4, 0x28, 0x8a, 0x4e, 0xa8, 0x19, 0x6a, 0x66, 0xe0, 0xd1, 0x50, 0x19, 0x52, 0xb2,
+	0xb4, 0xeb, 0x23, 0x64, 0xdf, 0xbe, 0x4d, 0x37, 0x59, 0x66, 0xd1, 0x45, 0x51, 0x14, 0x41, 0x91,
+	0x3c, 0x42, 0x5f, 0xa0, 0xe0, 0x21, 0xe7, 0x47, 0xb2, 0xea, 0x85, 0x57, 0x12, 0xf9, 0x7d, 0xe7,
+	0x87, 0xe7, 0x1c, 0x1e, 0x9e, 0x41, 0x3b, 0x5d, 0xbf, 0xdf, 0x6e, 0x36, 0x06, 0x77, 0x1b, 0x1e,
+	0x8b, 0x98, 0x08, 0x44, 0xbd, 0x17, 0x73, 0xc9, 0xf1, 0x32, 0xec, 0xd7, 0x07, 0x77, 0x2b, 0x65,
+	0x8f, 0x7b, 0x1c, 0x36, 0x1b, 0xea, 0x9f, 0xc6, 0x2b, 0xe5, 0x54, 0x4e, 0x13, 0xf5, 0xee, 0x56,
+	0xb6, 0x2b, 0x3c, 0xa3, 0xaa, 0xb2, 0xe7, 0x71, 0xee, 0x85, 0xac, 0x01, 0xab, 0x76, 0xbf, 0xd3,
+	0xa0, 0xd1, 0x48, 0x43, 0x07, 0x7f, 0x5f, 0x45, 0x8b, 0xaf, 0x68, 0x4c, 0xbb, 0x02, 0x7f, 0x83,
+	0x90, 0x17, 0xd3, 0x41, 0x20, 0x47, 0x76, 0xe0, 0x92, 0x42, 0xad, 0x70, 0xb8, 0x62, 0xad, 0x98,
+	0x9d, 0x53, 0x17, 0xdf, 0x41, 0x65, 0x87, 0x47, 0x32, 0xa6, 0x8e, 0xb4, 0x05, 0xef, 0xc7, 0x0e,
+	0xb3, 0x7d, 0x2a, 0x7c, 0x32, 0x0b, 0x44, 0x9c, 0x60, 0xe7, 0x00, 0x3d, 0xa7, 0xc2, 0xc7, 0x3f,
+	0x47, 0xbb, 0xed, 0x38, 0x70, 0x3d, 0x66, 0x33, 0xe9, 0xb3, 0x98, 0xf5, 0xbb, 0x36, 0x75, 0xdd,
+	0x98, 0x09, 0x41, 0xe6, 0x41, 0x68, 0x5b, 0xc3, 0x27, 0x06, 0x7d, 0xac, 0x41, 0xfc, 0x03, 0x5a,
+	0x37, 0x72, 0x8e, 0x4f, 0x83, 0x48, 0x79, 0xb3, 0x50, 0x2b, 0x1c, 0xce, 0x5b, 0x25, 0xbd, 0xfd,
+	0x44, 0xed, 0x9e, 0xba, 0xf8, 0x11, 0xda, 0x17, 0x81, 0x17, 0x31, 0xd7, 0x86, 0x9f, 0xd8, 0x16,
+	0x4c, 0xda, 0x72, 0x28, 0xec, 0xcb, 0x20, 0x72, 0xf9, 0x25, 0x59, 0x04, 0x21, 0xa2, 0x39, 0xe7,
+	0x40, 0x39, 0x67, 0xb2, 0x35, 0x14, 0x6f, 0x01, 0xc7, 0x4d, 0xb4, 0x6d, 0xe4, 0xdb, 0x54, 0x3a,
+	0x3e, 0x4b, 0x05, 0x97, 0x40, 0x70, 0x4b, 0x83, 0x47, 0x1a, 0x33, 0x32, 0x0f, 0x51, 0x25, 0x3d,
+	0x8c, 0xc2, 0xa9, 0xec, 0xc7, 0x99, 0xe0, 0xb2, 0xb6, 0x98, 0x30, 0xce, 0x53, 0x82, 0x91, 0xbe,
+	0x8b, 0xb6, 0x25, 0x8d, 0x3d, 0x26, 0x55, 0x44, 0x6c, 0x39, 0xb4, 0x65, 0xd0, 0x65, 0xbc, 0x2f,
+	0x09, 0x02, 0x41, 0xac, 0xc1, 0x13, 0xe9, 0xb7, 0x86, 0x2d, 0x8d, 0xe0, 0x9f, 0x21, 0x4c, 0x07,
+	0x2c, 0xa6, 0x1e, 0xb3, 0xdb, 0x21, 0x77, 0x2e, 0x40, 0x84, 0x14, 0x81, 0xbf, 0x61, 0x90, 0x23,
+	0x05, 0x28, 0x01, 0xfc, 0x2b, 0x74, 0x2b, 0x61, 0xa7, 0x6e, 0xe6, 0xc4, 0x56, 0xb5, 0x7f, 0x86,
+	0x92, 0xc4, 0x3d, 0x13, 0xbf, 0x87, 0x76, 0x52, 0x63, 0xc2, 0xc9, 0x4b, 0x96, 0x74, 0x48, 0x12,
+	0x83, 0xc2, 0xc9, 0x84, 0x22, 0xb4, 0x2f, 0x42, 0x2a, 0x7c, 0xbb, 0xa3, 0xf2, 0x1f, 0xf0, 0x68,
+	0x3c, 0x1d, 0x64, 0xad, 0x56, 0x38, 0x5c, 0x3d, 0xaa, 0x7f, 0xfc, 0xfc, 0xed, 0xcc, 0xbf, 0x3e,
+	0x7f, 0xfb, 0x83, 0x17, 0x48, 0xbf, 0xdf, 0xae, 0x3b, 0xbc, 0xdb, 0x70, 0xb8, 0xe8, 0x72, 0x61,
+	0x7e, 0x6e, 0x0b, 0xf7, 0xa2, 0x21, 0x47, 0x3d, 0x26, 0xea, 0xc7, 0xcc, 0xb1, 0x08, 0xe8, 0x7c,
+	0x6a, 0x54, 0xe6, 0xb2, 0x87, 0xdf, 0xa1, 0xf2, 0x84, 0x3d, 0x48, 0x1f, 0x59, 0xbf, 0x91, 0x1d,
+	0x3c, 0x66, 0x07, 0x92, 0x8d, 0x47, 0xe8, 0xbb, 0x09, 0x0b, 0x57, 0x73, 0x4e, 0x36, 0x6e, 0x64,
+	0xae, 0x3a, 0x66, 0xee, 0x64, 0xb2, 0x50, 0xf0, 0x87, 0x02, 0xba, 0x3d, 0x61, 0xdb, 0xe1, 0x51,
+	0x27, 0x0c, 0x1c, 0x19, 0x44, 0xde, 0x34, 0x3f, 0x36, 0x6f, 0xe4, 0xc7, 0x4f, 0xc7, 0xfc, 0x78,
+	0x92, 0x99, 0xb8, 0xea, 0xd2, 0x4b, 0xf4, 0x7d, 0x3f, 0x6a, 0xf3, 0xc8, 0xb5, 0x41, 0x46, 0xb9,
+	0x31, 0xfd, 0xbe, 0x61, 0xa8, 0x91, 0x9a, 0x26, 0x9f, 0x1b, 0xee, 0x94, 0x7b, 0xb7, 0x83, 0x16,
+	0xe1, 0x62, 0x0b, 0xb2, 0x55, 0x9b, 0x3b, 0x5c, 0xb1, 0xcc, 0x0a, 0xd7, 0xd1, 0x16, 0xef, 0x4b,
+	0x8f, 0x2b, 0x0b, 0xb9, 0xbb, 0x51, 0x06, 0xb5, 0x9b, 0x09, 0x94, 0x5d, 0x8d, 0x87, 0xa8, 0x72,
+	0x19, 0x48, 0xdf, 0x8d, 0xe9, 0x25, 0x0d, 0x81, 0x0e, 0xf5, 0x0a, 0x55, 0x2b, 0xc8, 0xb6, 0xae,
+	0xf5, 0x8c, 0xd1, 0x32, 0x04, 0xa8, 0x5c, 0x81, 0x5f, 0xa0, 0xcd, 0xdc, 0x31, 0x7a, 0xd0, 0x03,
+	0xc9, 0x4e, 0x6d, 0xee, 0xb0, 0xd8, 0xdc, 0xab, 0x27, 0xbd, 0xb7, 0x9e, 0xba, 0xaf, 0x9b, 0xe4,
+	0xd1, 0xbc, 0x8a, 0xb3, 0xb5, 0x2e, 0xc6, 0xb7, 0xb1, 0x83, 0x2a, 0xba, 0x57, 0x4d, 0x39, 0x80,
+	0x20, 0xbb, 0xa0, 0xb5, 0x96, 0x69, 0x85, 0x0e, 0xf6, 0x72, 0xf2, 0x40, 0x46, 0xf9, 0xae, 0x33,
+	0x15, 0x15, 0xd8, 0x46, 0xe5, 0x6e, 0x10, 0xd9, 0xa6, 0x37, 0x76, 0x18, 0xb3, 0x63, 0x2a, 0x03,
+	0x4e, 0xc8, 0x8d, 0x2a, 0x60, 0xb3, 0x1b, 0x44, 0x47, 0xa0, 0xea, 0x29, 0x63, 0x96, 0x52, 0xa4,
+	0xda, 0x93, 0xd2, 0xda, 0x8b, 0x03, 0x87, 0xd9, 0xf2, 0x92, 0xf6, 0x92, 0xcc, 0xee, 0xe9, 0xf6,
+	0xd4, 0x61, 0xec, 0x95, 0xc2, 0x5a, 0x97, 0xb4, 0xa7, 0x73, 0xf9, 0x60, 0xfe, 0x2f, 0xff, 0xae,
+	0xcd, 0x1c, 0xfc, 0xad, 0x80, 0x76, 0xa6, 0x9f, 0x09, 0xef, 0xa1, 0xe5, 0xb4, 0x8b, 0xeb, 0x37,
+	0x65, 0xc9, 0x31, 0xfd, 0x9b, 0xa0, 0xa5, 0x24, 0xc7, 0xb3, 0x60, 0x20, 0x59, 0xe2, 0x33, 0xb4,
+	0x26, 0xf9, 0x05, 0x8b, 0xb2, 0x10, 0xce, 0x4d, 0x86, 0xb0, 0xa5, 0xf0, 0xff, 0x17, 0xc2, 0x12,
+	0x48, 0x9b, 0x3d, 0x71, 0x70, 0x86, 0x76, 0xa6, 0xd3, 0x95, 0x77, 0xda, 0x90, 0xf1, 0x4e, 0xf9,
+	0xa0, 0xd6, 0xd7, 0x79, 0x77, 0xf0, 0xdf, 0x59, 0xb4, 0x3e, 0x51, 0x17, 0xd7, 0x1d, 0xf3, 0x1d,
+	0x2a, 0xf7, 0xf8, 0x25, 0x8b, 0x6d, 0x37, 0xe8, 0x74, 0x6c, 0xe9, 0xc7, 0x4c, 0xf8, 0x3c, 0x74,
+	0xc9, 0xec, 0x8d, 0xd2, 0x86, 0x41, 0xd7, 0x71, 0xd0, 0xe9, 0xb4, 0x12, 0x4d, 0xf8, 0x47, 0x84,
+	0xbb, 0x74, 0x98, 0xbf, 0x95, 0xd4, 0x63, 0x64, 0x0e, 0xbc, 0x5e, 0xef, 0xd2, 0x61, 0xea, 0xec,
+	0x63, 0x8f, 0xe1, 0xfb, 0x68, 0x57, 0x55, 0x51, 0x8e, 0x1c, 0x44, 0x92, 0xc5, 0x03, 0x1a, 0xc2,
+	0xab, 0x3c, 0x6f, 0xa9, 0x22, 0x4b, 0x25, 0x4e, 0x0d, 0x86, 0xff, 0x80, 0x36, 0x72, 0x36, 0xc0,
+	0x09, 0xb2, 0x70, 0xa3, 0x13, 0xac, 0xa5, 0x1e, 0xbd, 0x52, 0x5a, 0xf0, 0xf7, 0x68, 0xcd, 0xf1,
+	0x99, 0x73, 0x61, 0x77, 0xfb, 0xa1, 0x0c, 0x44, 0xe0, 0xc1, 0xc3, 0xbd, 0x6c, 0x95, 0x60, 0xf7,
+	0xcc, 0x6c, 0x1e, 0xfc, 0x75, 0x0e, 0xad, 0x3e, 0xd3, 0x13, 0xd2, 0xb9, 0xa4, 0x92, 0xe1, 0x43,
+	0xb4, 0x68, 0x6e, 0xad, 0x0a, 0x78, 0xb1, 0xb9, 0x91, 0x15, 0x87, 0x4e, 0x8a, 0x65, 0x70, 0xfc,
+	0x6b, 0xb4, 0xce, 0x86, 0x92, 0xc5, 0x11, 0x0d, 0x6d, 0xa1, 0x64, 0x05, 0x59, 0x80, 0x7a, 0xda,
+	0xcd, 0x44, 0x4e, 0x0c, 0x01, 0x74, 0x5b, 0x6b, 0x2c, 0xbf, 0x14, 0xf8, 0x3e, 0x2a, 0x9a, 0x3a,
+	0x89, 0x3a, 0x5c, 0x80, 0x83, 0xc5, 0x66, 0x79, 0xa2, 0x1a, 0x4f, 0x15, 0x66, 0x21, 0x99, 0xfe,
+	0xc7, 0xfb, 0x68, 0x05, 0xba, 0x51, 0x18, 0x08, 0x49, 0x96, 0xa0, 0xd9, 0x65, 0x1b, 0xf8, 0xf7,
+	0xa8, 0xfc, 0xbe, 0x4f, 0x63, 0x1a, 0xc9, 0x40, 0x0d, 0x21, 0x2e, 0xeb, 0x71, 0x11, 0x48, 0x41,
+	0x96, 0xc1, 0xb7, 0xfd, 0x4c, 0xfb, 0xeb, 0x8c, 0x75, 0xac, 0x49, 0xa6, 0xce, 0xb7, 0xde, 0x5f,
+	0x41, 0xc0, 0xa8, 0xd7, 0xa7, 0xb1, 0x1b, 0xd0, 0x48, 0x90, 0x15, 0x6d, 0x34, 0xdd, 0x50, 0x46,
+	0x93, 0x4e, 0xc9, 0x5c, 0x5b, 0xc6, 0x34, 0x12, 0x1d, 0x16, 0x0b, 0x82, 0x26, 0x8d, 0xb6, 0x52,
+	0x56, 0xcb, 0x90, 0x12, 0xa3, 0xf2, 0x0a, 0x22, 0x0e, 0xfe, 0x8c, 0x16, 0x7e, 0xc7, 0x23, 0x87,
+	0xe1, 0x1f, 0xd1, 0xe6, 0x80, 0x86, 0x81, 0x4b, 0x25, 0x8f, 0xd3, 0x71, 0x4f, 0xdf, 0x88, 0x8d,
+	0x14, 0x48, 0x26, 0xbd, 0x43, 0xb4, 0x11, 0x52, 0x21, 0x6d, 0x36, 0x60, 0x91, 0xb4, 0x23, 0xa5,
+	0xc0, 0x5c, 0xb6, 0x35, 0xb5, 0x7f, 0xa2, 0xb6, 0x41, 0xed, 0xc1, 0x3f, 0x16, 0x51, 0x69, 0x2c,
+	0x45, 0xd7, 0xdf, 0xb8, 0x5b, 0x69, 0xbe, 0xb5, 0xea, 0x01, 0x97, 0xcc, 0x8e, 0x99, 0xc3, 0x63,
+	0x57, 0x90, 0x59, 0x38, 0xea, 0x77, 0x57, 0x73, 0x0f, 0xf6, 0xde, 0x70, 0xc9, 0x2c, 0x60, 0x5a,
+	0x84, 0x4d, 0x07, 0x04, 0x7e, 0x84, 0x4a, 0x2e, 0x0b, 0x99, 0x47, 0x25, 0xb3, 0x2f, 0xd8, 0x28,
+	0xe9, 0x4f, 0xb9, 0x87, 0xe3, 0x4c, 0x78, 0xc7, 0x86, 0xf1, 0x82, 0x8d, 0x84, 0xb5, 0xea, 0xe6,
+	0x56, 0xf8, 0x4f, 0xa8, 0xda, 0x8f, 0xf4, 0xd4, 0xe9, 0xda, 0x82, 0x45, 0xae, 0x2d, 0xb9, 0x9d,
+	0xfa, 0x2c, 0x87, 0x6a, 0x42, 0x56, 0x0a, 0x49, 0xee, 0x25, 0x62, 0x91, 0xdb, 0xe2, 0x89, 0xab,
+	0x56, 0x25, 0x95, 0x1f, 0x07, 0x5a, 0x43, 0x81, 0x7f, 0x89, 0xf6, 0x20, 0xac, 0xbc, 0x2d, 0x58,
+	0x3c, 0x60, 0xee, 0x58, 0x7c, 0xf5, 0x28, 0xbd, 0xa3, 0x08, 0x2f, 0x0d, 0x9e, 0xc5, 0x19, 0xff,
+	0x02, 0xad, 0xe6, 0x9e, 0x30, 0x55, 0xe9, 0x73, 0x50, 0xe9, 0xfa, 0x0b, 0xa2, 0x9e, 0x7c, 0x41,
+	0xd4, 0x1f, 0x47, 0x23, 0xab, 0x98, 0x3d, 0xc9, 0x02, 0x3f, 0x40, 0x25, 0x35, 0xa8, 0x04, 0x71,
+	0x57, 0x3d, 0x25, 0x91, 0x20, 0x4b, 0xd7, 0x48, 0x8e, 0x53, 0x71, 0x05, 0x2d, 0x0b, 0xf6, 0xbe,
+	0xcf, 0x94, 0x7b, 0x7a, 0x84, 0x4e, 0xd7, 0xf8, 0x27, 0x68, 0x11, 0xfc, 0xd6, 0xa5, 0x5c, 0x6c,
+	0xae, 0x67, 0x11, 0x01, 0x8f, 0x2d, 0x03, 0xe3, 0x67, 0xa8, 0x3c, 0x7e, 0xe8, 0x01, 0x0d, 0x05,
+	0xd3, 0xa3, 0x75, 0xb1, 0xb9, 0x3d, 0xe5, 0x49, 0x6f, 0x0d, 0x2d, 0x9c, 0x0f, 0xc3, 0x1b, 0x10,
+	0x50, 0x9f, 0x15, 0x5a, 0x51, 0x12, 0x07, 0x88, 0xb3, 0x7a, 0xd0, 0x75, 0x00, 0xf5, 0xec, 0x4d,
+	0x40, 0xd2, 0x50, 0x60, 0x6c, 0x6c, 0x0d, 0x75, 0x08, 0x5f, 0xa3, 0xad, 0x50, 0x35, 0x0d, 0x69,
+	0xe6, 0x67, 0x9f, 0x05, 0x9e, 0x2f, 0x61, 0xf6, 0x2e, 0x36, 0x6f, 0x65, 0x7e, 0xfc, 0x16, 0x48,
+	0x30, 0x8d, 0x3c, 0x07, 0x8a, 0xb9, 0x5f, 0x9b, 0xe1, 0x24, 0x80, 0xdf, 0xa2, 0xed, 0xb1, 0x72,
+	0xb3, 0xfd, 0x40, 0x48, 0x1e, 0x8f, 0x48, 0x09, 0x62, 0xf2, 0x4d, 0xa6, 0x34, 0x5f, 0x73, 0xcf,
+	0x35, 0x29, 0xb9, 0xb6, 0xee, 0x14, 0xe8, 0x37, 0x1f, 0xbf, 0x54, 0x0b, 0x9f, 0xbe, 0x54, 0x0b,
+	0xff, 0xf9, 0x52, 0x2d, 0x7c, 0xf8, 0x5a, 0x9d, 0xf9, 0xf4, 0xb5, 0x3a, 0xf3, 0xcf, 0xaf, 0xd5,
+	0x99, 0x3f, 0xde, 0xc9, 0x75, 0xf3, 0x33, 0x78, 0x21, 0x5a, 0x8c, 0x76, 0xf5, 0xb7, 0x66, 0xa3,
+	0xcb, 0xdd, 0x7e, 0xc8, 0x1a, 0x43, 0xb3, 0x84, 0xde, 0xde, 0x5e, 0x84, 0x14, 0xdf, 0xfb, 0xdf,
+	0x00, 0x9a, 0xa7, 0x5d, 0x4c, 0xd1, 0x0e, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -590,6 +894,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeePriceTwapWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FeePriceTwapWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	{
+		size := m.MinBridgeFeeRatio.Size()
+		i -= size
+		if _, err := m.MinBridgeFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	if len(m.ChainOutgoingTxTimeouts) > 0 {
+		for iNdEx := len(m.ChainOutgoingTxTimeouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainOutgoingTxTimeouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.SignerSetParams) > 0 {
+		for iNdEx := len(m.SignerSetParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSetParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.WithdrawalTimelockBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WithdrawalTimelockBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.OutgoingTxTimeout != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.OutgoingTxTimeout))
 		i--
@@ -723,7 +1085,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *ChainOutgoingTxTimeout) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -733,32 +1095,20 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainOutgoingTxTimeout) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainOutgoingTxTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TokenInfos != nil {
-		{
-			size, err := m.TokenInfos.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ExternalStates) > 0 {
-		for iNdEx := len(m.ExternalStates) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TokenTimeouts) > 0 {
+		for iNdEx := len(m.TokenTimeouts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ExternalStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TokenTimeouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -766,25 +1116,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if m.Timeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Nonce) Marshal() (dAtA []byte, err error) {
+func (m *TokenOutgoingTxTimeout) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -794,32 +1144,30 @@ func (m *Nonce) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Nonce) MarshalTo(dAtA []byte) (int, error) {
+func (m *TokenOutgoingTxTimeout) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Nonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TokenOutgoingTxTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastEventNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEventNonce))
+	if m.Timeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timeout))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if m.TokenId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TokenId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExternalState) Marshal() (dAtA []byte, err error) {
+func (m *SignerSetParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -829,19 +1177,245 @@ func (m *ExternalState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExternalState) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignerSetParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExternalState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignerSetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.CheckMultisig {
+		i--
+		if m.CheckMultisig {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	{
-		size, err := m.LatestBlockHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MaxSignerPower.Size()
+		i -= size
+		if _, err := m.MaxSignerPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MinSignerSetInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinSignerSetInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxSignerSetAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxSignerSetAge))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.PowerDiffThreshold.Size()
+		i -= size
+		if _, err := m.PowerDiffThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TimelockedTransfers) > 0 {
+		for iNdEx := len(m.TimelockedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimelockedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for iNdEx := len(m.QuarantinedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Blocklist) > 0 {
+		for iNdEx := len(m.Blocklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Blocklist[iNdEx])
+			copy(dAtA[i:], m.Blocklist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Blocklist[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TokenInfos != nil {
+		{
+			size, err := m.TokenInfos.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ExternalStates) > 0 {
+		for iNdEx := len(m.ExternalStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExternalStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Nonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Nonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Nonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastEventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegateKeysHistory) > 0 {
+		for iNdEx := len(m.DelegateKeysHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeysHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size, err := m.LatestBlockHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
 		i -= size
@@ -1043,6 +1617,89 @@ func (m *Params) Size() (n int) {
 	if m.OutgoingTxTimeout != 0 {
 		n += 2 + sovGenesis(uint64(m.OutgoingTxTimeout))
 	}
+	if m.WithdrawalTimelockBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.WithdrawalTimelockBlocks))
+	}
+	if len(m.SignerSetParams) > 0 {
+		for _, e := range m.SignerSetParams {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainOutgoingTxTimeouts) > 0 {
+		for _, e := range m.ChainOutgoingTxTimeouts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MinBridgeFeeRatio.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.FeePriceTwapWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.FeePriceTwapWindow))
+	}
+	return n
+}
+
+func (m *ChainOutgoingTxTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGenesis(uint64(m.Timeout))
+	}
+	if len(m.TokenTimeouts) > 0 {
+		for _, e := range m.TokenTimeouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenOutgoingTxTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TokenId != 0 {
+		n += 1 + sovGenesis(uint64(m.TokenId))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGenesis(uint64(m.Timeout))
+	}
+	return n
+}
+
+func (m *SignerSetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.PowerDiffThreshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxSignerSetAge != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxSignerSetAge))
+	}
+	if m.MinSignerSetInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.MinSignerSetInterval))
+	}
+	l = m.MaxSignerPower.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.CheckMultisig {
+		n += 2
+	}
 	return n
 }
 
@@ -1066,6 +1723,30 @@ func (m *GenesisState) Size() (n int) {
 		l = m.TokenInfos.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Blocklist) > 0 {
+		for _, s := range m.Blocklist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for _, e := range m.QuarantinedDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimelockedTransfers) > 0 {
+		for _, e := range m.TimelockedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1146,8 +1827,14 @@ func (m *ExternalState) Size() (n int) {
 	}
 	l = m.LatestBlockHeight.Size()
 	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
+	if len(m.DelegateKeysHistory) > 0 {
+		for _, e := range m.DelegateKeysHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
 
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -1458,18 +2145,608 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+			}
+			m.UnbondSlashingSignerSetTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxTimeout", wireType)
+			}
+			m.OutgoingTxTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutgoingTxTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalTimelockBlocks", wireType)
+			}
+			m.WithdrawalTimelockBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalTimelockBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerSetParams = append(m.SignerSetParams, SignerSetParams{})
+			if err := m.SignerSetParams[len(m.SignerSetParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainOutgoingTxTimeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainOutgoingTxTimeouts = append(m.ChainOutgoingTxTimeouts, ChainOutgoingTxTimeout{})
+			if err := m.ChainOutgoingTxTimeouts[len(m.ChainOutgoingTxTimeouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBridgeFeeRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBridgeFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePriceTwapWindow", wireType)
+			}
+			m.FeePriceTwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePriceTwapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainOutgoingTxTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainOutgoingTxTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainOutgoingTxTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenTimeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenTimeouts = append(m.TokenTimeouts, TokenOutgoingTxTimeout{})
+			if err := m.TokenTimeouts[len(m.TokenTimeouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenOutgoingTxTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenOutgoingTxTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenOutgoingTxTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			m.TokenId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 15:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1479,28 +2756,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerDiffThreshold", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1527,15 +2803,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PowerDiffThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSignerSetAge", wireType)
 			}
-			var byteLen int
+			m.MaxSignerSetAge = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1545,30 +2821,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxSignerSetAge |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 18:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignerSetInterval", wireType)
 			}
-			m.UnbondSlashingSignerSetTxsWindow = 0
+			m.MinSignerSetInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1578,16 +2840,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				m.MinSignerSetInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSignerPower", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1597,29 +2859,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, string(dAtA[iNdEx:postIndex]))
+			if err := m.MaxSignerPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 20:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckMultisig", wireType)
 			}
-			m.OutgoingTxTimeout = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1629,11 +2892,12 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutgoingTxTimeout |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.CheckMultisig = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1793,6 +3057,138 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocklist = append(m.Blocklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuarantinedDeposits = append(m.QuarantinedDeposits, QuarantinedDeposit{})
+			if err := m.QuarantinedDeposits[len(m.QuarantinedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockedTransfers = append(m.TimelockedTransfers, TimelockedTransfer{})
+			if err := m.TimelockedTransfers[len(m.TimelockedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2312,6 +3708,40 @@ func (m *ExternalState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeysHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeysHistory = append(m.DelegateKeysHistory, DelegateKeysHistory{})
+			if err := m.DelegateKeysHistory[len(m.DelegateKeysHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -61,6 +62,42 @@ const (
 	TxStatusKey
 
 	TxFeeRecordKey
+
+	// PendingEventNonceKey indexes event nonces which have vote records that are not yet accepted
+	PendingEventNonceKey
+
+	// SendToExternalIdKey indexes the pool key of an unbatched send to external by its id
+	SendToExternalIdKey
+
+	// SendToExternalExpiresAtKey indexes unbatched send to externals by expiry time and serves as an expiry queue
+	SendToExternalExpiresAtKey
+
+	// BatchTxByTokenKey indexes batch nonces by external token id
+	BatchTxByTokenKey
+
+	// BlockedAddressKey indexes addresses blocked by governance
+	BlockedAddressKey
+
+	// QuarantinedDepositKey indexes deposits held in the quarantine account by id
+	QuarantinedDepositKey
+
+	// LastQuarantinedDepositIDKey indexes the last quarantined deposit id
+	LastQuarantinedDepositIDKey
+
+	// GuardianKey indexes the guardian set which is able to cancel timelocked transfers
+	GuardianKey
+
+	// TimelockedTransferKey indexes transfers held by the withdrawal timelock by id
+	TimelockedTransferKey
+
+	// TimelockedTransferReleaseKey indexes timelocked transfers by release height
+	TimelockedTransferReleaseKey
+
+	// DelegateKeysHistoryKey indexes the delegate keys rotations by validator
+	DelegateKeysHistoryKey
+
+	// LastDelegateKeysChangeHeightKey indexes the last block height in which the external addresses of the chain changed
+	LastDelegateKeysChangeHeightKey
 )
 
 ////////////////////
@@ -110,6 +147,13 @@ func MakeExternalEventVoteRecordKey(chainId ChainID, eventNonce uint64, claimHas
 	return bytes.Join([][]byte{{ExternalEventVoteRecordKey}, chainId.Bytes(), sdk.Uint64ToBigEndian(eventNonce), claimHash}, []byte{})
 }
 
+// MakePendingEventNonceKey returns the following key format
+// prefix     nonce
+// [0x16][0 0 0 0 0 0 0 1]
+func MakePendingEventNonceKey(chainId ChainID, eventNonce uint64) []byte {
+	return bytes.Join([][]byte{{PendingEventNonceKey}, chainId.Bytes(), sdk.Uint64ToBigEndian(eventNonce)}, []byte{})
+}
+
 //////////////////
 // Outgoing Txs //
 //////////////////
@@ -131,6 +175,26 @@ func MakeSendToExternalKey(chainId ChainID, id uint64, fee ExternalToken) []byte
 	return bytes.Join([][]byte{{SendToExternalKey}, chainId.Bytes(), []byte(fee.ExternalTokenId), fee.Amount.BigInt().FillBytes(amount), sdk.Uint64ToBigEndian(id)}, []byte{})
 }
 
+// MakeSendToExternalIdKey returns the following key format
+// prefix     id
+// [0x17][0 0 0 0 0 0 0 1]
+func MakeSendToExternalIdKey(chainId ChainID, id uint64) []byte {
+	return bytes.Join([][]byte{{SendToExternalIdKey}, chainId.Bytes(), sdk.Uint64ToBigEndian(id)}, []byte{})
+}
+
+// MakeSendToExternalExpiresAtKey returns the following key format
+// prefix     chain        expires_at        id
+// [0x18][8 ethereum][0 0 0 0 98 12 34 56][0 0 0 0 0 0 0 1]
+func MakeSendToExternalExpiresAtKey(chainId ChainID, expiresAt uint64, id uint64) []byte {
+	return bytes.Join([][]byte{MakeSendToExternalExpiresAtPrefix(chainId), sdk.Uint64ToBigEndian(expiresAt), sdk.Uint64ToBigEndian(id)}, []byte{})
+}
+
+// MakeSendToExternalExpiresAtPrefix returns the prefix of the expiry time index of the chain, the chain id is
+// length prefixed so the index of a chain does not include the chains whose ids start with its id
+func MakeSendToExternalExpiresAtPrefix(chainId ChainID) []byte {
+	return append([]byte{SendToExternalExpiresAtKey}, address.MustLengthPrefix(chainId.Bytes())...)
+}
+
 // MakeLastEventNonceByValidatorKey indexes lateset event nonce by validator
 // MakeLastEventNonceByValidatorKey returns the following key format
 // prefix              cosmos-validator
@@ -147,6 +211,13 @@ func MakeBatchTxKey(chainId ChainID, externalTokenId string, nonce uint64) []byt
 	return bytes.Join([][]byte{{BatchTxPrefixByte}, chainId.Bytes(), []byte(externalTokenId), sdk.Uint64ToBigEndian(nonce)}, []byte{})
 }
 
+// MakeBatchTxByTokenKey returns the following key format
+// prefix     token_id                                    nonce
+// [0x19][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func MakeBatchTxByTokenKey(chainId ChainID, externalTokenId string, nonce uint64) []byte {
+	return bytes.Join([][]byte{{BatchTxByTokenKey}, chainId.Bytes(), []byte(externalTokenId), sdk.Uint64ToBigEndian(nonce)}, []byte{})
+}
+
 func MakeContractCallTxKey(chainId ChainID, invalscope []byte, invalnonce uint64) []byte {
 	return bytes.Join([][]byte{{ContractCallTxPrefixByte}, chainId.Bytes(), invalscope, sdk.Uint64ToBigEndian(invalnonce)}, []byte{})
 }
@@ -158,3 +229,52 @@ func GetTxStatusKey(inTxHash string) []byte {
 func GetTxFeeRecordKey(inTxHash string) []byte {
 	return bytes.Join([][]byte{{TxFeeRecordKey}, []byte(inTxHash)}, []byte{})
 }
+
+// MakeBlockedAddressKey returns the following key format
+// prefix     address
+// [0x1a][0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7]
+func MakeBlockedAddressKey(address string) []byte {
+	return append([]byte{BlockedAddressKey}, []byte(address)...)
+}
+
+// MakeQuarantinedDepositKey returns the following key format
+// prefix     id
+// [0x1b][0 0 0 0 0 0 0 1]
+func MakeQuarantinedDepositKey(id uint64) []byte {
+	return append([]byte{QuarantinedDepositKey}, sdk.Uint64ToBigEndian(id)...)
+}
+
+// MakeGuardianKey returns the following key format
+// prefix     guardian
+// [0x1d][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeGuardianKey(guardian sdk.AccAddress) []byte {
+	return append([]byte{GuardianKey}, guardian.Bytes()...)
+}
+
+// MakeTimelockedTransferKey returns the following key format
+// prefix     chain       id
+// [0x1e][ethereum][0 0 0 0 0 0 0 1]
+func MakeTimelockedTransferKey(chainId ChainID, id uint64) []byte {
+	return bytes.Join([][]byte{{TimelockedTransferKey}, chainId.Bytes(), sdk.Uint64ToBigEndian(id)}, []byte{})
+}
+
+// MakeTimelockedTransferReleaseKey returns the following key format
+// prefix     release_height       chain       id
+// [0x1f][0 0 0 0 0 0 1 0][ethereum][0 0 0 0 0 0 0 1]
+func MakeTimelockedTransferReleaseKey(releaseHeight uint64, chainId ChainID, id uint64) []byte {
+	return bytes.Join([][]byte{{TimelockedTransferReleaseKey}, sdk.Uint64ToBigEndian(releaseHeight), chainId.Bytes(), sdk.Uint64ToBigEndian(id)}, []byte{})
+}
+
+// MakeDelegateKeysHistoryKey returns the following key format
+// prefix     chain       cosmos-validator
+// [0x20][ethereum][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeDelegateKeysHistoryKey(chainId ChainID, validator sdk.ValAddress) []byte {
+	return bytes.Join([][]byte{{DelegateKeysHistoryKey}, chainId.Bytes(), validator.Bytes()}, []byte{})
+}
+
+// MakeLastDelegateKeysChangeHeightKey returns the following key format
+// prefix     chain
+// [0x21][ethereum]
+func MakeLastDelegateKeysChangeHeightKey(chainId ChainID) []byte {
+	return append([]byte{LastDelegateKeysChangeHeightKey}, chainId.Bytes()...)
+}
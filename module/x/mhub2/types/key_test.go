@@ -0,0 +1,14 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendToExternalExpiresAtPrefix(t *testing.T) {
+	key := MakeSendToExternalExpiresAtKey("bsc2", 100, 1)
+	require.True(t, bytes.HasPrefix(key, MakeSendToExternalExpiresAtPrefix("bsc2")))
+	require.False(t, bytes.HasPrefix(key, MakeSendToExternalExpiresAtPrefix("bsc")))
+}
@@ -10,9 +10,9 @@ import (
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/regen-network/cosmos-proto"
 	github_com_tendermint_tendermint_libs_bytes "github.com/tendermint/tendermint/libs/bytes"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -37,6 +37,7 @@ const (
 	TX_STATUS_BATCH_CREATED    TxStatusType = 2
 	TX_STATUS_BATCH_EXECUTED   TxStatusType = 3
 	TX_STATUS_REFUNDED         TxStatusType = 4
+	TX_STATUS_QUARANTINED      TxStatusType = 5
 )
 
 var TxStatusType_name = map[int32]string{
@@ -45,6 +46,7 @@ var TxStatusType_name = map[int32]string{
 	2: "TX_STATUS_BATCH_CREATED",
 	3: "TX_STATUS_BATCH_EXECUTED",
 	4: "TX_STATUS_REFUNDED",
+	5: "TX_STATUS_QUARANTINED",
 }
 
 var TxStatusType_value = map[string]int32{
@@ -53,6 +55,7 @@ var TxStatusType_value = map[string]int32{
 	"TX_STATUS_BATCH_CREATED":    2,
 	"TX_STATUS_BATCH_EXECUTED":   3,
 	"TX_STATUS_REFUNDED":         4,
+	"TX_STATUS_QUARANTINED":      5,
 }
 
 func (x TxStatusType) String() string {
@@ -63,6 +66,34 @@ func (TxStatusType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e98aa13e7c3fc003, []int{0}
 }
 
+type QuarantineResolution int32
+
+const (
+	QUARANTINE_RESOLUTION_UNSPECIFIED QuarantineResolution = 0
+	QUARANTINE_RESOLUTION_RELEASE     QuarantineResolution = 1
+	QUARANTINE_RESOLUTION_RETURN      QuarantineResolution = 2
+)
+
+var QuarantineResolution_name = map[int32]string{
+	0: "QUARANTINE_RESOLUTION_UNSPECIFIED",
+	1: "QUARANTINE_RESOLUTION_RELEASE",
+	2: "QUARANTINE_RESOLUTION_RETURN",
+}
+
+var QuarantineResolution_value = map[string]int32{
+	"QUARANTINE_RESOLUTION_UNSPECIFIED": 0,
+	"QUARANTINE_RESOLUTION_RELEASE":     1,
+	"QUARANTINE_RESOLUTION_RETURN":      2,
+}
+
+func (x QuarantineResolution) String() string {
+	return proto.EnumName(QuarantineResolution_name, int32(x))
+}
+
+func (QuarantineResolution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e98aa13e7c3fc003, []int{1}
+}
+
 // ExternalEventVoteRecord is an event that is pending of confirmation by 2/3 of
 // the signer set. The event is then attested and executed in the state machine
 // once the required threshold is met.
@@ -181,10 +212,12 @@ func (m *LatestBlockHeight) GetCosmosHeight() uint64 {
 }
 
 // ExternalSigner represents a cosmos validator with its corresponding bridge
-// operator address and its staking consensus power.
+// operator address and its staking consensus power. The weight is the share of
+// the signer in the multisig, the weights of a signer set sum up to 1000.
 type ExternalSigner struct {
 	Power           uint64 `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
 	ExternalAddress string `protobuf:"bytes,2,opt,name=external_address,json=externalAddress,proto3" json:"external_address,omitempty"`
+	Weight          uint64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
 func (m *ExternalSigner) Reset()         { *m = ExternalSigner{} }
@@ -234,6 +267,13 @@ func (m *ExternalSigner) GetExternalAddress() string {
 	return ""
 }
 
+func (m *ExternalSigner) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 // SignerSetTx is the Bridge multisig set that relays
 // transactions the two chains. The staking validators keep external keys which
 // are used to check signatures in order to get significant gas
@@ -243,6 +283,8 @@ type SignerSetTx struct {
 	Height   uint64          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 	Signers  ExternalSigners `protobuf:"bytes,3,rep,name=signers,proto3,castrepeated=ExternalSigners" json:"signers,omitempty"`
 	Sequence uint64          `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// sum of the signer weights required to sign a transaction of the multisig
+	Threshold uint64 `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
 func (m *SignerSetTx) Reset()         { *m = SignerSetTx{} }
@@ -306,6 +348,13 @@ func (m *SignerSetTx) GetSequence() uint64 {
 	return 0
 }
 
+func (m *SignerSetTx) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
 // BatchTx represents a batch of transactions going from Cosmos to External Chain.
 // Batch txs are are identified by a unique hash and the token contract that is
 // shared by all the SendToExternal
@@ -407,6 +456,8 @@ type SendToExternal struct {
 	CreatedAt         uint64        `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	RefundAddress     string        `protobuf:"bytes,10,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
 	RefundChainId     string        `protobuf:"bytes,11,opt,name=refund_chain_id,json=refundChainId,proto3" json:"refund_chain_id,omitempty"`
+	// unix time after which the tx is refunded if it is still not batched
+	ExpiresAt uint64 `protobuf:"varint,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *SendToExternal) Reset()         { *m = SendToExternal{} }
@@ -519,6 +570,13 @@ func (m *SendToExternal) GetRefundChainId() string {
 	return ""
 }
 
+func (m *SendToExternal) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 // ContractCallTx represents an individual arbitrary logic call transaction
 // from Cosmos to External.
 type ContractCallTx struct {
@@ -689,6 +747,13 @@ type TokenInfo struct {
 	ExternalTokenId  string                                 `protobuf:"bytes,4,opt,name=external_token_id,json=externalTokenId,proto3" json:"external_token_id,omitempty"`
 	ExternalDecimals uint64                                 `protobuf:"varint,5,opt,name=external_decimals,json=externalDecimals,proto3" json:"external_decimals,omitempty"`
 	Commission       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=commission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission"`
+	// minimal amount of a transfer to this chain, in hub denom units
+	MinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_amount"`
+	// minimal bridge fee of a transfer to this chain, in hub denom units
+	MinFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee"`
+	// transfers to this chain of at least this amount, in hub denom units, are
+	// held for withdrawal_timelock_blocks before entering the batch pool
+	TimelockThreshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=timelock_threshold,json=timelockThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"timelock_threshold"`
 }
 
 func (m *TokenInfo) Reset()         { *m = TokenInfo{} }
@@ -1018,8 +1083,268 @@ func (m *TokenInfosChangeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TokenInfosChangeProposal proto.InternalMessageInfo
 
+// QuarantinedDeposit is an incoming transfer that involved a blocked address.
+// Its amount is held by the quarantine module account until governance
+// releases it to the receiver or returns it to the sender.
+type QuarantinedDeposit struct {
+	Id              uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainId         string      `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Sender          string      `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	ReceiverChainId string      `protobuf:"bytes,4,opt,name=receiver_chain_id,json=receiverChainId,proto3" json:"receiver_chain_id,omitempty"`
+	Receiver        string      `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount          types1.Coin `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount"`
+	TxHash          string      `protobuf:"bytes,7,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *QuarantinedDeposit) Reset()         { *m = QuarantinedDeposit{} }
+func (m *QuarantinedDeposit) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedDeposit) ProtoMessage()    {}
+func (*QuarantinedDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98aa13e7c3fc003, []int{15}
+}
+func (m *QuarantinedDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuarantinedDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDeposit.Merge(m, src)
+}
+func (m *QuarantinedDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedDeposit proto.InternalMessageInfo
+
+func (m *QuarantinedDeposit) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QuarantinedDeposit) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetReceiverChainId() string {
+	if m != nil {
+		return m.ReceiverChainId
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+func (m *QuarantinedDeposit) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+type BlocklistChangeProposal struct {
+	AddAddresses    []string `protobuf:"bytes,1,rep,name=add_addresses,json=addAddresses,proto3" json:"add_addresses,omitempty"`
+	RemoveAddresses []string `protobuf:"bytes,2,rep,name=remove_addresses,json=removeAddresses,proto3" json:"remove_addresses,omitempty"`
+}
+
+func (m *BlocklistChangeProposal) Reset()      { *m = BlocklistChangeProposal{} }
+func (*BlocklistChangeProposal) ProtoMessage() {}
+func (*BlocklistChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98aa13e7c3fc003, []int{16}
+}
+func (m *BlocklistChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlocklistChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlocklistChangeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlocklistChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocklistChangeProposal.Merge(m, src)
+}
+func (m *BlocklistChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlocklistChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocklistChangeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocklistChangeProposal proto.InternalMessageInfo
+
+type QuarantinedDepositProposal struct {
+	DepositId  uint64               `protobuf:"varint,1,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+	Resolution QuarantineResolution `protobuf:"varint,2,opt,name=resolution,proto3,enum=mhub2.v1.QuarantineResolution" json:"resolution,omitempty"`
+}
+
+func (m *QuarantinedDepositProposal) Reset()      { *m = QuarantinedDepositProposal{} }
+func (*QuarantinedDepositProposal) ProtoMessage() {}
+func (*QuarantinedDepositProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98aa13e7c3fc003, []int{17}
+}
+func (m *QuarantinedDepositProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedDepositProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedDepositProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuarantinedDepositProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDepositProposal.Merge(m, src)
+}
+func (m *QuarantinedDepositProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedDepositProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDepositProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedDepositProposal proto.InternalMessageInfo
+
+// TimelockedTransfer is a large SendToExternal held out of the batch pool
+// until release_height. Until then guardians can cancel it.
+type TimelockedTransfer struct {
+	Transfer      SendToExternal `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer"`
+	ReleaseHeight uint64         `protobuf:"varint,2,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+}
+
+func (m *TimelockedTransfer) Reset()         { *m = TimelockedTransfer{} }
+func (m *TimelockedTransfer) String() string { return proto.CompactTextString(m) }
+func (*TimelockedTransfer) ProtoMessage()    {}
+func (*TimelockedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98aa13e7c3fc003, []int{18}
+}
+func (m *TimelockedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimelockedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockedTransfer.Merge(m, src)
+}
+func (m *TimelockedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockedTransfer proto.InternalMessageInfo
+
+func (m *TimelockedTransfer) GetTransfer() SendToExternal {
+	if m != nil {
+		return m.Transfer
+	}
+	return SendToExternal{}
+}
+
+func (m *TimelockedTransfer) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+type GuardianSetChangeProposal struct {
+	Guardians []string `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+}
+
+func (m *GuardianSetChangeProposal) Reset()      { *m = GuardianSetChangeProposal{} }
+func (*GuardianSetChangeProposal) ProtoMessage() {}
+func (*GuardianSetChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98aa13e7c3fc003, []int{19}
+}
+func (m *GuardianSetChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GuardianSetChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GuardianSetChangeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GuardianSetChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianSetChangeProposal.Merge(m, src)
+}
+func (m *GuardianSetChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *GuardianSetChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianSetChangeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianSetChangeProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("mhub2.v1.TxStatusType", TxStatusType_name, TxStatusType_value)
+	proto.RegisterEnum("mhub2.v1.QuarantineResolution", QuarantineResolution_name, QuarantineResolution_value)
 	proto.RegisterType((*ExternalEventVoteRecord)(nil), "mhub2.v1.ExternalEventVoteRecord")
 	proto.RegisterType((*LatestBlockHeight)(nil), "mhub2.v1.LatestBlockHeight")
 	proto.RegisterType((*ExternalSigner)(nil), "mhub2.v1.ExternalSigner")
@@ -1035,102 +1360,134 @@ func init() {
 	proto.RegisterType((*TxStatus)(nil), "mhub2.v1.TxStatus")
 	proto.RegisterType((*ColdStorageTransferProposal)(nil), "mhub2.v1.ColdStorageTransferProposal")
 	proto.RegisterType((*TokenInfosChangeProposal)(nil), "mhub2.v1.TokenInfosChangeProposal")
+	proto.RegisterType((*QuarantinedDeposit)(nil), "mhub2.v1.QuarantinedDeposit")
+	proto.RegisterType((*BlocklistChangeProposal)(nil), "mhub2.v1.BlocklistChangeProposal")
+	proto.RegisterType((*QuarantinedDepositProposal)(nil), "mhub2.v1.QuarantinedDepositProposal")
+	proto.RegisterType((*TimelockedTransfer)(nil), "mhub2.v1.TimelockedTransfer")
+	proto.RegisterType((*GuardianSetChangeProposal)(nil), "mhub2.v1.GuardianSetChangeProposal")
 }
 
 func init() { proto.RegisterFile("mhub2/v1/mhub2.proto", fileDescriptor_e98aa13e7c3fc003) }
 
 var fileDescriptor_e98aa13e7c3fc003 = []byte{
-	// 1428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xbb, 0x6f, 0x1b, 0x47,
-	0x1a, 0xe7, 0x92, 0x12, 0x45, 0x7e, 0x94, 0x28, 0x69, 0x2c, 0x58, 0x14, 0x7d, 0x47, 0x12, 0x3c,
-	0x9c, 0x4f, 0xe7, 0x3b, 0x93, 0x96, 0xec, 0x03, 0x0c, 0xe3, 0xee, 0x00, 0xf1, 0xa1, 0x93, 0x0e,
-	0x89, 0x6c, 0x2f, 0x57, 0x86, 0x91, 0x14, 0xc4, 0x70, 0x77, 0x44, 0x2e, 0x4c, 0xee, 0x30, 0x3b,
-	0x43, 0x9a, 0xfa, 0x0f, 0x0c, 0x55, 0x29, 0x52, 0xa4, 0x51, 0x20, 0x20, 0x48, 0xe3, 0xa4, 0x4c,
-	0x90, 0x7f, 0xc1, 0x48, 0xe5, 0x32, 0x48, 0x21, 0x07, 0x72, 0x13, 0xb8, 0x4f, 0x93, 0x2a, 0xd8,
-	0x99, 0xd9, 0xd5, 0xae, 0x24, 0xc7, 0x8f, 0x4a, 0xf3, 0x3d, 0xf7, 0xf7, 0x3d, 0x7e, 0xc3, 0x11,
-	0x2c, 0x0d, 0x7a, 0xa3, 0xce, 0x7a, 0x75, 0xbc, 0x56, 0x15, 0x87, 0xca, 0xd0, 0xa5, 0x9c, 0xa2,
-	0x94, 0x14, 0xc6, 0x6b, 0xf9, 0x15, 0x93, 0xb2, 0x01, 0x65, 0x6d, 0xa1, 0xaf, 0x4a, 0x41, 0x3a,
-	0xe5, 0x8b, 0x5d, 0x4a, 0xbb, 0x7d, 0x52, 0x15, 0x52, 0x67, 0xb4, 0x57, 0xe5, 0xf6, 0x80, 0x30,
-	0x8e, 0x07, 0x43, 0xe5, 0xb0, 0xd4, 0xa5, 0x5d, 0x2a, 0x03, 0xbd, 0x93, 0xd2, 0x16, 0x64, 0x92,
-	0x6a, 0x07, 0x33, 0x52, 0x1d, 0xaf, 0x75, 0x08, 0xc7, 0x6b, 0x55, 0x93, 0xda, 0x8e, 0xb2, 0xaf,
-	0x9c, 0x4d, 0x8b, 0x9d, 0x7d, 0x69, 0x2a, 0x1f, 0x68, 0xb0, 0xdc, 0x9c, 0x70, 0xe2, 0x3a, 0xb8,
-	0xdf, 0x1c, 0x13, 0x87, 0x3f, 0xa0, 0x9c, 0xe8, 0xc4, 0xa4, 0xae, 0x85, 0xfe, 0x03, 0xd3, 0xc4,
-	0x53, 0xe5, 0xb4, 0x92, 0xb6, 0x9a, 0x59, 0x5f, 0xaa, 0xc8, 0x34, 0x15, 0x3f, 0x4d, 0x65, 0xc3,
-	0xd9, 0xaf, 0x2d, 0xfe, 0xf0, 0xed, 0xf5, 0xb9, 0x48, 0x06, 0x5d, 0x46, 0xa1, 0x25, 0x98, 0x1e,
-	0x53, 0x4e, 0x58, 0x2e, 0x5e, 0x4a, 0xac, 0xa6, 0x75, 0x29, 0xa0, 0x3c, 0xa4, 0xb0, 0x69, 0x92,
-	0x21, 0x27, 0x56, 0x2e, 0x51, 0xd2, 0x56, 0x53, 0x7a, 0x20, 0x97, 0x31, 0x2c, 0x7e, 0x80, 0x39,
-	0x61, 0xbc, 0xd6, 0xa7, 0xe6, 0xa3, 0x2d, 0x62, 0x77, 0x7b, 0x1c, 0xfd, 0x0d, 0xe6, 0x89, 0x4a,
-	0xdf, 0xee, 0x09, 0x95, 0xc0, 0x33, 0xa5, 0x67, 0x7d, 0xb5, 0x72, 0xfc, 0x0b, 0xcc, 0xa9, 0xce,
-	0x2a, 0xb7, 0xb8, 0x70, 0x9b, 0x95, 0x4a, 0xe9, 0x54, 0xbe, 0x0f, 0x59, 0x1f, 0x6c, 0xcb, 0xee,
-	0x3a, 0xc4, 0xf5, 0x60, 0x0e, 0xe9, 0x63, 0xe2, 0xaa, 0xac, 0x52, 0x40, 0x7f, 0x87, 0x85, 0xe0,
-	0xab, 0xd8, 0xb2, 0x5c, 0xc2, 0x98, 0xc8, 0x97, 0xd6, 0x03, 0x34, 0x1b, 0x52, 0x5d, 0x3e, 0xd2,
-	0x20, 0x23, 0x73, 0xb5, 0x08, 0x37, 0x26, 0x5e, 0x42, 0x87, 0x3a, 0x26, 0xf1, 0x13, 0x0a, 0x01,
-	0x5d, 0x86, 0x64, 0x04, 0x96, 0x92, 0xd0, 0xff, 0x60, 0x86, 0x89, 0x60, 0x96, 0x4b, 0x94, 0x12,
-	0xab, 0x99, 0xf5, 0x5c, 0xc5, 0xdf, 0x94, 0x4a, 0x14, 0x69, 0xed, 0xd2, 0xd3, 0x17, 0xc5, 0xf9,
-	0xa8, 0x8e, 0xe9, 0x7e, 0xb4, 0xd7, 0x58, 0x46, 0x3e, 0x19, 0x11, 0xef, 0xcb, 0x53, 0xe2, 0x13,
-	0x81, 0x5c, 0x3e, 0xd1, 0x60, 0xa6, 0x86, 0xb9, 0xd9, 0x33, 0x26, 0xa8, 0x08, 0x99, 0x8e, 0x77,
-	0x6c, 0x87, 0x41, 0x82, 0x50, 0xed, 0x08, 0xa4, 0x39, 0x98, 0xf1, 0xd6, 0x8e, 0x8e, 0x7c, 0xa8,
-	0xbe, 0x88, 0xfe, 0x0d, 0xb3, 0xdc, 0xc5, 0x0e, 0xc3, 0x26, 0xb7, 0xa9, 0x73, 0x01, 0xe0, 0x16,
-	0x71, 0x2c, 0x83, 0xfa, 0x10, 0xf5, 0x88, 0x37, 0xba, 0x06, 0x8b, 0x41, 0x4b, 0x39, 0x7d, 0x44,
-	0x9c, 0xb6, 0x6d, 0x09, 0xa4, 0xa1, 0x9e, 0x1a, 0x9e, 0x7e, 0xdb, 0x0a, 0x75, 0x6b, 0x3a, 0xd2,
-	0xad, 0x70, 0x91, 0xc9, 0x33, 0x45, 0x7e, 0x97, 0x80, 0x6c, 0x14, 0x00, 0xca, 0x42, 0xdc, 0xb6,
-	0x54, 0x89, 0x71, 0x5b, 0xa4, 0x65, 0xc4, 0xb1, 0x88, 0xab, 0x66, 0xa9, 0x24, 0x74, 0x1d, 0x50,
-	0x00, 0xcd, 0x25, 0xa6, 0x3d, 0xb4, 0xbd, 0xb5, 0x4f, 0x08, 0x9f, 0x00, 0xb4, 0xee, 0x1b, 0xd0,
-	0x0a, 0xa4, 0xcc, 0x1e, 0xb6, 0x43, 0x05, 0xcc, 0x08, 0x79, 0xdb, 0x42, 0x37, 0x61, 0x5a, 0xd4,
-	0x26, 0x70, 0x67, 0xd6, 0x97, 0xcf, 0x0f, 0x53, 0x94, 0x58, 0x9b, 0x7a, 0x76, 0x5c, 0x8c, 0xe9,
-	0xd2, 0x17, 0x55, 0x21, 0xb1, 0x47, 0x64, 0x41, 0x6f, 0x0c, 0xf1, 0x3c, 0xd1, 0x32, 0xcc, 0xf0,
-	0x49, 0xbb, 0x87, 0x59, 0x2f, 0x37, 0x23, 0x0b, 0xe1, 0x93, 0x2d, 0xcc, 0x7a, 0xa8, 0x01, 0xd9,
-	0x31, 0xee, 0xb7, 0x4d, 0x3a, 0x18, 0xd8, 0x8c, 0xd9, 0xd4, 0xc9, 0xa5, 0xde, 0x26, 0xe9, 0xdc,
-	0x18, 0xf7, 0xeb, 0x41, 0x0c, 0xfa, 0x33, 0x80, 0xe9, 0x12, 0xcc, 0x89, 0xd5, 0xc6, 0x3c, 0x97,
-	0x16, 0xed, 0x4b, 0x2b, 0xcd, 0x06, 0x47, 0x7f, 0x85, 0xac, 0x4b, 0xf6, 0x46, 0x8e, 0x15, 0x30,
-	0x03, 0x04, 0x88, 0x39, 0xa9, 0x55, 0xbc, 0x40, 0x57, 0x61, 0x5e, 0xb9, 0x05, 0xcd, 0xca, 0x84,
-	0xfd, 0xea, 0xb2, 0x65, 0xe5, 0xcf, 0x12, 0x90, 0xad, 0x53, 0x87, 0xbb, 0xd8, 0xe4, 0x75, 0xdc,
-	0xef, 0x1b, 0x13, 0x6f, 0x1e, 0xb6, 0x33, 0xc6, 0x7d, 0xdb, 0xc2, 0xde, 0xee, 0x44, 0x56, 0x75,
-	0x31, 0x6c, 0x91, 0x1b, 0xdb, 0x3d, 0xe3, 0xce, 0x4c, 0x3a, 0x24, 0x62, 0xc4, 0xb3, 0xb5, 0xdb,
-	0xbf, 0x1d, 0x17, 0x6f, 0x75, 0x6d, 0xde, 0x1b, 0x75, 0x2a, 0x26, 0x1d, 0x54, 0xb9, 0x98, 0xf8,
-	0xc0, 0x76, 0x78, 0xf8, 0xd8, 0xb7, 0x3b, 0xac, 0xda, 0xd9, 0xe7, 0x84, 0x55, 0xb6, 0xc8, 0xa4,
-	0xe6, 0x1d, 0xa2, 0x1f, 0x6a, 0x79, 0x29, 0x3d, 0x6a, 0xf8, 0x25, 0xcb, 0xe5, 0xf0, 0x45, 0xcf,
-	0x32, 0xc4, 0xfb, 0x7d, 0x8a, 0xe5, 0x46, 0xcc, 0xea, 0xbe, 0x18, 0xa6, 0xd3, 0x74, 0x94, 0x4e,
-	0xff, 0x82, 0xa4, 0x98, 0x3f, 0xcb, 0x25, 0x05, 0x91, 0xde, 0x30, 0x24, 0xe5, 0x8c, 0xd6, 0x60,
-	0x6a, 0x8f, 0x10, 0x96, 0x9b, 0x79, 0x9b, 0x20, 0xe1, 0x1a, 0xa2, 0x53, 0xea, 0xb5, 0x74, 0x4a,
-	0x9f, 0xa1, 0xd3, 0x17, 0x1a, 0xcc, 0x45, 0x32, 0x7a, 0x6b, 0x1f, 0xf0, 0x56, 0x53, 0xa5, 0x28,
-	0xbe, 0x5e, 0xc8, 0xed, 0xf8, 0xc5, 0xdc, 0xde, 0x84, 0x24, 0x1e, 0xd0, 0x91, 0x4f, 0xb0, 0x5a,
-	0xc5, 0x03, 0xfa, 0xd3, 0x71, 0xf1, 0x6a, 0x68, 0x4a, 0xea, 0x07, 0x4d, 0xfe, 0xb9, 0xce, 0xac,
-	0x47, 0x55, 0xbe, 0x3f, 0x24, 0xac, 0xb2, 0xed, 0x70, 0x5d, 0x45, 0x97, 0x7f, 0xd5, 0x20, 0x2d,
-	0x73, 0x3a, 0x7b, 0xf4, 0x1c, 0xd5, 0x97, 0x60, 0xda, 0x22, 0x0e, 0x1d, 0x28, 0x14, 0x52, 0x88,
-	0x30, 0x37, 0x11, 0x65, 0xee, 0xbb, 0x5c, 0x4f, 0xff, 0x08, 0xf9, 0x5a, 0xc4, 0xb4, 0x07, 0xb8,
-	0xcf, 0xd4, 0x74, 0x83, 0x9f, 0x8d, 0x86, 0xd2, 0xa3, 0x1d, 0x80, 0x10, 0x1f, 0x93, 0x62, 0x2b,
-	0xdf, 0xa5, 0xe6, 0x06, 0x31, 0xf5, 0x50, 0x86, 0x72, 0x0d, 0x20, 0x28, 0x9b, 0xa1, 0x5b, 0x90,
-	0x51, 0x68, 0x3d, 0x31, 0xa7, 0x89, 0xa5, 0xb8, 0x74, 0xba, 0x14, 0x81, 0xab, 0x0e, 0x3c, 0x88,
-	0x2a, 0xaf, 0xc0, 0xf4, 0x76, 0xa3, 0x45, 0x38, 0x5a, 0x80, 0x84, 0x6d, 0xc9, 0xb0, 0x29, 0xdd,
-	0x3b, 0x96, 0xbf, 0xd7, 0x20, 0x63, 0x4c, 0x36, 0x89, 0xff, 0x0a, 0xd8, 0x3d, 0x77, 0xa5, 0x68,
-	0xef, 0x35, 0xb6, 0x33, 0x77, 0xcc, 0x7d, 0x98, 0x0d, 0x5a, 0xe8, 0x5d, 0x7e, 0xf1, 0xf7, 0x4a,
-	0x9a, 0xf1, 0x73, 0x6c, 0x12, 0x52, 0xfe, 0x4a, 0x83, 0x94, 0x31, 0x69, 0x71, 0xcc, 0x47, 0x0c,
-	0xfd, 0x13, 0xc0, 0x76, 0xda, 0xfe, 0x2d, 0x29, 0x21, 0x67, 0x5f, 0x1d, 0x17, 0x43, 0x5a, 0x3d,
-	0x65, 0x3b, 0x86, 0xbc, 0x37, 0xab, 0x90, 0xa1, 0x23, 0x1e, 0xb8, 0x4b, 0x30, 0xf3, 0xaf, 0x8e,
-	0x8b, 0x61, 0xb5, 0x9e, 0xa6, 0x23, 0xae, 0x02, 0xee, 0x40, 0x92, 0x89, 0x0f, 0x89, 0x35, 0xca,
-	0xae, 0x5f, 0x0e, 0x75, 0x5c, 0x41, 0x30, 0xf6, 0x87, 0xa4, 0x06, 0xaf, 0x8e, 0x8b, 0xca, 0x53,
-	0x57, 0x7f, 0xcb, 0x5f, 0x6b, 0x70, 0xa5, 0x4e, 0xfb, 0x56, 0x8b, 0x53, 0x17, 0x77, 0x89, 0xe1,
-	0xfd, 0x48, 0xee, 0x11, 0xf7, 0x9e, 0x4b, 0x87, 0x94, 0xe1, 0x7e, 0x64, 0x49, 0xb5, 0xe8, 0x92,
-	0x9a, 0x01, 0x77, 0xe2, 0x62, 0xd0, 0x2b, 0x15, 0xf5, 0x7e, 0xf4, 0x9e, 0x7e, 0x15, 0xf5, 0xf4,
-	0xab, 0xd4, 0xa9, 0xed, 0xd4, 0x6e, 0x78, 0xad, 0x7c, 0xfa, 0xa2, 0xb8, 0xfa, 0x16, 0xad, 0xf4,
-	0x02, 0x98, 0x4f, 0xac, 0x3b, 0xb3, 0x4f, 0x8e, 0x8a, 0xb1, 0xcf, 0x8f, 0x8a, 0xb1, 0x5f, 0x8e,
-	0x8a, 0xb1, 0xf2, 0xc7, 0x90, 0x3b, 0x5d, 0xb7, 0x7a, 0x0f, 0x3b, 0x5d, 0x12, 0x20, 0x5d, 0x83,
-	0xb4, 0x43, 0x1e, 0x07, 0xab, 0x27, 0x5f, 0x89, 0xe7, 0x57, 0x8f, 0xe9, 0x29, 0x87, 0x3c, 0x16,
-	0xa7, 0x68, 0xf2, 0x6b, 0xdf, 0xc4, 0x61, 0x36, 0xdc, 0x2f, 0x74, 0x03, 0x2e, 0x19, 0x0f, 0xdb,
-	0x2d, 0x63, 0xc3, 0xd8, 0x6d, 0xb5, 0x77, 0xee, 0x1a, 0xed, 0xcd, 0xbb, 0xbb, 0x3b, 0x8d, 0x85,
-	0x58, 0x7e, 0xf9, 0xe0, 0xb0, 0x74, 0x91, 0x09, 0xfd, 0x17, 0xf2, 0xa7, 0xea, 0x46, 0xf3, 0xde,
-	0xdd, 0xd6, 0xb6, 0xd1, 0xd6, 0x9b, 0xf5, 0xe6, 0xf6, 0x83, 0x66, 0x63, 0x41, 0xcb, 0x17, 0x0e,
-	0x0e, 0x4b, 0x7f, 0xe0, 0x81, 0x6e, 0xc3, 0xf2, 0xa9, 0xb5, 0xb6, 0x61, 0xd4, 0xb7, 0xda, 0x75,
-	0xbd, 0xb9, 0x61, 0x34, 0x1b, 0x0b, 0xf1, 0xfc, 0x95, 0x83, 0xc3, 0xd2, 0xeb, 0xcc, 0xe8, 0x0e,
-	0xe4, 0xce, 0x9a, 0x9a, 0x0f, 0x9b, 0xf5, 0x5d, 0x2f, 0x34, 0x91, 0xff, 0xd3, 0xc1, 0x61, 0xe9,
-	0xb5, 0x76, 0x54, 0x01, 0x74, 0x6a, 0xd3, 0x9b, 0x9b, 0xbb, 0x3b, 0x8d, 0x66, 0x63, 0x61, 0x2a,
-	0x7f, 0xf9, 0xe0, 0xb0, 0x74, 0x81, 0x25, 0x3f, 0xf5, 0xe4, 0xcb, 0x42, 0xac, 0xf6, 0xff, 0x67,
-	0x27, 0x05, 0xed, 0xf9, 0x49, 0x41, 0xfb, 0xf9, 0xa4, 0xa0, 0x7d, 0xfa, 0xb2, 0x10, 0x7b, 0xfe,
-	0xb2, 0x10, 0xfb, 0xf1, 0x65, 0x21, 0xf6, 0xd1, 0x8d, 0xd0, 0x94, 0x3f, 0xb4, 0x1d, 0x4e, 0x5c,
-	0x83, 0xe0, 0x81, 0xfc, 0x0f, 0xa4, 0x3a, 0xa0, 0xd6, 0xa8, 0x4f, 0xaa, 0x13, 0x25, 0x8a, 0x99,
-	0x77, 0x92, 0xe2, 0x19, 0x7f, 0xf3, 0xf7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x4e, 0x2c, 0x04,
-	0xaf, 0x0c, 0x00, 0x00,
+	// 1862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0xfa, 0x20, 0x1f, 0x25, 0x4a, 0x9a, 0xa8, 0x16, 0xc5, 0xd8, 0x24, 0xc3, 0x20,
+	0xa9, 0xea, 0xd6, 0xa4, 0xa5, 0xb8, 0x68, 0x60, 0xb4, 0x01, 0xf8, 0xb1, 0xb2, 0x59, 0x38, 0x94,
+	0xbd, 0x5c, 0x05, 0x41, 0x8b, 0x82, 0x18, 0xed, 0x8e, 0xc8, 0x85, 0xc9, 0x1d, 0x76, 0x67, 0x48,
+	0x51, 0xff, 0x41, 0xca, 0x53, 0x0f, 0x3d, 0xf4, 0xc2, 0xc2, 0x40, 0xd1, 0x4b, 0x7a, 0x2d, 0xd0,
+	0x5b, 0xcf, 0x41, 0x4f, 0x39, 0xf4, 0x50, 0xf4, 0xa0, 0x14, 0xf2, 0xa5, 0x30, 0x7a, 0x29, 0xd0,
+	0x53, 0x4f, 0xc5, 0xee, 0xcc, 0x2e, 0x77, 0x29, 0xc9, 0x76, 0x74, 0xe2, 0xbc, 0xf7, 0x7e, 0xef,
+	0xcd, 0x7b, 0x6f, 0xde, 0x9b, 0x79, 0x4b, 0xd8, 0xea, 0x77, 0x87, 0xc7, 0xfb, 0xe5, 0xd1, 0x5e,
+	0xd9, 0x5b, 0x94, 0x06, 0x0e, 0xe5, 0x14, 0x25, 0x04, 0x31, 0xda, 0xcb, 0xee, 0x18, 0x94, 0xf5,
+	0x29, 0x6b, 0x7b, 0xfc, 0xb2, 0x20, 0x04, 0x28, 0x9b, 0xef, 0x50, 0xda, 0xe9, 0x91, 0xb2, 0x47,
+	0x1d, 0x0f, 0x4f, 0xca, 0xdc, 0xea, 0x13, 0xc6, 0x71, 0x7f, 0x20, 0x01, 0x5b, 0x1d, 0xda, 0xa1,
+	0x42, 0xd1, 0x5d, 0x49, 0x6e, 0x4e, 0x18, 0x29, 0x1f, 0x63, 0x46, 0xca, 0xa3, 0xbd, 0x63, 0xc2,
+	0xf1, 0x5e, 0xd9, 0xa0, 0x96, 0x2d, 0xe5, 0x3b, 0xf3, 0x66, 0xb1, 0x7d, 0x26, 0x44, 0xc5, 0x89,
+	0x02, 0xdb, 0xea, 0x98, 0x13, 0xc7, 0xc6, 0x3d, 0x75, 0x44, 0x6c, 0xfe, 0x19, 0xe5, 0x44, 0x23,
+	0x06, 0x75, 0x4c, 0xf4, 0x13, 0x58, 0x22, 0x2e, 0x2b, 0xa3, 0x14, 0x94, 0xdd, 0xd4, 0xfe, 0x56,
+	0x49, 0x98, 0x29, 0xf9, 0x66, 0x4a, 0x15, 0xfb, 0xac, 0xba, 0xf9, 0xd7, 0x3f, 0xdd, 0x5b, 0x8b,
+	0x58, 0xd0, 0x84, 0x16, 0xda, 0x82, 0xa5, 0x11, 0xe5, 0x84, 0x65, 0x62, 0x85, 0xf8, 0x6e, 0x52,
+	0x13, 0x04, 0xca, 0x42, 0x02, 0x1b, 0x06, 0x19, 0x70, 0x62, 0x66, 0xe2, 0x05, 0x65, 0x37, 0xa1,
+	0x05, 0x74, 0x11, 0xc3, 0xe6, 0x13, 0xcc, 0x09, 0xe3, 0xd5, 0x1e, 0x35, 0x9e, 0x3f, 0x26, 0x56,
+	0xa7, 0xcb, 0xd1, 0x77, 0x61, 0x9d, 0x48, 0xf3, 0xed, 0xae, 0xc7, 0xf2, 0xfc, 0x59, 0xd4, 0xd2,
+	0x3e, 0x5b, 0x02, 0xdf, 0x87, 0x35, 0x99, 0x59, 0x09, 0x8b, 0x79, 0xb0, 0x55, 0xc1, 0x14, 0xa0,
+	0xa2, 0x05, 0x69, 0xdf, 0xd9, 0x96, 0xd5, 0xb1, 0x89, 0xe3, 0xba, 0x39, 0xa0, 0xa7, 0xc4, 0x91,
+	0x56, 0x05, 0x81, 0xbe, 0x07, 0x1b, 0xc1, 0xae, 0xd8, 0x34, 0x1d, 0xc2, 0x98, 0x67, 0x2f, 0xa9,
+	0x05, 0xde, 0x54, 0x04, 0x1b, 0xdd, 0x82, 0xe5, 0x53, 0xb1, 0x61, 0xdc, 0xb3, 0x20, 0xa9, 0xe2,
+	0x5f, 0x14, 0x48, 0x89, 0x3d, 0x5a, 0x84, 0xeb, 0x63, 0x77, 0x23, 0x9b, 0xda, 0x06, 0xf1, 0x37,
+	0xf2, 0x08, 0x57, 0x3b, 0xe2, 0xae, 0xa4, 0xd0, 0x23, 0x58, 0x61, 0x9e, 0x32, 0xcb, 0xc4, 0x0b,
+	0xf1, 0xdd, 0xd4, 0x7e, 0xa6, 0xe4, 0x57, 0x50, 0x29, 0x1a, 0x41, 0xf5, 0x9d, 0x2f, 0xbf, 0xc9,
+	0xaf, 0x47, 0x79, 0x4c, 0xf3, 0xb5, 0xdd, 0x84, 0x33, 0xf2, 0xcb, 0x21, 0x71, 0x77, 0x5e, 0xf4,
+	0xb6, 0x08, 0x68, 0x74, 0x1b, 0x92, 0xbc, 0xeb, 0x10, 0xd6, 0xa5, 0x3d, 0x33, 0xb3, 0xe4, 0x09,
+	0x67, 0x8c, 0xe2, 0x85, 0x02, 0x2b, 0x55, 0xcc, 0x8d, 0xae, 0x3e, 0x46, 0x79, 0x48, 0x1d, 0xbb,
+	0xcb, 0x76, 0x38, 0x04, 0xf0, 0x58, 0x4d, 0x2f, 0x8e, 0x0c, 0xac, 0xb8, 0xc5, 0x4a, 0x87, 0x7e,
+	0x20, 0x3e, 0x89, 0x7e, 0x0c, 0xab, 0xdc, 0xc1, 0x36, 0xc3, 0x06, 0xb7, 0xa8, 0x7d, 0x45, 0x38,
+	0x2d, 0x62, 0x9b, 0x3a, 0xf5, 0x03, 0xd0, 0x22, 0x68, 0x74, 0x17, 0x36, 0x83, 0x83, 0xe0, 0xf4,
+	0x39, 0xb1, 0xdb, 0x96, 0x99, 0x59, 0x8c, 0x9e, 0x84, 0xee, 0xf2, 0x1b, 0x66, 0x28, 0x97, 0x4b,
+	0x91, 0x5c, 0x86, 0x53, 0xb0, 0x1c, 0x4d, 0x41, 0xf1, 0x6f, 0x71, 0x48, 0x47, 0x1d, 0x40, 0x69,
+	0x88, 0x59, 0xa6, 0x0c, 0x31, 0x66, 0x79, 0x66, 0x19, 0xb1, 0x4d, 0xe2, 0xc8, 0x0a, 0x90, 0x14,
+	0xba, 0x07, 0x28, 0x70, 0xcd, 0x21, 0x86, 0x35, 0xb0, 0x88, 0x2d, 0x8a, 0x20, 0xa9, 0x05, 0x4e,
+	0x6b, 0xbe, 0x00, 0xed, 0x40, 0xc2, 0xe8, 0x62, 0x2b, 0x14, 0xc0, 0x8a, 0x47, 0x37, 0x4c, 0xf4,
+	0x11, 0x2c, 0x79, 0xb1, 0x79, 0x7e, 0xa7, 0xf6, 0xb7, 0x2f, 0x1f, 0xb5, 0x17, 0x62, 0x75, 0xf1,
+	0xab, 0xf3, 0xfc, 0x82, 0x26, 0xb0, 0xa8, 0x0c, 0xf1, 0x13, 0x22, 0x02, 0x7a, 0xa3, 0x8a, 0x8b,
+	0x44, 0xdb, 0xb0, 0xc2, 0xc7, 0xed, 0x2e, 0x66, 0xdd, 0xcc, 0x8a, 0x08, 0x84, 0x8f, 0x1f, 0x63,
+	0xd6, 0x45, 0x75, 0x48, 0x8f, 0x70, 0xaf, 0x6d, 0xd0, 0x7e, 0xdf, 0x62, 0xcc, 0xa2, 0x76, 0x26,
+	0xf1, 0x36, 0x46, 0xd7, 0x46, 0xb8, 0x57, 0x0b, 0x74, 0xd0, 0x1d, 0x00, 0xc3, 0x21, 0x98, 0x13,
+	0xb3, 0x8d, 0x79, 0x26, 0x29, 0xaa, 0x49, 0x72, 0x2a, 0x1c, 0x7d, 0x00, 0x69, 0x87, 0x9c, 0x0c,
+	0x6d, 0x33, 0xe8, 0x27, 0xf0, 0x9c, 0x58, 0x13, 0x5c, 0xbf, 0x9b, 0x3e, 0x84, 0x75, 0x09, 0x0b,
+	0x92, 0x95, 0x0a, 0xe3, 0x6a, 0x32, 0x65, 0x77, 0x00, 0xc8, 0x78, 0x60, 0x39, 0x84, 0xb9, 0xbb,
+	0xad, 0x8a, 0xdd, 0x24, 0xa7, 0xc2, 0x8b, 0xbf, 0x89, 0x43, 0xba, 0x46, 0x6d, 0xee, 0x60, 0x83,
+	0xd7, 0x70, 0xaf, 0xa7, 0x8f, 0xdd, 0xe3, 0xb2, 0xec, 0x11, 0xee, 0x59, 0x26, 0x76, 0x4b, 0x2b,
+	0x52, 0xc9, 0x9b, 0x61, 0x89, 0x28, 0xe8, 0xce, 0x1c, 0x9c, 0x19, 0x74, 0x40, 0xbc, 0x0a, 0x58,
+	0xad, 0x7e, 0xfc, 0xbf, 0xf3, 0xfc, 0x83, 0x8e, 0xc5, 0xbb, 0xc3, 0xe3, 0x92, 0x41, 0xfb, 0x65,
+	0xee, 0x15, 0x44, 0xdf, 0xb2, 0x79, 0x78, 0xd9, 0xb3, 0x8e, 0x59, 0xf9, 0xf8, 0x8c, 0x13, 0x56,
+	0x7a, 0x4c, 0xc6, 0x55, 0x77, 0x11, 0xdd, 0xa8, 0xe5, 0x9a, 0x74, 0x3b, 0xc7, 0xcf, 0x88, 0xa8,
+	0x1d, 0x9f, 0x74, 0x25, 0x03, 0x7c, 0xd6, 0xa3, 0x58, 0x14, 0xcc, 0xaa, 0xe6, 0x93, 0xe1, 0x6e,
+	0x5b, 0x8a, 0x76, 0xdb, 0x0f, 0x61, 0xd9, 0x2b, 0x0f, 0x96, 0x59, 0x2e, 0xc4, 0xdf, 0x7c, 0x86,
+	0x12, 0x8c, 0xf6, 0x60, 0xf1, 0x84, 0x10, 0x96, 0x59, 0x79, 0x1b, 0x25, 0x0f, 0x1a, 0xea, 0xb6,
+	0xc4, 0xb5, 0xdd, 0x96, 0x9c, 0xeb, 0xb6, 0xdf, 0x29, 0xb0, 0x16, 0xb1, 0xe8, 0x76, 0x45, 0xd0,
+	0xd6, 0x8a, 0x0c, 0x45, 0xb6, 0xf3, 0x95, 0xad, 0x1f, 0xbb, 0xba, 0xf5, 0x0f, 0x60, 0x19, 0xf7,
+	0xe9, 0xd0, 0xef, 0xbf, 0x6a, 0xc9, 0x75, 0xf4, 0x1f, 0xe7, 0xf9, 0x0f, 0x43, 0xa7, 0x24, 0x5f,
+	0x49, 0xf1, 0x73, 0x8f, 0x99, 0xcf, 0xcb, 0xfc, 0x6c, 0x40, 0x58, 0xa9, 0x61, 0x73, 0x4d, 0x6a,
+	0x17, 0xff, 0x1d, 0x87, 0xa4, 0xb0, 0x69, 0x9f, 0xd0, 0x4b, 0x37, 0xc1, 0x16, 0x2c, 0x99, 0xc4,
+	0xa6, 0x7d, 0xe9, 0x85, 0x20, 0x22, 0x8d, 0x1d, 0x8f, 0x36, 0xf6, 0xb7, 0xb9, 0xbd, 0xbe, 0x1f,
+	0xc2, 0x9a, 0xc4, 0xb0, 0xfa, 0xb8, 0xc7, 0xe4, 0xe9, 0x06, 0x6f, 0x51, 0x5d, 0xf2, 0x51, 0x13,
+	0x20, 0xd4, 0xae, 0xcb, 0x5e, 0x55, 0x7e, 0x9b, 0x98, 0xeb, 0xc4, 0xd0, 0x42, 0x16, 0xd0, 0xa7,
+	0x00, 0x7d, 0xcb, 0x6e, 0xcb, 0x1c, 0xae, 0xdc, 0x28, 0x87, 0xc9, 0xbe, 0x65, 0x57, 0x3c, 0x03,
+	0xee, 0xeb, 0xe5, 0x9a, 0x73, 0xef, 0xa7, 0xc4, 0xcd, 0xce, 0xa3, 0x6f, 0xd9, 0x07, 0x84, 0xa0,
+	0x5f, 0x00, 0x72, 0x2b, 0xdb, 0x9d, 0x07, 0xda, 0xb3, 0xa7, 0x2a, 0x79, 0x23, 0x9b, 0x9b, 0xbe,
+	0x25, 0x3d, 0x78, 0xe2, 0xaa, 0x00, 0xc1, 0x69, 0x33, 0xf4, 0x00, 0x52, 0xf2, 0x90, 0x5c, 0x32,
+	0xa3, 0x78, 0xbd, 0xf0, 0xce, 0xac, 0x17, 0x02, 0xa8, 0x06, 0x3c, 0xd0, 0x2a, 0xee, 0xc0, 0x52,
+	0xa3, 0xde, 0x22, 0x1c, 0x6d, 0x40, 0xdc, 0x32, 0x85, 0xda, 0xa2, 0xe6, 0x2e, 0x8b, 0x7f, 0x56,
+	0x20, 0xa5, 0x8f, 0x0f, 0x88, 0x3f, 0x51, 0x1d, 0x5d, 0xba, 0x68, 0x95, 0x1b, 0x45, 0x32, 0x77,
+	0xf3, 0x3e, 0x83, 0xd5, 0xa0, 0x72, 0xdc, 0x94, 0xc7, 0x6e, 0x64, 0x34, 0xe5, 0xdb, 0x38, 0x20,
+	0xa4, 0xf8, 0x07, 0x05, 0x12, 0xfa, 0xb8, 0xc5, 0x31, 0x1f, 0x32, 0xf4, 0x03, 0x00, 0xcb, 0x6e,
+	0xfb, 0x6f, 0x87, 0x70, 0x39, 0xfd, 0xea, 0x3c, 0x1f, 0xe2, 0x6a, 0x09, 0xcb, 0xd6, 0xc5, 0x6b,
+	0x52, 0x86, 0x14, 0x1d, 0xf2, 0x00, 0x2e, 0x9c, 0x59, 0x7f, 0x75, 0x9e, 0x0f, 0xb3, 0xb5, 0x24,
+	0x1d, 0x72, 0xa9, 0xf0, 0x10, 0x96, 0x99, 0xb7, 0x91, 0xd7, 0x3d, 0xe9, 0xfd, 0x5b, 0xa1, 0x8c,
+	0x4b, 0x17, 0xf4, 0xb3, 0x01, 0xa9, 0xc2, 0xab, 0xf3, 0xbc, 0x44, 0x6a, 0xf2, 0xb7, 0xf8, 0x47,
+	0x05, 0xde, 0xad, 0xd1, 0x9e, 0xd9, 0xe2, 0xd4, 0xc1, 0x1d, 0xa2, 0xbb, 0xa3, 0xc3, 0x09, 0x71,
+	0x9e, 0x3a, 0x74, 0x40, 0x19, 0xee, 0x45, 0x7a, 0x53, 0x89, 0xf6, 0xa6, 0x11, 0x5c, 0x19, 0x31,
+	0xef, 0xa0, 0x77, 0x4a, 0x72, 0x16, 0x77, 0xc7, 0xe8, 0x92, 0x1c, 0xa3, 0x4b, 0x35, 0x6a, 0xd9,
+	0xd5, 0xfb, 0x6e, 0x2a, 0xbf, 0xfc, 0x26, 0xbf, 0xfb, 0x16, 0xa9, 0x74, 0x15, 0x98, 0x7f, 0x9f,
+	0x3c, 0x5c, 0xfd, 0xe2, 0x45, 0x7e, 0xe1, 0xb7, 0x2f, 0xf2, 0x0b, 0xff, 0x7a, 0x91, 0x5f, 0x28,
+	0xfe, 0x1c, 0x32, 0xb3, 0x72, 0xab, 0x75, 0xb1, 0xdd, 0x21, 0x81, 0xa7, 0x7b, 0x90, 0xb4, 0xc9,
+	0x69, 0x50, 0x7a, 0x62, 0xe2, 0xbe, 0x5c, 0x7a, 0x4c, 0x4b, 0xd8, 0xe4, 0xd4, 0x5b, 0xcd, 0x19,
+	0xff, 0x8f, 0x02, 0xe8, 0xd9, 0x10, 0x3b, 0xd8, 0xe6, 0x96, 0x4d, 0xcc, 0x3a, 0x19, 0x50, 0x66,
+	0xf1, 0x4b, 0x77, 0x58, 0x38, 0x23, 0xb1, 0x68, 0x46, 0x66, 0x83, 0x4e, 0x3c, 0x32, 0xe8, 0xdc,
+	0x85, 0x4d, 0x87, 0x18, 0xc4, 0x1a, 0x11, 0xa7, 0x3d, 0x37, 0xc2, 0xac, 0xfb, 0x02, 0xff, 0x5d,
+	0xce, 0x42, 0xc2, 0x67, 0x79, 0x97, 0x57, 0x52, 0x0b, 0x68, 0xf4, 0xa3, 0x20, 0xe3, 0x62, 0x68,
+	0x79, 0x4d, 0xc6, 0xe5, 0xeb, 0x24, 0xe0, 0xd7, 0x4e, 0x2e, 0xc5, 0x53, 0xd8, 0xf6, 0xbe, 0x15,
+	0x7a, 0x16, 0xe3, 0x73, 0xf9, 0x7c, 0x1f, 0xd6, 0xb0, 0x19, 0x0c, 0x1b, 0x44, 0xf4, 0x65, 0x52,
+	0x5b, 0xc5, 0xa6, 0x3f, 0x6b, 0x10, 0xe6, 0x8e, 0xf9, 0x0e, 0xe9, 0xd3, 0x11, 0x09, 0xe1, 0xc4,
+	0xe7, 0xca, 0xba, 0xe0, 0x07, 0xd0, 0xb9, 0x64, 0xff, 0x4a, 0x81, 0xec, 0xe5, 0x64, 0x07, 0x9b,
+	0xdf, 0x01, 0x30, 0x05, 0x6b, 0xf6, 0xae, 0x25, 0x25, 0xa7, 0x61, 0xa2, 0x4f, 0x00, 0x1c, 0xc2,
+	0x68, 0x6f, 0xe8, 0x4e, 0x01, 0xde, 0x29, 0xa4, 0xf7, 0x73, 0xb3, 0xc3, 0x9e, 0x19, 0xd6, 0x02,
+	0x94, 0x16, 0xd2, 0x98, 0xf3, 0xe5, 0x14, 0x90, 0x2e, 0x6f, 0x36, 0x62, 0xfa, 0x1d, 0x80, 0x1e,
+	0x42, 0x82, 0xcb, 0xb5, 0x2c, 0xa7, 0x6b, 0x47, 0x6e, 0x99, 0xed, 0x00, 0x2f, 0x66, 0xb5, 0x1e,
+	0xc1, 0x8c, 0x44, 0xbf, 0xa5, 0xd6, 0x24, 0x57, 0x7e, 0x4c, 0x3d, 0x82, 0x9d, 0x47, 0x43, 0xec,
+	0x98, 0x16, 0xb6, 0x5b, 0x64, 0x3e, 0xff, 0xb7, 0x21, 0xd9, 0x91, 0x42, 0x3f, 0xf7, 0x33, 0x46,
+	0x34, 0x82, 0xbb, 0x17, 0x31, 0x58, 0x0d, 0xb7, 0x3a, 0xba, 0x0f, 0xef, 0xe8, 0x9f, 0xb7, 0x5b,
+	0x7a, 0x45, 0x3f, 0x6a, 0xb5, 0x9b, 0x87, 0x7a, 0xfb, 0xe0, 0xf0, 0xa8, 0x59, 0xdf, 0x58, 0xc8,
+	0x6e, 0x4f, 0xa6, 0x85, 0xab, 0x44, 0xe8, 0x13, 0xc8, 0xce, 0xd8, 0x75, 0xf5, 0xe9, 0x61, 0xab,
+	0xa1, 0xb7, 0x35, 0xb5, 0xa6, 0x36, 0x3e, 0x53, 0xeb, 0x1b, 0x4a, 0x36, 0x37, 0x99, 0x16, 0x5e,
+	0x83, 0x40, 0x1f, 0xc3, 0xf6, 0x4c, 0x5a, 0xad, 0xe8, 0xb5, 0xc7, 0xed, 0x9a, 0xa6, 0x56, 0x74,
+	0xb5, 0xbe, 0x11, 0xcb, 0xbe, 0x3b, 0x99, 0x16, 0xae, 0x13, 0xa3, 0x87, 0x90, 0x99, 0x17, 0xa9,
+	0x9f, 0xab, 0xb5, 0x23, 0x57, 0x35, 0x9e, 0xbd, 0x3d, 0x99, 0x16, 0xae, 0x95, 0xa3, 0x12, 0xa0,
+	0x99, 0x4c, 0x53, 0x0f, 0x8e, 0x9a, 0x75, 0xb5, 0xbe, 0xb1, 0x98, 0xbd, 0x35, 0x99, 0x16, 0xae,
+	0x90, 0xa0, 0x07, 0xf0, 0x9d, 0x19, 0xf7, 0xd9, 0x51, 0x45, 0xab, 0x34, 0xf5, 0x46, 0x53, 0xad,
+	0x6f, 0x2c, 0x65, 0x77, 0x26, 0xd3, 0xc2, 0xd5, 0xc2, 0xec, 0xe2, 0x17, 0xbf, 0xcf, 0x2d, 0xdc,
+	0xfd, 0xaf, 0x02, 0x5b, 0x57, 0x55, 0x16, 0x7a, 0x02, 0xef, 0xcd, 0xd0, 0x6d, 0x4d, 0x6d, 0x1d,
+	0x3e, 0x39, 0xd2, 0x1b, 0x87, 0xcd, 0xf6, 0x51, 0xb3, 0xf5, 0x54, 0xad, 0x35, 0x0e, 0x1a, 0xaa,
+	0x9b, 0xfa, 0x0f, 0x26, 0xd3, 0xc2, 0x9b, 0x81, 0xa8, 0x0e, 0x77, 0xae, 0x06, 0x69, 0xea, 0x13,
+	0xb5, 0xd2, 0x52, 0x37, 0x94, 0xec, 0x7b, 0x93, 0x69, 0xe1, 0xf5, 0x20, 0x54, 0x85, 0xdb, 0xd7,
+	0x01, 0xf4, 0x23, 0xad, 0xb9, 0x11, 0xcb, 0x16, 0x26, 0xd3, 0xc2, 0x6b, 0x31, 0x22, 0xec, 0xea,
+	0x4f, 0xbf, 0xba, 0xc8, 0x29, 0x5f, 0x5f, 0xe4, 0x94, 0x7f, 0x5e, 0xe4, 0x94, 0x5f, 0xbf, 0xcc,
+	0x2d, 0x7c, 0xfd, 0x32, 0xb7, 0xf0, 0xf7, 0x97, 0xb9, 0x85, 0x9f, 0xdd, 0x0f, 0xdd, 0xe6, 0x9f,
+	0x5a, 0x36, 0x27, 0x8e, 0x4e, 0x70, 0x5f, 0xfc, 0x6b, 0x53, 0xee, 0x53, 0x73, 0xd8, 0x23, 0xe5,
+	0xb1, 0x24, 0xbd, 0xbb, 0xfd, 0x78, 0xd9, 0xfb, 0xeb, 0xe3, 0xa3, 0xff, 0x0f, 0x00, 0x2d, 0xcd,
+	0x0f, 0x7f, 0xe3, 0x11, 0x00, 0x00,
 }
 
 func (m *ExternalEventVoteRecord) Marshal() (dAtA []byte, err error) {
@@ -1240,6 +1597,11 @@ func (m *ExternalSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintMhub2(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ExternalAddress) > 0 {
 		i -= len(m.ExternalAddress)
 		copy(dAtA[i:], m.ExternalAddress)
@@ -1275,6 +1637,11 @@ func (m *SignerSetTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintMhub2(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintMhub2(dAtA, i, uint64(m.Sequence))
 		i--
@@ -1391,6 +1758,11 @@ func (m *SendToExternal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintMhub2(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x60
+	}
 	if len(m.RefundChainId) > 0 {
 		i -= len(m.RefundChainId)
 		copy(dAtA[i:], m.RefundChainId)
@@ -1633,6 +2005,36 @@ func (m *TokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TimelockThreshold.Size()
+		i -= size
+		if _, err := m.TimelockThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMhub2(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMhub2(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMhub2(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.Commission.Size()
 		i -= size
@@ -1919,35 +2321,252 @@ func (m *TokenInfosChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMhub2(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMhub2(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuarantinedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ExternalEventVoteRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QuarantinedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovMhub2(uint64(l))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintMhub2(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.Votes) > 0 {
-		for _, s := range m.Votes {
-			l = len(s)
-			n += 1 + l + sovMhub2(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintMhub2(dAtA, i, uint64(size))
 	}
-	if m.Accepted {
-		n += 2
+	i--
+	dAtA[i] = 0x32
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintMhub2(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReceiverChainId) > 0 {
+		i -= len(m.ReceiverChainId)
+		copy(dAtA[i:], m.ReceiverChainId)
+		i = encodeVarintMhub2(dAtA, i, uint64(len(m.ReceiverChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMhub2(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMhub2(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMhub2(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlocklistChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlocklistChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlocklistChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveAddresses) > 0 {
+		for iNdEx := len(m.RemoveAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveAddresses[iNdEx])
+			i = encodeVarintMhub2(dAtA, i, uint64(len(m.RemoveAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AddAddresses) > 0 {
+		for iNdEx := len(m.AddAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddAddresses[iNdEx])
+			copy(dAtA[i:], m.AddAddresses[iNdEx])
+			i = encodeVarintMhub2(dAtA, i, uint64(len(m.AddAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantinedDepositProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedDepositProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedDepositProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resolution != 0 {
+		i = encodeVarintMhub2(dAtA, i, uint64(m.Resolution))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DepositId != 0 {
+		i = encodeVarintMhub2(dAtA, i, uint64(m.DepositId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintMhub2(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMhub2(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GuardianSetChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardianSetChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GuardianSetChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintMhub2(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMhub2(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMhub2(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExternalEventVoteRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovMhub2(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, s := range m.Votes {
+			l = len(s)
+			n += 1 + l + sovMhub2(uint64(l))
+		}
+	}
+	if m.Accepted {
+		n += 2
 	}
 	return n
 }
@@ -1980,6 +2599,9 @@ func (m *ExternalSigner) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMhub2(uint64(l))
 	}
+	if m.Weight != 0 {
+		n += 1 + sovMhub2(uint64(m.Weight))
+	}
 	return n
 }
 
@@ -2004,6 +2626,9 @@ func (m *SignerSetTx) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovMhub2(uint64(m.Sequence))
 	}
+	if m.Threshold != 0 {
+		n += 1 + sovMhub2(uint64(m.Threshold))
+	}
 	return n
 }
 
@@ -2080,6 +2705,9 @@ func (m *SendToExternal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMhub2(uint64(l))
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovMhub2(uint64(m.ExpiresAt))
+	}
 	return n
 }
 
@@ -2172,6 +2800,12 @@ func (m *TokenInfo) Size() (n int) {
 	}
 	l = m.Commission.Size()
 	n += 1 + l + sovMhub2(uint64(l))
+	l = m.MinAmount.Size()
+	n += 1 + l + sovMhub2(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovMhub2(uint64(l))
+	l = m.TimelockThreshold.Size()
+	n += 1 + l + sovMhub2(uint64(l))
 	return n
 }
 
@@ -2271,6 +2905,105 @@ func (m *TokenInfosChangeProposal) Size() (n int) {
 	return n
 }
 
+func (m *QuarantinedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMhub2(uint64(m.Id))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMhub2(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMhub2(uint64(l))
+	}
+	l = len(m.ReceiverChainId)
+	if l > 0 {
+		n += 1 + l + sovMhub2(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovMhub2(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMhub2(uint64(l))
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovMhub2(uint64(l))
+	}
+	return n
+}
+
+func (m *BlocklistChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AddAddresses) > 0 {
+		for _, s := range m.AddAddresses {
+			l = len(s)
+			n += 1 + l + sovMhub2(uint64(l))
+		}
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for _, s := range m.RemoveAddresses {
+			l = len(s)
+			n += 1 + l + sovMhub2(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuarantinedDepositProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositId != 0 {
+		n += 1 + sovMhub2(uint64(m.DepositId))
+	}
+	if m.Resolution != 0 {
+		n += 1 + sovMhub2(uint64(m.Resolution))
+	}
+	return n
+}
+
+func (m *TimelockedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovMhub2(uint64(l))
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovMhub2(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *GuardianSetChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovMhub2(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovMhub2(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2589,12 +3322,31 @@ func (m *ExternalSigner) Unmarshal(dAtA []byte) error {
 			}
 			m.ExternalAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMhub2(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMhub2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if skippy < 0 {
 				return ErrInvalidLengthMhub2
 			}
@@ -2733,6 +3485,25 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMhub2(dAtA[iNdEx:])
@@ -3310,6 +4081,25 @@ func (m *SendToExternal) Unmarshal(dAtA []byte) error {
 			}
 			m.RefundChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMhub2(dAtA[iNdEx:])
@@ -3965,6 +4755,108 @@ func (m *TokenInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimelockThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMhub2(dAtA[iNdEx:])
@@ -4670,6 +5562,669 @@ func (m *TokenInfosChangeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuarantinedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMhub2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMhub2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlocklistChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMhub2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlocklistChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlocklistChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddAddresses = append(m.AddAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMhub2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedDepositProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMhub2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedDepositProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedDepositProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositId", wireType)
+			}
+			m.DepositId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			m.Resolution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resolution |= QuarantineResolution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMhub2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimelockedTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMhub2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockedTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMhub2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GuardianSetChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMhub2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardianSetChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardianSetChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMhub2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMhub2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMhub2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMhub2(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,9 @@ var (
 	_ sdk.Msg = &MsgRequestBatchTx{}
 	_ sdk.Msg = &MsgSubmitExternalEvent{}
 	_ sdk.Msg = &MsgSubmitExternalTxConfirmation{}
+	_ sdk.Msg = &MsgCancelTimelockedTransfer{}
+	_ sdk.Msg = &MsgRotateDelegateKeys{}
+	_ sdk.Msg = &MsgDelegateKeysMulti{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitExternalEvent{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitExternalTxConfirmation{}
@@ -71,6 +74,135 @@ func (msg *MsgDelegateKeys) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(acc)}
 }
 
+// NewMsgDelegateKeysMulti returns a reference to a new MsgDelegateKeysMulti.
+func NewMsgDelegateKeysMulti(val sdk.ValAddress, orchAddr sdk.AccAddress, entries []DelegateKeysMultiEntry) *MsgDelegateKeysMulti {
+	return &MsgDelegateKeysMulti{
+		ValidatorAddress:    val.String(),
+		OrchestratorAddress: orchAddr.String(),
+		Entries:             entries,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgDelegateKeysMulti) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgDelegateKeysMulti) Type() string { return "delegate_keys_multi" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgDelegateKeysMulti) ValidateBasic() (err error) {
+	if len(msg.Entries) == 0 {
+		return sdkerrors.Wrap(ErrDelegateKeys, "no chains to delegate keys for")
+	}
+
+	seen := make(map[string]bool, len(msg.Entries))
+	for _, keys := range msg.DelegateKeys() {
+		if keys.ChainId == "" {
+			return sdkerrors.Wrap(ErrInvalid, "empty chain id")
+		}
+		if seen[keys.ChainId] {
+			return sdkerrors.Wrapf(ErrDelegateKeys, "duplicate chain %s", keys.ChainId)
+		}
+		seen[keys.ChainId] = true
+
+		if err = keys.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "chain %s", keys.ChainId)
+		}
+	}
+
+	return nil
+}
+
+// DelegateKeys splits the message into MsgDelegateKeys of the entries
+func (msg *MsgDelegateKeysMulti) DelegateKeys() []*MsgDelegateKeys {
+	msgs := make([]*MsgDelegateKeys, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		msgs[i] = &MsgDelegateKeys{
+			ValidatorAddress:    msg.ValidatorAddress,
+			OrchestratorAddress: msg.OrchestratorAddress,
+			ExternalAddress:     entry.ExternalAddress,
+			EthSignature:        entry.EthSignature,
+			ChainId:             entry.ChainId,
+		}
+	}
+
+	return msgs
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgDelegateKeysMulti) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgDelegateKeysMulti) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
+
+// NewMsgRotateDelegateKeys returns a reference to a new MsgRotateDelegateKeys. Empty orchAddr or ethAddr
+// keep the current key.
+func NewMsgRotateDelegateKeys(val sdk.ValAddress, chainId ChainID, orchAddr sdk.AccAddress, ethAddr string, ethSig []byte) *MsgRotateDelegateKeys {
+	msg := &MsgRotateDelegateKeys{
+		ValidatorAddress: val.String(),
+		ExternalAddress:  ethAddr,
+		EthSignature:     ethSig,
+		ChainId:          chainId.String(),
+	}
+	if !orchAddr.Empty() {
+		msg.OrchestratorAddress = orchAddr.String()
+	}
+	return msg
+}
+
+// Route should return the name of the module
+func (msg *MsgRotateDelegateKeys) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgRotateDelegateKeys) Type() string { return "rotate_delegate_keys" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgRotateDelegateKeys) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+	if msg.OrchestratorAddress == "" && msg.ExternalAddress == "" {
+		return sdkerrors.Wrap(ErrDelegateKeys, "nothing to rotate")
+	}
+	if msg.OrchestratorAddress != "" {
+		if _, err = sdk.AccAddressFromBech32(msg.OrchestratorAddress); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.OrchestratorAddress)
+		}
+	}
+	if msg.ExternalAddress != "" {
+		if !common.IsHexAddress(msg.ExternalAddress) {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "ethereum address")
+		}
+		if len(msg.EthSignature) == 0 {
+			return ErrEmptyEthSig
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRotateDelegateKeys) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgRotateDelegateKeys) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
+
 // Route should return the name of the module
 func (msg *MsgSubmitExternalEvent) Route() string { return RouterKey }
 
@@ -92,7 +224,7 @@ func (msg *MsgSubmitExternalEvent) ValidateBasic() (err error) {
 
 // GetSignBytes encodes the message for signing
 func (msg *MsgSubmitExternalEvent) GetSignBytes() []byte {
-	panic(fmt.Errorf("deprecated"))
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
 
 // GetSigners defines whose signature is required
@@ -133,7 +265,7 @@ func (msg *MsgSubmitExternalTxConfirmation) ValidateBasic() (err error) {
 
 // GetSignBytes encodes the message for signing
 func (msg *MsgSubmitExternalTxConfirmation) GetSignBytes() []byte {
-	panic(fmt.Errorf("deprecated"))
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
 
 // GetSigners defines whose signature is required
@@ -201,7 +333,7 @@ func (msg MsgSendToExternal) ValidateBasic() error {
 
 // GetSignBytes encodes the message for signing
 func (msg MsgSendToExternal) GetSignBytes() []byte {
-	panic(fmt.Errorf("deprecated"))
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
 // GetSigners defines whose signature is required
@@ -241,7 +373,7 @@ func (msg MsgRequestBatchTx) ValidateBasic() error {
 
 // GetSignBytes encodes the message for signing
 func (msg MsgRequestBatchTx) GetSignBytes() []byte {
-	panic(fmt.Errorf("deprecated"))
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
 // GetSigners defines whose signature is required
@@ -282,7 +414,7 @@ func (msg MsgCancelSendToExternal) ValidateBasic() error {
 
 // GetSignBytes encodes the message for signing
 func (msg MsgCancelSendToExternal) GetSignBytes() []byte {
-	panic(fmt.Errorf("deprecated"))
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
 // GetSigners defines whose signature is required
@@ -294,3 +426,44 @@ func (msg MsgCancelSendToExternal) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{acc}
 }
+
+// NewMsgCancelTimelockedTransfer returns a new MsgCancelTimelockedTransfer
+func NewMsgCancelTimelockedTransfer(id uint64, chainId ChainID, guardian sdk.AccAddress) *MsgCancelTimelockedTransfer {
+	return &MsgCancelTimelockedTransfer{
+		Id:       id,
+		ChainId:  chainId.String(),
+		Guardian: guardian.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelTimelockedTransfer) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelTimelockedTransfer) Type() string { return "cancel_timelocked_transfer" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgCancelTimelockedTransfer) ValidateBasic() error {
+	if msg.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "Id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Guardian); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Guardian)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelTimelockedTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelTimelockedTransfer) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Guardian)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
@@ -259,6 +259,105 @@ func (m *MsgCancelSendToExternalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelSendToExternalResponse proto.InternalMessageInfo
 
+// MsgCancelTimelockedTransfer allows a guardian to cancel a large transfer
+// while it is held by the withdrawal timelock. The transfer is refunded to
+// its sender.
+type MsgCancelTimelockedTransfer struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	ChainId  string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *MsgCancelTimelockedTransfer) Reset()         { *m = MsgCancelTimelockedTransfer{} }
+func (m *MsgCancelTimelockedTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTimelockedTransfer) ProtoMessage()    {}
+func (*MsgCancelTimelockedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{4}
+}
+func (m *MsgCancelTimelockedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTimelockedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTimelockedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTimelockedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTimelockedTransfer.Merge(m, src)
+}
+func (m *MsgCancelTimelockedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTimelockedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTimelockedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTimelockedTransfer proto.InternalMessageInfo
+
+func (m *MsgCancelTimelockedTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgCancelTimelockedTransfer) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *MsgCancelTimelockedTransfer) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type MsgCancelTimelockedTransferResponse struct {
+}
+
+func (m *MsgCancelTimelockedTransferResponse) Reset()         { *m = MsgCancelTimelockedTransferResponse{} }
+func (m *MsgCancelTimelockedTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTimelockedTransferResponse) ProtoMessage()    {}
+func (*MsgCancelTimelockedTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{5}
+}
+func (m *MsgCancelTimelockedTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTimelockedTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTimelockedTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTimelockedTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTimelockedTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelTimelockedTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTimelockedTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTimelockedTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTimelockedTransferResponse proto.InternalMessageInfo
+
 // MsgRequestBatchTx requests a batch of transactions with a given coin
 // denomination to send across the bridge to external chain.
 type MsgRequestBatchTx struct {
@@ -271,7 +370,7 @@ func (m *MsgRequestBatchTx) Reset()         { *m = MsgRequestBatchTx{} }
 func (m *MsgRequestBatchTx) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchTx) ProtoMessage()    {}
 func (*MsgRequestBatchTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{4}
+	return fileDescriptor_be2955e5a84f15d4, []int{6}
 }
 func (m *MsgRequestBatchTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +427,7 @@ func (m *MsgRequestBatchTxResponse) Reset()         { *m = MsgRequestBatchTxResp
 func (m *MsgRequestBatchTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchTxResponse) ProtoMessage()    {}
 func (*MsgRequestBatchTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{5}
+	return fileDescriptor_be2955e5a84f15d4, []int{7}
 }
 func (m *MsgRequestBatchTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,7 +469,7 @@ func (m *MsgSubmitExternalTxConfirmation) Reset()         { *m = MsgSubmitExtern
 func (m *MsgSubmitExternalTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitExternalTxConfirmation) ProtoMessage()    {}
 func (*MsgSubmitExternalTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{6}
+	return fileDescriptor_be2955e5a84f15d4, []int{8}
 }
 func (m *MsgSubmitExternalTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,7 +511,7 @@ func (m *ContractCallTxConfirmation) Reset()         { *m = ContractCallTxConfir
 func (m *ContractCallTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxConfirmation) ProtoMessage()    {}
 func (*ContractCallTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{7}
+	return fileDescriptor_be2955e5a84f15d4, []int{9}
 }
 func (m *ContractCallTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +580,7 @@ func (m *BatchTxConfirmation) Reset()         { *m = BatchTxConfirmation{} }
 func (m *BatchTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*BatchTxConfirmation) ProtoMessage()    {}
 func (*BatchTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{8}
+	return fileDescriptor_be2955e5a84f15d4, []int{10}
 }
 func (m *BatchTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +649,7 @@ func (m *SignerSetTxConfirmation) Reset()         { *m = SignerSetTxConfirmation
 func (m *SignerSetTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTxConfirmation) ProtoMessage()    {}
 func (*SignerSetTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{9}
+	return fileDescriptor_be2955e5a84f15d4, []int{11}
 }
 func (m *SignerSetTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,7 +706,7 @@ func (m *MsgSubmitTxConfirmationResponse) Reset()         { *m = MsgSubmitTxConf
 func (m *MsgSubmitTxConfirmationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitTxConfirmationResponse) ProtoMessage()    {}
 func (*MsgSubmitTxConfirmationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{10}
+	return fileDescriptor_be2955e5a84f15d4, []int{12}
 }
 func (m *MsgSubmitTxConfirmationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,7 +746,7 @@ func (m *MsgSubmitExternalEvent) Reset()         { *m = MsgSubmitExternalEvent{}
 func (m *MsgSubmitExternalEvent) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitExternalEvent) ProtoMessage()    {}
 func (*MsgSubmitExternalEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{11}
+	return fileDescriptor_be2955e5a84f15d4, []int{13}
 }
 func (m *MsgSubmitExternalEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +782,7 @@ func (m *MsgSubmitExternalEventResponse) Reset()         { *m = MsgSubmitExterna
 func (m *MsgSubmitExternalEventResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitExternalEventResponse) ProtoMessage()    {}
 func (*MsgSubmitExternalEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{12}
+	return fileDescriptor_be2955e5a84f15d4, []int{14}
 }
 func (m *MsgSubmitExternalEventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,7 +826,7 @@ func (m *MsgDelegateKeys) Reset()         { *m = MsgDelegateKeys{} }
 func (m *MsgDelegateKeys) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateKeys) ProtoMessage()    {}
 func (*MsgDelegateKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{13}
+	return fileDescriptor_be2955e5a84f15d4, []int{15}
 }
 func (m *MsgDelegateKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,7 +897,7 @@ func (m *MsgDelegateKeysResponse) Reset()         { *m = MsgDelegateKeysResponse
 func (m *MsgDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateKeysResponse) ProtoMessage()    {}
 func (*MsgDelegateKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{14}
+	return fileDescriptor_be2955e5a84f15d4, []int{16}
 }
 func (m *MsgDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -827,26 +926,28 @@ func (m *MsgDelegateKeysResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateKeysResponse proto.InternalMessageInfo
 
-// DelegateKeysSignMsg defines the message structure an operator is expected to
-// sign when submitting a MsgDelegateKeys message. The resulting signature should
-// populate the eth_signature field.
-type DelegateKeysSignMsg struct {
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	Nonce            uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+// MsgDelegateKeysMulti delegates the keys of a validator for several chains at
+// once. The orchestrator address is shared by all the chains, while every chain
+// gets its own external address signed over the DelegateKeysSignMsg. Either all
+// the entries are applied or none of them.
+type MsgDelegateKeysMulti struct {
+	ValidatorAddress    string                   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OrchestratorAddress string                   `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+	Entries             []DelegateKeysMultiEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *DelegateKeysSignMsg) Reset()         { *m = DelegateKeysSignMsg{} }
-func (m *DelegateKeysSignMsg) String() string { return proto.CompactTextString(m) }
-func (*DelegateKeysSignMsg) ProtoMessage()    {}
-func (*DelegateKeysSignMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{15}
+func (m *MsgDelegateKeysMulti) Reset()         { *m = MsgDelegateKeysMulti{} }
+func (m *MsgDelegateKeysMulti) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateKeysMulti) ProtoMessage()    {}
+func (*MsgDelegateKeysMulti) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{17}
 }
-func (m *DelegateKeysSignMsg) XXX_Unmarshal(b []byte) error {
+func (m *MsgDelegateKeysMulti) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateKeysSignMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDelegateKeysMulti) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateKeysSignMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDelegateKeysMulti.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -856,56 +957,58 @@ func (m *DelegateKeysSignMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DelegateKeysSignMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateKeysSignMsg.Merge(m, src)
+func (m *MsgDelegateKeysMulti) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateKeysMulti.Merge(m, src)
 }
-func (m *DelegateKeysSignMsg) XXX_Size() int {
+func (m *MsgDelegateKeysMulti) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateKeysSignMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateKeysSignMsg.DiscardUnknown(m)
+func (m *MsgDelegateKeysMulti) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateKeysMulti.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateKeysSignMsg proto.InternalMessageInfo
+var xxx_messageInfo_MsgDelegateKeysMulti proto.InternalMessageInfo
 
-func (m *DelegateKeysSignMsg) GetValidatorAddress() string {
+func (m *MsgDelegateKeysMulti) GetValidatorAddress() string {
 	if m != nil {
 		return m.ValidatorAddress
 	}
 	return ""
 }
 
-func (m *DelegateKeysSignMsg) GetNonce() uint64 {
+func (m *MsgDelegateKeysMulti) GetOrchestratorAddress() string {
 	if m != nil {
-		return m.Nonce
+		return m.OrchestratorAddress
 	}
-	return 0
+	return ""
 }
 
-// SendToHubEvent is submitted when the SendToHubEvent is emitted by they
-// mhub2 contract.
-type SendToHubEvent struct {
-	EventNonce     uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	ExternalCoinId string                                 `protobuf:"bytes,2,opt,name=external_coin_id,json=externalCoinId,proto3" json:"external_coin_id,omitempty"`
-	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
-	Sender         string                                 `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
-	CosmosReceiver string                                 `protobuf:"bytes,5,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
-	ExternalHeight uint64                                 `protobuf:"varint,6,opt,name=external_height,json=externalHeight,proto3" json:"external_height,omitempty"`
-	TxHash         string                                 `protobuf:"bytes,7,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+func (m *MsgDelegateKeysMulti) GetEntries() []DelegateKeysMultiEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
 
-func (m *SendToHubEvent) Reset()         { *m = SendToHubEvent{} }
-func (m *SendToHubEvent) String() string { return proto.CompactTextString(m) }
-func (*SendToHubEvent) ProtoMessage()    {}
-func (*SendToHubEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{16}
+// DelegateKeysMultiEntry is the external address of a validator on a single chain
+type DelegateKeysMultiEntry struct {
+	ChainId         string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ExternalAddress string `protobuf:"bytes,2,opt,name=external_address,json=externalAddress,proto3" json:"external_address,omitempty"`
+	EthSignature    []byte `protobuf:"bytes,3,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
 }
-func (m *SendToHubEvent) XXX_Unmarshal(b []byte) error {
+
+func (m *DelegateKeysMultiEntry) Reset()         { *m = DelegateKeysMultiEntry{} }
+func (m *DelegateKeysMultiEntry) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysMultiEntry) ProtoMessage()    {}
+func (*DelegateKeysMultiEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{18}
+}
+func (m *DelegateKeysMultiEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SendToHubEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelegateKeysMultiEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SendToHubEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelegateKeysMultiEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -915,86 +1018,99 @@ func (m *SendToHubEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *SendToHubEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SendToHubEvent.Merge(m, src)
+func (m *DelegateKeysMultiEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysMultiEntry.Merge(m, src)
 }
-func (m *SendToHubEvent) XXX_Size() int {
+func (m *DelegateKeysMultiEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *SendToHubEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_SendToHubEvent.DiscardUnknown(m)
+func (m *DelegateKeysMultiEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysMultiEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SendToHubEvent proto.InternalMessageInfo
-
-func (m *SendToHubEvent) GetEventNonce() uint64 {
-	if m != nil {
-		return m.EventNonce
-	}
-	return 0
-}
+var xxx_messageInfo_DelegateKeysMultiEntry proto.InternalMessageInfo
 
-func (m *SendToHubEvent) GetExternalCoinId() string {
+func (m *DelegateKeysMultiEntry) GetChainId() string {
 	if m != nil {
-		return m.ExternalCoinId
+		return m.ChainId
 	}
 	return ""
 }
 
-func (m *SendToHubEvent) GetSender() string {
+func (m *DelegateKeysMultiEntry) GetExternalAddress() string {
 	if m != nil {
-		return m.Sender
+		return m.ExternalAddress
 	}
 	return ""
 }
 
-func (m *SendToHubEvent) GetCosmosReceiver() string {
+func (m *DelegateKeysMultiEntry) GetEthSignature() []byte {
 	if m != nil {
-		return m.CosmosReceiver
+		return m.EthSignature
 	}
-	return ""
+	return nil
 }
 
-func (m *SendToHubEvent) GetExternalHeight() uint64 {
-	if m != nil {
-		return m.ExternalHeight
-	}
-	return 0
+type MsgDelegateKeysMultiResponse struct {
 }
 
-func (m *SendToHubEvent) GetTxHash() string {
-	if m != nil {
-		return m.TxHash
+func (m *MsgDelegateKeysMultiResponse) Reset()         { *m = MsgDelegateKeysMultiResponse{} }
+func (m *MsgDelegateKeysMultiResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateKeysMultiResponse) ProtoMessage()    {}
+func (*MsgDelegateKeysMultiResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{19}
+}
+func (m *MsgDelegateKeysMultiResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateKeysMultiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateKeysMultiResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgDelegateKeysMultiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateKeysMultiResponse.Merge(m, src)
+}
+func (m *MsgDelegateKeysMultiResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateKeysMultiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateKeysMultiResponse.DiscardUnknown(m)
 }
 
-// TransferToChainEvent is submitted when the TransferToChainEvent is emitted by they
-// mhub2 contract.
-type TransferToChainEvent struct {
-	EventNonce       uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	ExternalCoinId   string                                 `protobuf:"bytes,2,opt,name=external_coin_id,json=externalCoinId,proto3" json:"external_coin_id,omitempty"`
-	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
-	Fee              github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee"`
-	Sender           string                                 `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
-	ReceiverChainId  string                                 `protobuf:"bytes,6,opt,name=receiver_chain_id,json=receiverChainId,proto3" json:"receiver_chain_id,omitempty"`
-	ExternalReceiver string                                 `protobuf:"bytes,7,opt,name=external_receiver,json=externalReceiver,proto3" json:"external_receiver,omitempty"`
-	ExternalHeight   uint64                                 `protobuf:"varint,8,opt,name=external_height,json=externalHeight,proto3" json:"external_height,omitempty"`
-	TxHash           string                                 `protobuf:"bytes,9,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+var xxx_messageInfo_MsgDelegateKeysMultiResponse proto.InternalMessageInfo
+
+// MsgRotateDelegateKeys allows a validator which has already delegated its keys
+// for a chain to replace the orchestrator and/or the external address. An empty
+// field keeps the current key. A new external address has to sign the
+// DelegateKeysSignMsg, the same way as for MsgDelegateKeys.
+type MsgRotateDelegateKeys struct {
+	ValidatorAddress    string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+	ExternalAddress     string `protobuf:"bytes,3,opt,name=external_address,json=externalAddress,proto3" json:"external_address,omitempty"`
+	EthSignature        []byte `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
+	ChainId             string `protobuf:"bytes,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *TransferToChainEvent) Reset()         { *m = TransferToChainEvent{} }
-func (m *TransferToChainEvent) String() string { return proto.CompactTextString(m) }
-func (*TransferToChainEvent) ProtoMessage()    {}
-func (*TransferToChainEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{17}
+func (m *MsgRotateDelegateKeys) Reset()         { *m = MsgRotateDelegateKeys{} }
+func (m *MsgRotateDelegateKeys) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDelegateKeys) ProtoMessage()    {}
+func (*MsgRotateDelegateKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{20}
 }
-func (m *TransferToChainEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgRotateDelegateKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TransferToChainEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRotateDelegateKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TransferToChainEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRotateDelegateKeys.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1004,91 +1120,111 @@ func (m *TransferToChainEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *TransferToChainEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransferToChainEvent.Merge(m, src)
+func (m *MsgRotateDelegateKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDelegateKeys.Merge(m, src)
 }
-func (m *TransferToChainEvent) XXX_Size() int {
+func (m *MsgRotateDelegateKeys) XXX_Size() int {
 	return m.Size()
 }
-func (m *TransferToChainEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransferToChainEvent.DiscardUnknown(m)
+func (m *MsgRotateDelegateKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDelegateKeys.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransferToChainEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgRotateDelegateKeys proto.InternalMessageInfo
 
-func (m *TransferToChainEvent) GetEventNonce() uint64 {
+func (m *MsgRotateDelegateKeys) GetValidatorAddress() string {
 	if m != nil {
-		return m.EventNonce
+		return m.ValidatorAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *TransferToChainEvent) GetExternalCoinId() string {
+func (m *MsgRotateDelegateKeys) GetOrchestratorAddress() string {
 	if m != nil {
-		return m.ExternalCoinId
+		return m.OrchestratorAddress
 	}
 	return ""
 }
 
-func (m *TransferToChainEvent) GetSender() string {
+func (m *MsgRotateDelegateKeys) GetExternalAddress() string {
 	if m != nil {
-		return m.Sender
+		return m.ExternalAddress
 	}
 	return ""
 }
 
-func (m *TransferToChainEvent) GetReceiverChainId() string {
+func (m *MsgRotateDelegateKeys) GetEthSignature() []byte {
 	if m != nil {
-		return m.ReceiverChainId
+		return m.EthSignature
 	}
-	return ""
+	return nil
 }
 
-func (m *TransferToChainEvent) GetExternalReceiver() string {
+func (m *MsgRotateDelegateKeys) GetChainId() string {
 	if m != nil {
-		return m.ExternalReceiver
+		return m.ChainId
 	}
 	return ""
 }
 
-func (m *TransferToChainEvent) GetExternalHeight() uint64 {
-	if m != nil {
-		return m.ExternalHeight
-	}
-	return 0
+type MsgRotateDelegateKeysResponse struct {
 }
 
-func (m *TransferToChainEvent) GetTxHash() string {
-	if m != nil {
-		return m.TxHash
-	}
-	return ""
+func (m *MsgRotateDelegateKeysResponse) Reset()         { *m = MsgRotateDelegateKeysResponse{} }
+func (m *MsgRotateDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDelegateKeysResponse) ProtoMessage()    {}
+func (*MsgRotateDelegateKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{21}
 }
-
-// BatchExecutedEvent claims that a batch of BatchTxExecuted operations on the
-// bridge contract was executed successfully
-type BatchExecutedEvent struct {
-	ExternalCoinId string                                 `protobuf:"bytes,1,opt,name=external_coin_id,json=externalCoinId,proto3" json:"external_coin_id,omitempty"`
-	EventNonce     uint64                                 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	ExternalHeight uint64                                 `protobuf:"varint,3,opt,name=external_height,json=externalHeight,proto3" json:"external_height,omitempty"`
-	BatchNonce     uint64                                 `protobuf:"varint,4,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
-	TxHash         string                                 `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	FeePaid        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=fee_paid,json=feePaid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_paid"`
-	FeePayer       string                                 `protobuf:"bytes,7,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+func (m *MsgRotateDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *BatchExecutedEvent) Reset()         { *m = BatchExecutedEvent{} }
-func (m *BatchExecutedEvent) String() string { return proto.CompactTextString(m) }
-func (*BatchExecutedEvent) ProtoMessage()    {}
-func (*BatchExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{18}
+func (m *MsgRotateDelegateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDelegateKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (m *BatchExecutedEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgRotateDelegateKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDelegateKeysResponse.Merge(m, src)
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDelegateKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateDelegateKeysResponse proto.InternalMessageInfo
+
+// DelegateKeysRotation records a replacement of the delegate keys of a
+// validator on a chain
+type DelegateKeysRotation struct {
+	PreviousOrchestratorAddress string `protobuf:"bytes,1,opt,name=previous_orchestrator_address,json=previousOrchestratorAddress,proto3" json:"previous_orchestrator_address,omitempty"`
+	PreviousExternalAddress     string `protobuf:"bytes,2,opt,name=previous_external_address,json=previousExternalAddress,proto3" json:"previous_external_address,omitempty"`
+	OrchestratorAddress         string `protobuf:"bytes,3,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+	ExternalAddress             string `protobuf:"bytes,4,opt,name=external_address,json=externalAddress,proto3" json:"external_address,omitempty"`
+	Height                      uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DelegateKeysRotation) Reset()         { *m = DelegateKeysRotation{} }
+func (m *DelegateKeysRotation) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysRotation) ProtoMessage()    {}
+func (*DelegateKeysRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{22}
+}
+func (m *DelegateKeysRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelegateKeysRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchExecutedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelegateKeysRotation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1098,83 +1234,72 @@ func (m *BatchExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *BatchExecutedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchExecutedEvent.Merge(m, src)
+func (m *DelegateKeysRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysRotation.Merge(m, src)
 }
-func (m *BatchExecutedEvent) XXX_Size() int {
+func (m *DelegateKeysRotation) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchExecutedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchExecutedEvent.DiscardUnknown(m)
+func (m *DelegateKeysRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysRotation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchExecutedEvent proto.InternalMessageInfo
+var xxx_messageInfo_DelegateKeysRotation proto.InternalMessageInfo
 
-func (m *BatchExecutedEvent) GetExternalCoinId() string {
+func (m *DelegateKeysRotation) GetPreviousOrchestratorAddress() string {
 	if m != nil {
-		return m.ExternalCoinId
+		return m.PreviousOrchestratorAddress
 	}
 	return ""
 }
 
-func (m *BatchExecutedEvent) GetEventNonce() uint64 {
-	if m != nil {
-		return m.EventNonce
-	}
-	return 0
-}
-
-func (m *BatchExecutedEvent) GetExternalHeight() uint64 {
+func (m *DelegateKeysRotation) GetPreviousExternalAddress() string {
 	if m != nil {
-		return m.ExternalHeight
+		return m.PreviousExternalAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *BatchExecutedEvent) GetBatchNonce() uint64 {
+func (m *DelegateKeysRotation) GetOrchestratorAddress() string {
 	if m != nil {
-		return m.BatchNonce
+		return m.OrchestratorAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *BatchExecutedEvent) GetTxHash() string {
+func (m *DelegateKeysRotation) GetExternalAddress() string {
 	if m != nil {
-		return m.TxHash
+		return m.ExternalAddress
 	}
 	return ""
 }
 
-func (m *BatchExecutedEvent) GetFeePayer() string {
+func (m *DelegateKeysRotation) GetHeight() uint64 {
 	if m != nil {
-		return m.FeePayer
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-// NOTE: bytes.HexBytes is supposed to "help" with json encoding/decoding
-// investigate?
-type ContractCallExecutedEvent struct {
-	EventNonce        uint64                                               `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	InvalidationScope github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=invalidation_scope,json=invalidationScope,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"invalidation_scope,omitempty"`
-	InvalidationNonce uint64                                               `protobuf:"varint,3,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
-	ReturnData        []byte                                               `protobuf:"bytes,4,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty"`
-	ExternalHeight    uint64                                               `protobuf:"varint,5,opt,name=external_height,json=externalHeight,proto3" json:"external_height,omitempty"`
-	TxHash            string                                               `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+// DelegateKeysHistory is the list of delegate keys rotations of a validator on
+// a chain in the order they happened
+type DelegateKeysHistory struct {
+	ValidatorAddress string                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Rotations        []DelegateKeysRotation `protobuf:"bytes,2,rep,name=rotations,proto3" json:"rotations"`
 }
 
-func (m *ContractCallExecutedEvent) Reset()         { *m = ContractCallExecutedEvent{} }
-func (m *ContractCallExecutedEvent) String() string { return proto.CompactTextString(m) }
-func (*ContractCallExecutedEvent) ProtoMessage()    {}
-func (*ContractCallExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{19}
+func (m *DelegateKeysHistory) Reset()         { *m = DelegateKeysHistory{} }
+func (m *DelegateKeysHistory) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysHistory) ProtoMessage()    {}
+func (*DelegateKeysHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{23}
 }
-func (m *ContractCallExecutedEvent) XXX_Unmarshal(b []byte) error {
+func (m *DelegateKeysHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractCallExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelegateKeysHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractCallExecutedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelegateKeysHistory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1184,82 +1309,111 @@ func (m *ContractCallExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ContractCallExecutedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractCallExecutedEvent.Merge(m, src)
+func (m *DelegateKeysHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysHistory.Merge(m, src)
 }
-func (m *ContractCallExecutedEvent) XXX_Size() int {
+func (m *DelegateKeysHistory) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractCallExecutedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractCallExecutedEvent.DiscardUnknown(m)
+func (m *DelegateKeysHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysHistory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractCallExecutedEvent proto.InternalMessageInfo
+var xxx_messageInfo_DelegateKeysHistory proto.InternalMessageInfo
 
-func (m *ContractCallExecutedEvent) GetEventNonce() uint64 {
+func (m *DelegateKeysHistory) GetValidatorAddress() string {
 	if m != nil {
-		return m.EventNonce
+		return m.ValidatorAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *ContractCallExecutedEvent) GetInvalidationScope() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+func (m *DelegateKeysHistory) GetRotations() []DelegateKeysRotation {
 	if m != nil {
-		return m.InvalidationScope
+		return m.Rotations
 	}
 	return nil
 }
 
-func (m *ContractCallExecutedEvent) GetInvalidationNonce() uint64 {
-	if m != nil {
-		return m.InvalidationNonce
-	}
-	return 0
+// DelegateKeysSignMsg defines the message structure an operator is expected to
+// sign when submitting a MsgDelegateKeys message. The resulting signature should
+// populate the eth_signature field.
+type DelegateKeysSignMsg struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Nonce            uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *ContractCallExecutedEvent) GetReturnData() []byte {
-	if m != nil {
-		return m.ReturnData
+func (m *DelegateKeysSignMsg) Reset()         { *m = DelegateKeysSignMsg{} }
+func (m *DelegateKeysSignMsg) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysSignMsg) ProtoMessage()    {}
+func (*DelegateKeysSignMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{24}
+}
+func (m *DelegateKeysSignMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysSignMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysSignMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *DelegateKeysSignMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysSignMsg.Merge(m, src)
+}
+func (m *DelegateKeysSignMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysSignMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysSignMsg.DiscardUnknown(m)
 }
 
-func (m *ContractCallExecutedEvent) GetExternalHeight() uint64 {
+var xxx_messageInfo_DelegateKeysSignMsg proto.InternalMessageInfo
+
+func (m *DelegateKeysSignMsg) GetValidatorAddress() string {
 	if m != nil {
-		return m.ExternalHeight
+		return m.ValidatorAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *ContractCallExecutedEvent) GetTxHash() string {
+func (m *DelegateKeysSignMsg) GetNonce() uint64 {
 	if m != nil {
-		return m.TxHash
+		return m.Nonce
 	}
-	return ""
+	return 0
 }
 
-// This informs the Cosmos module that a validator
-// set has been updated.
-type SignerSetTxExecutedEvent struct {
-	EventNonce       uint64            `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	SignerSetTxNonce uint64            `protobuf:"varint,2,opt,name=signer_set_tx_nonce,json=signerSetTxNonce,proto3" json:"signer_set_tx_nonce,omitempty"`
-	ExternalHeight   uint64            `protobuf:"varint,3,opt,name=external_height,json=externalHeight,proto3" json:"external_height,omitempty"`
-	Members          []*ExternalSigner `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
-	TxHash           string            `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+// SendToHubEvent is submitted when the SendToHubEvent is emitted by they
+// mhub2 contract.
+type SendToHubEvent struct {
+	EventNonce     uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	ExternalCoinId string                                 `protobuf:"bytes,2,opt,name=external_coin_id,json=externalCoinId,proto3" json:"external_coin_id,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Sender         string                                 `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	CosmosReceiver string                                 `protobuf:"bytes,5,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	ExternalHeight uint64                                 `protobuf:"varint,6,opt,name=external_height,json=externalHeight,proto3" json:"external_height,omitempty"`
+	TxHash         string                                 `protobuf:"bytes,7,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 }
 
-func (m *SignerSetTxExecutedEvent) Reset()         { *m = SignerSetTxExecutedEvent{} }
-func (m *SignerSetTxExecutedEvent) String() string { return proto.CompactTextString(m) }
-func (*SignerSetTxExecutedEvent) ProtoMessage()    {}
-func (*SignerSetTxExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be2955e5a84f15d4, []int{20}
+func (m *SendToHubEvent) Reset()         { *m = SendToHubEvent{} }
+func (m *SendToHubEvent) String() string { return proto.CompactTextString(m) }
+func (*SendToHubEvent) ProtoMessage()    {}
+func (*SendToHubEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{25}
 }
-func (m *SignerSetTxExecutedEvent) XXX_Unmarshal(b []byte) error {
+func (m *SendToHubEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SignerSetTxExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SendToHubEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SignerSetTxExecutedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SendToHubEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1269,893 +1423,1021 @@ func (m *SignerSetTxExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *SignerSetTxExecutedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignerSetTxExecutedEvent.Merge(m, src)
+func (m *SendToHubEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToHubEvent.Merge(m, src)
 }
-func (m *SignerSetTxExecutedEvent) XXX_Size() int {
+func (m *SendToHubEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *SignerSetTxExecutedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignerSetTxExecutedEvent.DiscardUnknown(m)
+func (m *SendToHubEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToHubEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignerSetTxExecutedEvent proto.InternalMessageInfo
+var xxx_messageInfo_SendToHubEvent proto.InternalMessageInfo
 
-func (m *SignerSetTxExecutedEvent) GetEventNonce() uint64 {
+func (m *SendToHubEvent) GetEventNonce() uint64 {
 	if m != nil {
 		return m.EventNonce
 	}
 	return 0
 }
 
-func (m *SignerSetTxExecutedEvent) GetSignerSetTxNonce() uint64 {
+func (m *SendToHubEvent) GetExternalCoinId() string {
 	if m != nil {
-		return m.SignerSetTxNonce
+		return m.ExternalCoinId
 	}
-	return 0
+	return ""
 }
 
-func (m *SignerSetTxExecutedEvent) GetExternalHeight() uint64 {
+func (m *SendToHubEvent) GetSender() string {
 	if m != nil {
-		return m.ExternalHeight
+		return m.Sender
 	}
-	return 0
+	return ""
 }
 
-func (m *SignerSetTxExecutedEvent) GetMembers() []*ExternalSigner {
+func (m *SendToHubEvent) GetCosmosReceiver() string {
 	if m != nil {
-		return m.Members
+		return m.CosmosReceiver
 	}
-	return nil
+	return ""
 }
 
-func (m *SignerSetTxExecutedEvent) GetTxHash() string {
+func (m *SendToHubEvent) GetExternalHeight() uint64 {
 	if m != nil {
-		return m.TxHash
+		return m.ExternalHeight
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*MsgSendToExternal)(nil), "mhub2.v1.MsgSendToExternal")
-	proto.RegisterType((*MsgSendToExternalResponse)(nil), "mhub2.v1.MsgSendToExternalResponse")
-	proto.RegisterType((*MsgCancelSendToExternal)(nil), "mhub2.v1.MsgCancelSendToExternal")
-	proto.RegisterType((*MsgCancelSendToExternalResponse)(nil), "mhub2.v1.MsgCancelSendToExternalResponse")
-	proto.RegisterType((*MsgRequestBatchTx)(nil), "mhub2.v1.MsgRequestBatchTx")
-	proto.RegisterType((*MsgRequestBatchTxResponse)(nil), "mhub2.v1.MsgRequestBatchTxResponse")
-	proto.RegisterType((*MsgSubmitExternalTxConfirmation)(nil), "mhub2.v1.MsgSubmitExternalTxConfirmation")
-	proto.RegisterType((*ContractCallTxConfirmation)(nil), "mhub2.v1.ContractCallTxConfirmation")
-	proto.RegisterType((*BatchTxConfirmation)(nil), "mhub2.v1.BatchTxConfirmation")
-	proto.RegisterType((*SignerSetTxConfirmation)(nil), "mhub2.v1.SignerSetTxConfirmation")
-	proto.RegisterType((*MsgSubmitTxConfirmationResponse)(nil), "mhub2.v1.MsgSubmitTxConfirmationResponse")
-	proto.RegisterType((*MsgSubmitExternalEvent)(nil), "mhub2.v1.MsgSubmitExternalEvent")
-	proto.RegisterType((*MsgSubmitExternalEventResponse)(nil), "mhub2.v1.MsgSubmitExternalEventResponse")
-	proto.RegisterType((*MsgDelegateKeys)(nil), "mhub2.v1.MsgDelegateKeys")
-	proto.RegisterType((*MsgDelegateKeysResponse)(nil), "mhub2.v1.MsgDelegateKeysResponse")
-	proto.RegisterType((*DelegateKeysSignMsg)(nil), "mhub2.v1.DelegateKeysSignMsg")
-	proto.RegisterType((*SendToHubEvent)(nil), "mhub2.v1.SendToHubEvent")
-	proto.RegisterType((*TransferToChainEvent)(nil), "mhub2.v1.TransferToChainEvent")
-	proto.RegisterType((*BatchExecutedEvent)(nil), "mhub2.v1.BatchExecutedEvent")
-	proto.RegisterType((*ContractCallExecutedEvent)(nil), "mhub2.v1.ContractCallExecutedEvent")
-	proto.RegisterType((*SignerSetTxExecutedEvent)(nil), "mhub2.v1.SignerSetTxExecutedEvent")
+func (m *SendToHubEvent) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("mhub2/v1/msgs.proto", fileDescriptor_be2955e5a84f15d4) }
-
-var fileDescriptor_be2955e5a84f15d4 = []byte{
-	// 1382 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xfa, 0x4f, 0xfe, 0xbc, 0xa4, 0xf9, 0xb3, 0xb1, 0x5a, 0xdb, 0xad, 0xec, 0xc4, 0x95,
-	0x68, 0xda, 0x2a, 0x6b, 0x12, 0x90, 0x40, 0x95, 0x40, 0xd4, 0x6e, 0xaa, 0x04, 0x14, 0x04, 0xb6,
-	0x0f, 0x08, 0x55, 0xb2, 0xc6, 0xbb, 0xcf, 0xeb, 0x55, 0xed, 0x59, 0xb3, 0x33, 0x8e, 0xec, 0x6f,
-	0x80, 0x38, 0xf5, 0x23, 0xf4, 0xc0, 0x05, 0xc4, 0x81, 0x43, 0x25, 0xce, 0xbd, 0x55, 0x3d, 0xf5,
-	0x88, 0x10, 0xaa, 0xaa, 0xf6, 0xc2, 0x89, 0x0f, 0xc0, 0x09, 0xed, 0xcc, 0xae, 0x33, 0xeb, 0xac,
-	0x93, 0xa6, 0xe2, 0xc0, 0xc9, 0x9e, 0xf7, 0xde, 0xbc, 0xfd, 0xbd, 0xf7, 0x7e, 0x33, 0xef, 0x0d,
-	0x6c, 0xf4, 0xbb, 0xc3, 0xf6, 0x5e, 0xe5, 0x78, 0xb7, 0xd2, 0x67, 0x36, 0x33, 0x06, 0x9e, 0xcb,
-	0x5d, 0x7d, 0x41, 0x08, 0x8d, 0xe3, 0xdd, 0x42, 0xd1, 0x74, 0x59, 0xdf, 0x65, 0x95, 0x36, 0x61,
-	0x58, 0x39, 0xde, 0x6d, 0x23, 0x27, 0xbb, 0x15, 0xd3, 0x75, 0xa8, 0xb4, 0x2c, 0xe4, 0xa5, 0xbe,
-	0x25, 0x56, 0x15, 0xb9, 0x08, 0x54, 0xd9, 0x13, 0xcf, 0xc2, 0x5b, 0x20, 0xb5, 0x5d, 0xdb, 0x95,
-	0xd6, 0xfe, 0xbf, 0x40, 0x7a, 0xcd, 0x76, 0x5d, 0xbb, 0x87, 0x15, 0x32, 0x70, 0x2a, 0x84, 0x52,
-	0x97, 0x13, 0xee, 0xb8, 0x34, 0xf4, 0x94, 0x0f, 0xb4, 0x62, 0xd5, 0x1e, 0x76, 0x2a, 0x84, 0x8e,
-	0xa5, 0xaa, 0xfc, 0xb7, 0x06, 0xeb, 0x47, 0xcc, 0x6e, 0x20, 0xb5, 0x9a, 0xee, 0xfe, 0x88, 0xa3,
-	0x47, 0x49, 0x4f, 0xbf, 0x0c, 0x73, 0x0c, 0xa9, 0x85, 0x5e, 0x4e, 0xdb, 0xd4, 0xb6, 0x17, 0xeb,
-	0xc1, 0x4a, 0xdf, 0x01, 0x1d, 0x03, 0x9b, 0x96, 0x87, 0xa6, 0x33, 0x70, 0x90, 0xf2, 0x5c, 0x52,
-	0xd8, 0xac, 0x87, 0x9a, 0x7a, 0xa8, 0xd0, 0x3f, 0x82, 0x39, 0xd2, 0x77, 0x87, 0x94, 0xe7, 0x52,
-	0x9b, 0xda, 0xf6, 0xd2, 0x5e, 0xde, 0x08, 0x02, 0xf4, 0xb3, 0x61, 0x04, 0xd9, 0x30, 0x6a, 0xae,
-	0x43, 0xab, 0xe9, 0x67, 0x2f, 0x4b, 0x89, 0x7a, 0x60, 0xae, 0x7f, 0x0a, 0xd0, 0xf6, 0x1c, 0xcb,
-	0xc6, 0x56, 0x07, 0x31, 0x97, 0x7e, 0xbb, 0xcd, 0x8b, 0x72, 0xcb, 0x7d, 0x44, 0x3d, 0x0f, 0x0b,
-	0x66, 0x97, 0x38, 0xb4, 0xe5, 0x58, 0xb9, 0x8c, 0x40, 0x37, 0x2f, 0xd6, 0x87, 0x56, 0xf9, 0x36,
-	0xe4, 0x4f, 0xc5, 0x5b, 0x47, 0x36, 0x70, 0x29, 0x43, 0x7d, 0x05, 0x92, 0x8e, 0x25, 0x62, 0x4e,
-	0xd7, 0x93, 0x8e, 0x55, 0x7e, 0x00, 0x57, 0x8e, 0x98, 0x5d, 0x23, 0xd4, 0xc4, 0xde, 0x54, 0x8a,
-	0xa6, 0x4c, 0x95, 0x94, 0x25, 0x23, 0x29, 0x53, 0xa1, 0xa4, 0xa2, 0x50, 0xb6, 0xa0, 0x34, 0xc3,
-	0x7b, 0x08, 0xa8, 0xfc, 0x40, 0x54, 0xa7, 0x8e, 0xdf, 0x0d, 0x91, 0xf1, 0x2a, 0xe1, 0x66, 0xb7,
-	0x39, 0xd2, 0xb3, 0x90, 0xb1, 0x90, 0xba, 0xfd, 0xa0, 0x38, 0x72, 0x21, 0x00, 0x38, 0x36, 0x55,
-	0x00, 0x88, 0xd5, 0x59, 0x00, 0xae, 0x8a, 0x5c, 0x44, 0xbd, 0x4f, 0x3e, 0xfd, 0xab, 0x26, 0xe0,
-	0x35, 0x86, 0xed, 0xbe, 0xc3, 0x43, 0x60, 0xcd, 0x51, 0xcd, 0xa5, 0x1d, 0xc7, 0xeb, 0x0b, 0x7e,
-	0xe9, 0x4d, 0x58, 0x36, 0x95, 0xb5, 0x00, 0xb4, 0xb4, 0x97, 0x35, 0x24, 0xdf, 0x8c, 0x90, 0x6f,
-	0xc6, 0x5d, 0x3a, 0xae, 0x16, 0x9e, 0x3f, 0xd9, 0xb9, 0x1c, 0xef, 0xa7, 0x1e, 0xf1, 0xf2, 0x0e,
-	0x91, 0xdc, 0x49, 0x7f, 0xff, 0xb8, 0x94, 0x28, 0x3f, 0xd5, 0xa0, 0x50, 0x73, 0x29, 0xf7, 0x88,
-	0xc9, 0x6b, 0xa4, 0x37, 0x8d, 0x76, 0x07, 0x74, 0x87, 0x1e, 0x93, 0x9e, 0x63, 0x89, 0x75, 0x8b,
-	0x99, 0xee, 0x00, 0x05, 0xe6, 0xe5, 0xfa, 0xba, 0xaa, 0x69, 0xf8, 0x8a, 0x53, 0xe6, 0xd4, 0xa5,
-	0x26, 0x0a, 0x48, 0xe9, 0xa8, 0xf9, 0x97, 0xbe, 0x42, 0xbf, 0x01, 0xab, 0x93, 0xb3, 0x11, 0xc0,
-	0x97, 0x20, 0x57, 0x42, 0x71, 0x43, 0x86, 0x71, 0x0d, 0x16, 0x7d, 0x3d, 0xe1, 0x43, 0x4f, 0x72,
-	0x7b, 0xb9, 0x7e, 0x22, 0x28, 0xff, 0xa4, 0xc1, 0x46, 0x50, 0x8a, 0x08, 0xf8, 0x5b, 0x30, 0x39,
-	0x60, 0x2d, 0xee, 0x3e, 0x44, 0x91, 0x05, 0x49, 0x80, 0xc9, 0x77, 0x9b, 0xbe, 0xfc, 0xd0, 0xd2,
-	0x4b, 0xb0, 0xd4, 0xf6, 0x5d, 0x44, 0x20, 0x83, 0x10, 0xfd, 0xa7, 0x58, 0x7f, 0xd0, 0xe0, 0x8a,
-	0x34, 0x6c, 0x20, 0x9f, 0xc2, 0xbb, 0x0d, 0x6b, 0xd2, 0x73, 0x8b, 0x21, 0x0f, 0x80, 0xc8, 0xd3,
-	0xb2, 0xc2, 0xc2, 0x2d, 0x33, 0xc1, 0x24, 0xcf, 0x07, 0x93, 0x9a, 0x06, 0xb3, 0xa5, 0xd0, 0x75,
-	0x8a, 0x5e, 0x21, 0xa5, 0x1f, 0x69, 0x70, 0xf9, 0x14, 0xa5, 0xf7, 0x8f, 0xfd, 0xab, 0xea, 0x13,
-	0xc8, 0xa0, 0xff, 0xe7, 0x4c, 0x0a, 0xaf, 0x3f, 0x7f, 0xb2, 0x73, 0x29, 0xb2, 0xaf, 0x2e, 0x77,
-	0xbd, 0x3b, 0x65, 0x37, 0xa1, 0x18, 0x8f, 0x68, 0x02, 0xfa, 0x4f, 0x0d, 0x56, 0x8f, 0x98, 0x7d,
-	0x0f, 0x7b, 0x68, 0x13, 0x8e, 0x5f, 0xe0, 0x98, 0xe9, 0xb7, 0x61, 0x3d, 0xa0, 0x9f, 0xeb, 0xb5,
-	0x88, 0x65, 0x79, 0xc8, 0x58, 0x40, 0x86, 0xb5, 0x89, 0xe2, 0xae, 0x94, 0xeb, 0xbb, 0x90, 0x75,
-	0x3d, 0xb3, 0x8b, 0x8c, 0x7b, 0x11, 0x7b, 0x89, 0x74, 0x43, 0xd5, 0x85, 0x5b, 0x6e, 0xc2, 0xda,
-	0xa4, 0x24, 0xa1, 0x79, 0x2a, 0xca, 0xb5, 0xd0, 0xf4, 0x3a, 0x5c, 0x42, 0xde, 0x6d, 0x4d, 0xb3,
-	0x64, 0x19, 0x79, 0xb7, 0x11, 0xca, 0xce, 0xba, 0x8f, 0xf3, 0xe2, 0x8a, 0x55, 0xa3, 0x9b, 0x44,
-	0xfe, 0x0d, 0x6c, 0xa8, 0x72, 0xdf, 0xdd, 0x11, 0xb3, 0x2f, 0x16, 0x7c, 0x16, 0x32, 0xea, 0x21,
-	0x90, 0x8b, 0xf2, 0x2f, 0x49, 0x58, 0x91, 0x37, 0xee, 0xc1, 0xb0, 0x2d, 0x09, 0x50, 0x82, 0x25,
-	0x51, 0xca, 0x08, 0x55, 0x41, 0x88, 0x24, 0x4d, 0xb7, 0x95, 0x9c, 0xf8, 0x0d, 0xdc, 0x8f, 0x65,
-	0x8a, 0xa7, 0x7e, 0x33, 0x3a, 0xb4, 0xf4, 0xfb, 0x91, 0xb6, 0xb7, 0x58, 0x35, 0xfc, 0xf6, 0xf4,
-	0xc7, 0xcb, 0xd2, 0x7b, 0xb6, 0xc3, 0xbb, 0xc3, 0xb6, 0x61, 0xba, 0xfd, 0xa0, 0xd3, 0x07, 0x3f,
-	0x3b, 0xcc, 0x7a, 0x58, 0xe1, 0xe3, 0x01, 0x32, 0xe3, 0x90, 0xf2, 0x49, 0x17, 0x3c, 0x69, 0x29,
-	0xe9, 0x48, 0x4b, 0xb9, 0x01, 0xab, 0xc1, 0xd4, 0xe0, 0xa1, 0x89, 0xce, 0x31, 0x7a, 0x41, 0x52,
-	0x57, 0xa4, 0xb8, 0x1e, 0x48, 0x23, 0x27, 0xab, 0x8b, 0x8e, 0xdd, 0xe5, 0xb9, 0x39, 0x79, 0x04,
-	0x43, 0xf1, 0x81, 0x90, 0xea, 0x57, 0x60, 0x9e, 0x8f, 0x5a, 0x5d, 0xc2, 0xba, 0xb9, 0x79, 0xf9,
-	0x29, 0x3e, 0x3a, 0x20, 0xac, 0x7b, 0x27, 0xfd, 0xd7, 0xe3, 0x92, 0x56, 0xfe, 0x31, 0x05, 0xd9,
-	0xa6, 0x47, 0x28, 0xeb, 0xa0, 0xd7, 0x74, 0x6b, 0x7e, 0xe5, 0xfe, 0xb7, 0x49, 0xfb, 0x0c, 0x52,
-	0xe1, 0xcc, 0x70, 0x71, 0x27, 0xfe, 0x56, 0x25, 0xed, 0x99, 0x48, 0xda, 0x6f, 0xc1, 0x7a, 0x98,
-	0xef, 0xd6, 0x84, 0xcd, 0x73, 0xf2, 0x54, 0x84, 0x8a, 0x9a, 0x64, 0xb5, 0xcf, 0x51, 0x75, 0x50,
-	0x92, 0x45, 0x92, 0xa9, 0x5d, 0x53, 0xe6, 0xa4, 0x99, 0x65, 0x5a, 0x38, 0xaf, 0x4c, 0x8b, 0x31,
-	0x65, 0xfa, 0x39, 0x09, 0xba, 0x68, 0x1d, 0xfb, 0x23, 0x34, 0x87, 0x1c, 0x2d, 0x59, 0xa4, 0xb8,
-	0x1a, 0x68, 0xb1, 0x35, 0x98, 0x2a, 0x67, 0xf2, 0x54, 0x39, 0x63, 0x90, 0xa6, 0x62, 0x91, 0x4e,
-	0x75, 0xa0, 0xf4, 0xa9, 0x0e, 0xa4, 0x84, 0x92, 0x51, 0x43, 0xd1, 0x0f, 0x61, 0xa1, 0x83, 0xd8,
-	0x1a, 0x90, 0x30, 0xb9, 0x17, 0x2e, 0xe2, 0x7c, 0x07, 0xf1, 0x2b, 0xe2, 0x58, 0xfa, 0x55, 0x58,
-	0x94, 0xae, 0xc6, 0x93, 0xe4, 0x2f, 0x08, 0xdd, 0x18, 0xbd, 0xf2, 0x6f, 0x49, 0xc8, 0xab, 0xb3,
-	0x42, 0x34, 0x67, 0xe7, 0x12, 0xdb, 0x8e, 0x9d, 0x25, 0xfc, 0x8c, 0x2d, 0x57, 0x3f, 0xfe, 0xe7,
-	0x65, 0xe9, 0x43, 0x05, 0x2c, 0x17, 0xfc, 0xe9, 0x3b, 0x94, 0xab, 0x7f, 0x7b, 0x4e, 0x9b, 0x55,
-	0xda, 0x63, 0x8e, 0xcc, 0x38, 0xc0, 0x51, 0xd5, 0xff, 0xf3, 0xf6, 0x53, 0x48, 0x6a, 0xd6, 0x14,
-	0x52, 0x82, 0x25, 0x0f, 0xf9, 0xd0, 0xa3, 0x2d, 0x8b, 0x70, 0x12, 0x5c, 0xc6, 0x20, 0x45, 0xf7,
-	0x08, 0x27, 0x71, 0x25, 0xcc, 0x9c, 0x47, 0xb6, 0x39, 0xb5, 0x42, 0xe5, 0x57, 0x1a, 0xe4, 0x94,
-	0xae, 0x7f, 0xc1, 0xc4, 0xed, 0xc0, 0x86, 0x32, 0x17, 0xf0, 0x51, 0x84, 0x6b, 0x6b, 0xec, 0xc4,
-	0xef, 0x05, 0x19, 0xb7, 0x07, 0xf3, 0x7d, 0xec, 0xb7, 0xd1, 0x63, 0xb9, 0xf4, 0x66, 0x6a, 0x7b,
-	0x69, 0x2f, 0x67, 0x84, 0x8f, 0x30, 0x63, 0x3f, 0x32, 0x47, 0xd4, 0x43, 0xc3, 0x99, 0x24, 0xdc,
-	0x7b, 0x9a, 0x86, 0x94, 0xdf, 0x6a, 0x9a, 0x61, 0x9b, 0x98, 0x8c, 0xfd, 0x57, 0x4f, 0xbc, 0x9e,
-	0x7a, 0x46, 0x14, 0xae, 0x9f, 0xa1, 0x9c, 0x74, 0xb5, 0x84, 0xde, 0x81, 0x6c, 0xec, 0x93, 0x62,
-	0x2b, 0xb2, 0x3d, 0xce, 0xa4, 0x70, 0xf3, 0x5c, 0x13, 0xe5, 0x3b, 0x4d, 0x58, 0x99, 0x7a, 0x39,
-	0x44, 0xd1, 0x47, 0x95, 0x53, 0xe8, 0x67, 0xbc, 0x0a, 0x12, 0x3a, 0x85, 0x6c, 0xdc, 0x90, 0xa5,
-	0x47, 0xa1, 0x9d, 0xf5, 0x6c, 0x28, 0xc4, 0x99, 0xce, 0x18, 0xd9, 0x12, 0xba, 0x09, 0x1b, 0x71,
-	0x03, 0xdb, 0xe6, 0x19, 0x9f, 0x13, 0x16, 0x85, 0xed, 0xf3, 0x2c, 0x94, 0x8f, 0x7c, 0x0d, 0xab,
-	0x0d, 0xe4, 0x91, 0x19, 0x2b, 0x1f, 0xd9, 0xae, 0xaa, 0x0a, 0x5b, 0x33, 0x55, 0x27, 0x2e, 0xab,
-	0x9f, 0x3f, 0x7b, 0x5d, 0xd4, 0x5e, 0xbc, 0x2e, 0x6a, 0xaf, 0x5e, 0x17, 0xb5, 0x47, 0x6f, 0x8a,
-	0x89, 0x17, 0x6f, 0x8a, 0x89, 0xdf, 0xdf, 0x14, 0x13, 0xdf, 0xbe, 0xaf, 0xdc, 0x0d, 0x47, 0x0e,
-	0xe5, 0xe8, 0x35, 0x91, 0xf4, 0xe5, 0x2b, 0xbf, 0xd2, 0x77, 0xad, 0x61, 0x0f, 0x2b, 0xa3, 0x60,
-	0x29, 0xae, 0xb5, 0xf6, 0x9c, 0x18, 0x43, 0x3f, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x1b, 0xc8,
-	0xad, 0xcd, 0x6d, 0x10, 0x00, 0x00,
+// TransferToChainEvent is submitted when the TransferToChainEvent is emitted by they
+// mhub2 contract.
+type TransferToChainEvent struct {
+	EventNonce       uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	ExternalCoinId   string                                 `protobuf:"bytes,2,opt,name=external_coin_id,json=externalCoinId,proto3" json:"external_coin_id,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Fee              github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee"`
+	Sender           string                                 `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	ReceiverChainId  string                                 `protobuf:"bytes,6,opt,name=receiver_chain_id,json=receiverChainId,proto3" json:"receiver_chain_id,omitempty"`
+	ExternalReceiver string                                 `protobuf:"bytes,7,opt,name=external_receiver,json=externalReceiver,proto3" json:"external_receiver,omitempty"`
+	ExternalHeight   uint64                                 `protobuf:"varint,8,opt,name=external_height,json=externalHeight,proto3" json:"external_height,omitempty"`
+	TxHash           string                                 `protobuf:"bytes,9,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 }
 
-func (this *SendToHubEvent) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*SendToHubEvent)
-	if !ok {
-		that2, ok := that.(SendToHubEvent)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.EventNonce != that1.EventNonce {
-		return false
-	}
-	if this.ExternalCoinId != that1.ExternalCoinId {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	if this.Sender != that1.Sender {
-		return false
-	}
-	if this.CosmosReceiver != that1.CosmosReceiver {
-		return false
-	}
-	if this.ExternalHeight != that1.ExternalHeight {
-		return false
-	}
-	if this.TxHash != that1.TxHash {
-		return false
-	}
-	return true
+func (m *TransferToChainEvent) Reset()         { *m = TransferToChainEvent{} }
+func (m *TransferToChainEvent) String() string { return proto.CompactTextString(m) }
+func (*TransferToChainEvent) ProtoMessage()    {}
+func (*TransferToChainEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{26}
 }
-func (this *TransferToChainEvent) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*TransferToChainEvent)
-	if !ok {
-		that2, ok := that.(TransferToChainEvent)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *TransferToChainEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferToChainEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferToChainEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.EventNonce != that1.EventNonce {
-		return false
-	}
-	if this.ExternalCoinId != that1.ExternalCoinId {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	if !this.Fee.Equal(that1.Fee) {
-		return false
-	}
-	if this.Sender != that1.Sender {
-		return false
-	}
-	if this.ReceiverChainId != that1.ReceiverChainId {
-		return false
-	}
-	if this.ExternalReceiver != that1.ExternalReceiver {
-		return false
-	}
-	if this.ExternalHeight != that1.ExternalHeight {
-		return false
-	}
-	if this.TxHash != that1.TxHash {
-		return false
-	}
-	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	SendToExternal(ctx context.Context, in *MsgSendToExternal, opts ...grpc.CallOption) (*MsgSendToExternalResponse, error)
-	CancelSendToExternal(ctx context.Context, in *MsgCancelSendToExternal, opts ...grpc.CallOption) (*MsgCancelSendToExternalResponse, error)
-	RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error)
-	SubmitTxConfirmation(ctx context.Context, in *MsgSubmitExternalTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitTxConfirmationResponse, error)
-	SubmitExternalEvent(ctx context.Context, in *MsgSubmitExternalEvent, opts ...grpc.CallOption) (*MsgSubmitExternalEventResponse, error)
-	SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error)
+func (m *TransferToChainEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferToChainEvent.Merge(m, src)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *TransferToChainEvent) XXX_Size() int {
+	return m.Size()
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *TransferToChainEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferToChainEvent.DiscardUnknown(m)
 }
 
-func (c *msgClient) SendToExternal(ctx context.Context, in *MsgSendToExternal, opts ...grpc.CallOption) (*MsgSendToExternalResponse, error) {
-	out := new(MsgSendToExternalResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/SendToExternal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TransferToChainEvent proto.InternalMessageInfo
+
+func (m *TransferToChainEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CancelSendToExternal(ctx context.Context, in *MsgCancelSendToExternal, opts ...grpc.CallOption) (*MsgCancelSendToExternalResponse, error) {
-	out := new(MsgCancelSendToExternalResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/CancelSendToExternal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TransferToChainEvent) GetExternalCoinId() string {
+	if m != nil {
+		return m.ExternalCoinId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error) {
-	out := new(MsgRequestBatchTxResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/RequestBatchTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TransferToChainEvent) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitTxConfirmation(ctx context.Context, in *MsgSubmitExternalTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitTxConfirmationResponse, error) {
-	out := new(MsgSubmitTxConfirmationResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/SubmitTxConfirmation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TransferToChainEvent) GetReceiverChainId() string {
+	if m != nil {
+		return m.ReceiverChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitExternalEvent(ctx context.Context, in *MsgSubmitExternalEvent, opts ...grpc.CallOption) (*MsgSubmitExternalEventResponse, error) {
-	out := new(MsgSubmitExternalEventResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/SubmitExternalEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TransferToChainEvent) GetExternalReceiver() string {
+	if m != nil {
+		return m.ExternalReceiver
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error) {
-	out := new(MsgDelegateKeysResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/SetDelegateKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TransferToChainEvent) GetExternalHeight() uint64 {
+	if m != nil {
+		return m.ExternalHeight
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	SendToExternal(context.Context, *MsgSendToExternal) (*MsgSendToExternalResponse, error)
-	CancelSendToExternal(context.Context, *MsgCancelSendToExternal) (*MsgCancelSendToExternalResponse, error)
-	RequestBatchTx(context.Context, *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error)
-	SubmitTxConfirmation(context.Context, *MsgSubmitExternalTxConfirmation) (*MsgSubmitTxConfirmationResponse, error)
-	SubmitExternalEvent(context.Context, *MsgSubmitExternalEvent) (*MsgSubmitExternalEventResponse, error)
-	SetDelegateKeys(context.Context, *MsgDelegateKeys) (*MsgDelegateKeysResponse, error)
+func (m *TransferToChainEvent) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// BatchExecutedEvent claims that a batch of BatchTxExecuted operations on the
+// bridge contract was executed successfully
+type BatchExecutedEvent struct {
+	ExternalCoinId string                                 `protobuf:"bytes,1,opt,name=external_coin_id,json=externalCoinId,proto3" json:"external_coin_id,omitempty"`
+	EventNonce     uint64                                 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	ExternalHeight uint64                                 `protobuf:"varint,3,opt,name=external_height,json=externalHeight,proto3" json:"external_height,omitempty"`
+	BatchNonce     uint64                                 `protobuf:"varint,4,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	TxHash         string                                 `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	FeePaid        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=fee_paid,json=feePaid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_paid"`
+	FeePayer       string                                 `protobuf:"bytes,7,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
 }
 
-func (*UnimplementedMsgServer) SendToExternal(ctx context.Context, req *MsgSendToExternal) (*MsgSendToExternalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendToExternal not implemented")
+func (m *BatchExecutedEvent) Reset()         { *m = BatchExecutedEvent{} }
+func (m *BatchExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*BatchExecutedEvent) ProtoMessage()    {}
+func (*BatchExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{27}
 }
-func (*UnimplementedMsgServer) CancelSendToExternal(ctx context.Context, req *MsgCancelSendToExternal) (*MsgCancelSendToExternalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToExternal not implemented")
+func (m *BatchExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RequestBatchTx(ctx context.Context, req *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestBatchTx not implemented")
+func (m *BatchExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SubmitTxConfirmation(ctx context.Context, req *MsgSubmitExternalTxConfirmation) (*MsgSubmitTxConfirmationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitTxConfirmation not implemented")
+func (m *BatchExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchExecutedEvent.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitExternalEvent(ctx context.Context, req *MsgSubmitExternalEvent) (*MsgSubmitExternalEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitExternalEvent not implemented")
+func (m *BatchExecutedEvent) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetDelegateKeys(ctx context.Context, req *MsgDelegateKeys) (*MsgDelegateKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateKeys not implemented")
+func (m *BatchExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchExecutedEvent.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_BatchExecutedEvent proto.InternalMessageInfo
 
-func _Msg_SendToExternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendToExternal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendToExternal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Msg/SendToExternal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendToExternal(ctx, req.(*MsgSendToExternal))
+func (m *BatchExecutedEvent) GetExternalCoinId() string {
+	if m != nil {
+		return m.ExternalCoinId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelSendToExternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelSendToExternal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelSendToExternal(ctx, in)
+func (m *BatchExecutedEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Msg/CancelSendToExternal",
+	return 0
+}
+
+func (m *BatchExecutedEvent) GetExternalHeight() uint64 {
+	if m != nil {
+		return m.ExternalHeight
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelSendToExternal(ctx, req.(*MsgCancelSendToExternal))
+	return 0
+}
+
+func (m *BatchExecutedEvent) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RequestBatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestBatchTx)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BatchExecutedEvent) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestBatchTx(ctx, in)
+	return ""
+}
+
+func (m *BatchExecutedEvent) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Msg/RequestBatchTx",
+	return ""
+}
+
+// NOTE: bytes.HexBytes is supposed to "help" with json encoding/decoding
+// investigate?
+type ContractCallExecutedEvent struct {
+	EventNonce        uint64                                               `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	InvalidationScope github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=invalidation_scope,json=invalidationScope,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64                                               `protobuf:"varint,3,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	ReturnData        []byte                                               `protobuf:"bytes,4,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty"`
+	ExternalHeight    uint64                                               `protobuf:"varint,5,opt,name=external_height,json=externalHeight,proto3" json:"external_height,omitempty"`
+	TxHash            string                                               `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *ContractCallExecutedEvent) Reset()         { *m = ContractCallExecutedEvent{} }
+func (m *ContractCallExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractCallExecutedEvent) ProtoMessage()    {}
+func (*ContractCallExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{28}
+}
+func (m *ContractCallExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCallExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestBatchTx(ctx, req.(*MsgRequestBatchTx))
+}
+func (m *ContractCallExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallExecutedEvent.Merge(m, src)
+}
+func (m *ContractCallExecutedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCallExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallExecutedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCallExecutedEvent proto.InternalMessageInfo
+
+func (m *ContractCallExecutedEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SubmitTxConfirmation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitExternalTxConfirmation)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ContractCallExecutedEvent) GetInvalidationScope() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.InvalidationScope
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitTxConfirmation(ctx, in)
+	return nil
+}
+
+func (m *ContractCallExecutedEvent) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Msg/SubmitTxConfirmation",
+	return 0
+}
+
+func (m *ContractCallExecutedEvent) GetReturnData() []byte {
+	if m != nil {
+		return m.ReturnData
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitTxConfirmation(ctx, req.(*MsgSubmitExternalTxConfirmation))
+	return nil
+}
+
+func (m *ContractCallExecutedEvent) GetExternalHeight() uint64 {
+	if m != nil {
+		return m.ExternalHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SubmitExternalEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitExternalEvent)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ContractCallExecutedEvent) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitExternalEvent(ctx, in)
+	return ""
+}
+
+// This informs the Cosmos module that a validator
+// set has been updated.
+type SignerSetTxExecutedEvent struct {
+	EventNonce       uint64            `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	SignerSetTxNonce uint64            `protobuf:"varint,2,opt,name=signer_set_tx_nonce,json=signerSetTxNonce,proto3" json:"signer_set_tx_nonce,omitempty"`
+	ExternalHeight   uint64            `protobuf:"varint,3,opt,name=external_height,json=externalHeight,proto3" json:"external_height,omitempty"`
+	Members          []*ExternalSigner `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	TxHash           string            `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// threshold of the multisig set up on the external chain, it is 0 for the
+	// chains which don't report the multisig weights
+	Threshold uint64 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *SignerSetTxExecutedEvent) Reset()         { *m = SignerSetTxExecutedEvent{} }
+func (m *SignerSetTxExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxExecutedEvent) ProtoMessage()    {}
+func (*SignerSetTxExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be2955e5a84f15d4, []int{29}
+}
+func (m *SignerSetTxExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetTxExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetTxExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Msg/SubmitExternalEvent",
+}
+func (m *SignerSetTxExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxExecutedEvent.Merge(m, src)
+}
+func (m *SignerSetTxExecutedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetTxExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxExecutedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSetTxExecutedEvent proto.InternalMessageInfo
+
+func (m *SignerSetTxExecutedEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitExternalEvent(ctx, req.(*MsgSubmitExternalEvent))
+	return 0
+}
+
+func (m *SignerSetTxExecutedEvent) GetSignerSetTxNonce() uint64 {
+	if m != nil {
+		return m.SignerSetTxNonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SetDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegateKeys)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *SignerSetTxExecutedEvent) GetExternalHeight() uint64 {
+	if m != nil {
+		return m.ExternalHeight
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDelegateKeys(ctx, in)
+	return 0
+}
+
+func (m *SignerSetTxExecutedEvent) GetMembers() []*ExternalSigner {
+	if m != nil {
+		return m.Members
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Msg/SetDelegateKeys",
+	return nil
+}
+
+func (m *SignerSetTxExecutedEvent) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDelegateKeys(ctx, req.(*MsgDelegateKeys))
+	return ""
+}
+
+func (m *SignerSetTxExecutedEvent) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mhub2.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SendToExternal",
-			Handler:    _Msg_SendToExternal_Handler,
-		},
-		{
-			MethodName: "CancelSendToExternal",
-			Handler:    _Msg_CancelSendToExternal_Handler,
-		},
-		{
-			MethodName: "RequestBatchTx",
-			Handler:    _Msg_RequestBatchTx_Handler,
-		},
-		{
-			MethodName: "SubmitTxConfirmation",
-			Handler:    _Msg_SubmitTxConfirmation_Handler,
-		},
-		{
-			MethodName: "SubmitExternalEvent",
-			Handler:    _Msg_SubmitExternalEvent_Handler,
-		},
-		{
-			MethodName: "SetDelegateKeys",
-			Handler:    _Msg_SetDelegateKeys_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mhub2/v1/msgs.proto",
+func init() {
+	proto.RegisterType((*MsgSendToExternal)(nil), "mhub2.v1.MsgSendToExternal")
+	proto.RegisterType((*MsgSendToExternalResponse)(nil), "mhub2.v1.MsgSendToExternalResponse")
+	proto.RegisterType((*MsgCancelSendToExternal)(nil), "mhub2.v1.MsgCancelSendToExternal")
+	proto.RegisterType((*MsgCancelSendToExternalResponse)(nil), "mhub2.v1.MsgCancelSendToExternalResponse")
+	proto.RegisterType((*MsgCancelTimelockedTransfer)(nil), "mhub2.v1.MsgCancelTimelockedTransfer")
+	proto.RegisterType((*MsgCancelTimelockedTransferResponse)(nil), "mhub2.v1.MsgCancelTimelockedTransferResponse")
+	proto.RegisterType((*MsgRequestBatchTx)(nil), "mhub2.v1.MsgRequestBatchTx")
+	proto.RegisterType((*MsgRequestBatchTxResponse)(nil), "mhub2.v1.MsgRequestBatchTxResponse")
+	proto.RegisterType((*MsgSubmitExternalTxConfirmation)(nil), "mhub2.v1.MsgSubmitExternalTxConfirmation")
+	proto.RegisterType((*ContractCallTxConfirmation)(nil), "mhub2.v1.ContractCallTxConfirmation")
+	proto.RegisterType((*BatchTxConfirmation)(nil), "mhub2.v1.BatchTxConfirmation")
+	proto.RegisterType((*SignerSetTxConfirmation)(nil), "mhub2.v1.SignerSetTxConfirmation")
+	proto.RegisterType((*MsgSubmitTxConfirmationResponse)(nil), "mhub2.v1.MsgSubmitTxConfirmationResponse")
+	proto.RegisterType((*MsgSubmitExternalEvent)(nil), "mhub2.v1.MsgSubmitExternalEvent")
+	proto.RegisterType((*MsgSubmitExternalEventResponse)(nil), "mhub2.v1.MsgSubmitExternalEventResponse")
+	proto.RegisterType((*MsgDelegateKeys)(nil), "mhub2.v1.MsgDelegateKeys")
+	proto.RegisterType((*MsgDelegateKeysResponse)(nil), "mhub2.v1.MsgDelegateKeysResponse")
+	proto.RegisterType((*MsgDelegateKeysMulti)(nil), "mhub2.v1.MsgDelegateKeysMulti")
+	proto.RegisterType((*DelegateKeysMultiEntry)(nil), "mhub2.v1.DelegateKeysMultiEntry")
+	proto.RegisterType((*MsgDelegateKeysMultiResponse)(nil), "mhub2.v1.MsgDelegateKeysMultiResponse")
+	proto.RegisterType((*MsgRotateDelegateKeys)(nil), "mhub2.v1.MsgRotateDelegateKeys")
+	proto.RegisterType((*MsgRotateDelegateKeysResponse)(nil), "mhub2.v1.MsgRotateDelegateKeysResponse")
+	proto.RegisterType((*DelegateKeysRotation)(nil), "mhub2.v1.DelegateKeysRotation")
+	proto.RegisterType((*DelegateKeysHistory)(nil), "mhub2.v1.DelegateKeysHistory")
+	proto.RegisterType((*DelegateKeysSignMsg)(nil), "mhub2.v1.DelegateKeysSignMsg")
+	proto.RegisterType((*SendToHubEvent)(nil), "mhub2.v1.SendToHubEvent")
+	proto.RegisterType((*TransferToChainEvent)(nil), "mhub2.v1.TransferToChainEvent")
+	proto.RegisterType((*BatchExecutedEvent)(nil), "mhub2.v1.BatchExecutedEvent")
+	proto.RegisterType((*ContractCallExecutedEvent)(nil), "mhub2.v1.ContractCallExecutedEvent")
+	proto.RegisterType((*SignerSetTxExecutedEvent)(nil), "mhub2.v1.SignerSetTxExecutedEvent")
 }
 
-func (m *MsgSendToExternal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+func init() { proto.RegisterFile("mhub2/v1/msgs.proto", fileDescriptor_be2955e5a84f15d4) }
 
-func (m *MsgSendToExternal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_be2955e5a84f15d4 = []byte{
+	// 1674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x6f, 0x1b, 0xd7,
+	0x11, 0xd7, 0x92, 0x14, 0x25, 0x8e, 0x64, 0x7d, 0xac, 0x58, 0x89, 0xa4, 0x64, 0x52, 0xa2, 0x60,
+	0x5b, 0xb6, 0x21, 0xb2, 0x52, 0x0b, 0xb4, 0x30, 0xd0, 0xc2, 0xa6, 0x2c, 0x43, 0x6a, 0xa1, 0x7e,
+	0x50, 0x3c, 0x14, 0x85, 0x01, 0x76, 0xb9, 0x3b, 0x5a, 0x2e, 0x4c, 0xbe, 0xc7, 0xee, 0x7b, 0x14,
+	0xc8, 0x73, 0x81, 0xa2, 0x28, 0x7a, 0xf0, 0x9f, 0xe0, 0x43, 0x2f, 0x2d, 0x72, 0xc8, 0xc1, 0x40,
+	0x72, 0xcd, 0xcd, 0xf0, 0xc9, 0xc7, 0x20, 0x08, 0x8c, 0x40, 0xbe, 0xe4, 0x94, 0x7b, 0x72, 0x08,
+	0x82, 0x7d, 0x6f, 0x77, 0xb9, 0x4b, 0x2e, 0x29, 0xc9, 0x48, 0x80, 0x20, 0x27, 0xf2, 0xcd, 0xcc,
+	0x9b, 0xf7, 0x9b, 0xdf, 0xcc, 0xee, 0xcc, 0x5b, 0x58, 0x69, 0x37, 0xbb, 0x8d, 0xfd, 0xf2, 0xf9,
+	0x5e, 0xb9, 0xcd, 0x4c, 0x56, 0xea, 0xd8, 0x94, 0x53, 0x75, 0x56, 0x08, 0x4b, 0xe7, 0x7b, 0xb9,
+	0xbc, 0x4e, 0x59, 0x9b, 0xb2, 0x72, 0x43, 0x63, 0x58, 0x3e, 0xdf, 0x6b, 0x20, 0xd7, 0xf6, 0xca,
+	0x3a, 0xb5, 0x88, 0xb4, 0xcc, 0x65, 0xa5, 0xbe, 0x2e, 0x56, 0x65, 0xb9, 0x70, 0x55, 0xe9, 0x81,
+	0x67, 0xe1, 0xcd, 0x95, 0x9a, 0xd4, 0xa4, 0xd2, 0xda, 0xf9, 0xe7, 0x4a, 0x37, 0x4c, 0x4a, 0xcd,
+	0x16, 0x96, 0xb5, 0x8e, 0x55, 0xd6, 0x08, 0xa1, 0x5c, 0xe3, 0x16, 0x25, 0x9e, 0xa7, 0xac, 0xab,
+	0x15, 0xab, 0x46, 0xf7, 0xac, 0xac, 0x91, 0xbe, 0x54, 0x15, 0xbf, 0x52, 0x60, 0xf9, 0x84, 0x99,
+	0xa7, 0x48, 0x8c, 0x1a, 0x3d, 0xec, 0x71, 0xb4, 0x89, 0xd6, 0x52, 0x57, 0x21, 0xc9, 0x90, 0x18,
+	0x68, 0x67, 0x94, 0x4d, 0x65, 0x27, 0x55, 0x75, 0x57, 0xea, 0x2e, 0xa8, 0xe8, 0xda, 0xd4, 0x6d,
+	0xd4, 0xad, 0x8e, 0x85, 0x84, 0x67, 0x62, 0xc2, 0x66, 0xd9, 0xd3, 0x54, 0x3d, 0x85, 0xfa, 0x2b,
+	0x48, 0x6a, 0x6d, 0xda, 0x25, 0x3c, 0x13, 0xdf, 0x54, 0x76, 0xe6, 0xf6, 0xb3, 0x25, 0x37, 0x40,
+	0x87, 0x8d, 0x92, 0xcb, 0x46, 0xe9, 0x80, 0x5a, 0xa4, 0x92, 0x78, 0xf5, 0xb6, 0x30, 0x55, 0x75,
+	0xcd, 0xd5, 0xdf, 0x02, 0x34, 0x6c, 0xcb, 0x30, 0xb1, 0x7e, 0x86, 0x98, 0x49, 0x5c, 0x6d, 0x73,
+	0x4a, 0x6e, 0x79, 0x82, 0xa8, 0x66, 0x61, 0x56, 0x6f, 0x6a, 0x16, 0xa9, 0x5b, 0x46, 0x66, 0x5a,
+	0xa0, 0x9b, 0x11, 0xeb, 0x63, 0xa3, 0x78, 0x1f, 0xb2, 0x23, 0xf1, 0x56, 0x91, 0x75, 0x28, 0x61,
+	0xa8, 0x2e, 0x40, 0xcc, 0x32, 0x44, 0xcc, 0x89, 0x6a, 0xcc, 0x32, 0x8a, 0x4f, 0x61, 0xed, 0x84,
+	0x99, 0x07, 0x1a, 0xd1, 0xb1, 0x35, 0x44, 0xd1, 0x90, 0x69, 0x80, 0xb2, 0x58, 0x88, 0xb2, 0x20,
+	0x94, 0x78, 0x18, 0xca, 0x16, 0x14, 0xc6, 0x78, 0xf7, 0x00, 0x15, 0x0d, 0x58, 0xf7, 0x4d, 0x6a,
+	0x56, 0x1b, 0x5b, 0x54, 0x7f, 0x86, 0x46, 0xcd, 0xd6, 0x08, 0x3b, 0x43, 0x7b, 0x04, 0x44, 0x0e,
+	0x66, 0xcd, 0xae, 0x66, 0x1b, 0x96, 0x46, 0x5c, 0x18, 0xfe, 0x7a, 0x12, 0x90, 0x5b, 0xb0, 0x3d,
+	0xe1, 0x14, 0x1f, 0xcc, 0x53, 0x51, 0x2a, 0x55, 0xfc, 0x7b, 0x17, 0x19, 0xaf, 0x68, 0x5c, 0x6f,
+	0xd6, 0x7a, 0x6a, 0x1a, 0xa6, 0x0d, 0x24, 0xb4, 0xed, 0x56, 0x8a, 0x5c, 0x08, 0x36, 0x2c, 0x93,
+	0x04, 0xd8, 0x10, 0xab, 0x49, 0x20, 0xd6, 0x21, 0x3b, 0xe2, 0xdd, 0x3f, 0xfa, 0x43, 0x45, 0x70,
+	0x75, 0xda, 0x6d, 0xb4, 0x2d, 0xee, 0xb1, 0x54, 0xeb, 0x1d, 0x50, 0x72, 0x66, 0xd9, 0x6d, 0x51,
+	0xec, 0x6a, 0x0d, 0xe6, 0xf5, 0xc0, 0x5a, 0x00, 0x9a, 0xdb, 0x4f, 0x97, 0x64, 0xf1, 0x97, 0xbc,
+	0xe2, 0x2f, 0x3d, 0x22, 0xfd, 0x4a, 0xee, 0xf5, 0xcb, 0xdd, 0xd5, 0x68, 0x3f, 0xd5, 0x90, 0x97,
+	0xf7, 0x88, 0xe4, 0x41, 0xe2, 0x5f, 0x2f, 0x0a, 0x53, 0xc5, 0x4f, 0x14, 0xc8, 0x1d, 0x50, 0xc2,
+	0x6d, 0x4d, 0xe7, 0x07, 0x5a, 0x6b, 0x18, 0xed, 0x2e, 0xa8, 0x16, 0x39, 0xd7, 0x5a, 0x96, 0x21,
+	0xd6, 0x75, 0xa6, 0xd3, 0x0e, 0x0a, 0xcc, 0xf3, 0xd5, 0xe5, 0xa0, 0xe6, 0xd4, 0x51, 0x8c, 0x98,
+	0x13, 0x4a, 0x74, 0x14, 0x90, 0x12, 0x61, 0xf3, 0x3f, 0x38, 0x0a, 0xf5, 0x0e, 0x2c, 0xfa, 0x0f,
+	0xaa, 0x0b, 0x5f, 0x82, 0x5c, 0xf0, 0xc4, 0xa7, 0x32, 0x8c, 0x0d, 0x48, 0x39, 0x7a, 0x8d, 0x77,
+	0x6d, 0xf9, 0xa0, 0xcd, 0x57, 0x07, 0x82, 0xe2, 0xff, 0x14, 0x58, 0x71, 0x53, 0x11, 0x02, 0x7f,
+	0x0f, 0xfc, 0xa7, 0xbd, 0xce, 0xe9, 0x33, 0x14, 0x2c, 0xc8, 0x02, 0xf0, 0xcf, 0xad, 0x39, 0xf2,
+	0x63, 0x43, 0x2d, 0xc0, 0x5c, 0xc3, 0x71, 0x11, 0x82, 0x0c, 0x42, 0xf4, 0xbd, 0x62, 0xfd, 0xb7,
+	0x02, 0x6b, 0xd2, 0xf0, 0x14, 0xf9, 0x10, 0xde, 0x1d, 0x58, 0x92, 0x9e, 0xeb, 0x0c, 0xb9, 0x0b,
+	0x44, 0x3e, 0x35, 0x0b, 0xcc, 0xdb, 0x32, 0x16, 0x4c, 0xec, 0x72, 0x30, 0xf1, 0x61, 0x30, 0x5b,
+	0x81, 0x72, 0x1d, 0x2a, 0x2f, 0xaf, 0xa4, 0x9f, 0x2b, 0xb0, 0x3a, 0x52, 0xd2, 0x87, 0xe7, 0xce,
+	0x7b, 0xf3, 0x37, 0x30, 0x8d, 0xce, 0x9f, 0x89, 0x25, 0xbc, 0xfc, 0xfa, 0xe5, 0xee, 0x8d, 0xd0,
+	0xbe, 0xaa, 0xdc, 0xf5, 0xfe, 0x25, 0xbb, 0x09, 0xf9, 0x68, 0x44, 0x3e, 0xe8, 0xcf, 0x15, 0x58,
+	0x3c, 0x61, 0xe6, 0x63, 0x6c, 0xa1, 0xa9, 0x71, 0xfc, 0x3d, 0xf6, 0x99, 0x7a, 0x1f, 0x96, 0xdd,
+	0xf2, 0xa3, 0x76, 0x5d, 0x33, 0x0c, 0x1b, 0x19, 0x73, 0x8b, 0x61, 0xc9, 0x57, 0x3c, 0x92, 0x72,
+	0x75, 0x0f, 0xd2, 0xd4, 0xd6, 0x9b, 0xc8, 0xb8, 0x1d, 0xb2, 0x97, 0x48, 0x57, 0x82, 0x3a, 0x6f,
+	0xcb, 0x5d, 0x58, 0xf2, 0x53, 0xe2, 0x99, 0xc7, 0xc3, 0xb5, 0xe6, 0x99, 0x6e, 0xc3, 0x0d, 0xe4,
+	0xcd, 0xfa, 0x70, 0x95, 0xcc, 0x23, 0x6f, 0x9e, 0x7a, 0xb2, 0x49, 0xcd, 0x21, 0x0b, 0x6b, 0x43,
+	0xd1, 0xf9, 0x91, 0x7f, 0xac, 0x40, 0x7a, 0x48, 0x77, 0xd2, 0x6d, 0x71, 0xeb, 0x07, 0x0f, 0xff,
+	0x21, 0xcc, 0x20, 0xe1, 0xb6, 0x85, 0x4e, 0xd4, 0xf1, 0x9d, 0xb9, 0xfd, 0xcd, 0x92, 0x37, 0x5d,
+	0x94, 0x46, 0xd0, 0x1c, 0x12, 0x6e, 0xf7, 0xdd, 0x7e, 0xe8, 0x6d, 0x2b, 0xfe, 0x43, 0x81, 0xd5,
+	0x68, 0xcb, 0x10, 0x17, 0x4a, 0x88, 0x8b, 0x48, 0xda, 0x63, 0x57, 0xa4, 0x3d, 0x3e, 0x4a, 0x7b,
+	0x31, 0x0f, 0x1b, 0x51, 0xfc, 0xf9, 0x04, 0x5f, 0x28, 0xf0, 0x33, 0xa7, 0x01, 0x38, 0xb3, 0x0b,
+	0xfe, 0x54, 0x0b, 0xac, 0x00, 0x37, 0x23, 0x63, 0xf4, 0x59, 0xf8, 0x4f, 0x0c, 0xd2, 0x21, 0x85,
+	0x3b, 0xca, 0xa9, 0x15, 0xb8, 0xd9, 0xb1, 0xf1, 0xdc, 0xa2, 0x5d, 0x56, 0x8f, 0x0c, 0x50, 0x12,
+	0xb2, 0xee, 0x19, 0xfd, 0x31, 0x22, 0xd0, 0x07, 0x90, 0xf5, 0x7d, 0x8c, 0xc9, 0xed, 0x9a, 0x67,
+	0x70, 0x38, 0x14, 0xf9, 0x38, 0x5e, 0xe3, 0xd7, 0xe3, 0x35, 0x11, 0xcd, 0xeb, 0x2a, 0x24, 0x9b,
+	0x68, 0x99, 0x4d, 0x2e, 0x08, 0x4b, 0x54, 0xdd, 0x55, 0xf1, 0x9f, 0x0a, 0xac, 0x04, 0xe9, 0x38,
+	0xb2, 0x18, 0xa7, 0x76, 0xff, 0x7a, 0x25, 0x51, 0x81, 0x94, 0xed, 0x4d, 0xc4, 0x99, 0x98, 0x78,
+	0x86, 0xf2, 0xd1, 0xcf, 0x90, 0xc7, 0xb6, 0x37, 0x51, 0xfa, 0xdb, 0x8a, 0x7f, 0x09, 0xe3, 0x70,
+	0x92, 0x7d, 0xc2, 0xcc, 0xeb, 0xe1, 0x48, 0xc3, 0x74, 0xb0, 0x07, 0xca, 0x45, 0xf1, 0x83, 0x18,
+	0x2c, 0xc8, 0xe9, 0xef, 0xa8, 0xdb, 0x90, 0xef, 0xff, 0x02, 0xcc, 0x89, 0x37, 0x79, 0xa8, 0x53,
+	0x81, 0x10, 0xc9, 0x2e, 0xb5, 0x13, 0x60, 0x56, 0xa7, 0xb2, 0xd2, 0x86, 0xda, 0x94, 0x33, 0x18,
+	0x1f, 0x1b, 0xea, 0x93, 0xd0, 0x08, 0x9e, 0xaa, 0x94, 0x9c, 0xc0, 0x3e, 0x7b, 0x5b, 0xb8, 0x6d,
+	0x5a, 0xbc, 0xd9, 0x6d, 0x94, 0x74, 0xda, 0x76, 0x6f, 0x1d, 0xee, 0xcf, 0x2e, 0x33, 0x9e, 0x95,
+	0x79, 0xbf, 0x83, 0xac, 0x74, 0x4c, 0xb8, 0x3f, 0x91, 0x0f, 0xc6, 0xdb, 0x44, 0x68, 0xbc, 0xbd,
+	0x03, 0x8b, 0x72, 0x9f, 0x73, 0x1f, 0x40, 0xeb, 0x1c, 0x6d, 0xb7, 0xe4, 0x17, 0xa4, 0xb8, 0xea,
+	0x4a, 0x43, 0x8d, 0xd5, 0x4d, 0x75, 0x52, 0x76, 0x60, 0x4f, 0x7c, 0x24, 0xa4, 0xea, 0x1a, 0xcc,
+	0xf0, 0x5e, 0xbd, 0xa9, 0xb1, 0x66, 0x66, 0x46, 0x1e, 0xc5, 0x7b, 0x47, 0x1a, 0x6b, 0x3e, 0x48,
+	0x7c, 0xf9, 0xa2, 0xa0, 0x14, 0xff, 0x1b, 0x87, 0xb4, 0x37, 0x99, 0xd6, 0xe8, 0x81, 0xf3, 0x5c,
+	0xfd, 0x68, 0x49, 0x7b, 0x08, 0x71, 0xef, 0xfe, 0x72, 0x7d, 0x27, 0xce, 0xd6, 0x00, 0xed, 0xd3,
+	0x21, 0xda, 0xef, 0xc1, 0xb2, 0xc7, 0x77, 0xdd, 0x7f, 0xd7, 0x24, 0xe5, 0xb3, 0xe5, 0x29, 0x0e,
+	0xdc, 0x17, 0xf9, 0xfd, 0xc0, 0xb0, 0xe6, 0x27, 0x49, 0x52, 0xbb, 0x14, 0xb8, 0xb3, 0x8d, 0x4d,
+	0xd3, 0xec, 0x65, 0x69, 0x4a, 0x45, 0xa4, 0xe9, 0xff, 0x31, 0x50, 0xc5, 0xe4, 0x78, 0xd8, 0x43,
+	0xbd, 0xcb, 0xd1, 0x90, 0x49, 0x8a, 0xca, 0x81, 0x12, 0x99, 0x83, 0xa1, 0x74, 0xc6, 0x46, 0xd2,
+	0x19, 0x81, 0x34, 0x1e, 0x89, 0x74, 0x68, 0x00, 0x4d, 0x8c, 0x0c, 0xa0, 0x81, 0x50, 0xa6, 0x83,
+	0xa1, 0xa8, 0xc7, 0x30, 0x7b, 0x86, 0x58, 0xef, 0x68, 0x1e, 0xb9, 0xd7, 0x4e, 0xe2, 0xcc, 0x19,
+	0xe2, 0x9f, 0x34, 0xcb, 0x50, 0xd7, 0x21, 0x25, 0x5d, 0xf5, 0x7d, 0xf2, 0x67, 0x85, 0xae, 0x8f,
+	0x76, 0xf1, 0xa3, 0x18, 0x64, 0x83, 0x57, 0x85, 0x30, 0x67, 0x97, 0x16, 0xb6, 0x19, 0x79, 0x95,
+	0x70, 0x18, 0x9b, 0xaf, 0xfc, 0xfa, 0x9b, 0xb7, 0x85, 0x5f, 0x06, 0xc0, 0x72, 0x51, 0x3f, 0x6d,
+	0x8b, 0xf0, 0xe0, 0xdf, 0x96, 0xd5, 0x60, 0xe5, 0x46, 0x9f, 0x23, 0x2b, 0x1d, 0x61, 0xaf, 0xe2,
+	0xfc, 0xb9, 0xfa, 0x25, 0x24, 0x3e, 0xee, 0x12, 0x52, 0x80, 0x39, 0x1b, 0x79, 0xd7, 0x26, 0x75,
+	0x43, 0xe3, 0x9a, 0xdb, 0x2a, 0x41, 0x8a, 0x1e, 0x6b, 0x5c, 0x8b, 0x4a, 0xe1, 0xf4, 0x65, 0xc5,
+	0x96, 0x0c, 0x66, 0xa8, 0xf8, 0xad, 0x02, 0x99, 0xc0, 0xd0, 0x7f, 0x4d, 0xe2, 0x76, 0x61, 0x25,
+	0x70, 0x2d, 0xe0, 0xbd, 0x50, 0xad, 0x2d, 0xb1, 0x81, 0xdf, 0x6b, 0x56, 0xdc, 0x3e, 0xcc, 0xb4,
+	0xb1, 0xdd, 0x40, 0xdb, 0xe9, 0x77, 0x4e, 0xbb, 0xc9, 0x0c, 0xda, 0xcd, 0x61, 0xe8, 0x1a, 0x51,
+	0xf5, 0x0c, 0xc7, 0x17, 0xe1, 0x06, 0xa4, 0x78, 0xd3, 0x46, 0xd6, 0xa4, 0x2d, 0xc3, 0x7d, 0x65,
+	0x0e, 0x04, 0xfb, 0x5f, 0x27, 0x21, 0xee, 0x34, 0xa2, 0x9a, 0xd7, 0x44, 0x3c, 0xff, 0xea, 0xfa,
+	0xe0, 0xcc, 0x91, 0x0f, 0x1e, 0xb9, 0xed, 0x09, 0x4a, 0x7f, 0x16, 0x99, 0x52, 0xcf, 0x20, 0x1d,
+	0xf9, 0xf1, 0x63, 0x2b, 0xb4, 0x3d, 0xca, 0x24, 0x77, 0xf7, 0x52, 0x93, 0xc0, 0x39, 0x35, 0x58,
+	0x18, 0xfa, 0xac, 0x10, 0x46, 0x1f, 0x56, 0xe6, 0xb6, 0x27, 0x28, 0x03, 0x5e, 0x09, 0xa4, 0xa3,
+	0x6e, 0x60, 0x6a, 0x18, 0xda, 0xa4, 0x6f, 0x0a, 0xb9, 0x28, 0xd3, 0x31, 0xf7, 0xb9, 0x29, 0x55,
+	0x87, 0x95, 0xa8, 0xdb, 0xdc, 0xe6, 0x84, 0xe3, 0x84, 0x45, 0x6e, 0xe7, 0x32, 0x8b, 0xc0, 0x21,
+	0x7f, 0x86, 0xc5, 0x53, 0xe4, 0xa1, 0xf9, 0x38, 0x1b, 0xda, 0x1e, 0x54, 0xe5, 0xb6, 0xc6, 0xaa,
+	0x02, 0x2e, 0x6d, 0xc8, 0x8c, 0xfd, 0xc2, 0x74, 0x2b, 0x22, 0x8d, 0xa3, 0x66, 0xb9, 0xdd, 0x2b,
+	0x99, 0x05, 0xce, 0xfc, 0x1b, 0xa8, 0x11, 0x93, 0x7e, 0x21, 0x9c, 0xd8, 0x11, 0x83, 0xdc, 0x9d,
+	0x4b, 0x0c, 0x42, 0x27, 0xa4, 0x87, 0x88, 0x92, 0xf7, 0xb5, 0xfc, 0x58, 0x4a, 0x84, 0x3e, 0x77,
+	0x7b, 0xb2, 0x7e, 0x70, 0x42, 0xe5, 0x77, 0xaf, 0x2e, 0xf2, 0xca, 0x9b, 0x8b, 0xbc, 0xf2, 0xc5,
+	0x45, 0x5e, 0x79, 0xfe, 0x2e, 0x3f, 0xf5, 0xe6, 0x5d, 0x7e, 0xea, 0xd3, 0x77, 0xf9, 0xa9, 0xbf,
+	0xfe, 0x3c, 0xf0, 0xc6, 0x3d, 0xb1, 0x08, 0x47, 0xbb, 0x86, 0x5a, 0x5b, 0x7e, 0xc7, 0x2d, 0xb7,
+	0xa9, 0xd1, 0x6d, 0x61, 0xb9, 0xe7, 0x2e, 0x45, 0xb3, 0x68, 0x24, 0xc5, 0xdd, 0xfe, 0x17, 0xdf,
+	0x0d, 0x00, 0x54, 0x60, 0xc9, 0x53, 0x4f, 0x16, 0x00, 0x00,
 }
 
-func (m *MsgSendToExternal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x2a
+func (this *SendToHubEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	{
-		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*SendToHubEvent)
+	if !ok {
+		that2, ok := that.(SendToHubEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ExternalRecipient) > 0 {
-		i -= len(m.ExternalRecipient)
-		copy(dAtA[i:], m.ExternalRecipient)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalRecipient)))
-		i--
-		dAtA[i] = 0x12
+	if this.EventNonce != that1.EventNonce {
+		return false
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	if this.ExternalCoinId != that1.ExternalCoinId {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.CosmosReceiver != that1.CosmosReceiver {
+		return false
+	}
+	if this.ExternalHeight != that1.ExternalHeight {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
+	return true
 }
+func (this *TransferToChainEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgSendToExternalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*TransferToChainEvent)
+	if !ok {
+		that2, ok := that.(TransferToChainEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EventNonce != that1.EventNonce {
+		return false
+	}
+	if this.ExternalCoinId != that1.ExternalCoinId {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if !this.Fee.Equal(that1.Fee) {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.ReceiverChainId != that1.ReceiverChainId {
+		return false
+	}
+	if this.ExternalReceiver != that1.ExternalReceiver {
+		return false
+	}
+	if this.ExternalHeight != that1.ExternalHeight {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
+	return true
 }
 
-func (m *MsgSendToExternalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SendToExternal(ctx context.Context, in *MsgSendToExternal, opts ...grpc.CallOption) (*MsgSendToExternalResponse, error)
+	CancelSendToExternal(ctx context.Context, in *MsgCancelSendToExternal, opts ...grpc.CallOption) (*MsgCancelSendToExternalResponse, error)
+	RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error)
+	SubmitTxConfirmation(ctx context.Context, in *MsgSubmitExternalTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitTxConfirmationResponse, error)
+	SubmitExternalEvent(ctx context.Context, in *MsgSubmitExternalEvent, opts ...grpc.CallOption) (*MsgSubmitExternalEventResponse, error)
+	SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error)
+	CancelTimelockedTransfer(ctx context.Context, in *MsgCancelTimelockedTransfer, opts ...grpc.CallOption) (*MsgCancelTimelockedTransferResponse, error)
+	RotateDelegateKeys(ctx context.Context, in *MsgRotateDelegateKeys, opts ...grpc.CallOption) (*MsgRotateDelegateKeysResponse, error)
+	SetDelegateKeysMulti(ctx context.Context, in *MsgDelegateKeysMulti, opts ...grpc.CallOption) (*MsgDelegateKeysMultiResponse, error)
 }
 
-func (m *MsgSendToExternalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgCancelSendToExternal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SendToExternal(ctx context.Context, in *MsgSendToExternal, opts ...grpc.CallOption) (*MsgSendToExternalResponse, error) {
+	out := new(MsgSendToExternalResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/SendToExternal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelSendToExternal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelSendToExternal(ctx context.Context, in *MsgCancelSendToExternal, opts ...grpc.CallOption) (*MsgCancelSendToExternalResponse, error) {
+	out := new(MsgCancelSendToExternalResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/CancelSendToExternal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelSendToExternal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error) {
+	out := new(MsgRequestBatchTxResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/RequestBatchTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCancelSendToExternalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitTxConfirmation(ctx context.Context, in *MsgSubmitExternalTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitTxConfirmationResponse, error) {
+	out := new(MsgSubmitTxConfirmationResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/SubmitTxConfirmation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelSendToExternalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgCancelSendToExternalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SubmitExternalEvent(ctx context.Context, in *MsgSubmitExternalEvent, opts ...grpc.CallOption) (*MsgSubmitExternalEventResponse, error) {
+	out := new(MsgSubmitExternalEventResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/SubmitExternalEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRequestBatchTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error) {
+	out := new(MsgDelegateKeysResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/SetDelegateKeys", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRequestBatchTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelTimelockedTransfer(ctx context.Context, in *MsgCancelTimelockedTransfer, opts ...grpc.CallOption) (*MsgCancelTimelockedTransferResponse, error) {
+	out := new(MsgCancelTimelockedTransferResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/CancelTimelockedTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRequestBatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RotateDelegateKeys(ctx context.Context, in *MsgRotateDelegateKeys, opts ...grpc.CallOption) (*MsgRotateDelegateKeysResponse, error) {
+	out := new(MsgRotateDelegateKeysResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/RotateDelegateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRequestBatchTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetDelegateKeysMulti(ctx context.Context, in *MsgDelegateKeysMulti, opts ...grpc.CallOption) (*MsgDelegateKeysMultiResponse, error) {
+	out := new(MsgDelegateKeysMultiResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Msg/SetDelegateKeysMulti", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRequestBatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SendToExternal(context.Context, *MsgSendToExternal) (*MsgSendToExternalResponse, error)
+	CancelSendToExternal(context.Context, *MsgCancelSendToExternal) (*MsgCancelSendToExternalResponse, error)
+	RequestBatchTx(context.Context, *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error)
+	SubmitTxConfirmation(context.Context, *MsgSubmitExternalTxConfirmation) (*MsgSubmitTxConfirmationResponse, error)
+	SubmitExternalEvent(context.Context, *MsgSubmitExternalEvent) (*MsgSubmitExternalEventResponse, error)
+	SetDelegateKeys(context.Context, *MsgDelegateKeys) (*MsgDelegateKeysResponse, error)
+	CancelTimelockedTransfer(context.Context, *MsgCancelTimelockedTransfer) (*MsgCancelTimelockedTransferResponse, error)
+	RotateDelegateKeys(context.Context, *MsgRotateDelegateKeys) (*MsgRotateDelegateKeysResponse, error)
+	SetDelegateKeysMulti(context.Context, *MsgDelegateKeysMulti) (*MsgDelegateKeysMultiResponse, error)
 }
 
-func (m *MsgRequestBatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgSubmitExternalTxConfirmation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SendToExternal(ctx context.Context, req *MsgSendToExternal) (*MsgSendToExternalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToExternal not implemented")
+}
+func (*UnimplementedMsgServer) CancelSendToExternal(ctx context.Context, req *MsgCancelSendToExternal) (*MsgCancelSendToExternalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToExternal not implemented")
+}
+func (*UnimplementedMsgServer) RequestBatchTx(ctx context.Context, req *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBatchTx not implemented")
+}
+func (*UnimplementedMsgServer) SubmitTxConfirmation(ctx context.Context, req *MsgSubmitExternalTxConfirmation) (*MsgSubmitTxConfirmationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTxConfirmation not implemented")
+}
+func (*UnimplementedMsgServer) SubmitExternalEvent(ctx context.Context, req *MsgSubmitExternalEvent) (*MsgSubmitExternalEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitExternalEvent not implemented")
+}
+func (*UnimplementedMsgServer) SetDelegateKeys(ctx context.Context, req *MsgDelegateKeys) (*MsgDelegateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateKeys not implemented")
+}
+func (*UnimplementedMsgServer) CancelTimelockedTransfer(ctx context.Context, req *MsgCancelTimelockedTransfer) (*MsgCancelTimelockedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTimelockedTransfer not implemented")
+}
+func (*UnimplementedMsgServer) RotateDelegateKeys(ctx context.Context, req *MsgRotateDelegateKeys) (*MsgRotateDelegateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDelegateKeys not implemented")
+}
+func (*UnimplementedMsgServer) SetDelegateKeysMulti(ctx context.Context, req *MsgDelegateKeysMulti) (*MsgDelegateKeysMultiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateKeysMulti not implemented")
 }
 
-func (m *MsgSubmitExternalTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgSubmitExternalTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_SendToExternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendToExternal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).SendToExternal(ctx, in)
 	}
-	if m.Confirmation != nil {
-		{
-			size, err := m.Confirmation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Msg/SendToExternal",
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ContractCallTxConfirmation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendToExternal(ctx, req.(*MsgSendToExternal))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractCallTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractCallTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_CancelSendToExternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSendToExternal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ExternalSigner) > 0 {
-		i -= len(m.ExternalSigner)
-		copy(dAtA[i:], m.ExternalSigner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalSigner)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSendToExternal(ctx, in)
 	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Msg/CancelSendToExternal",
 	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSendToExternal(ctx, req.(*MsgCancelSendToExternal))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxConfirmation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RequestBatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestBatchTx)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RequestBatchTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Msg/RequestBatchTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestBatchTx(ctx, req.(*MsgRequestBatchTx))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitTxConfirmation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitExternalTxConfirmation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitTxConfirmation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Msg/SubmitTxConfirmation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitTxConfirmation(ctx, req.(*MsgSubmitExternalTxConfirmation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_SubmitExternalEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitExternalEvent)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ExternalSigner) > 0 {
-		i -= len(m.ExternalSigner)
-		copy(dAtA[i:], m.ExternalSigner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalSigner)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitExternalEvent(ctx, in)
 	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Msg/SubmitExternalEvent",
 	}
-	if len(m.ExternalTokenId) > 0 {
-		i -= len(m.ExternalTokenId)
-		copy(dAtA[i:], m.ExternalTokenId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalTokenId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitExternalEvent(ctx, req.(*MsgSubmitExternalEvent))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SignerSetTxConfirmation) Marshal() (dAtA []byte, err error) {
+func _Msg_SetDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Msg/SetDelegateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegateKeys(ctx, req.(*MsgDelegateKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTimelockedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTimelockedTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTimelockedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Msg/CancelTimelockedTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTimelockedTransfer(ctx, req.(*MsgCancelTimelockedTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateDelegateKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateDelegateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Msg/RotateDelegateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateDelegateKeys(ctx, req.(*MsgRotateDelegateKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDelegateKeysMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateKeysMulti)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegateKeysMulti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Msg/SetDelegateKeysMulti",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegateKeysMulti(ctx, req.(*MsgDelegateKeysMulti))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mhub2.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendToExternal",
+			Handler:    _Msg_SendToExternal_Handler,
+		},
+		{
+			MethodName: "CancelSendToExternal",
+			Handler:    _Msg_CancelSendToExternal_Handler,
+		},
+		{
+			MethodName: "RequestBatchTx",
+			Handler:    _Msg_RequestBatchTx_Handler,
+		},
+		{
+			MethodName: "SubmitTxConfirmation",
+			Handler:    _Msg_SubmitTxConfirmation_Handler,
+		},
+		{
+			MethodName: "SubmitExternalEvent",
+			Handler:    _Msg_SubmitExternalEvent_Handler,
+		},
+		{
+			MethodName: "SetDelegateKeys",
+			Handler:    _Msg_SetDelegateKeys_Handler,
+		},
+		{
+			MethodName: "CancelTimelockedTransfer",
+			Handler:    _Msg_CancelTimelockedTransfer_Handler,
+		},
+		{
+			MethodName: "RotateDelegateKeys",
+			Handler:    _Msg_RotateDelegateKeys_Handler,
+		},
+		{
+			MethodName: "SetDelegateKeysMulti",
+			Handler:    _Msg_SetDelegateKeysMulti_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mhub2/v1/msgs.proto",
+}
+
+func (m *MsgSendToExternal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2165,39 +2447,61 @@ func (m *SignerSetTxConfirmation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignerSetTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendToExternal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignerSetTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendToExternal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if len(m.ExternalSigner) > 0 {
-		i -= len(m.ExternalSigner)
-		copy(dAtA[i:], m.ExternalSigner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalSigner)))
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ExternalRecipient) > 0 {
+		i -= len(m.ExternalRecipient)
+		copy(dAtA[i:], m.ExternalRecipient)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalRecipient)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.SignerSetNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SignerSetNonce))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitTxConfirmationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendToExternalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2207,20 +2511,25 @@ func (m *MsgSubmitTxConfirmationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitTxConfirmationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendToExternalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitTxConfirmationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendToExternalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Id != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitExternalEvent) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelSendToExternal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2230,12 +2539,12 @@ func (m *MsgSubmitExternalEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitExternalEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToExternal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitExternalEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToExternal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2247,29 +2556,22 @@ func (m *MsgSubmitExternalEvent) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Event != nil {
-		{
-			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgs(dAtA, i, uint64(size))
-		}
+	if m.Id != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitExternalEventResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelSendToExternalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2279,12 +2581,12 @@ func (m *MsgSubmitExternalEventResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitExternalEventResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToExternalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitExternalEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToExternalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2292,7 +2594,7 @@ func (m *MsgSubmitExternalEventResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateKeys) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelTimelockedTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2302,12 +2604,12 @@ func (m *MsgDelegateKeys) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelTimelockedTransfer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelTimelockedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2317,40 +2619,24 @@ func (m *MsgDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.ChainId)
 		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.EthSignature) > 0 {
-		i -= len(m.EthSignature)
-		copy(dAtA[i:], m.EthSignature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ExternalAddress) > 0 {
-		i -= len(m.ExternalAddress)
-		copy(dAtA[i:], m.ExternalAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalAddress)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Guardian)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if m.Id != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelTimelockedTransferResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2360,12 +2646,12 @@ func (m *MsgDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelTimelockedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelTimelockedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2373,7 +2659,7 @@ func (m *MsgDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
+func (m *MsgRequestBatchTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2383,32 +2669,41 @@ func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysSignMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRequestBatchTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRequestBatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SendToHubEvent) Marshal() (dAtA []byte, err error) {
+func (m *MsgRequestBatchTxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2418,68 +2713,69 @@ func (m *SendToHubEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SendToHubEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRequestBatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SendToHubEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRequestBatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.ExternalHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.ExternalHeight))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.CosmosReceiver) > 0 {
-		i -= len(m.CosmosReceiver)
-		copy(dAtA[i:], m.CosmosReceiver)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
-		i--
-		dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitExternalTxConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitExternalTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitExternalTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
 		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ExternalCoinId) > 0 {
-		i -= len(m.ExternalCoinId)
-		copy(dAtA[i:], m.ExternalCoinId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalCoinId)))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+	if m.Confirmation != nil {
+		{
+			size, err := m.Confirmation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TransferToChainEvent) Marshal() (dAtA []byte, err error) {
+func (m *ContractCallTxConfirmation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2489,85 +2785,46 @@ func (m *TransferToChainEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransferToChainEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractCallTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransferToChainEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractCallTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.ExternalHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.ExternalHeight))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.ExternalReceiver) > 0 {
-		i -= len(m.ExternalReceiver)
-		copy(dAtA[i:], m.ExternalReceiver)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalReceiver)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ReceiverChainId) > 0 {
-		i -= len(m.ReceiverChainId)
-		copy(dAtA[i:], m.ReceiverChainId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ReceiverChainId)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x22
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+	if len(m.ExternalSigner) > 0 {
+		i -= len(m.ExternalSigner)
+		copy(dAtA[i:], m.ExternalSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalSigner)))
 		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.Fee.Size()
-		i -= size
-		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ExternalCoinId) > 0 {
-		i -= len(m.ExternalCoinId)
-		copy(dAtA[i:], m.ExternalCoinId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalCoinId)))
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationScope)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchExecutedEvent) Marshal() (dAtA []byte, err error) {
+func (m *BatchTxConfirmation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2577,66 +2834,46 @@ func (m *BatchExecutedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FeePayer) > 0 {
-		i -= len(m.FeePayer)
-		copy(dAtA[i:], m.FeePayer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.FeePayer)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x22
 	}
-	{
-		size := m.FeePaid.Size()
-		i -= size
-		if _, err := m.FeePaid.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxHash)))
+	if len(m.ExternalSigner) > 0 {
+		i -= len(m.ExternalSigner)
+		copy(dAtA[i:], m.ExternalSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalSigner)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
 	if m.BatchNonce != 0 {
 		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
 		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExternalHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.ExternalHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
-		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.ExternalCoinId) > 0 {
-		i -= len(m.ExternalCoinId)
-		copy(dAtA[i:], m.ExternalCoinId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalCoinId)))
+	if len(m.ExternalTokenId) > 0 {
+		i -= len(m.ExternalTokenId)
+		copy(dAtA[i:], m.ExternalTokenId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalTokenId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractCallExecutedEvent) Marshal() (dAtA []byte, err error) {
+func (m *SignerSetTxConfirmation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2646,56 +2883,39 @@ func (m *ContractCallExecutedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractCallExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignerSetTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractCallExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignerSetTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.ExternalHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.ExternalHeight))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ReturnData) > 0 {
-		i -= len(m.ReturnData)
-		copy(dAtA[i:], m.ReturnData)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ReturnData)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationScope)))
+	if len(m.ExternalSigner) > 0 {
+		i -= len(m.ExternalSigner)
+		copy(dAtA[i:], m.ExternalSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalSigner)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SignerSetNonce))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SignerSetTxExecutedEvent) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitTxConfirmationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2705,513 +2925,2352 @@ func (m *SignerSetTxExecutedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignerSetTxExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitTxConfirmationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignerSetTxExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitTxConfirmationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMsgs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitExternalEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ExternalHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.ExternalHeight))
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitExternalEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitExternalEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.SignerSetTxNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SignerSetTxNonce))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSubmitExternalEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSendToExternal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.ExternalRecipient)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.BridgeFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+
+func (m *MsgSubmitExternalEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendToExternalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubmitExternalEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMsgs(uint64(m.Id))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelSendToExternal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMsgs(uint64(m.Id))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgDelegateKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelSendToExternalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBatchTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ExternalAddress) > 0 {
+		i -= len(m.ExternalAddress)
+		copy(dAtA[i:], m.ExternalAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBatchTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitExternalTxConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Confirmation != nil {
-		l = m.Confirmation.Size()
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+func (m *MsgDelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractCallTxConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
-	}
-	l = len(m.ExternalSigner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchTxConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ExternalTokenId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.BatchNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.BatchNonce))
-	}
-	l = len(m.ExternalSigner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgDelegateKeysMulti) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SignerSetTxConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDelegateKeysMulti) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateKeysMulti) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SignerSetNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.SignerSetNonce))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.ExternalSigner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitTxConfirmationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegateKeysMultiEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitExternalEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DelegateKeysMultiEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysMultiEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ExternalAddress) > 0 {
+		i -= len(m.ExternalAddress)
+		copy(dAtA[i:], m.ExternalAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitExternalEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateKeysMultiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateKeysMultiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateKeysMultiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateKeys) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRotateDelegateKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ExternalAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ExternalAddress) > 0 {
+		i -= len(m.ExternalAddress)
+		copy(dAtA[i:], m.ExternalAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.EthSignature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRotateDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysSignMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRotateDelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovMsgs(uint64(m.Nonce))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SendToHubEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegateKeysRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
-	}
-	l = len(m.ExternalCoinId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ExternalAddress) > 0 {
+		i -= len(m.ExternalAddress)
+		copy(dAtA[i:], m.ExternalAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.CosmosReceiver)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ExternalHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.ExternalHeight))
+	if len(m.PreviousExternalAddress) > 0 {
+		i -= len(m.PreviousExternalAddress)
+		copy(dAtA[i:], m.PreviousExternalAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PreviousExternalAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.PreviousOrchestratorAddress) > 0 {
+		i -= len(m.PreviousOrchestratorAddress)
+		copy(dAtA[i:], m.PreviousOrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PreviousOrchestratorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TransferToChainEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegateKeysHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
-	}
-	l = len(m.ExternalCoinId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.Fee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.ReceiverChainId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.ExternalReceiver)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ExternalHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.ExternalHeight))
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysSignMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchExecutedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SendToHubEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToHubEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToHubEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ExternalCoinId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
+	if m.ExternalHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ExternalHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ExternalCoinId) > 0 {
+		i -= len(m.ExternalCoinId)
+		copy(dAtA[i:], m.ExternalCoinId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalCoinId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferToChainEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferToChainEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferToChainEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x4a
 	}
 	if m.ExternalHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.ExternalHeight))
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ExternalHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ExternalReceiver) > 0 {
+		i -= len(m.ExternalReceiver)
+		copy(dAtA[i:], m.ExternalReceiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalReceiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ReceiverChainId) > 0 {
+		i -= len(m.ReceiverChainId)
+		copy(dAtA[i:], m.ReceiverChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ReceiverChainId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ExternalCoinId) > 0 {
+		i -= len(m.ExternalCoinId)
+		copy(dAtA[i:], m.ExternalCoinId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalCoinId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.FeePaid.Size()
+		i -= size
+		if _, err := m.FeePaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.BatchNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.BatchNonce))
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.ExternalHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ExternalHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.FeePaid.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = len(m.FeePayer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ExternalCoinId) > 0 {
+		i -= len(m.ExternalCoinId)
+		copy(dAtA[i:], m.ExternalCoinId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExternalCoinId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExternalHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ExternalHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ReturnData) > 0 {
+		i -= len(m.ReturnData)
+		copy(dAtA[i:], m.ReturnData)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ReturnData)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExternalHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ExternalHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignerSetTxNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SignerSetTxNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSendToExternal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ExternalRecipient)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendToExternalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMsgs(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelSendToExternal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMsgs(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelSendToExternalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelTimelockedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMsgs(uint64(m.Id))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelTimelockedTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestBatchTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestBatchTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitExternalTxConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Confirmation != nil {
+		l = m.Confirmation.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	}
+	l = len(m.ExternalSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExternalTokenId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.BatchNonce))
+	}
+	l = len(m.ExternalSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.SignerSetNonce))
+	}
+	l = len(m.ExternalSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitTxConfirmationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitExternalEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitExternalEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ExternalAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateKeysMulti) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegateKeysMultiEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ExternalAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateKeysMultiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateDelegateKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ExternalAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateDelegateKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DelegateKeysRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousOrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.PreviousExternalAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ExternalAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMsgs(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DelegateKeysHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegateKeysSignMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovMsgs(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *SendToHubEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.ExternalCoinId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ExternalHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.ExternalHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferToChainEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.ExternalCoinId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ReceiverChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ExternalReceiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ExternalHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.ExternalHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExternalCoinId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.ExternalHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.ExternalHeight))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.BatchNonce))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.FeePaid.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	}
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ExternalHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.ExternalHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.SignerSetTxNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.SignerSetTxNonce))
+	}
+	if m.ExternalHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.ExternalHeight))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovMsgs(uint64(m.Threshold))
+	}
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSendToExternal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToExternal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToExternal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendToExternalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToExternalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToExternalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToExternal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToExternal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToExternal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToExternalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToExternalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToExternalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTimelockedTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTimelockedTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTimelockedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTimelockedTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTimelockedTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTimelockedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestBatchTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestBatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ContractCallExecutedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
-	}
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
-	}
-	l = len(m.ReturnData)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.ExternalHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.ExternalHeight))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *SignerSetTxExecutedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
-	}
-	if m.SignerSetTxNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.SignerSetTxNonce))
-	}
-	if m.ExternalHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.ExternalHeight))
-	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgRequestBatchTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestBatchTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestBatchTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
-}
 
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSendToExternal) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitExternalTxConfirmation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3234,15 +5293,83 @@ func (m *MsgSendToExternal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToExternal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitExternalTxConfirmation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToExternal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitExternalTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Confirmation == nil {
+				m.Confirmation = &types1.Any{}
+			}
+			if err := m.Confirmation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3270,13 +5397,66 @@ func (m *MsgSendToExternal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3286,29 +5466,31 @@ func (m *MsgSendToExternal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalRecipient = string(dAtA[iNdEx:postIndex])
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
 			}
-			var msglen int
+			m.InvalidationNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3318,30 +5500,16 @@ func (m *MsgSendToExternal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalSigner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3351,30 +5519,29 @@ func (m *MsgSendToExternal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExternalSigner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3384,23 +5551,25 @@ func (m *MsgSendToExternal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3426,7 +5595,7 @@ func (m *MsgSendToExternal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendToExternalResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3449,17 +5618,49 @@ func (m *MsgSendToExternalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToExternalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTxConfirmation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToExternalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalTokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalTokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
 			}
-			m.Id = 0
+			m.BatchNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3469,11 +5670,77 @@ func (m *MsgSendToExternalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3498,7 +5765,7 @@ func (m *MsgSendToExternalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToExternal) Unmarshal(dAtA []byte) error {
+func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3521,17 +5788,17 @@ func (m *MsgCancelSendToExternal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToExternal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerSetTxConfirmation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToExternal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerSetTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
 			}
-			m.Id = 0
+			m.SignerSetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3541,14 +5808,14 @@ func (m *MsgCancelSendToExternal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalSigner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3576,13 +5843,13 @@ func (m *MsgCancelSendToExternal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.ExternalSigner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3592,23 +5859,25 @@ func (m *MsgCancelSendToExternal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3634,7 +5903,7 @@ func (m *MsgCancelSendToExternal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToExternalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitTxConfirmationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3657,10 +5926,10 @@ func (m *MsgCancelSendToExternalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToExternalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitTxConfirmationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToExternalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitTxConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3687,7 +5956,7 @@ func (m *MsgCancelSendToExternalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitExternalEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3710,17 +5979,17 @@ func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBatchTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitExternalEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitExternalEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3730,23 +5999,27 @@ func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.Event == nil {
+				m.Event = &types1.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3836,7 +6109,7 @@ func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBatchTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitExternalEventResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3859,10 +6132,10 @@ func (m *MsgRequestBatchTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBatchTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitExternalEventResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBatchTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitExternalEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3889,7 +6162,7 @@ func (m *MsgRequestBatchTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitExternalTxConfirmation) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3912,17 +6185,17 @@ func (m *MsgSubmitExternalTxConfirmation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitExternalTxConfirmation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateKeys: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitExternalTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirmation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3932,31 +6205,27 @@ func (m *MsgSubmitExternalTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Confirmation == nil {
-				m.Confirmation = &types1.Any{}
-			}
-			if err := m.Confirmation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3984,11 +6253,11 @@ func (m *MsgSubmitExternalTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4016,64 +6285,11 @@ func (m *MsgSubmitExternalTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ExternalAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxConfirmation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4100,33 +6316,14 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationScope == nil {
-				m.InvalidationScope = []byte{}
+			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthSignature == nil {
+				m.EthSignature = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
-			}
-			m.InvalidationNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4154,42 +6351,61 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalSigner = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -4214,7 +6430,7 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateKeysMulti) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4237,15 +6453,15 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxConfirmation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateKeysMulti: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateKeysMulti: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalTokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4273,30 +6489,11 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalTokenId = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
-			}
-			m.BatchNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4324,13 +6521,13 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalSigner = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4340,24 +6537,24 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			m.Entries = append(m.Entries, DelegateKeysMultiEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4384,7 +6581,7 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
+func (m *DelegateKeysMultiEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4407,17 +6604,17 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxConfirmation: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegateKeysMultiEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegateKeysMultiEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.SignerSetNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4427,14 +6624,27 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4462,11 +6672,11 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalSigner = string(dAtA[iNdEx:postIndex])
+			m.ExternalAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4493,9 +6703,9 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthSignature == nil {
+				m.EthSignature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4522,7 +6732,7 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitTxConfirmationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateKeysMultiResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4545,10 +6755,10 @@ func (m *MsgSubmitTxConfirmationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitTxConfirmationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateKeysMultiResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitTxConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateKeysMultiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4575,7 +6785,7 @@ func (m *MsgSubmitTxConfirmationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitExternalEvent) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateDelegateKeys) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4598,17 +6808,17 @@ func (m *MsgSubmitExternalEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitExternalEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateDelegateKeys: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitExternalEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4618,31 +6828,27 @@ func (m *MsgSubmitExternalEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &types1.Any{}
-			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4670,9 +6876,75 @@ func (m *MsgSubmitExternalEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthSignature == nil {
+				m.EthSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -4728,7 +7000,7 @@ func (m *MsgSubmitExternalEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitExternalEventResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateDelegateKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4751,10 +7023,10 @@ func (m *MsgSubmitExternalEventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitExternalEventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateDelegateKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitExternalEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4781,7 +7053,7 @@ func (m *MsgSubmitExternalEventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
+func (m *DelegateKeysRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4804,15 +7076,15 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateKeys: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegateKeysRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegateKeysRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4840,11 +7112,11 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.PreviousOrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousExternalAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4872,11 +7144,11 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.PreviousExternalAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4904,13 +7176,13 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalAddress = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4920,31 +7192,29 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.EthSignature == nil {
-				m.EthSignature = []byte{}
-			}
+			m.ExternalAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4954,24 +7224,11 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -4996,7 +7253,7 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
+func (m *DelegateKeysHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5019,12 +7276,78 @@ func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateKeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegateKeysHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegateKeysHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rotations = append(m.Rotations, DelegateKeysRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -6327,6 +8650,25 @@ func (m *SignerSetTxExecutedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -94,9 +94,11 @@ func (u SignerSetTx) GetCheckpoint(gravityID []byte) []byte {
 
 	memberAddresses := make([]gethcommon.Address, len(u.Signers))
 	convertedPowers := make([]*big.Int, len(u.Signers))
+	convertedWeights := make([]*big.Int, len(u.Signers))
 	for i, m := range u.Signers {
 		memberAddresses[i] = gethcommon.HexToAddress(m.ExternalAddress)
 		convertedPowers[i] = big.NewInt(int64(m.Power))
+		convertedWeights[i] = big.NewInt(int64(m.Weight))
 	}
 	// the word 'checkpoint' needs to be the same as the 'name' above in the checkpointAbiJson
 	// but other than that it's a constant that has no impact on the output. This is because
@@ -107,6 +109,8 @@ func (u SignerSetTx) GetCheckpoint(gravityID []byte) []byte {
 		big.NewInt(int64(u.Nonce)),
 		memberAddresses,
 		convertedPowers,
+		convertedWeights,
+		big.NewInt(int64(u.Threshold)),
 	}
 
 	return packCall(SignerSetTxCheckpointABIJSON, "checkpoint", args)
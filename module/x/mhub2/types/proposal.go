@@ -12,17 +12,29 @@ const (
 	// ProposalTypeColdStorageTransfer defines the type for a ColdStorageTransferProposal
 	ProposalTypeColdStorageTransfer = "ColdStorageTransfer"
 	ProposalTypeTokenInfosChange    = "TokenInfosChange"
+	ProposalTypeBlocklistChange     = "BlocklistChange"
+	ProposalTypeQuarantinedDeposit  = "QuarantinedDeposit"
+	ProposalTypeGuardianSetChange   = "GuardianSetChange"
 )
 
 // Assert ColdStorageTransferProposal implements govtypes.Content at compile-time
 var _ govtypes.Content = &ColdStorageTransferProposal{}
 var _ govtypes.Content = &TokenInfosChangeProposal{}
+var _ govtypes.Content = &BlocklistChangeProposal{}
+var _ govtypes.Content = &QuarantinedDepositProposal{}
+var _ govtypes.Content = &GuardianSetChangeProposal{}
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeColdStorageTransfer)
 	govtypes.RegisterProposalType(ProposalTypeTokenInfosChange)
+	govtypes.RegisterProposalType(ProposalTypeBlocklistChange)
+	govtypes.RegisterProposalType(ProposalTypeQuarantinedDeposit)
+	govtypes.RegisterProposalType(ProposalTypeGuardianSetChange)
 	govtypes.RegisterProposalTypeCodec(&ColdStorageTransferProposal{}, "mhub2/ColdStorageTransferProposal")
 	govtypes.RegisterProposalTypeCodec(&TokenInfosChangeProposal{}, "mhub2/TokenInfosChangeProposal")
+	govtypes.RegisterProposalTypeCodec(&BlocklistChangeProposal{}, "mhub2/BlocklistChangeProposal")
+	govtypes.RegisterProposalTypeCodec(&QuarantinedDepositProposal{}, "mhub2/QuarantinedDepositProposal")
+	govtypes.RegisterProposalTypeCodec(&GuardianSetChangeProposal{}, "mhub2/GuardianSetChangeProposal")
 }
 
 func NewColdStorageTransferProposal(chainId ChainID, amount sdk.Coins) *ColdStorageTransferProposal {
@@ -33,6 +45,18 @@ func NewTokenInfosChangeProposal(tokenInfos *TokenInfos) *TokenInfosChangePropos
 	return &TokenInfosChangeProposal{NewInfos: tokenInfos}
 }
 
+func NewBlocklistChangeProposal(add []string, remove []string) *BlocklistChangeProposal {
+	return &BlocklistChangeProposal{AddAddresses: add, RemoveAddresses: remove}
+}
+
+func NewQuarantinedDepositProposal(depositId uint64, resolution QuarantineResolution) *QuarantinedDepositProposal {
+	return &QuarantinedDepositProposal{DepositId: depositId, Resolution: resolution}
+}
+
+func NewGuardianSetChangeProposal(guardians []string) *GuardianSetChangeProposal {
+	return &GuardianSetChangeProposal{Guardians: guardians}
+}
+
 // GetTitle returns the title of a community pool spend proposal.
 func (csp *ColdStorageTransferProposal) GetTitle() string { return "ColdStorageTransferProposal" }
 
@@ -77,6 +101,22 @@ func (tic *TokenInfosChangeProposal) ValidateBasic() error {
 		return err
 	}
 
+	if tic.NewInfos == nil {
+		return nil
+	}
+
+	for _, info := range tic.NewInfos.TokenInfos {
+		if !info.MinAmount.IsNil() && info.MinAmount.IsNegative() {
+			return fmt.Errorf("negative min amount for %s on %s", info.Denom, info.ChainId)
+		}
+		if !info.MinFee.IsNil() && info.MinFee.IsNegative() {
+			return fmt.Errorf("negative min fee for %s on %s", info.Denom, info.ChainId)
+		}
+		if !info.TimelockThreshold.IsNil() && info.TimelockThreshold.IsNegative() {
+			return fmt.Errorf("negative timelock threshold for %s on %s", info.Denom, info.ChainId)
+		}
+	}
+
 	return nil
 }
 
@@ -87,3 +127,107 @@ func (tic TokenInfosChangeProposal) String() string {
   New Tokens:      %s`, tic.NewInfos))
 	return b.String()
 }
+
+func (bcp *BlocklistChangeProposal) GetTitle() string { return "BlocklistChangeProposal" }
+
+func (bcp *BlocklistChangeProposal) GetDescription() string { return "BlocklistChangeProposal" }
+
+func (bcp *BlocklistChangeProposal) ProposalRoute() string { return RouterKey }
+
+func (bcp *BlocklistChangeProposal) ProposalType() string { return ProposalTypeBlocklistChange }
+
+func (bcp *BlocklistChangeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(bcp)
+	if err != nil {
+		return err
+	}
+
+	if len(bcp.AddAddresses) == 0 && len(bcp.RemoveAddresses) == 0 {
+		return fmt.Errorf("no addresses to add or remove")
+	}
+
+	for _, address := range append(append([]string{}, bcp.AddAddresses...), bcp.RemoveAddresses...) {
+		if _, err := NormalizeBlocklistAddress(address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (bcp BlocklistChangeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Blocklist Change Proposal:
+  Add:      %s
+  Remove:   %s`, bcp.AddAddresses, bcp.RemoveAddresses))
+	return b.String()
+}
+
+func (qdp *QuarantinedDepositProposal) GetTitle() string { return "QuarantinedDepositProposal" }
+
+func (qdp *QuarantinedDepositProposal) GetDescription() string { return "QuarantinedDepositProposal" }
+
+func (qdp *QuarantinedDepositProposal) ProposalRoute() string { return RouterKey }
+
+func (qdp *QuarantinedDepositProposal) ProposalType() string { return ProposalTypeQuarantinedDeposit }
+
+func (qdp *QuarantinedDepositProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(qdp)
+	if err != nil {
+		return err
+	}
+
+	if qdp.Resolution != QUARANTINE_RESOLUTION_RELEASE && qdp.Resolution != QUARANTINE_RESOLUTION_RETURN {
+		return fmt.Errorf("invalid resolution %s", qdp.Resolution)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (qdp QuarantinedDepositProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Quarantined Deposit Proposal:
+  Deposit Id:      %d
+  Resolution:      %s`, qdp.DepositId, qdp.Resolution))
+	return b.String()
+}
+
+func (gsc *GuardianSetChangeProposal) GetTitle() string { return "GuardianSetChangeProposal" }
+
+func (gsc *GuardianSetChangeProposal) GetDescription() string { return "GuardianSetChangeProposal" }
+
+func (gsc *GuardianSetChangeProposal) ProposalRoute() string { return RouterKey }
+
+func (gsc *GuardianSetChangeProposal) ProposalType() string { return ProposalTypeGuardianSetChange }
+
+// ValidateBasic checks the new guardian set. An empty set is allowed and leaves held transfers
+// to be released without a possibility to cancel them.
+func (gsc *GuardianSetChangeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(gsc)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gsc.Guardians))
+	for _, guardian := range gsc.Guardians {
+		if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+			return fmt.Errorf("invalid guardian address %q: %w", guardian, err)
+		}
+		if seen[guardian] {
+			return fmt.Errorf("duplicate guardian %s", guardian)
+		}
+		seen[guardian] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (gsc GuardianSetChangeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Guardian Set Change Proposal:
+  Guardians:      %s`, gsc.Guardians))
+	return b.String()
+}
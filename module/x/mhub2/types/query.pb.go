@@ -369,22 +369,23 @@ func (m *DiscountForHolderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DiscountForHolderResponse proto.InternalMessageInfo
 
-//  rpc Params
-type ParamsRequest struct {
+type TransferMinimumsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
-func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*ParamsRequest) ProtoMessage()    {}
-func (*ParamsRequest) Descriptor() ([]byte, []int) {
+func (m *TransferMinimumsRequest) Reset()         { *m = TransferMinimumsRequest{} }
+func (m *TransferMinimumsRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferMinimumsRequest) ProtoMessage()    {}
+func (*TransferMinimumsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{8}
 }
-func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *TransferMinimumsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TransferMinimumsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TransferMinimumsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -394,34 +395,49 @@ func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParamsRequest.Merge(m, src)
+func (m *TransferMinimumsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferMinimumsRequest.Merge(m, src)
 }
-func (m *ParamsRequest) XXX_Size() int {
+func (m *TransferMinimumsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
+func (m *TransferMinimumsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferMinimumsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_TransferMinimumsRequest proto.InternalMessageInfo
 
-type ParamsResponse struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *TransferMinimumsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
-func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*ParamsResponse) ProtoMessage()    {}
-func (*ParamsResponse) Descriptor() ([]byte, []int) {
+func (m *TransferMinimumsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type TransferMinimumsResponse struct {
+	MinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_amount"`
+	MinFee    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee"`
+}
+
+func (m *TransferMinimumsResponse) Reset()         { *m = TransferMinimumsResponse{} }
+func (m *TransferMinimumsResponse) String() string { return proto.CompactTextString(m) }
+func (*TransferMinimumsResponse) ProtoMessage()    {}
+func (*TransferMinimumsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{9}
 }
-func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *TransferMinimumsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TransferMinimumsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TransferMinimumsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -431,43 +447,33 @@ func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParamsResponse.Merge(m, src)
+func (m *TransferMinimumsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferMinimumsResponse.Merge(m, src)
 }
-func (m *ParamsResponse) XXX_Size() int {
+func (m *TransferMinimumsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
+func (m *TransferMinimumsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferMinimumsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
-
-func (m *ParamsResponse) GetParams() Params {
-	if m != nil {
-		return m.Params
-	}
-	return Params{}
-}
+var xxx_messageInfo_TransferMinimumsResponse proto.InternalMessageInfo
 
-//  rpc SignerSetTx
-type SignerSetTxRequest struct {
-	SignerSetNonce uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
-	ChainId        string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type BlocklistRequest struct {
 }
 
-func (m *SignerSetTxRequest) Reset()         { *m = SignerSetTxRequest{} }
-func (m *SignerSetTxRequest) String() string { return proto.CompactTextString(m) }
-func (*SignerSetTxRequest) ProtoMessage()    {}
-func (*SignerSetTxRequest) Descriptor() ([]byte, []int) {
+func (m *BlocklistRequest) Reset()         { *m = BlocklistRequest{} }
+func (m *BlocklistRequest) String() string { return proto.CompactTextString(m) }
+func (*BlocklistRequest) ProtoMessage()    {}
+func (*BlocklistRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{10}
 }
-func (m *SignerSetTxRequest) XXX_Unmarshal(b []byte) error {
+func (m *BlocklistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SignerSetTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BlocklistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SignerSetTxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BlocklistRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -477,48 +483,34 @@ func (m *SignerSetTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SignerSetTxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignerSetTxRequest.Merge(m, src)
+func (m *BlocklistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocklistRequest.Merge(m, src)
 }
-func (m *SignerSetTxRequest) XXX_Size() int {
+func (m *BlocklistRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SignerSetTxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignerSetTxRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SignerSetTxRequest proto.InternalMessageInfo
-
-func (m *SignerSetTxRequest) GetSignerSetNonce() uint64 {
-	if m != nil {
-		return m.SignerSetNonce
-	}
-	return 0
+func (m *BlocklistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocklistRequest.DiscardUnknown(m)
 }
 
-func (m *SignerSetTxRequest) GetChainId() string {
-	if m != nil {
-		return m.ChainId
-	}
-	return ""
-}
+var xxx_messageInfo_BlocklistRequest proto.InternalMessageInfo
 
-type LatestSignerSetTxRequest struct {
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type BlocklistResponse struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (m *LatestSignerSetTxRequest) Reset()         { *m = LatestSignerSetTxRequest{} }
-func (m *LatestSignerSetTxRequest) String() string { return proto.CompactTextString(m) }
-func (*LatestSignerSetTxRequest) ProtoMessage()    {}
-func (*LatestSignerSetTxRequest) Descriptor() ([]byte, []int) {
+func (m *BlocklistResponse) Reset()         { *m = BlocklistResponse{} }
+func (m *BlocklistResponse) String() string { return proto.CompactTextString(m) }
+func (*BlocklistResponse) ProtoMessage()    {}
+func (*BlocklistResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{11}
 }
-func (m *LatestSignerSetTxRequest) XXX_Unmarshal(b []byte) error {
+func (m *BlocklistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LatestSignerSetTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BlocklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LatestSignerSetTxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BlocklistResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -528,41 +520,40 @@ func (m *LatestSignerSetTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *LatestSignerSetTxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LatestSignerSetTxRequest.Merge(m, src)
+func (m *BlocklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocklistResponse.Merge(m, src)
 }
-func (m *LatestSignerSetTxRequest) XXX_Size() int {
+func (m *BlocklistResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *LatestSignerSetTxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LatestSignerSetTxRequest.DiscardUnknown(m)
+func (m *BlocklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocklistResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LatestSignerSetTxRequest proto.InternalMessageInfo
+var xxx_messageInfo_BlocklistResponse proto.InternalMessageInfo
 
-func (m *LatestSignerSetTxRequest) GetChainId() string {
+func (m *BlocklistResponse) GetAddresses() []string {
 	if m != nil {
-		return m.ChainId
+		return m.Addresses
 	}
-	return ""
+	return nil
 }
 
-type LastObservedSignerSetTxRequest struct {
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type QuarantinedDepositsRequest struct {
 }
 
-func (m *LastObservedSignerSetTxRequest) Reset()         { *m = LastObservedSignerSetTxRequest{} }
-func (m *LastObservedSignerSetTxRequest) String() string { return proto.CompactTextString(m) }
-func (*LastObservedSignerSetTxRequest) ProtoMessage()    {}
-func (*LastObservedSignerSetTxRequest) Descriptor() ([]byte, []int) {
+func (m *QuarantinedDepositsRequest) Reset()         { *m = QuarantinedDepositsRequest{} }
+func (m *QuarantinedDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedDepositsRequest) ProtoMessage()    {}
+func (*QuarantinedDepositsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{12}
 }
-func (m *LastObservedSignerSetTxRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuarantinedDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LastObservedSignerSetTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuarantinedDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LastObservedSignerSetTxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuarantinedDepositsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -572,41 +563,34 @@ func (m *LastObservedSignerSetTxRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *LastObservedSignerSetTxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LastObservedSignerSetTxRequest.Merge(m, src)
+func (m *QuarantinedDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDepositsRequest.Merge(m, src)
 }
-func (m *LastObservedSignerSetTxRequest) XXX_Size() int {
+func (m *QuarantinedDepositsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *LastObservedSignerSetTxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LastObservedSignerSetTxRequest.DiscardUnknown(m)
+func (m *QuarantinedDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDepositsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LastObservedSignerSetTxRequest proto.InternalMessageInfo
-
-func (m *LastObservedSignerSetTxRequest) GetChainId() string {
-	if m != nil {
-		return m.ChainId
-	}
-	return ""
-}
+var xxx_messageInfo_QuarantinedDepositsRequest proto.InternalMessageInfo
 
-type SignerSetTxResponse struct {
-	SignerSet *SignerSetTx `protobuf:"bytes,1,opt,name=signer_set,json=signerSet,proto3" json:"signer_set,omitempty"`
+type QuarantinedDepositsResponse struct {
+	Deposits []QuarantinedDeposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits"`
 }
 
-func (m *SignerSetTxResponse) Reset()         { *m = SignerSetTxResponse{} }
-func (m *SignerSetTxResponse) String() string { return proto.CompactTextString(m) }
-func (*SignerSetTxResponse) ProtoMessage()    {}
-func (*SignerSetTxResponse) Descriptor() ([]byte, []int) {
+func (m *QuarantinedDepositsResponse) Reset()         { *m = QuarantinedDepositsResponse{} }
+func (m *QuarantinedDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedDepositsResponse) ProtoMessage()    {}
+func (*QuarantinedDepositsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{13}
 }
-func (m *SignerSetTxResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuarantinedDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SignerSetTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuarantinedDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SignerSetTxResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuarantinedDepositsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -616,44 +600,41 @@ func (m *SignerSetTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SignerSetTxResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignerSetTxResponse.Merge(m, src)
+func (m *QuarantinedDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDepositsResponse.Merge(m, src)
 }
-func (m *SignerSetTxResponse) XXX_Size() int {
+func (m *QuarantinedDepositsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SignerSetTxResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignerSetTxResponse.DiscardUnknown(m)
+func (m *QuarantinedDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDepositsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignerSetTxResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuarantinedDepositsResponse proto.InternalMessageInfo
 
-func (m *SignerSetTxResponse) GetSignerSet() *SignerSetTx {
+func (m *QuarantinedDepositsResponse) GetDeposits() []QuarantinedDeposit {
 	if m != nil {
-		return m.SignerSet
+		return m.Deposits
 	}
 	return nil
 }
 
-//  rpc BatchTx
-type BatchTxRequest struct {
-	ExternalTokenId string `protobuf:"bytes,1,opt,name=external_token_id,json=externalTokenId,proto3" json:"external_token_id,omitempty"`
-	BatchNonce      uint64 `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
-	ChainId         string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type TimelockedTransfersRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *BatchTxRequest) Reset()         { *m = BatchTxRequest{} }
-func (m *BatchTxRequest) String() string { return proto.CompactTextString(m) }
-func (*BatchTxRequest) ProtoMessage()    {}
-func (*BatchTxRequest) Descriptor() ([]byte, []int) {
+func (m *TimelockedTransfersRequest) Reset()         { *m = TimelockedTransfersRequest{} }
+func (m *TimelockedTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*TimelockedTransfersRequest) ProtoMessage()    {}
+func (*TimelockedTransfersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{14}
 }
-func (m *BatchTxRequest) XXX_Unmarshal(b []byte) error {
+func (m *TimelockedTransfersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TimelockedTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchTxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TimelockedTransfersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -663,55 +644,41 @@ func (m *BatchTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *BatchTxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchTxRequest.Merge(m, src)
+func (m *TimelockedTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockedTransfersRequest.Merge(m, src)
 }
-func (m *BatchTxRequest) XXX_Size() int {
+func (m *TimelockedTransfersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchTxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchTxRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_BatchTxRequest proto.InternalMessageInfo
-
-func (m *BatchTxRequest) GetExternalTokenId() string {
-	if m != nil {
-		return m.ExternalTokenId
-	}
-	return ""
+func (m *TimelockedTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockedTransfersRequest.DiscardUnknown(m)
 }
 
-func (m *BatchTxRequest) GetBatchNonce() uint64 {
-	if m != nil {
-		return m.BatchNonce
-	}
-	return 0
-}
+var xxx_messageInfo_TimelockedTransfersRequest proto.InternalMessageInfo
 
-func (m *BatchTxRequest) GetChainId() string {
+func (m *TimelockedTransfersRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type BatchTxResponse struct {
-	Batch *BatchTx `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+type TimelockedTransfersResponse struct {
+	Transfers []TimelockedTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
 }
 
-func (m *BatchTxResponse) Reset()         { *m = BatchTxResponse{} }
-func (m *BatchTxResponse) String() string { return proto.CompactTextString(m) }
-func (*BatchTxResponse) ProtoMessage()    {}
-func (*BatchTxResponse) Descriptor() ([]byte, []int) {
+func (m *TimelockedTransfersResponse) Reset()         { *m = TimelockedTransfersResponse{} }
+func (m *TimelockedTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*TimelockedTransfersResponse) ProtoMessage()    {}
+func (*TimelockedTransfersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{15}
 }
-func (m *BatchTxResponse) XXX_Unmarshal(b []byte) error {
+func (m *TimelockedTransfersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TimelockedTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchTxResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TimelockedTransfersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -721,44 +688,40 @@ func (m *BatchTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *BatchTxResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchTxResponse.Merge(m, src)
+func (m *TimelockedTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockedTransfersResponse.Merge(m, src)
 }
-func (m *BatchTxResponse) XXX_Size() int {
+func (m *TimelockedTransfersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchTxResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchTxResponse.DiscardUnknown(m)
+func (m *TimelockedTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockedTransfersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchTxResponse proto.InternalMessageInfo
+var xxx_messageInfo_TimelockedTransfersResponse proto.InternalMessageInfo
 
-func (m *BatchTxResponse) GetBatch() *BatchTx {
+func (m *TimelockedTransfersResponse) GetTransfers() []TimelockedTransfer {
 	if m != nil {
-		return m.Batch
+		return m.Transfers
 	}
 	return nil
 }
 
-//  rpc ContractCallTx
-type ContractCallTxRequest struct {
-	InvalidationScope []byte `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
-	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
-	ChainId           string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type GuardiansRequest struct {
 }
 
-func (m *ContractCallTxRequest) Reset()         { *m = ContractCallTxRequest{} }
-func (m *ContractCallTxRequest) String() string { return proto.CompactTextString(m) }
-func (*ContractCallTxRequest) ProtoMessage()    {}
-func (*ContractCallTxRequest) Descriptor() ([]byte, []int) {
+func (m *GuardiansRequest) Reset()         { *m = GuardiansRequest{} }
+func (m *GuardiansRequest) String() string { return proto.CompactTextString(m) }
+func (*GuardiansRequest) ProtoMessage()    {}
+func (*GuardiansRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{16}
 }
-func (m *ContractCallTxRequest) XXX_Unmarshal(b []byte) error {
+func (m *GuardiansRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractCallTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GuardiansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractCallTxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GuardiansRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -768,55 +731,34 @@ func (m *ContractCallTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ContractCallTxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractCallTxRequest.Merge(m, src)
+func (m *GuardiansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardiansRequest.Merge(m, src)
 }
-func (m *ContractCallTxRequest) XXX_Size() int {
+func (m *GuardiansRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractCallTxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractCallTxRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ContractCallTxRequest proto.InternalMessageInfo
-
-func (m *ContractCallTxRequest) GetInvalidationScope() []byte {
-	if m != nil {
-		return m.InvalidationScope
-	}
-	return nil
-}
-
-func (m *ContractCallTxRequest) GetInvalidationNonce() uint64 {
-	if m != nil {
-		return m.InvalidationNonce
-	}
-	return 0
+func (m *GuardiansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardiansRequest.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxRequest) GetChainId() string {
-	if m != nil {
-		return m.ChainId
-	}
-	return ""
-}
+var xxx_messageInfo_GuardiansRequest proto.InternalMessageInfo
 
-type ContractCallTxResponse struct {
-	LogicCall *ContractCallTx `protobuf:"bytes,1,opt,name=logic_call,json=logicCall,proto3" json:"logic_call,omitempty"`
+type GuardiansResponse struct {
+	Guardians []string `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
 }
 
-func (m *ContractCallTxResponse) Reset()         { *m = ContractCallTxResponse{} }
-func (m *ContractCallTxResponse) String() string { return proto.CompactTextString(m) }
-func (*ContractCallTxResponse) ProtoMessage()    {}
-func (*ContractCallTxResponse) Descriptor() ([]byte, []int) {
+func (m *GuardiansResponse) Reset()         { *m = GuardiansResponse{} }
+func (m *GuardiansResponse) String() string { return proto.CompactTextString(m) }
+func (*GuardiansResponse) ProtoMessage()    {}
+func (*GuardiansResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{17}
 }
-func (m *ContractCallTxResponse) XXX_Unmarshal(b []byte) error {
+func (m *GuardiansResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractCallTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GuardiansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractCallTxResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GuardiansResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -826,43 +768,41 @@ func (m *ContractCallTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ContractCallTxResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractCallTxResponse.Merge(m, src)
+func (m *GuardiansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardiansResponse.Merge(m, src)
 }
-func (m *ContractCallTxResponse) XXX_Size() int {
+func (m *GuardiansResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractCallTxResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractCallTxResponse.DiscardUnknown(m)
+func (m *GuardiansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardiansResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractCallTxResponse proto.InternalMessageInfo
+var xxx_messageInfo_GuardiansResponse proto.InternalMessageInfo
 
-func (m *ContractCallTxResponse) GetLogicCall() *ContractCallTx {
+func (m *GuardiansResponse) GetGuardians() []string {
 	if m != nil {
-		return m.LogicCall
+		return m.Guardians
 	}
 	return nil
 }
 
-// rpc SignerSetTxConfirmations
-type SignerSetTxConfirmationsRequest struct {
-	SignerSetNonce uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
-	ChainId        string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type SignerSetParamsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *SignerSetTxConfirmationsRequest) Reset()         { *m = SignerSetTxConfirmationsRequest{} }
-func (m *SignerSetTxConfirmationsRequest) String() string { return proto.CompactTextString(m) }
-func (*SignerSetTxConfirmationsRequest) ProtoMessage()    {}
-func (*SignerSetTxConfirmationsRequest) Descriptor() ([]byte, []int) {
+func (m *SignerSetParamsRequest) Reset()         { *m = SignerSetParamsRequest{} }
+func (m *SignerSetParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*SignerSetParamsRequest) ProtoMessage()    {}
+func (*SignerSetParamsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{18}
 }
-func (m *SignerSetTxConfirmationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *SignerSetParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SignerSetTxConfirmationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignerSetParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SignerSetTxConfirmationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignerSetParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -872,48 +812,41 @@ func (m *SignerSetTxConfirmationsRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *SignerSetTxConfirmationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignerSetTxConfirmationsRequest.Merge(m, src)
+func (m *SignerSetParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetParamsRequest.Merge(m, src)
 }
-func (m *SignerSetTxConfirmationsRequest) XXX_Size() int {
+func (m *SignerSetParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SignerSetTxConfirmationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignerSetTxConfirmationsRequest.DiscardUnknown(m)
+func (m *SignerSetParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignerSetTxConfirmationsRequest proto.InternalMessageInfo
-
-func (m *SignerSetTxConfirmationsRequest) GetSignerSetNonce() uint64 {
-	if m != nil {
-		return m.SignerSetNonce
-	}
-	return 0
-}
+var xxx_messageInfo_SignerSetParamsRequest proto.InternalMessageInfo
 
-func (m *SignerSetTxConfirmationsRequest) GetChainId() string {
+func (m *SignerSetParamsRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type SignerSetTxConfirmationsResponse struct {
-	Signatures []*SignerSetTxConfirmation `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+type SignerSetParamsResponse struct {
+	Params SignerSetParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *SignerSetTxConfirmationsResponse) Reset()         { *m = SignerSetTxConfirmationsResponse{} }
-func (m *SignerSetTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
-func (*SignerSetTxConfirmationsResponse) ProtoMessage()    {}
-func (*SignerSetTxConfirmationsResponse) Descriptor() ([]byte, []int) {
+func (m *SignerSetParamsResponse) Reset()         { *m = SignerSetParamsResponse{} }
+func (m *SignerSetParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*SignerSetParamsResponse) ProtoMessage()    {}
+func (*SignerSetParamsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{19}
 }
-func (m *SignerSetTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *SignerSetParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SignerSetTxConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignerSetParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SignerSetTxConfirmationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignerSetParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -923,43 +856,42 @@ func (m *SignerSetTxConfirmationsResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *SignerSetTxConfirmationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignerSetTxConfirmationsResponse.Merge(m, src)
+func (m *SignerSetParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetParamsResponse.Merge(m, src)
 }
-func (m *SignerSetTxConfirmationsResponse) XXX_Size() int {
+func (m *SignerSetParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SignerSetTxConfirmationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignerSetTxConfirmationsResponse.DiscardUnknown(m)
+func (m *SignerSetParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignerSetTxConfirmationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_SignerSetParamsResponse proto.InternalMessageInfo
 
-func (m *SignerSetTxConfirmationsResponse) GetSignatures() []*SignerSetTxConfirmation {
+func (m *SignerSetParamsResponse) GetParams() SignerSetParams {
 	if m != nil {
-		return m.Signatures
+		return m.Params
 	}
-	return nil
+	return SignerSetParams{}
 }
 
-//  rpc SignerSetTxs
-type SignerSetTxsRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	ChainId    string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type TransferExpiryRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *SignerSetTxsRequest) Reset()         { *m = SignerSetTxsRequest{} }
-func (m *SignerSetTxsRequest) String() string { return proto.CompactTextString(m) }
-func (*SignerSetTxsRequest) ProtoMessage()    {}
-func (*SignerSetTxsRequest) Descriptor() ([]byte, []int) {
+func (m *TransferExpiryRequest) Reset()         { *m = TransferExpiryRequest{} }
+func (m *TransferExpiryRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferExpiryRequest) ProtoMessage()    {}
+func (*TransferExpiryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{20}
 }
-func (m *SignerSetTxsRequest) XXX_Unmarshal(b []byte) error {
+func (m *TransferExpiryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SignerSetTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TransferExpiryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SignerSetTxsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TransferExpiryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -969,49 +901,51 @@ func (m *SignerSetTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SignerSetTxsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignerSetTxsRequest.Merge(m, src)
+func (m *TransferExpiryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferExpiryRequest.Merge(m, src)
 }
-func (m *SignerSetTxsRequest) XXX_Size() int {
+func (m *TransferExpiryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SignerSetTxsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignerSetTxsRequest.DiscardUnknown(m)
+func (m *TransferExpiryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferExpiryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignerSetTxsRequest proto.InternalMessageInfo
+var xxx_messageInfo_TransferExpiryRequest proto.InternalMessageInfo
 
-func (m *SignerSetTxsRequest) GetPagination() *query.PageRequest {
+func (m *TransferExpiryRequest) GetChainId() string {
 	if m != nil {
-		return m.Pagination
+		return m.ChainId
 	}
-	return nil
+	return ""
 }
 
-func (m *SignerSetTxsRequest) GetChainId() string {
+func (m *TransferExpiryRequest) GetId() uint64 {
 	if m != nil {
-		return m.ChainId
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-type SignerSetTxsResponse struct {
-	SignerSets []*SignerSetTx      `protobuf:"bytes,1,rep,name=signer_sets,json=signerSets,proto3" json:"signer_sets,omitempty"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type TransferExpiryResponse struct {
+	Transfer *SendToExternal `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	// unix time after which the transfer is refunded if it is still not batched,
+	// zero while the transfer is held by the withdrawal timelock
+	ExpiresAt uint64 `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (m *SignerSetTxsResponse) Reset()         { *m = SignerSetTxsResponse{} }
-func (m *SignerSetTxsResponse) String() string { return proto.CompactTextString(m) }
-func (*SignerSetTxsResponse) ProtoMessage()    {}
-func (*SignerSetTxsResponse) Descriptor() ([]byte, []int) {
+func (m *TransferExpiryResponse) Reset()         { *m = TransferExpiryResponse{} }
+func (m *TransferExpiryResponse) String() string { return proto.CompactTextString(m) }
+func (*TransferExpiryResponse) ProtoMessage()    {}
+func (*TransferExpiryResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{21}
 }
-func (m *SignerSetTxsResponse) XXX_Unmarshal(b []byte) error {
+func (m *TransferExpiryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SignerSetTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TransferExpiryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SignerSetTxsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TransferExpiryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1021,50 +955,53 @@ func (m *SignerSetTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *SignerSetTxsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignerSetTxsResponse.Merge(m, src)
+func (m *TransferExpiryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferExpiryResponse.Merge(m, src)
 }
-func (m *SignerSetTxsResponse) XXX_Size() int {
+func (m *TransferExpiryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SignerSetTxsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignerSetTxsResponse.DiscardUnknown(m)
+func (m *TransferExpiryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferExpiryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignerSetTxsResponse proto.InternalMessageInfo
+var xxx_messageInfo_TransferExpiryResponse proto.InternalMessageInfo
 
-func (m *SignerSetTxsResponse) GetSignerSets() []*SignerSetTx {
+func (m *TransferExpiryResponse) GetTransfer() *SendToExternal {
 	if m != nil {
-		return m.SignerSets
+		return m.Transfer
 	}
 	return nil
 }
 
-func (m *SignerSetTxsResponse) GetPagination() *query.PageResponse {
+func (m *TransferExpiryResponse) GetExpiresAt() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.ExpiresAt
 	}
-	return nil
+	return 0
 }
 
-//  rpc BatchTxs
-type BatchTxsRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	ChainId    string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+// bridge_fee is optional, the suggested min fee is used if it is not set
+type EstimateSendToExternalRequest struct {
+	ChainId           string     `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Sender            string     `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	ExternalRecipient string     `protobuf:"bytes,3,opt,name=external_recipient,json=externalRecipient,proto3" json:"external_recipient,omitempty"`
+	Amount            types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	BridgeFee         types.Coin `protobuf:"bytes,5,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
 }
 
-func (m *BatchTxsRequest) Reset()         { *m = BatchTxsRequest{} }
-func (m *BatchTxsRequest) String() string { return proto.CompactTextString(m) }
-func (*BatchTxsRequest) ProtoMessage()    {}
-func (*BatchTxsRequest) Descriptor() ([]byte, []int) {
+func (m *EstimateSendToExternalRequest) Reset()         { *m = EstimateSendToExternalRequest{} }
+func (m *EstimateSendToExternalRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateSendToExternalRequest) ProtoMessage()    {}
+func (*EstimateSendToExternalRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{22}
 }
-func (m *BatchTxsRequest) XXX_Unmarshal(b []byte) error {
+func (m *EstimateSendToExternalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EstimateSendToExternalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchTxsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EstimateSendToExternalRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1074,49 +1011,80 @@ func (m *BatchTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *BatchTxsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchTxsRequest.Merge(m, src)
+func (m *EstimateSendToExternalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateSendToExternalRequest.Merge(m, src)
 }
-func (m *BatchTxsRequest) XXX_Size() int {
+func (m *EstimateSendToExternalRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchTxsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchTxsRequest.DiscardUnknown(m)
+func (m *EstimateSendToExternalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateSendToExternalRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchTxsRequest proto.InternalMessageInfo
+var xxx_messageInfo_EstimateSendToExternalRequest proto.InternalMessageInfo
 
-func (m *BatchTxsRequest) GetPagination() *query.PageRequest {
+func (m *EstimateSendToExternalRequest) GetChainId() string {
 	if m != nil {
-		return m.Pagination
+		return m.ChainId
 	}
-	return nil
+	return ""
 }
 
-func (m *BatchTxsRequest) GetChainId() string {
+func (m *EstimateSendToExternalRequest) GetSender() string {
 	if m != nil {
-		return m.ChainId
+		return m.Sender
 	}
 	return ""
 }
 
-type BatchTxsResponse struct {
-	Batches    []*BatchTx          `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *EstimateSendToExternalRequest) GetExternalRecipient() string {
+	if m != nil {
+		return m.ExternalRecipient
+	}
+	return ""
 }
 
-func (m *BatchTxsResponse) Reset()         { *m = BatchTxsResponse{} }
-func (m *BatchTxsResponse) String() string { return proto.CompactTextString(m) }
-func (*BatchTxsResponse) ProtoMessage()    {}
-func (*BatchTxsResponse) Descriptor() ([]byte, []int) {
+func (m *EstimateSendToExternalRequest) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EstimateSendToExternalRequest) GetBridgeFee() types.Coin {
+	if m != nil {
+		return m.BridgeFee
+	}
+	return types.Coin{}
+}
+
+type EstimateSendToExternalResponse struct {
+	Commission types.Coin `protobuf:"bytes,1,opt,name=commission,proto3" json:"commission"`
+	BridgeFee  types.Coin `protobuf:"bytes,2,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
+	// bridge fees which cover the min and fast gas of the chain at the oracle prices,
+	// but not less than the min fee of the token
+	MinFee  types.Coin `protobuf:"bytes,3,opt,name=min_fee,json=minFee,proto3" json:"min_fee"`
+	FastFee types.Coin `protobuf:"bytes,4,opt,name=fast_fee,json=fastFee,proto3" json:"fast_fee"`
+	// amount the recipient receives, in the external decimals of the token
+	AmountReceived github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount_received,json=amountReceived,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount_received"`
+	// whether the transfer is held by the withdrawal timelock
+	Timelocked bool `protobuf:"varint,6,opt,name=timelocked,proto3" json:"timelocked,omitempty"`
+	// reasons MsgSendToExternal with these values would be rejected
+	Violations []string `protobuf:"bytes,7,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (m *EstimateSendToExternalResponse) Reset()         { *m = EstimateSendToExternalResponse{} }
+func (m *EstimateSendToExternalResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateSendToExternalResponse) ProtoMessage()    {}
+func (*EstimateSendToExternalResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{23}
 }
-func (m *BatchTxsResponse) XXX_Unmarshal(b []byte) error {
+func (m *EstimateSendToExternalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EstimateSendToExternalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchTxsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EstimateSendToExternalResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1126,50 +1094,76 @@ func (m *BatchTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *BatchTxsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchTxsResponse.Merge(m, src)
+func (m *EstimateSendToExternalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateSendToExternalResponse.Merge(m, src)
 }
-func (m *BatchTxsResponse) XXX_Size() int {
+func (m *EstimateSendToExternalResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchTxsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchTxsResponse.DiscardUnknown(m)
+func (m *EstimateSendToExternalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateSendToExternalResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchTxsResponse proto.InternalMessageInfo
+var xxx_messageInfo_EstimateSendToExternalResponse proto.InternalMessageInfo
 
-func (m *BatchTxsResponse) GetBatches() []*BatchTx {
+func (m *EstimateSendToExternalResponse) GetCommission() types.Coin {
 	if m != nil {
-		return m.Batches
+		return m.Commission
 	}
-	return nil
+	return types.Coin{}
 }
 
-func (m *BatchTxsResponse) GetPagination() *query.PageResponse {
+func (m *EstimateSendToExternalResponse) GetBridgeFee() types.Coin {
 	if m != nil {
-		return m.Pagination
+		return m.BridgeFee
+	}
+	return types.Coin{}
+}
+
+func (m *EstimateSendToExternalResponse) GetMinFee() types.Coin {
+	if m != nil {
+		return m.MinFee
+	}
+	return types.Coin{}
+}
+
+func (m *EstimateSendToExternalResponse) GetFastFee() types.Coin {
+	if m != nil {
+		return m.FastFee
+	}
+	return types.Coin{}
+}
+
+func (m *EstimateSendToExternalResponse) GetTimelocked() bool {
+	if m != nil {
+		return m.Timelocked
+	}
+	return false
+}
+
+func (m *EstimateSendToExternalResponse) GetViolations() []string {
+	if m != nil {
+		return m.Violations
 	}
 	return nil
 }
 
-//  rpc ContractCallTxs
-type ContractCallTxsRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	ChainId    string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+// rpc Params
+type ParamsRequest struct {
 }
 
-func (m *ContractCallTxsRequest) Reset()         { *m = ContractCallTxsRequest{} }
-func (m *ContractCallTxsRequest) String() string { return proto.CompactTextString(m) }
-func (*ContractCallTxsRequest) ProtoMessage()    {}
-func (*ContractCallTxsRequest) Descriptor() ([]byte, []int) {
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{24}
 }
-func (m *ContractCallTxsRequest) XXX_Unmarshal(b []byte) error {
+func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractCallTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractCallTxsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1179,49 +1173,34 @@ func (m *ContractCallTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ContractCallTxsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractCallTxsRequest.Merge(m, src)
+func (m *ParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRequest.Merge(m, src)
 }
-func (m *ContractCallTxsRequest) XXX_Size() int {
+func (m *ParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractCallTxsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractCallTxsRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ContractCallTxsRequest proto.InternalMessageInfo
-
-func (m *ContractCallTxsRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
+func (m *ParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxsRequest) GetChainId() string {
-	if m != nil {
-		return m.ChainId
-	}
-	return ""
-}
+var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
 
-type ContractCallTxsResponse struct {
-	Calls      []*ContractCallTx   `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type ParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *ContractCallTxsResponse) Reset()         { *m = ContractCallTxsResponse{} }
-func (m *ContractCallTxsResponse) String() string { return proto.CompactTextString(m) }
-func (*ContractCallTxsResponse) ProtoMessage()    {}
-func (*ContractCallTxsResponse) Descriptor() ([]byte, []int) {
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{25}
 }
-func (m *ContractCallTxsResponse) XXX_Unmarshal(b []byte) error {
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractCallTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractCallTxsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1231,52 +1210,43 @@ func (m *ContractCallTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *ContractCallTxsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractCallTxsResponse.Merge(m, src)
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
 }
-func (m *ContractCallTxsResponse) XXX_Size() int {
+func (m *ParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractCallTxsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractCallTxsResponse.DiscardUnknown(m)
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractCallTxsResponse proto.InternalMessageInfo
-
-func (m *ContractCallTxsResponse) GetCalls() []*ContractCallTx {
-	if m != nil {
-		return m.Calls
-	}
-	return nil
-}
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
 
-func (m *ContractCallTxsResponse) GetPagination() *query.PageResponse {
+func (m *ParamsResponse) GetParams() Params {
 	if m != nil {
-		return m.Pagination
+		return m.Params
 	}
-	return nil
+	return Params{}
 }
 
-// rpc UnsignedSignerSetTxs
-type UnsignedSignerSetTxsRequest struct {
-	// NOTE: this is an sdk.AccAddress and can represent either the
-	// orchestartor address or the cooresponding validator address
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+// rpc SignerSetTx
+type SignerSetTxRequest struct {
+	SignerSetNonce uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	ChainId        string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *UnsignedSignerSetTxsRequest) Reset()         { *m = UnsignedSignerSetTxsRequest{} }
-func (m *UnsignedSignerSetTxsRequest) String() string { return proto.CompactTextString(m) }
-func (*UnsignedSignerSetTxsRequest) ProtoMessage()    {}
-func (*UnsignedSignerSetTxsRequest) Descriptor() ([]byte, []int) {
+func (m *SignerSetTxRequest) Reset()         { *m = SignerSetTxRequest{} }
+func (m *SignerSetTxRequest) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxRequest) ProtoMessage()    {}
+func (*SignerSetTxRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{26}
 }
-func (m *UnsignedSignerSetTxsRequest) XXX_Unmarshal(b []byte) error {
+func (m *SignerSetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnsignedSignerSetTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignerSetTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnsignedSignerSetTxsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignerSetTxRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1286,48 +1256,48 @@ func (m *UnsignedSignerSetTxsRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *UnsignedSignerSetTxsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnsignedSignerSetTxsRequest.Merge(m, src)
+func (m *SignerSetTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxRequest.Merge(m, src)
 }
-func (m *UnsignedSignerSetTxsRequest) XXX_Size() int {
+func (m *SignerSetTxRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnsignedSignerSetTxsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnsignedSignerSetTxsRequest.DiscardUnknown(m)
+func (m *SignerSetTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnsignedSignerSetTxsRequest proto.InternalMessageInfo
+var xxx_messageInfo_SignerSetTxRequest proto.InternalMessageInfo
 
-func (m *UnsignedSignerSetTxsRequest) GetAddress() string {
+func (m *SignerSetTxRequest) GetSignerSetNonce() uint64 {
 	if m != nil {
-		return m.Address
+		return m.SignerSetNonce
 	}
-	return ""
+	return 0
 }
 
-func (m *UnsignedSignerSetTxsRequest) GetChainId() string {
+func (m *SignerSetTxRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type UnsignedSignerSetTxsResponse struct {
-	SignerSets []*SignerSetTx `protobuf:"bytes,1,rep,name=signer_sets,json=signerSets,proto3" json:"signer_sets,omitempty"`
+type LatestSignerSetTxRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *UnsignedSignerSetTxsResponse) Reset()         { *m = UnsignedSignerSetTxsResponse{} }
-func (m *UnsignedSignerSetTxsResponse) String() string { return proto.CompactTextString(m) }
-func (*UnsignedSignerSetTxsResponse) ProtoMessage()    {}
-func (*UnsignedSignerSetTxsResponse) Descriptor() ([]byte, []int) {
+func (m *LatestSignerSetTxRequest) Reset()         { *m = LatestSignerSetTxRequest{} }
+func (m *LatestSignerSetTxRequest) String() string { return proto.CompactTextString(m) }
+func (*LatestSignerSetTxRequest) ProtoMessage()    {}
+func (*LatestSignerSetTxRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{27}
 }
-func (m *UnsignedSignerSetTxsResponse) XXX_Unmarshal(b []byte) error {
+func (m *LatestSignerSetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnsignedSignerSetTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LatestSignerSetTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnsignedSignerSetTxsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LatestSignerSetTxRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1337,44 +1307,41 @@ func (m *UnsignedSignerSetTxsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *UnsignedSignerSetTxsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnsignedSignerSetTxsResponse.Merge(m, src)
+func (m *LatestSignerSetTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatestSignerSetTxRequest.Merge(m, src)
 }
-func (m *UnsignedSignerSetTxsResponse) XXX_Size() int {
+func (m *LatestSignerSetTxRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnsignedSignerSetTxsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnsignedSignerSetTxsResponse.DiscardUnknown(m)
+func (m *LatestSignerSetTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatestSignerSetTxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnsignedSignerSetTxsResponse proto.InternalMessageInfo
+var xxx_messageInfo_LatestSignerSetTxRequest proto.InternalMessageInfo
 
-func (m *UnsignedSignerSetTxsResponse) GetSignerSets() []*SignerSetTx {
+func (m *LatestSignerSetTxRequest) GetChainId() string {
 	if m != nil {
-		return m.SignerSets
+		return m.ChainId
 	}
-	return nil
+	return ""
 }
 
-type UnsignedBatchTxsRequest struct {
-	// NOTE: this is an sdk.AccAddress and can represent either the
-	// orchestrator address or the cooresponding validator address
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type LastObservedSignerSetTxRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *UnsignedBatchTxsRequest) Reset()         { *m = UnsignedBatchTxsRequest{} }
-func (m *UnsignedBatchTxsRequest) String() string { return proto.CompactTextString(m) }
-func (*UnsignedBatchTxsRequest) ProtoMessage()    {}
-func (*UnsignedBatchTxsRequest) Descriptor() ([]byte, []int) {
+func (m *LastObservedSignerSetTxRequest) Reset()         { *m = LastObservedSignerSetTxRequest{} }
+func (m *LastObservedSignerSetTxRequest) String() string { return proto.CompactTextString(m) }
+func (*LastObservedSignerSetTxRequest) ProtoMessage()    {}
+func (*LastObservedSignerSetTxRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{28}
 }
-func (m *UnsignedBatchTxsRequest) XXX_Unmarshal(b []byte) error {
+func (m *LastObservedSignerSetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnsignedBatchTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LastObservedSignerSetTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnsignedBatchTxsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LastObservedSignerSetTxRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1384,49 +1351,41 @@ func (m *UnsignedBatchTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *UnsignedBatchTxsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnsignedBatchTxsRequest.Merge(m, src)
+func (m *LastObservedSignerSetTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastObservedSignerSetTxRequest.Merge(m, src)
 }
-func (m *UnsignedBatchTxsRequest) XXX_Size() int {
+func (m *LastObservedSignerSetTxRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnsignedBatchTxsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnsignedBatchTxsRequest.DiscardUnknown(m)
+func (m *LastObservedSignerSetTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastObservedSignerSetTxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnsignedBatchTxsRequest proto.InternalMessageInfo
-
-func (m *UnsignedBatchTxsRequest) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
+var xxx_messageInfo_LastObservedSignerSetTxRequest proto.InternalMessageInfo
 
-func (m *UnsignedBatchTxsRequest) GetChainId() string {
+func (m *LastObservedSignerSetTxRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type UnsignedBatchTxsResponse struct {
-	// Note these are returned with the signature empty
-	Batches []*BatchTx `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+type SignerSetTxResponse struct {
+	SignerSet *SignerSetTx `protobuf:"bytes,1,opt,name=signer_set,json=signerSet,proto3" json:"signer_set,omitempty"`
 }
 
-func (m *UnsignedBatchTxsResponse) Reset()         { *m = UnsignedBatchTxsResponse{} }
-func (m *UnsignedBatchTxsResponse) String() string { return proto.CompactTextString(m) }
-func (*UnsignedBatchTxsResponse) ProtoMessage()    {}
-func (*UnsignedBatchTxsResponse) Descriptor() ([]byte, []int) {
+func (m *SignerSetTxResponse) Reset()         { *m = SignerSetTxResponse{} }
+func (m *SignerSetTxResponse) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxResponse) ProtoMessage()    {}
+func (*SignerSetTxResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{29}
 }
-func (m *UnsignedBatchTxsResponse) XXX_Unmarshal(b []byte) error {
+func (m *SignerSetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnsignedBatchTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignerSetTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnsignedBatchTxsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignerSetTxResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1436,43 +1395,44 @@ func (m *UnsignedBatchTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *UnsignedBatchTxsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnsignedBatchTxsResponse.Merge(m, src)
+func (m *SignerSetTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxResponse.Merge(m, src)
 }
-func (m *UnsignedBatchTxsResponse) XXX_Size() int {
+func (m *SignerSetTxResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnsignedBatchTxsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnsignedBatchTxsResponse.DiscardUnknown(m)
+func (m *SignerSetTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnsignedBatchTxsResponse proto.InternalMessageInfo
+var xxx_messageInfo_SignerSetTxResponse proto.InternalMessageInfo
 
-func (m *UnsignedBatchTxsResponse) GetBatches() []*BatchTx {
+func (m *SignerSetTxResponse) GetSignerSet() *SignerSetTx {
 	if m != nil {
-		return m.Batches
+		return m.SignerSet
 	}
 	return nil
 }
 
-//  rpc UnsignedContractCallTxs
-type UnsignedContractCallTxsRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+// rpc BatchTx
+type BatchTxRequest struct {
+	ExternalTokenId string `protobuf:"bytes,1,opt,name=external_token_id,json=externalTokenId,proto3" json:"external_token_id,omitempty"`
+	BatchNonce      uint64 `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	ChainId         string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *UnsignedContractCallTxsRequest) Reset()         { *m = UnsignedContractCallTxsRequest{} }
-func (m *UnsignedContractCallTxsRequest) String() string { return proto.CompactTextString(m) }
-func (*UnsignedContractCallTxsRequest) ProtoMessage()    {}
-func (*UnsignedContractCallTxsRequest) Descriptor() ([]byte, []int) {
+func (m *BatchTxRequest) Reset()         { *m = BatchTxRequest{} }
+func (m *BatchTxRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchTxRequest) ProtoMessage()    {}
+func (*BatchTxRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{30}
 }
-func (m *UnsignedContractCallTxsRequest) XXX_Unmarshal(b []byte) error {
+func (m *BatchTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnsignedContractCallTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnsignedContractCallTxsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchTxRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1482,48 +1442,55 @@ func (m *UnsignedContractCallTxsRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *UnsignedContractCallTxsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnsignedContractCallTxsRequest.Merge(m, src)
+func (m *BatchTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxRequest.Merge(m, src)
 }
-func (m *UnsignedContractCallTxsRequest) XXX_Size() int {
+func (m *BatchTxRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnsignedContractCallTxsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnsignedContractCallTxsRequest.DiscardUnknown(m)
+func (m *BatchTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnsignedContractCallTxsRequest proto.InternalMessageInfo
+var xxx_messageInfo_BatchTxRequest proto.InternalMessageInfo
 
-func (m *UnsignedContractCallTxsRequest) GetAddress() string {
+func (m *BatchTxRequest) GetExternalTokenId() string {
 	if m != nil {
-		return m.Address
+		return m.ExternalTokenId
 	}
 	return ""
 }
 
-func (m *UnsignedContractCallTxsRequest) GetChainId() string {
+func (m *BatchTxRequest) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *BatchTxRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type UnsignedContractCallTxsResponse struct {
-	Calls []*ContractCallTx `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+type BatchTxResponse struct {
+	Batch *BatchTx `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
 }
 
-func (m *UnsignedContractCallTxsResponse) Reset()         { *m = UnsignedContractCallTxsResponse{} }
-func (m *UnsignedContractCallTxsResponse) String() string { return proto.CompactTextString(m) }
-func (*UnsignedContractCallTxsResponse) ProtoMessage()    {}
-func (*UnsignedContractCallTxsResponse) Descriptor() ([]byte, []int) {
+func (m *BatchTxResponse) Reset()         { *m = BatchTxResponse{} }
+func (m *BatchTxResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchTxResponse) ProtoMessage()    {}
+func (*BatchTxResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{31}
 }
-func (m *UnsignedContractCallTxsResponse) XXX_Unmarshal(b []byte) error {
+func (m *BatchTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnsignedContractCallTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnsignedContractCallTxsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchTxResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1533,41 +1500,44 @@ func (m *UnsignedContractCallTxsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *UnsignedContractCallTxsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnsignedContractCallTxsResponse.Merge(m, src)
+func (m *BatchTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxResponse.Merge(m, src)
 }
-func (m *UnsignedContractCallTxsResponse) XXX_Size() int {
+func (m *BatchTxResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnsignedContractCallTxsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnsignedContractCallTxsResponse.DiscardUnknown(m)
+func (m *BatchTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnsignedContractCallTxsResponse proto.InternalMessageInfo
+var xxx_messageInfo_BatchTxResponse proto.InternalMessageInfo
 
-func (m *UnsignedContractCallTxsResponse) GetCalls() []*ContractCallTx {
+func (m *BatchTxResponse) GetBatch() *BatchTx {
 	if m != nil {
-		return m.Calls
+		return m.Batch
 	}
 	return nil
 }
 
-type BatchTxFeesRequest struct {
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+// rpc ContractCallTx
+type ContractCallTxRequest struct {
+	InvalidationScope []byte `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	ChainId           string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *BatchTxFeesRequest) Reset()         { *m = BatchTxFeesRequest{} }
-func (m *BatchTxFeesRequest) String() string { return proto.CompactTextString(m) }
-func (*BatchTxFeesRequest) ProtoMessage()    {}
-func (*BatchTxFeesRequest) Descriptor() ([]byte, []int) {
+func (m *ContractCallTxRequest) Reset()         { *m = ContractCallTxRequest{} }
+func (m *ContractCallTxRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractCallTxRequest) ProtoMessage()    {}
+func (*ContractCallTxRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{32}
 }
-func (m *BatchTxFeesRequest) XXX_Unmarshal(b []byte) error {
+func (m *ContractCallTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchTxFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractCallTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchTxFeesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractCallTxRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1577,41 +1547,55 @@ func (m *BatchTxFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *BatchTxFeesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchTxFeesRequest.Merge(m, src)
+func (m *ContractCallTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallTxRequest.Merge(m, src)
 }
-func (m *BatchTxFeesRequest) XXX_Size() int {
+func (m *ContractCallTxRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchTxFeesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchTxFeesRequest.DiscardUnknown(m)
+func (m *ContractCallTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallTxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchTxFeesRequest proto.InternalMessageInfo
+var xxx_messageInfo_ContractCallTxRequest proto.InternalMessageInfo
 
-func (m *BatchTxFeesRequest) GetChainId() string {
+func (m *ContractCallTxRequest) GetInvalidationScope() []byte {
 	if m != nil {
-		return m.ChainId
+		return m.InvalidationScope
 	}
-	return ""
+	return nil
 }
 
-type BatchTxFeesResponse struct {
-	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
-}
+func (m *ContractCallTxRequest) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
+	}
+	return 0
+}
 
-func (m *BatchTxFeesResponse) Reset()         { *m = BatchTxFeesResponse{} }
-func (m *BatchTxFeesResponse) String() string { return proto.CompactTextString(m) }
-func (*BatchTxFeesResponse) ProtoMessage()    {}
-func (*BatchTxFeesResponse) Descriptor() ([]byte, []int) {
+func (m *ContractCallTxRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type ContractCallTxResponse struct {
+	LogicCall *ContractCallTx `protobuf:"bytes,1,opt,name=logic_call,json=logicCall,proto3" json:"logic_call,omitempty"`
+}
+
+func (m *ContractCallTxResponse) Reset()         { *m = ContractCallTxResponse{} }
+func (m *ContractCallTxResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractCallTxResponse) ProtoMessage()    {}
+func (*ContractCallTxResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{33}
 }
-func (m *BatchTxFeesResponse) XXX_Unmarshal(b []byte) error {
+func (m *ContractCallTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchTxFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractCallTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchTxFeesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractCallTxResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1621,43 +1605,43 @@ func (m *BatchTxFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *BatchTxFeesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchTxFeesResponse.Merge(m, src)
+func (m *ContractCallTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallTxResponse.Merge(m, src)
 }
-func (m *BatchTxFeesResponse) XXX_Size() int {
+func (m *ContractCallTxResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchTxFeesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchTxFeesResponse.DiscardUnknown(m)
+func (m *ContractCallTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallTxResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchTxFeesResponse proto.InternalMessageInfo
+var xxx_messageInfo_ContractCallTxResponse proto.InternalMessageInfo
 
-func (m *BatchTxFeesResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *ContractCallTxResponse) GetLogicCall() *ContractCallTx {
 	if m != nil {
-		return m.Fees
+		return m.LogicCall
 	}
 	return nil
 }
 
-type ContractCallTxConfirmationsRequest struct {
-	InvalidationScope []byte `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
-	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
-	ChainId           string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+// rpc SignerSetTxConfirmations
+type SignerSetTxConfirmationsRequest struct {
+	SignerSetNonce uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	ChainId        string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *ContractCallTxConfirmationsRequest) Reset()         { *m = ContractCallTxConfirmationsRequest{} }
-func (m *ContractCallTxConfirmationsRequest) String() string { return proto.CompactTextString(m) }
-func (*ContractCallTxConfirmationsRequest) ProtoMessage()    {}
-func (*ContractCallTxConfirmationsRequest) Descriptor() ([]byte, []int) {
+func (m *SignerSetTxConfirmationsRequest) Reset()         { *m = SignerSetTxConfirmationsRequest{} }
+func (m *SignerSetTxConfirmationsRequest) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxConfirmationsRequest) ProtoMessage()    {}
+func (*SignerSetTxConfirmationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{34}
 }
-func (m *ContractCallTxConfirmationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *SignerSetTxConfirmationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractCallTxConfirmationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignerSetTxConfirmationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractCallTxConfirmationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignerSetTxConfirmationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1667,55 +1651,48 @@ func (m *ContractCallTxConfirmationsRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *ContractCallTxConfirmationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractCallTxConfirmationsRequest.Merge(m, src)
+func (m *SignerSetTxConfirmationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxConfirmationsRequest.Merge(m, src)
 }
-func (m *ContractCallTxConfirmationsRequest) XXX_Size() int {
+func (m *SignerSetTxConfirmationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractCallTxConfirmationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractCallTxConfirmationsRequest.DiscardUnknown(m)
+func (m *SignerSetTxConfirmationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxConfirmationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractCallTxConfirmationsRequest proto.InternalMessageInfo
-
-func (m *ContractCallTxConfirmationsRequest) GetInvalidationScope() []byte {
-	if m != nil {
-		return m.InvalidationScope
-	}
-	return nil
-}
+var xxx_messageInfo_SignerSetTxConfirmationsRequest proto.InternalMessageInfo
 
-func (m *ContractCallTxConfirmationsRequest) GetInvalidationNonce() uint64 {
+func (m *SignerSetTxConfirmationsRequest) GetSignerSetNonce() uint64 {
 	if m != nil {
-		return m.InvalidationNonce
+		return m.SignerSetNonce
 	}
 	return 0
 }
 
-func (m *ContractCallTxConfirmationsRequest) GetChainId() string {
+func (m *SignerSetTxConfirmationsRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type ContractCallTxConfirmationsResponse struct {
-	Signatures []*ContractCallTxConfirmation `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+type SignerSetTxConfirmationsResponse struct {
+	Signatures []*SignerSetTxConfirmation `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
-func (m *ContractCallTxConfirmationsResponse) Reset()         { *m = ContractCallTxConfirmationsResponse{} }
-func (m *ContractCallTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
-func (*ContractCallTxConfirmationsResponse) ProtoMessage()    {}
-func (*ContractCallTxConfirmationsResponse) Descriptor() ([]byte, []int) {
+func (m *SignerSetTxConfirmationsResponse) Reset()         { *m = SignerSetTxConfirmationsResponse{} }
+func (m *SignerSetTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxConfirmationsResponse) ProtoMessage()    {}
+func (*SignerSetTxConfirmationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{35}
 }
-func (m *ContractCallTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *SignerSetTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractCallTxConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignerSetTxConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractCallTxConfirmationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignerSetTxConfirmationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1725,43 +1702,43 @@ func (m *ContractCallTxConfirmationsResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *ContractCallTxConfirmationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractCallTxConfirmationsResponse.Merge(m, src)
+func (m *SignerSetTxConfirmationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxConfirmationsResponse.Merge(m, src)
 }
-func (m *ContractCallTxConfirmationsResponse) XXX_Size() int {
+func (m *SignerSetTxConfirmationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractCallTxConfirmationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractCallTxConfirmationsResponse.DiscardUnknown(m)
+func (m *SignerSetTxConfirmationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxConfirmationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractCallTxConfirmationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_SignerSetTxConfirmationsResponse proto.InternalMessageInfo
 
-func (m *ContractCallTxConfirmationsResponse) GetSignatures() []*ContractCallTxConfirmation {
+func (m *SignerSetTxConfirmationsResponse) GetSignatures() []*SignerSetTxConfirmation {
 	if m != nil {
 		return m.Signatures
 	}
 	return nil
 }
 
-type BatchTxConfirmationsRequest struct {
-	BatchNonce      uint64 `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
-	ExternalTokenId string `protobuf:"bytes,2,opt,name=external_token_id,json=externalTokenId,proto3" json:"external_token_id,omitempty"`
-	ChainId         string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+// rpc SignerSetTxs
+type SignerSetTxsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ChainId    string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *BatchTxConfirmationsRequest) Reset()         { *m = BatchTxConfirmationsRequest{} }
-func (m *BatchTxConfirmationsRequest) String() string { return proto.CompactTextString(m) }
-func (*BatchTxConfirmationsRequest) ProtoMessage()    {}
-func (*BatchTxConfirmationsRequest) Descriptor() ([]byte, []int) {
+func (m *SignerSetTxsRequest) Reset()         { *m = SignerSetTxsRequest{} }
+func (m *SignerSetTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxsRequest) ProtoMessage()    {}
+func (*SignerSetTxsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{36}
 }
-func (m *BatchTxConfirmationsRequest) XXX_Unmarshal(b []byte) error {
+func (m *SignerSetTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchTxConfirmationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignerSetTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchTxConfirmationsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignerSetTxsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1771,55 +1748,49 @@ func (m *BatchTxConfirmationsRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *BatchTxConfirmationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchTxConfirmationsRequest.Merge(m, src)
+func (m *SignerSetTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxsRequest.Merge(m, src)
 }
-func (m *BatchTxConfirmationsRequest) XXX_Size() int {
+func (m *SignerSetTxsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchTxConfirmationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchTxConfirmationsRequest.DiscardUnknown(m)
+func (m *SignerSetTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchTxConfirmationsRequest proto.InternalMessageInfo
-
-func (m *BatchTxConfirmationsRequest) GetBatchNonce() uint64 {
-	if m != nil {
-		return m.BatchNonce
-	}
-	return 0
-}
+var xxx_messageInfo_SignerSetTxsRequest proto.InternalMessageInfo
 
-func (m *BatchTxConfirmationsRequest) GetExternalTokenId() string {
+func (m *SignerSetTxsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.ExternalTokenId
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *BatchTxConfirmationsRequest) GetChainId() string {
+func (m *SignerSetTxsRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type BatchTxConfirmationsResponse struct {
-	Signatures []*BatchTxConfirmation `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+type SignerSetTxsResponse struct {
+	SignerSets []*SignerSetTx      `protobuf:"bytes,1,rep,name=signer_sets,json=signerSets,proto3" json:"signer_sets,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BatchTxConfirmationsResponse) Reset()         { *m = BatchTxConfirmationsResponse{} }
-func (m *BatchTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
-func (*BatchTxConfirmationsResponse) ProtoMessage()    {}
-func (*BatchTxConfirmationsResponse) Descriptor() ([]byte, []int) {
+func (m *SignerSetTxsResponse) Reset()         { *m = SignerSetTxsResponse{} }
+func (m *SignerSetTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxsResponse) ProtoMessage()    {}
+func (*SignerSetTxsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{37}
 }
-func (m *BatchTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *SignerSetTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchTxConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignerSetTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchTxConfirmationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignerSetTxsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1829,42 +1800,50 @@ func (m *BatchTxConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *BatchTxConfirmationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchTxConfirmationsResponse.Merge(m, src)
+func (m *SignerSetTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxsResponse.Merge(m, src)
 }
-func (m *BatchTxConfirmationsResponse) XXX_Size() int {
+func (m *SignerSetTxsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchTxConfirmationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchTxConfirmationsResponse.DiscardUnknown(m)
+func (m *SignerSetTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchTxConfirmationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_SignerSetTxsResponse proto.InternalMessageInfo
 
-func (m *BatchTxConfirmationsResponse) GetSignatures() []*BatchTxConfirmation {
+func (m *SignerSetTxsResponse) GetSignerSets() []*SignerSetTx {
 	if m != nil {
-		return m.Signatures
+		return m.SignerSets
 	}
 	return nil
 }
 
-type LastSubmittedExternalEventRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+func (m *SignerSetTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *LastSubmittedExternalEventRequest) Reset()         { *m = LastSubmittedExternalEventRequest{} }
-func (m *LastSubmittedExternalEventRequest) String() string { return proto.CompactTextString(m) }
-func (*LastSubmittedExternalEventRequest) ProtoMessage()    {}
-func (*LastSubmittedExternalEventRequest) Descriptor() ([]byte, []int) {
+// rpc BatchTxs
+type BatchTxsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ChainId    string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *BatchTxsRequest) Reset()         { *m = BatchTxsRequest{} }
+func (m *BatchTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchTxsRequest) ProtoMessage()    {}
+func (*BatchTxsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{38}
 }
-func (m *LastSubmittedExternalEventRequest) XXX_Unmarshal(b []byte) error {
+func (m *BatchTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LastSubmittedExternalEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LastSubmittedExternalEventRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchTxsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1874,48 +1853,49 @@ func (m *LastSubmittedExternalEventRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *LastSubmittedExternalEventRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LastSubmittedExternalEventRequest.Merge(m, src)
+func (m *BatchTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxsRequest.Merge(m, src)
 }
-func (m *LastSubmittedExternalEventRequest) XXX_Size() int {
+func (m *BatchTxsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *LastSubmittedExternalEventRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LastSubmittedExternalEventRequest.DiscardUnknown(m)
+func (m *BatchTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LastSubmittedExternalEventRequest proto.InternalMessageInfo
+var xxx_messageInfo_BatchTxsRequest proto.InternalMessageInfo
 
-func (m *LastSubmittedExternalEventRequest) GetAddress() string {
+func (m *BatchTxsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Address
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *LastSubmittedExternalEventRequest) GetChainId() string {
+func (m *BatchTxsRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type LastSubmittedExternalEventResponse struct {
-	EventNonce uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+type BatchTxsResponse struct {
+	Batches    []*BatchTx          `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *LastSubmittedExternalEventResponse) Reset()         { *m = LastSubmittedExternalEventResponse{} }
-func (m *LastSubmittedExternalEventResponse) String() string { return proto.CompactTextString(m) }
-func (*LastSubmittedExternalEventResponse) ProtoMessage()    {}
-func (*LastSubmittedExternalEventResponse) Descriptor() ([]byte, []int) {
+func (m *BatchTxsResponse) Reset()         { *m = BatchTxsResponse{} }
+func (m *BatchTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchTxsResponse) ProtoMessage()    {}
+func (*BatchTxsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{39}
 }
-func (m *LastSubmittedExternalEventResponse) XXX_Unmarshal(b []byte) error {
+func (m *BatchTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LastSubmittedExternalEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LastSubmittedExternalEventResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchTxsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1925,42 +1905,50 @@ func (m *LastSubmittedExternalEventResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *LastSubmittedExternalEventResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LastSubmittedExternalEventResponse.Merge(m, src)
+func (m *BatchTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxsResponse.Merge(m, src)
 }
-func (m *LastSubmittedExternalEventResponse) XXX_Size() int {
+func (m *BatchTxsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *LastSubmittedExternalEventResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LastSubmittedExternalEventResponse.DiscardUnknown(m)
+func (m *BatchTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LastSubmittedExternalEventResponse proto.InternalMessageInfo
+var xxx_messageInfo_BatchTxsResponse proto.InternalMessageInfo
 
-func (m *LastSubmittedExternalEventResponse) GetEventNonce() uint64 {
+func (m *BatchTxsResponse) GetBatches() []*BatchTx {
 	if m != nil {
-		return m.EventNonce
+		return m.Batches
 	}
-	return 0
+	return nil
 }
 
-type ExternalIdToDenomRequest struct {
-	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+func (m *BatchTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *ExternalIdToDenomRequest) Reset()         { *m = ExternalIdToDenomRequest{} }
-func (m *ExternalIdToDenomRequest) String() string { return proto.CompactTextString(m) }
-func (*ExternalIdToDenomRequest) ProtoMessage()    {}
-func (*ExternalIdToDenomRequest) Descriptor() ([]byte, []int) {
+// rpc ContractCallTxs
+type ContractCallTxsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ChainId    string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *ContractCallTxsRequest) Reset()         { *m = ContractCallTxsRequest{} }
+func (m *ContractCallTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractCallTxsRequest) ProtoMessage()    {}
+func (*ContractCallTxsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{40}
 }
-func (m *ExternalIdToDenomRequest) XXX_Unmarshal(b []byte) error {
+func (m *ContractCallTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExternalIdToDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractCallTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExternalIdToDenomRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractCallTxsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1970,48 +1958,49 @@ func (m *ExternalIdToDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ExternalIdToDenomRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExternalIdToDenomRequest.Merge(m, src)
+func (m *ContractCallTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallTxsRequest.Merge(m, src)
 }
-func (m *ExternalIdToDenomRequest) XXX_Size() int {
+func (m *ContractCallTxsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExternalIdToDenomRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExternalIdToDenomRequest.DiscardUnknown(m)
+func (m *ContractCallTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallTxsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExternalIdToDenomRequest proto.InternalMessageInfo
+var xxx_messageInfo_ContractCallTxsRequest proto.InternalMessageInfo
 
-func (m *ExternalIdToDenomRequest) GetExternalId() string {
+func (m *ContractCallTxsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.ExternalId
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *ExternalIdToDenomRequest) GetChainId() string {
+func (m *ContractCallTxsRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type ExternalIdToDenomResponse struct {
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+type ContractCallTxsResponse struct {
+	Calls      []*ContractCallTx   `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ExternalIdToDenomResponse) Reset()         { *m = ExternalIdToDenomResponse{} }
-func (m *ExternalIdToDenomResponse) String() string { return proto.CompactTextString(m) }
-func (*ExternalIdToDenomResponse) ProtoMessage()    {}
-func (*ExternalIdToDenomResponse) Descriptor() ([]byte, []int) {
+func (m *ContractCallTxsResponse) Reset()         { *m = ContractCallTxsResponse{} }
+func (m *ContractCallTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractCallTxsResponse) ProtoMessage()    {}
+func (*ContractCallTxsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{41}
 }
-func (m *ExternalIdToDenomResponse) XXX_Unmarshal(b []byte) error {
+func (m *ContractCallTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExternalIdToDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractCallTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExternalIdToDenomResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractCallTxsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2021,42 +2010,52 @@ func (m *ExternalIdToDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ExternalIdToDenomResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExternalIdToDenomResponse.Merge(m, src)
+func (m *ContractCallTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallTxsResponse.Merge(m, src)
 }
-func (m *ExternalIdToDenomResponse) XXX_Size() int {
+func (m *ContractCallTxsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExternalIdToDenomResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExternalIdToDenomResponse.DiscardUnknown(m)
+func (m *ContractCallTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallTxsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExternalIdToDenomResponse proto.InternalMessageInfo
+var xxx_messageInfo_ContractCallTxsResponse proto.InternalMessageInfo
 
-func (m *ExternalIdToDenomResponse) GetDenom() string {
+func (m *ContractCallTxsResponse) GetCalls() []*ContractCallTx {
 	if m != nil {
-		return m.Denom
+		return m.Calls
 	}
-	return ""
+	return nil
 }
 
-type DenomToExternalIdRequest struct {
-	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+func (m *ContractCallTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// rpc UnsignedSignerSetTxs
+type UnsignedSignerSetTxsRequest struct {
+	// NOTE: this is an sdk.AccAddress and can represent either the
+	// orchestartor address or the cooresponding validator address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *DenomToExternalIdRequest) Reset()         { *m = DenomToExternalIdRequest{} }
-func (m *DenomToExternalIdRequest) String() string { return proto.CompactTextString(m) }
-func (*DenomToExternalIdRequest) ProtoMessage()    {}
-func (*DenomToExternalIdRequest) Descriptor() ([]byte, []int) {
+func (m *UnsignedSignerSetTxsRequest) Reset()         { *m = UnsignedSignerSetTxsRequest{} }
+func (m *UnsignedSignerSetTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsignedSignerSetTxsRequest) ProtoMessage()    {}
+func (*UnsignedSignerSetTxsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{42}
 }
-func (m *DenomToExternalIdRequest) XXX_Unmarshal(b []byte) error {
+func (m *UnsignedSignerSetTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DenomToExternalIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnsignedSignerSetTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DenomToExternalIdRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnsignedSignerSetTxsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2066,48 +2065,48 @@ func (m *DenomToExternalIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *DenomToExternalIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DenomToExternalIdRequest.Merge(m, src)
+func (m *UnsignedSignerSetTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsignedSignerSetTxsRequest.Merge(m, src)
 }
-func (m *DenomToExternalIdRequest) XXX_Size() int {
+func (m *UnsignedSignerSetTxsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DenomToExternalIdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DenomToExternalIdRequest.DiscardUnknown(m)
+func (m *UnsignedSignerSetTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsignedSignerSetTxsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DenomToExternalIdRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnsignedSignerSetTxsRequest proto.InternalMessageInfo
 
-func (m *DenomToExternalIdRequest) GetDenom() string {
+func (m *UnsignedSignerSetTxsRequest) GetAddress() string {
 	if m != nil {
-		return m.Denom
+		return m.Address
 	}
 	return ""
 }
 
-func (m *DenomToExternalIdRequest) GetChainId() string {
+func (m *UnsignedSignerSetTxsRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type DenomToExternalIdResponse struct {
-	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+type UnsignedSignerSetTxsResponse struct {
+	SignerSets []*SignerSetTx `protobuf:"bytes,1,rep,name=signer_sets,json=signerSets,proto3" json:"signer_sets,omitempty"`
 }
 
-func (m *DenomToExternalIdResponse) Reset()         { *m = DenomToExternalIdResponse{} }
-func (m *DenomToExternalIdResponse) String() string { return proto.CompactTextString(m) }
-func (*DenomToExternalIdResponse) ProtoMessage()    {}
-func (*DenomToExternalIdResponse) Descriptor() ([]byte, []int) {
+func (m *UnsignedSignerSetTxsResponse) Reset()         { *m = UnsignedSignerSetTxsResponse{} }
+func (m *UnsignedSignerSetTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsignedSignerSetTxsResponse) ProtoMessage()    {}
+func (*UnsignedSignerSetTxsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{43}
 }
-func (m *DenomToExternalIdResponse) XXX_Unmarshal(b []byte) error {
+func (m *UnsignedSignerSetTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DenomToExternalIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnsignedSignerSetTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DenomToExternalIdResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnsignedSignerSetTxsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2117,42 +2116,44 @@ func (m *DenomToExternalIdResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *DenomToExternalIdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DenomToExternalIdResponse.Merge(m, src)
+func (m *UnsignedSignerSetTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsignedSignerSetTxsResponse.Merge(m, src)
 }
-func (m *DenomToExternalIdResponse) XXX_Size() int {
+func (m *UnsignedSignerSetTxsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DenomToExternalIdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DenomToExternalIdResponse.DiscardUnknown(m)
+func (m *UnsignedSignerSetTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsignedSignerSetTxsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DenomToExternalIdResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnsignedSignerSetTxsResponse proto.InternalMessageInfo
 
-func (m *DenomToExternalIdResponse) GetExternalId() string {
+func (m *UnsignedSignerSetTxsResponse) GetSignerSets() []*SignerSetTx {
 	if m != nil {
-		return m.ExternalId
+		return m.SignerSets
 	}
-	return ""
+	return nil
 }
 
-type DelegateKeysByValidatorRequest struct {
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	ChainId          string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type UnsignedBatchTxsRequest struct {
+	// NOTE: this is an sdk.AccAddress and can represent either the
+	// orchestrator address or the cooresponding validator address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *DelegateKeysByValidatorRequest) Reset()         { *m = DelegateKeysByValidatorRequest{} }
-func (m *DelegateKeysByValidatorRequest) String() string { return proto.CompactTextString(m) }
-func (*DelegateKeysByValidatorRequest) ProtoMessage()    {}
-func (*DelegateKeysByValidatorRequest) Descriptor() ([]byte, []int) {
+func (m *UnsignedBatchTxsRequest) Reset()         { *m = UnsignedBatchTxsRequest{} }
+func (m *UnsignedBatchTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsignedBatchTxsRequest) ProtoMessage()    {}
+func (*UnsignedBatchTxsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{44}
 }
-func (m *DelegateKeysByValidatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *UnsignedBatchTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateKeysByValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnsignedBatchTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateKeysByValidatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnsignedBatchTxsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2162,49 +2163,49 @@ func (m *DelegateKeysByValidatorRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *DelegateKeysByValidatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateKeysByValidatorRequest.Merge(m, src)
+func (m *UnsignedBatchTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsignedBatchTxsRequest.Merge(m, src)
 }
-func (m *DelegateKeysByValidatorRequest) XXX_Size() int {
+func (m *UnsignedBatchTxsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateKeysByValidatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateKeysByValidatorRequest.DiscardUnknown(m)
+func (m *UnsignedBatchTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsignedBatchTxsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateKeysByValidatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnsignedBatchTxsRequest proto.InternalMessageInfo
 
-func (m *DelegateKeysByValidatorRequest) GetValidatorAddress() string {
+func (m *UnsignedBatchTxsRequest) GetAddress() string {
 	if m != nil {
-		return m.ValidatorAddress
+		return m.Address
 	}
 	return ""
 }
 
-func (m *DelegateKeysByValidatorRequest) GetChainId() string {
+func (m *UnsignedBatchTxsRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type DelegateKeysByValidatorResponse struct {
-	EthAddress          string `protobuf:"bytes,1,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
-	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+type UnsignedBatchTxsResponse struct {
+	// Note these are returned with the signature empty
+	Batches []*BatchTx `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
 }
 
-func (m *DelegateKeysByValidatorResponse) Reset()         { *m = DelegateKeysByValidatorResponse{} }
-func (m *DelegateKeysByValidatorResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegateKeysByValidatorResponse) ProtoMessage()    {}
-func (*DelegateKeysByValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_503a4f22a1222790, []int{45}
-}
-func (m *DelegateKeysByValidatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *UnsignedBatchTxsResponse) Reset()         { *m = UnsignedBatchTxsResponse{} }
+func (m *UnsignedBatchTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsignedBatchTxsResponse) ProtoMessage()    {}
+func (*UnsignedBatchTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{45}
+}
+func (m *UnsignedBatchTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateKeysByValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnsignedBatchTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateKeysByValidatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnsignedBatchTxsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2214,49 +2215,43 @@ func (m *DelegateKeysByValidatorResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *DelegateKeysByValidatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateKeysByValidatorResponse.Merge(m, src)
+func (m *UnsignedBatchTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsignedBatchTxsResponse.Merge(m, src)
 }
-func (m *DelegateKeysByValidatorResponse) XXX_Size() int {
+func (m *UnsignedBatchTxsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateKeysByValidatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateKeysByValidatorResponse.DiscardUnknown(m)
+func (m *UnsignedBatchTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsignedBatchTxsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateKeysByValidatorResponse proto.InternalMessageInfo
-
-func (m *DelegateKeysByValidatorResponse) GetEthAddress() string {
-	if m != nil {
-		return m.EthAddress
-	}
-	return ""
-}
+var xxx_messageInfo_UnsignedBatchTxsResponse proto.InternalMessageInfo
 
-func (m *DelegateKeysByValidatorResponse) GetOrchestratorAddress() string {
+func (m *UnsignedBatchTxsResponse) GetBatches() []*BatchTx {
 	if m != nil {
-		return m.OrchestratorAddress
+		return m.Batches
 	}
-	return ""
+	return nil
 }
 
-type DelegateKeysByExternalSignerRequest struct {
-	ExternalSigner string `protobuf:"bytes,1,opt,name=external_signer,json=externalSigner,proto3" json:"external_signer,omitempty"`
-	ChainId        string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+// rpc UnsignedContractCallTxs
+type UnsignedContractCallTxsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *DelegateKeysByExternalSignerRequest) Reset()         { *m = DelegateKeysByExternalSignerRequest{} }
-func (m *DelegateKeysByExternalSignerRequest) String() string { return proto.CompactTextString(m) }
-func (*DelegateKeysByExternalSignerRequest) ProtoMessage()    {}
-func (*DelegateKeysByExternalSignerRequest) Descriptor() ([]byte, []int) {
+func (m *UnsignedContractCallTxsRequest) Reset()         { *m = UnsignedContractCallTxsRequest{} }
+func (m *UnsignedContractCallTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsignedContractCallTxsRequest) ProtoMessage()    {}
+func (*UnsignedContractCallTxsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{46}
 }
-func (m *DelegateKeysByExternalSignerRequest) XXX_Unmarshal(b []byte) error {
+func (m *UnsignedContractCallTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateKeysByExternalSignerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnsignedContractCallTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateKeysByExternalSignerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnsignedContractCallTxsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2266,49 +2261,48 @@ func (m *DelegateKeysByExternalSignerRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *DelegateKeysByExternalSignerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateKeysByExternalSignerRequest.Merge(m, src)
+func (m *UnsignedContractCallTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsignedContractCallTxsRequest.Merge(m, src)
 }
-func (m *DelegateKeysByExternalSignerRequest) XXX_Size() int {
+func (m *UnsignedContractCallTxsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateKeysByExternalSignerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateKeysByExternalSignerRequest.DiscardUnknown(m)
+func (m *UnsignedContractCallTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsignedContractCallTxsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateKeysByExternalSignerRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnsignedContractCallTxsRequest proto.InternalMessageInfo
 
-func (m *DelegateKeysByExternalSignerRequest) GetExternalSigner() string {
+func (m *UnsignedContractCallTxsRequest) GetAddress() string {
 	if m != nil {
-		return m.ExternalSigner
+		return m.Address
 	}
 	return ""
 }
 
-func (m *DelegateKeysByExternalSignerRequest) GetChainId() string {
+func (m *UnsignedContractCallTxsRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type DelegateKeysByExternalSignerResponse struct {
-	ValidatorAddress    string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+type UnsignedContractCallTxsResponse struct {
+	Calls []*ContractCallTx `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
 }
 
-func (m *DelegateKeysByExternalSignerResponse) Reset()         { *m = DelegateKeysByExternalSignerResponse{} }
-func (m *DelegateKeysByExternalSignerResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegateKeysByExternalSignerResponse) ProtoMessage()    {}
-func (*DelegateKeysByExternalSignerResponse) Descriptor() ([]byte, []int) {
+func (m *UnsignedContractCallTxsResponse) Reset()         { *m = UnsignedContractCallTxsResponse{} }
+func (m *UnsignedContractCallTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsignedContractCallTxsResponse) ProtoMessage()    {}
+func (*UnsignedContractCallTxsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{47}
 }
-func (m *DelegateKeysByExternalSignerResponse) XXX_Unmarshal(b []byte) error {
+func (m *UnsignedContractCallTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateKeysByExternalSignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnsignedContractCallTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateKeysByExternalSignerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnsignedContractCallTxsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2318,49 +2312,41 @@ func (m *DelegateKeysByExternalSignerResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *DelegateKeysByExternalSignerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateKeysByExternalSignerResponse.Merge(m, src)
+func (m *UnsignedContractCallTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsignedContractCallTxsResponse.Merge(m, src)
 }
-func (m *DelegateKeysByExternalSignerResponse) XXX_Size() int {
+func (m *UnsignedContractCallTxsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateKeysByExternalSignerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateKeysByExternalSignerResponse.DiscardUnknown(m)
+func (m *UnsignedContractCallTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsignedContractCallTxsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateKeysByExternalSignerResponse proto.InternalMessageInfo
-
-func (m *DelegateKeysByExternalSignerResponse) GetValidatorAddress() string {
-	if m != nil {
-		return m.ValidatorAddress
-	}
-	return ""
-}
+var xxx_messageInfo_UnsignedContractCallTxsResponse proto.InternalMessageInfo
 
-func (m *DelegateKeysByExternalSignerResponse) GetOrchestratorAddress() string {
+func (m *UnsignedContractCallTxsResponse) GetCalls() []*ContractCallTx {
 	if m != nil {
-		return m.OrchestratorAddress
+		return m.Calls
 	}
-	return ""
+	return nil
 }
 
-type DelegateKeysByOrchestratorRequest struct {
-	OrchestratorAddress string `protobuf:"bytes,1,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
-	ChainId             string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type BatchTxFeesRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *DelegateKeysByOrchestratorRequest) Reset()         { *m = DelegateKeysByOrchestratorRequest{} }
-func (m *DelegateKeysByOrchestratorRequest) String() string { return proto.CompactTextString(m) }
-func (*DelegateKeysByOrchestratorRequest) ProtoMessage()    {}
-func (*DelegateKeysByOrchestratorRequest) Descriptor() ([]byte, []int) {
+func (m *BatchTxFeesRequest) Reset()         { *m = BatchTxFeesRequest{} }
+func (m *BatchTxFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchTxFeesRequest) ProtoMessage()    {}
+func (*BatchTxFeesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{48}
 }
-func (m *DelegateKeysByOrchestratorRequest) XXX_Unmarshal(b []byte) error {
+func (m *BatchTxFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateKeysByOrchestratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchTxFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateKeysByOrchestratorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchTxFeesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2370,49 +2356,41 @@ func (m *DelegateKeysByOrchestratorRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *DelegateKeysByOrchestratorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateKeysByOrchestratorRequest.Merge(m, src)
+func (m *BatchTxFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxFeesRequest.Merge(m, src)
 }
-func (m *DelegateKeysByOrchestratorRequest) XXX_Size() int {
+func (m *BatchTxFeesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateKeysByOrchestratorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateKeysByOrchestratorRequest.DiscardUnknown(m)
+func (m *BatchTxFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxFeesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateKeysByOrchestratorRequest proto.InternalMessageInfo
-
-func (m *DelegateKeysByOrchestratorRequest) GetOrchestratorAddress() string {
-	if m != nil {
-		return m.OrchestratorAddress
-	}
-	return ""
-}
+var xxx_messageInfo_BatchTxFeesRequest proto.InternalMessageInfo
 
-func (m *DelegateKeysByOrchestratorRequest) GetChainId() string {
+func (m *BatchTxFeesRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type DelegateKeysByOrchestratorResponse struct {
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	ExternalSigner   string `protobuf:"bytes,2,opt,name=external_signer,json=externalSigner,proto3" json:"external_signer,omitempty"`
+type BatchTxFeesResponse struct {
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
 }
 
-func (m *DelegateKeysByOrchestratorResponse) Reset()         { *m = DelegateKeysByOrchestratorResponse{} }
-func (m *DelegateKeysByOrchestratorResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegateKeysByOrchestratorResponse) ProtoMessage()    {}
-func (*DelegateKeysByOrchestratorResponse) Descriptor() ([]byte, []int) {
+func (m *BatchTxFeesResponse) Reset()         { *m = BatchTxFeesResponse{} }
+func (m *BatchTxFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchTxFeesResponse) ProtoMessage()    {}
+func (*BatchTxFeesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{49}
 }
-func (m *DelegateKeysByOrchestratorResponse) XXX_Unmarshal(b []byte) error {
+func (m *BatchTxFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateKeysByOrchestratorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchTxFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateKeysByOrchestratorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchTxFeesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2422,48 +2400,43 @@ func (m *DelegateKeysByOrchestratorResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *DelegateKeysByOrchestratorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateKeysByOrchestratorResponse.Merge(m, src)
+func (m *BatchTxFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxFeesResponse.Merge(m, src)
 }
-func (m *DelegateKeysByOrchestratorResponse) XXX_Size() int {
+func (m *BatchTxFeesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateKeysByOrchestratorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateKeysByOrchestratorResponse.DiscardUnknown(m)
+func (m *BatchTxFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxFeesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateKeysByOrchestratorResponse proto.InternalMessageInfo
-
-func (m *DelegateKeysByOrchestratorResponse) GetValidatorAddress() string {
-	if m != nil {
-		return m.ValidatorAddress
-	}
-	return ""
-}
+var xxx_messageInfo_BatchTxFeesResponse proto.InternalMessageInfo
 
-func (m *DelegateKeysByOrchestratorResponse) GetExternalSigner() string {
+func (m *BatchTxFeesResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.ExternalSigner
+		return m.Fees
 	}
-	return ""
+	return nil
 }
 
-type DelegateKeysRequest struct {
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type ContractCallTxConfirmationsRequest struct {
+	InvalidationScope []byte `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	ChainId           string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *DelegateKeysRequest) Reset()         { *m = DelegateKeysRequest{} }
-func (m *DelegateKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*DelegateKeysRequest) ProtoMessage()    {}
-func (*DelegateKeysRequest) Descriptor() ([]byte, []int) {
+func (m *ContractCallTxConfirmationsRequest) Reset()         { *m = ContractCallTxConfirmationsRequest{} }
+func (m *ContractCallTxConfirmationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractCallTxConfirmationsRequest) ProtoMessage()    {}
+func (*ContractCallTxConfirmationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{50}
 }
-func (m *DelegateKeysRequest) XXX_Unmarshal(b []byte) error {
+func (m *ContractCallTxConfirmationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractCallTxConfirmationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateKeysRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractCallTxConfirmationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2473,41 +2446,55 @@ func (m *DelegateKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DelegateKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateKeysRequest.Merge(m, src)
+func (m *ContractCallTxConfirmationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallTxConfirmationsRequest.Merge(m, src)
 }
-func (m *DelegateKeysRequest) XXX_Size() int {
+func (m *ContractCallTxConfirmationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateKeysRequest.DiscardUnknown(m)
+func (m *ContractCallTxConfirmationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallTxConfirmationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateKeysRequest proto.InternalMessageInfo
+var xxx_messageInfo_ContractCallTxConfirmationsRequest proto.InternalMessageInfo
 
-func (m *DelegateKeysRequest) GetChainId() string {
+func (m *ContractCallTxConfirmationsRequest) GetInvalidationScope() []byte {
+	if m != nil {
+		return m.InvalidationScope
+	}
+	return nil
+}
+
+func (m *ContractCallTxConfirmationsRequest) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
+	}
+	return 0
+}
+
+func (m *ContractCallTxConfirmationsRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type DelegateKeysResponse struct {
-	DelegateKeys []*MsgDelegateKeys `protobuf:"bytes,1,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+type ContractCallTxConfirmationsResponse struct {
+	Signatures []*ContractCallTxConfirmation `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
-func (m *DelegateKeysResponse) Reset()         { *m = DelegateKeysResponse{} }
-func (m *DelegateKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegateKeysResponse) ProtoMessage()    {}
-func (*DelegateKeysResponse) Descriptor() ([]byte, []int) {
+func (m *ContractCallTxConfirmationsResponse) Reset()         { *m = ContractCallTxConfirmationsResponse{} }
+func (m *ContractCallTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractCallTxConfirmationsResponse) ProtoMessage()    {}
+func (*ContractCallTxConfirmationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{51}
 }
-func (m *DelegateKeysResponse) XXX_Unmarshal(b []byte) error {
+func (m *ContractCallTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractCallTxConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateKeysResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractCallTxConfirmationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2517,43 +2504,43 @@ func (m *DelegateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *DelegateKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateKeysResponse.Merge(m, src)
+func (m *ContractCallTxConfirmationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallTxConfirmationsResponse.Merge(m, src)
 }
-func (m *DelegateKeysResponse) XXX_Size() int {
+func (m *ContractCallTxConfirmationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateKeysResponse.DiscardUnknown(m)
+func (m *ContractCallTxConfirmationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallTxConfirmationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateKeysResponse proto.InternalMessageInfo
+var xxx_messageInfo_ContractCallTxConfirmationsResponse proto.InternalMessageInfo
 
-func (m *DelegateKeysResponse) GetDelegateKeys() []*MsgDelegateKeys {
+func (m *ContractCallTxConfirmationsResponse) GetSignatures() []*ContractCallTxConfirmation {
 	if m != nil {
-		return m.DelegateKeys
+		return m.Signatures
 	}
 	return nil
 }
 
-// NOTE: if there is no sender address, return all
-type BatchedSendToExternalsRequest struct {
-	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
-	ChainId       string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type BatchTxConfirmationsRequest struct {
+	BatchNonce      uint64 `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	ExternalTokenId string `protobuf:"bytes,2,opt,name=external_token_id,json=externalTokenId,proto3" json:"external_token_id,omitempty"`
+	ChainId         string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *BatchedSendToExternalsRequest) Reset()         { *m = BatchedSendToExternalsRequest{} }
-func (m *BatchedSendToExternalsRequest) String() string { return proto.CompactTextString(m) }
-func (*BatchedSendToExternalsRequest) ProtoMessage()    {}
-func (*BatchedSendToExternalsRequest) Descriptor() ([]byte, []int) {
+func (m *BatchTxConfirmationsRequest) Reset()         { *m = BatchTxConfirmationsRequest{} }
+func (m *BatchTxConfirmationsRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchTxConfirmationsRequest) ProtoMessage()    {}
+func (*BatchTxConfirmationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{52}
 }
-func (m *BatchedSendToExternalsRequest) XXX_Unmarshal(b []byte) error {
+func (m *BatchTxConfirmationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchedSendToExternalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchTxConfirmationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchedSendToExternalsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchTxConfirmationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2563,49 +2550,55 @@ func (m *BatchedSendToExternalsRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *BatchedSendToExternalsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchedSendToExternalsRequest.Merge(m, src)
+func (m *BatchTxConfirmationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxConfirmationsRequest.Merge(m, src)
 }
-func (m *BatchedSendToExternalsRequest) XXX_Size() int {
+func (m *BatchTxConfirmationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchedSendToExternalsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchedSendToExternalsRequest.DiscardUnknown(m)
+func (m *BatchTxConfirmationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxConfirmationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchedSendToExternalsRequest proto.InternalMessageInfo
+var xxx_messageInfo_BatchTxConfirmationsRequest proto.InternalMessageInfo
 
-func (m *BatchedSendToExternalsRequest) GetSenderAddress() string {
+func (m *BatchTxConfirmationsRequest) GetBatchNonce() uint64 {
 	if m != nil {
-		return m.SenderAddress
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *BatchTxConfirmationsRequest) GetExternalTokenId() string {
+	if m != nil {
+		return m.ExternalTokenId
 	}
 	return ""
 }
 
-func (m *BatchedSendToExternalsRequest) GetChainId() string {
+func (m *BatchTxConfirmationsRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type BatchedSendToExternalsResponse struct {
-	SendToExternals []*SendToExternal `protobuf:"bytes,1,rep,name=send_to_externals,json=sendToExternals,proto3" json:"send_to_externals,omitempty"`
-	ChainId         string            `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type BatchTxConfirmationsResponse struct {
+	Signatures []*BatchTxConfirmation `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
-func (m *BatchedSendToExternalsResponse) Reset()         { *m = BatchedSendToExternalsResponse{} }
-func (m *BatchedSendToExternalsResponse) String() string { return proto.CompactTextString(m) }
-func (*BatchedSendToExternalsResponse) ProtoMessage()    {}
-func (*BatchedSendToExternalsResponse) Descriptor() ([]byte, []int) {
+func (m *BatchTxConfirmationsResponse) Reset()         { *m = BatchTxConfirmationsResponse{} }
+func (m *BatchTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchTxConfirmationsResponse) ProtoMessage()    {}
+func (*BatchTxConfirmationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{53}
 }
-func (m *BatchedSendToExternalsResponse) XXX_Unmarshal(b []byte) error {
+func (m *BatchTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchedSendToExternalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchTxConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchedSendToExternalsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchTxConfirmationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2615,50 +2608,42 @@ func (m *BatchedSendToExternalsResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *BatchedSendToExternalsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchedSendToExternalsResponse.Merge(m, src)
+func (m *BatchTxConfirmationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxConfirmationsResponse.Merge(m, src)
 }
-func (m *BatchedSendToExternalsResponse) XXX_Size() int {
+func (m *BatchTxConfirmationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchedSendToExternalsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchedSendToExternalsResponse.DiscardUnknown(m)
+func (m *BatchTxConfirmationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxConfirmationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchedSendToExternalsResponse proto.InternalMessageInfo
+var xxx_messageInfo_BatchTxConfirmationsResponse proto.InternalMessageInfo
 
-func (m *BatchedSendToExternalsResponse) GetSendToExternals() []*SendToExternal {
+func (m *BatchTxConfirmationsResponse) GetSignatures() []*BatchTxConfirmation {
 	if m != nil {
-		return m.SendToExternals
+		return m.Signatures
 	}
 	return nil
 }
 
-func (m *BatchedSendToExternalsResponse) GetChainId() string {
-	if m != nil {
-		return m.ChainId
-	}
-	return ""
-}
-
-type UnbatchedSendToExternalsRequest struct {
-	SenderAddress string             `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
-	ChainId       string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Pagination    *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type LastSubmittedExternalEventRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *UnbatchedSendToExternalsRequest) Reset()         { *m = UnbatchedSendToExternalsRequest{} }
-func (m *UnbatchedSendToExternalsRequest) String() string { return proto.CompactTextString(m) }
-func (*UnbatchedSendToExternalsRequest) ProtoMessage()    {}
-func (*UnbatchedSendToExternalsRequest) Descriptor() ([]byte, []int) {
+func (m *LastSubmittedExternalEventRequest) Reset()         { *m = LastSubmittedExternalEventRequest{} }
+func (m *LastSubmittedExternalEventRequest) String() string { return proto.CompactTextString(m) }
+func (*LastSubmittedExternalEventRequest) ProtoMessage()    {}
+func (*LastSubmittedExternalEventRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_503a4f22a1222790, []int{54}
 }
-func (m *UnbatchedSendToExternalsRequest) XXX_Unmarshal(b []byte) error {
+func (m *LastSubmittedExternalEventRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnbatchedSendToExternalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LastSubmittedExternalEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnbatchedSendToExternalsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LastSubmittedExternalEventRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2668,57 +2653,93 @@ func (m *UnbatchedSendToExternalsRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *UnbatchedSendToExternalsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnbatchedSendToExternalsRequest.Merge(m, src)
+func (m *LastSubmittedExternalEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastSubmittedExternalEventRequest.Merge(m, src)
 }
-func (m *UnbatchedSendToExternalsRequest) XXX_Size() int {
+func (m *LastSubmittedExternalEventRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnbatchedSendToExternalsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnbatchedSendToExternalsRequest.DiscardUnknown(m)
+func (m *LastSubmittedExternalEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastSubmittedExternalEventRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnbatchedSendToExternalsRequest proto.InternalMessageInfo
+var xxx_messageInfo_LastSubmittedExternalEventRequest proto.InternalMessageInfo
 
-func (m *UnbatchedSendToExternalsRequest) GetSenderAddress() string {
+func (m *LastSubmittedExternalEventRequest) GetAddress() string {
 	if m != nil {
-		return m.SenderAddress
+		return m.Address
 	}
 	return ""
 }
 
-func (m *UnbatchedSendToExternalsRequest) GetChainId() string {
+func (m *LastSubmittedExternalEventRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-func (m *UnbatchedSendToExternalsRequest) GetPagination() *query.PageRequest {
+type LastSubmittedExternalEventResponse struct {
+	EventNonce uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+}
+
+func (m *LastSubmittedExternalEventResponse) Reset()         { *m = LastSubmittedExternalEventResponse{} }
+func (m *LastSubmittedExternalEventResponse) String() string { return proto.CompactTextString(m) }
+func (*LastSubmittedExternalEventResponse) ProtoMessage()    {}
+func (*LastSubmittedExternalEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{55}
+}
+func (m *LastSubmittedExternalEventResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastSubmittedExternalEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastSubmittedExternalEventResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastSubmittedExternalEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastSubmittedExternalEventResponse.Merge(m, src)
+}
+func (m *LastSubmittedExternalEventResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastSubmittedExternalEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastSubmittedExternalEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastSubmittedExternalEventResponse proto.InternalMessageInfo
+
+func (m *LastSubmittedExternalEventResponse) GetEventNonce() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.EventNonce
 	}
-	return nil
+	return 0
 }
 
-type UnbatchedSendToExternalsResponse struct {
-	SendToExternals []*SendToExternal   `protobuf:"bytes,1,rep,name=send_to_externals,json=sendToExternals,proto3" json:"send_to_externals,omitempty"`
-	ChainId         string              `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Pagination      *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type ExternalIdToDenomRequest struct {
+	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *UnbatchedSendToExternalsResponse) Reset()         { *m = UnbatchedSendToExternalsResponse{} }
-func (m *UnbatchedSendToExternalsResponse) String() string { return proto.CompactTextString(m) }
-func (*UnbatchedSendToExternalsResponse) ProtoMessage()    {}
-func (*UnbatchedSendToExternalsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_503a4f22a1222790, []int{55}
+func (m *ExternalIdToDenomRequest) Reset()         { *m = ExternalIdToDenomRequest{} }
+func (m *ExternalIdToDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*ExternalIdToDenomRequest) ProtoMessage()    {}
+func (*ExternalIdToDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{56}
 }
-func (m *UnbatchedSendToExternalsResponse) XXX_Unmarshal(b []byte) error {
+func (m *ExternalIdToDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnbatchedSendToExternalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExternalIdToDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnbatchedSendToExternalsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExternalIdToDenomRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2728,2754 +2749,2616 @@ func (m *UnbatchedSendToExternalsResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *UnbatchedSendToExternalsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnbatchedSendToExternalsResponse.Merge(m, src)
+func (m *ExternalIdToDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalIdToDenomRequest.Merge(m, src)
 }
-func (m *UnbatchedSendToExternalsResponse) XXX_Size() int {
+func (m *ExternalIdToDenomRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnbatchedSendToExternalsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnbatchedSendToExternalsResponse.DiscardUnknown(m)
+func (m *ExternalIdToDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalIdToDenomRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnbatchedSendToExternalsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExternalIdToDenomRequest proto.InternalMessageInfo
 
-func (m *UnbatchedSendToExternalsResponse) GetSendToExternals() []*SendToExternal {
+func (m *ExternalIdToDenomRequest) GetExternalId() string {
 	if m != nil {
-		return m.SendToExternals
+		return m.ExternalId
 	}
-	return nil
+	return ""
 }
 
-func (m *UnbatchedSendToExternalsResponse) GetChainId() string {
+func (m *ExternalIdToDenomRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-func (m *UnbatchedSendToExternalsResponse) GetPagination() *query.PageResponse {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
+type ExternalIdToDenomResponse struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*TokenInfosRequest)(nil), "mhub2.v1.TokenInfosRequest")
-	proto.RegisterType((*TokenInfosResponse)(nil), "mhub2.v1.TokenInfosResponse")
-	proto.RegisterType((*TransactionStatusRequest)(nil), "mhub2.v1.TransactionStatusRequest")
-	proto.RegisterType((*TransactionStatusResponse)(nil), "mhub2.v1.TransactionStatusResponse")
-	proto.RegisterType((*TransactionFeeRecordRequest)(nil), "mhub2.v1.TransactionFeeRecordRequest")
-	proto.RegisterType((*TransactionFeeRecordResponse)(nil), "mhub2.v1.TransactionFeeRecordResponse")
-	proto.RegisterType((*DiscountForHolderRequest)(nil), "mhub2.v1.DiscountForHolderRequest")
-	proto.RegisterType((*DiscountForHolderResponse)(nil), "mhub2.v1.DiscountForHolderResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "mhub2.v1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "mhub2.v1.ParamsResponse")
-	proto.RegisterType((*SignerSetTxRequest)(nil), "mhub2.v1.SignerSetTxRequest")
-	proto.RegisterType((*LatestSignerSetTxRequest)(nil), "mhub2.v1.LatestSignerSetTxRequest")
-	proto.RegisterType((*LastObservedSignerSetTxRequest)(nil), "mhub2.v1.LastObservedSignerSetTxRequest")
-	proto.RegisterType((*SignerSetTxResponse)(nil), "mhub2.v1.SignerSetTxResponse")
-	proto.RegisterType((*BatchTxRequest)(nil), "mhub2.v1.BatchTxRequest")
-	proto.RegisterType((*BatchTxResponse)(nil), "mhub2.v1.BatchTxResponse")
-	proto.RegisterType((*ContractCallTxRequest)(nil), "mhub2.v1.ContractCallTxRequest")
-	proto.RegisterType((*ContractCallTxResponse)(nil), "mhub2.v1.ContractCallTxResponse")
-	proto.RegisterType((*SignerSetTxConfirmationsRequest)(nil), "mhub2.v1.SignerSetTxConfirmationsRequest")
-	proto.RegisterType((*SignerSetTxConfirmationsResponse)(nil), "mhub2.v1.SignerSetTxConfirmationsResponse")
-	proto.RegisterType((*SignerSetTxsRequest)(nil), "mhub2.v1.SignerSetTxsRequest")
-	proto.RegisterType((*SignerSetTxsResponse)(nil), "mhub2.v1.SignerSetTxsResponse")
-	proto.RegisterType((*BatchTxsRequest)(nil), "mhub2.v1.BatchTxsRequest")
-	proto.RegisterType((*BatchTxsResponse)(nil), "mhub2.v1.BatchTxsResponse")
-	proto.RegisterType((*ContractCallTxsRequest)(nil), "mhub2.v1.ContractCallTxsRequest")
-	proto.RegisterType((*ContractCallTxsResponse)(nil), "mhub2.v1.ContractCallTxsResponse")
-	proto.RegisterType((*UnsignedSignerSetTxsRequest)(nil), "mhub2.v1.UnsignedSignerSetTxsRequest")
-	proto.RegisterType((*UnsignedSignerSetTxsResponse)(nil), "mhub2.v1.UnsignedSignerSetTxsResponse")
-	proto.RegisterType((*UnsignedBatchTxsRequest)(nil), "mhub2.v1.UnsignedBatchTxsRequest")
-	proto.RegisterType((*UnsignedBatchTxsResponse)(nil), "mhub2.v1.UnsignedBatchTxsResponse")
-	proto.RegisterType((*UnsignedContractCallTxsRequest)(nil), "mhub2.v1.UnsignedContractCallTxsRequest")
-	proto.RegisterType((*UnsignedContractCallTxsResponse)(nil), "mhub2.v1.UnsignedContractCallTxsResponse")
-	proto.RegisterType((*BatchTxFeesRequest)(nil), "mhub2.v1.BatchTxFeesRequest")
-	proto.RegisterType((*BatchTxFeesResponse)(nil), "mhub2.v1.BatchTxFeesResponse")
-	proto.RegisterType((*ContractCallTxConfirmationsRequest)(nil), "mhub2.v1.ContractCallTxConfirmationsRequest")
-	proto.RegisterType((*ContractCallTxConfirmationsResponse)(nil), "mhub2.v1.ContractCallTxConfirmationsResponse")
-	proto.RegisterType((*BatchTxConfirmationsRequest)(nil), "mhub2.v1.BatchTxConfirmationsRequest")
-	proto.RegisterType((*BatchTxConfirmationsResponse)(nil), "mhub2.v1.BatchTxConfirmationsResponse")
-	proto.RegisterType((*LastSubmittedExternalEventRequest)(nil), "mhub2.v1.LastSubmittedExternalEventRequest")
-	proto.RegisterType((*LastSubmittedExternalEventResponse)(nil), "mhub2.v1.LastSubmittedExternalEventResponse")
-	proto.RegisterType((*ExternalIdToDenomRequest)(nil), "mhub2.v1.ExternalIdToDenomRequest")
-	proto.RegisterType((*ExternalIdToDenomResponse)(nil), "mhub2.v1.ExternalIdToDenomResponse")
-	proto.RegisterType((*DenomToExternalIdRequest)(nil), "mhub2.v1.DenomToExternalIdRequest")
-	proto.RegisterType((*DenomToExternalIdResponse)(nil), "mhub2.v1.DenomToExternalIdResponse")
-	proto.RegisterType((*DelegateKeysByValidatorRequest)(nil), "mhub2.v1.DelegateKeysByValidatorRequest")
-	proto.RegisterType((*DelegateKeysByValidatorResponse)(nil), "mhub2.v1.DelegateKeysByValidatorResponse")
-	proto.RegisterType((*DelegateKeysByExternalSignerRequest)(nil), "mhub2.v1.DelegateKeysByExternalSignerRequest")
-	proto.RegisterType((*DelegateKeysByExternalSignerResponse)(nil), "mhub2.v1.DelegateKeysByExternalSignerResponse")
-	proto.RegisterType((*DelegateKeysByOrchestratorRequest)(nil), "mhub2.v1.DelegateKeysByOrchestratorRequest")
-	proto.RegisterType((*DelegateKeysByOrchestratorResponse)(nil), "mhub2.v1.DelegateKeysByOrchestratorResponse")
-	proto.RegisterType((*DelegateKeysRequest)(nil), "mhub2.v1.DelegateKeysRequest")
-	proto.RegisterType((*DelegateKeysResponse)(nil), "mhub2.v1.DelegateKeysResponse")
-	proto.RegisterType((*BatchedSendToExternalsRequest)(nil), "mhub2.v1.BatchedSendToExternalsRequest")
-	proto.RegisterType((*BatchedSendToExternalsResponse)(nil), "mhub2.v1.BatchedSendToExternalsResponse")
-	proto.RegisterType((*UnbatchedSendToExternalsRequest)(nil), "mhub2.v1.UnbatchedSendToExternalsRequest")
-	proto.RegisterType((*UnbatchedSendToExternalsResponse)(nil), "mhub2.v1.UnbatchedSendToExternalsResponse")
+func (m *ExternalIdToDenomResponse) Reset()         { *m = ExternalIdToDenomResponse{} }
+func (m *ExternalIdToDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*ExternalIdToDenomResponse) ProtoMessage()    {}
+func (*ExternalIdToDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{57}
 }
-
-func init() { proto.RegisterFile("mhub2/v1/query.proto", fileDescriptor_503a4f22a1222790) }
-
-var fileDescriptor_503a4f22a1222790 = []byte{
-	// 2431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x9a, 0xcd, 0x6f, 0xdc, 0xc6,
-	0x15, 0xc0, 0x4d, 0xd9, 0x96, 0x9d, 0x27, 0x5b, 0x96, 0x46, 0x6b, 0x6b, 0xb5, 0x96, 0x77, 0xa5,
-	0xb1, 0x23, 0xc9, 0xb2, 0xb4, 0xd4, 0x97, 0xd5, 0x34, 0x5f, 0x4d, 0x64, 0x59, 0xb1, 0xe3, 0xd8,
-	0xb1, 0x57, 0xb2, 0x91, 0x16, 0x28, 0x08, 0x6a, 0x39, 0xda, 0x25, 0xbc, 0x22, 0x65, 0x92, 0xab,
-	0x4a, 0x15, 0x74, 0x68, 0x81, 0x06, 0x39, 0xa4, 0x40, 0xda, 0x02, 0x2d, 0x7a, 0xe8, 0xa1, 0xed,
-	0xad, 0x41, 0x5b, 0xa0, 0xa7, 0xdc, 0x5b, 0xa0, 0x39, 0xf4, 0x10, 0xa0, 0x97, 0xa2, 0x87, 0xb4,
-	0xb0, 0xfb, 0x87, 0x14, 0x1c, 0x0e, 0xc9, 0x21, 0x39, 0xc3, 0xdd, 0x28, 0xae, 0x7b, 0xb2, 0x77,
-	0xe6, 0xbd, 0x79, 0xbf, 0xf7, 0x66, 0x86, 0xf3, 0xe6, 0x69, 0xa0, 0xb0, 0xdd, 0x6c, 0x6f, 0x2e,
-	0xa8, 0xbb, 0xf3, 0xea, 0x93, 0x36, 0x71, 0xf6, 0xab, 0x3b, 0x8e, 0xed, 0xd9, 0xe8, 0x34, 0x6d,
-	0xad, 0xee, 0xce, 0x97, 0xa6, 0xeb, 0xb6, 0xbb, 0x6d, 0xbb, 0xea, 0xa6, 0xee, 0x92, 0x40, 0x44,
-	0xdd, 0x9d, 0xdf, 0x24, 0x9e, 0x3e, 0xaf, 0xee, 0xe8, 0x0d, 0xd3, 0xd2, 0x3d, 0xd3, 0xb6, 0x02,
-	0xad, 0x52, 0x99, 0x97, 0x0d, 0xa5, 0xea, 0xb6, 0x19, 0xf6, 0x17, 0x1a, 0x76, 0xc3, 0xa6, 0xff,
-	0x55, 0xfd, 0xff, 0xb1, 0xd6, 0xd1, 0x86, 0x6d, 0x37, 0x5a, 0x44, 0xd5, 0x77, 0x4c, 0x55, 0xb7,
-	0x2c, 0xdb, 0xa3, 0x43, 0xba, 0xac, 0xf7, 0x42, 0xc4, 0xd7, 0x20, 0x16, 0x71, 0xcd, 0xb0, 0x3d,
-	0xe6, 0x0e, 0x50, 0x83, 0xd6, 0xa1, 0xb8, 0xd5, 0x6d, 0x30, 0x51, 0x3c, 0x04, 0x83, 0x1b, 0xf6,
-	0x63, 0x62, 0xdd, 0xb6, 0xb6, 0x6c, 0xb7, 0x46, 0x9e, 0xb4, 0x89, 0xeb, 0xe1, 0x55, 0x40, 0x7c,
-	0xa3, 0xbb, 0x63, 0x5b, 0x2e, 0x41, 0x55, 0x38, 0xd1, 0x32, 0x5d, 0xaf, 0xa8, 0x8c, 0x29, 0x53,
-	0x7d, 0x0b, 0x85, 0x6a, 0x18, 0x86, 0x6a, 0x2c, 0xbb, 0x72, 0xe2, 0xf3, 0x2f, 0x2b, 0xc7, 0x6a,
-	0x54, 0x0e, 0x2f, 0x42, 0x71, 0xc3, 0xd1, 0x2d, 0x57, 0xaf, 0xfb, 0xcc, 0xeb, 0x9e, 0xee, 0xb5,
-	0x43, 0x0b, 0x68, 0x18, 0x4e, 0x79, 0x7b, 0x5a, 0x53, 0x77, 0x9b, 0x74, 0xb8, 0x97, 0x6a, 0xbd,
-	0xde, 0xde, 0x2d, 0xdd, 0x6d, 0xe2, 0xbb, 0x30, 0x22, 0x50, 0x62, 0x04, 0x73, 0xd0, 0xeb, 0xd2,
-	0x16, 0xc6, 0x80, 0x38, 0x86, 0xbd, 0x40, 0x96, 0x12, 0x28, 0x35, 0x26, 0x87, 0x97, 0xe1, 0x22,
-	0x37, 0xdc, 0x1a, 0x21, 0x35, 0x52, 0xb7, 0x1d, 0xa3, 0x23, 0xc6, 0x3a, 0x8c, 0x8a, 0xf5, 0x18,
-	0xc9, 0x22, 0xf4, 0x3a, 0xb4, 0x85, 0x91, 0x9c, 0xe7, 0x49, 0x22, 0xf1, 0x10, 0x26, 0x10, 0xc5,
-	0x4b, 0x50, 0x5c, 0x35, 0xdd, 0xba, 0xdd, 0xb6, 0xbc, 0x35, 0xdb, 0xb9, 0x65, 0xb7, 0x0c, 0xe2,
-	0x84, 0x24, 0x45, 0x38, 0xa5, 0x1b, 0x86, 0x43, 0x5c, 0x97, 0x91, 0x84, 0x3f, 0x71, 0x03, 0x46,
-	0x04, 0x5a, 0x8c, 0xe3, 0x5d, 0x38, 0x6d, 0xb0, 0x4e, 0xaa, 0x77, 0x66, 0xa5, 0xea, 0xcf, 0xc0,
-	0x3f, 0xbf, 0xac, 0x4c, 0x34, 0x4c, 0xaf, 0xd9, 0xde, 0xac, 0xd6, 0xed, 0x6d, 0x95, 0x2d, 0xbd,
-	0xe0, 0x9f, 0x59, 0xd7, 0x78, 0xac, 0x7a, 0xfb, 0x3b, 0xc4, 0xad, 0xae, 0x92, 0x7a, 0x2d, 0xd2,
-	0xc7, 0xe7, 0xe0, 0xec, 0x7d, 0xdd, 0xd1, 0xb7, 0xa3, 0x65, 0xf0, 0x16, 0xf4, 0x87, 0x0d, 0xd1,
-	0x12, 0xe8, 0xdd, 0xa1, 0x2d, 0xcc, 0xed, 0x81, 0xd8, 0xed, 0x40, 0x92, 0x2d, 0x00, 0x26, 0x85,
-	0xbf, 0x0d, 0x68, 0xdd, 0x6c, 0x58, 0xc4, 0x59, 0x27, 0xde, 0xc6, 0x5e, 0xe8, 0xeb, 0x14, 0x0c,
-	0xb8, 0xb4, 0x55, 0x73, 0x89, 0xa7, 0x59, 0xb6, 0x55, 0x27, 0x74, 0xbc, 0x13, 0xb5, 0x7e, 0x37,
-	0x94, 0xbe, 0xe7, 0xb7, 0xa2, 0x11, 0x38, 0x5d, 0x6f, 0xea, 0xa6, 0xa5, 0x99, 0x46, 0xb1, 0x27,
-	0x08, 0x0b, 0xfd, 0x7d, 0xdb, 0xc0, 0xd7, 0xa1, 0xf8, 0x9e, 0xee, 0x11, 0xd7, 0x13, 0x18, 0xe0,
-	0xd5, 0x94, 0xa4, 0xda, 0x6b, 0x50, 0x7e, 0x4f, 0x77, 0xbd, 0xf7, 0x37, 0x5d, 0xe2, 0xec, 0x12,
-	0xe3, 0xab, 0x29, 0xdf, 0x81, 0xa1, 0x84, 0x02, 0x8b, 0xca, 0x12, 0x40, 0xec, 0x4f, 0x76, 0x41,
-	0xf0, 0x2a, 0x2f, 0x45, 0x0e, 0xe2, 0x3d, 0xe8, 0x5f, 0xd1, 0xbd, 0x7a, 0x33, 0xb6, 0x3c, 0x0d,
-	0x83, 0x64, 0xcf, 0x23, 0x8e, 0xa5, 0xb7, 0x34, 0xcf, 0xdf, 0x53, 0x31, 0xc2, 0xb9, 0xb0, 0x23,
-	0xd8, 0x6b, 0x06, 0xaa, 0x40, 0xdf, 0xa6, 0xaf, 0xcd, 0xc2, 0xd7, 0x43, 0xc3, 0x07, 0xb4, 0x29,
-	0x1b, 0xba, 0xe3, 0x49, 0x37, 0x5e, 0x85, 0x73, 0x91, 0x65, 0xe6, 0xc2, 0x24, 0x9c, 0xa4, 0xba,
-	0x8c, 0x7e, 0x30, 0xa6, 0x0f, 0x25, 0x83, 0x7e, 0xfc, 0x89, 0x02, 0xe7, 0x6f, 0xd8, 0x96, 0xe7,
-	0xe8, 0x75, 0xef, 0x86, 0xde, 0x6a, 0xc5, 0xf4, 0xb3, 0x80, 0x4c, 0x6b, 0x57, 0x6f, 0x99, 0x06,
-	0xfd, 0x46, 0x69, 0x6e, 0xdd, 0xde, 0x09, 0xe6, 0xf5, 0x4c, 0x6d, 0x90, 0xef, 0x59, 0xf7, 0x3b,
-	0x32, 0xe2, 0xbc, 0x1f, 0x09, 0xf1, 0x8e, 0xee, 0x3c, 0x80, 0x0b, 0x69, 0x22, 0xe6, 0xd5, 0x37,
-	0x00, 0x5a, 0x76, 0xc3, 0xac, 0x6b, 0x75, 0xbd, 0xd5, 0x62, 0xae, 0x15, 0x63, 0xd7, 0x52, 0x5a,
-	0x2f, 0x51, 0x59, 0xff, 0x07, 0xde, 0x82, 0x0a, 0x37, 0x6b, 0x37, 0x6c, 0x6b, 0xcb, 0x74, 0xb6,
-	0x83, 0x4f, 0xef, 0x73, 0x5d, 0xc4, 0x04, 0xc6, 0xe4, 0x76, 0x98, 0x13, 0x6f, 0x07, 0xab, 0x4b,
-	0xf7, 0xda, 0x0e, 0xf1, 0xf7, 0xdd, 0xf1, 0xa9, 0xbe, 0x85, 0x71, 0xe1, 0xea, 0xe2, 0xf5, 0x6b,
-	0x9c, 0x12, 0xde, 0x4b, 0xac, 0xdb, 0xc8, 0x85, 0x35, 0x80, 0xf8, 0x98, 0x62, 0xe1, 0x99, 0xa8,
-	0x06, 0x5f, 0x89, 0xaa, 0x7f, 0x4e, 0x55, 0x83, 0x63, 0x8f, 0x9d, 0x56, 0xd5, 0xfb, 0x7a, 0x83,
-	0x30, 0xdd, 0x1a, 0xa7, 0x99, 0xe7, 0xe0, 0x2f, 0x14, 0x28, 0x24, 0x4d, 0x33, 0xaf, 0x96, 0xa1,
-	0x2f, 0x0e, 0x5f, 0xe8, 0x96, 0x64, 0xd3, 0x40, 0x14, 0x50, 0x17, 0xbd, 0x93, 0x60, 0xee, 0xa1,
-	0xcc, 0x93, 0x1d, 0x99, 0x03, 0xa3, 0x3c, 0x34, 0xf6, 0xa2, 0x4d, 0xf0, 0x22, 0xe3, 0xf1, 0x91,
-	0x02, 0x03, 0xb1, 0x59, 0x16, 0x8b, 0x6b, 0x70, 0x8a, 0x6e, 0xae, 0x68, 0x7a, 0x05, 0xdb, 0x2f,
-	0x94, 0x78, 0x7e, 0x01, 0x38, 0x48, 0x6f, 0x9b, 0x17, 0x19, 0x87, 0x9f, 0x2a, 0x30, 0x9c, 0xb1,
-	0x1e, 0x1d, 0x32, 0x27, 0xfd, 0xfd, 0x1a, 0x06, 0x43, 0xbe, 0x61, 0x03, 0xb1, 0xe7, 0x17, 0x91,
-	0x1a, 0x5c, 0x7c, 0x68, 0xd1, 0xb5, 0x66, 0x88, 0xb6, 0x8b, 0xf4, 0x88, 0xce, 0x73, 0xf4, 0x11,
-	0x8c, 0x8a, 0xc7, 0xfc, 0x7a, 0xfb, 0x00, 0xdf, 0x83, 0xe1, 0x70, 0xdc, 0xf4, 0x32, 0x3e, 0x12,
-	0xe7, 0x3b, 0x50, 0xcc, 0x8e, 0x77, 0x84, 0xf5, 0x89, 0x1f, 0x42, 0x39, 0x1c, 0x48, 0xb2, 0xbc,
-	0x8e, 0xc4, 0xf7, 0x00, 0x2a, 0xd2, 0x61, 0x8f, 0xb6, 0x6e, 0xb0, 0x0a, 0x88, 0xd1, 0xaf, 0x11,
-	0xe2, 0x76, 0x71, 0xfc, 0xef, 0xc2, 0x50, 0x42, 0x81, 0xd9, 0xd5, 0xe0, 0xc4, 0x16, 0x89, 0x62,
-	0x33, 0x92, 0x58, 0x79, 0xe1, 0x9a, 0xbb, 0x61, 0x9b, 0xd6, 0xca, 0x9c, 0x9f, 0x1b, 0xfd, 0xee,
-	0x5f, 0x95, 0xa9, 0x2e, 0x52, 0x33, 0x5f, 0xc1, 0xad, 0xd1, 0x81, 0xf1, 0xaf, 0x14, 0xc0, 0x49,
-	0x17, 0x84, 0x27, 0xd2, 0xff, 0xed, 0x00, 0x7e, 0x0c, 0x97, 0x73, 0xf1, 0x58, 0x9c, 0x56, 0x05,
-	0x07, 0xd9, 0x15, 0xd9, 0x24, 0x49, 0xcf, 0xb2, 0x1f, 0x29, 0x70, 0x91, 0xcd, 0x82, 0x30, 0x0a,
-	0xa9, 0xc4, 0x48, 0xc9, 0x24, 0x46, 0xc2, 0x2c, 0xab, 0x47, 0x9c, 0x65, 0xe5, 0x38, 0xfd, 0x5d,
-	0x18, 0x15, 0x63, 0x30, 0x6f, 0xdf, 0x10, 0x78, 0x7b, 0x29, 0xb3, 0x6f, 0xa4, 0x6e, 0x7e, 0x00,
-	0xe3, 0x7e, 0x9e, 0xba, 0xde, 0xde, 0xdc, 0x36, 0x3d, 0x8f, 0x18, 0x37, 0x19, 0xd9, 0xcd, 0x5d,
-	0x62, 0x79, 0x5f, 0x6b, 0x27, 0xdd, 0x04, 0x9c, 0x37, 0x32, 0xc3, 0xaf, 0x40, 0x1f, 0xf1, 0x1b,
-	0x92, 0x61, 0xa4, 0x4d, 0x34, 0x8c, 0xf8, 0x11, 0x14, 0x43, 0xcd, 0xdb, 0xc6, 0x86, 0xbd, 0x4a,
-	0x2c, 0x7b, 0x9b, 0x9b, 0x83, 0x28, 0xc4, 0xd1, 0x36, 0x02, 0x12, 0x89, 0xe7, 0xe1, 0xcd, 0xc3,
-	0x88, 0x60, 0x5c, 0x46, 0x55, 0x80, 0x93, 0x86, 0xdf, 0xc0, 0x86, 0x0c, 0x7e, 0xe0, 0x3b, 0x50,
-	0xa4, 0x62, 0x1b, 0x76, 0xac, 0x19, 0xa2, 0x08, 0x35, 0xf2, 0xec, 0xbf, 0x0e, 0x23, 0x82, 0xc1,
-	0xb8, 0xa8, 0xe4, 0x39, 0x86, 0x9b, 0x50, 0x5e, 0x25, 0x2d, 0xd2, 0xd0, 0x3d, 0x72, 0x87, 0xec,
-	0xbb, 0x2b, 0xfb, 0x8f, 0x82, 0x6d, 0x64, 0x47, 0x17, 0xbd, 0x6b, 0x30, 0xb8, 0x1b, 0xb6, 0x69,
-	0xc9, 0xd9, 0x1b, 0x88, 0x3a, 0xde, 0xee, 0x3c, 0x8d, 0x6d, 0xa8, 0x48, 0x2d, 0x71, 0xb4, 0x5e,
-	0x33, 0x65, 0x04, 0x88, 0xd7, 0x0c, 0x87, 0x9f, 0x87, 0x82, 0xed, 0xf8, 0x5f, 0x6d, 0xcf, 0x49,
-	0xe0, 0x04, 0xa6, 0x86, 0xf8, 0x3e, 0xa6, 0x82, 0x4d, 0xb8, 0x9c, 0x34, 0x1b, 0x46, 0x29, 0x38,
-	0xa8, 0x42, 0x2f, 0x27, 0x21, 0xda, 0x4b, 0x5a, 0x70, 0x6a, 0x31, 0xf3, 0xfd, 0x24, 0x21, 0x9f,
-	0xe7, 0xe1, 0x87, 0x0a, 0x5c, 0xc9, 0xb7, 0x15, 0x9d, 0x4f, 0x5f, 0x21, 0xa4, 0x47, 0xf0, 0xf9,
-	0x09, 0x8c, 0x27, 0x39, 0xde, 0xe7, 0x84, 0x42, 0x8f, 0x65, 0xe3, 0x2a, 0xd2, 0x71, 0xf3, 0x7c,
-	0xff, 0x3e, 0xe0, 0x3c, 0x93, 0x47, 0x71, 0x5c, 0x30, 0x25, 0x3d, 0xa2, 0x29, 0xc1, 0x73, 0x30,
-	0xc4, 0xdb, 0xee, 0xe2, 0x60, 0x7c, 0x04, 0x85, 0xa4, 0x06, 0xe3, 0x7b, 0x13, 0xce, 0x1a, 0xac,
-	0x5d, 0x7b, 0x4c, 0xf6, 0xe3, 0x23, 0x32, 0xfa, 0x0c, 0xde, 0x75, 0x1b, 0x09, 0xcd, 0x33, 0x06,
-	0xf7, 0x0b, 0xeb, 0x70, 0x89, 0x7e, 0x27, 0x89, 0xb1, 0x4e, 0x2c, 0x23, 0xde, 0x91, 0x11, 0xd3,
-	0xcb, 0xd0, 0xef, 0x12, 0xcb, 0x20, 0x69, 0xef, 0xcf, 0x06, 0xad, 0x5d, 0x04, 0xfa, 0x07, 0x0a,
-	0x94, 0x65, 0x36, 0xa2, 0x73, 0x6b, 0xd0, 0x1f, 0x4e, 0xf3, 0x6c, 0x2d, 0x8c, 0x94, 0x20, 0xc7,
-	0x48, 0x6a, 0xd7, 0xce, 0xb9, 0xc9, 0xd1, 0xf2, 0x18, 0x3e, 0x55, 0xfc, 0xe4, 0x66, 0xf3, 0x7f,
-	0xeb, 0x69, 0x2a, 0xab, 0x3f, 0x7e, 0xd4, 0xac, 0x1e, 0xff, 0x4d, 0x81, 0x31, 0x39, 0xed, 0x0b,
-	0x8a, 0x59, 0x2a, 0xe9, 0x3f, 0x7e, 0xe4, 0xa4, 0x7f, 0xe1, 0xcf, 0x18, 0x4e, 0x3e, 0xf0, 0x45,
-	0xd1, 0x43, 0xe8, 0x0d, 0x8a, 0x58, 0x68, 0x38, 0x5d, 0xd6, 0x62, 0x71, 0x28, 0x15, 0xb3, 0x1d,
-	0xc1, 0x90, 0xb8, 0xf8, 0xc3, 0xbf, 0xff, 0xe7, 0x67, 0x3d, 0x08, 0x0d, 0xa8, 0x51, 0x95, 0x35,
-	0xa8, 0x81, 0x21, 0x17, 0xfa, 0xb8, 0x24, 0x1e, 0x8d, 0x8a, 0x73, 0x7b, 0x66, 0xe0, 0x92, 0xa4,
-	0x97, 0x59, 0x99, 0xa4, 0x56, 0xc6, 0x51, 0x25, 0xb6, 0x12, 0xdf, 0x1e, 0xd4, 0x83, 0x30, 0x58,
-	0x87, 0xe8, 0x43, 0x05, 0x06, 0x33, 0xe5, 0x31, 0x84, 0xe3, 0xd1, 0x65, 0xb5, 0xb3, 0x4e, 0x04,
-	0x55, 0x4a, 0x30, 0x85, 0x26, 0x84, 0x04, 0x2d, 0x3a, 0x2a, 0x0f, 0xf2, 0x4b, 0x05, 0x86, 0x25,
-	0x05, 0x37, 0x34, 0xc5, 0xe3, 0xe4, 0xd5, 0xe4, 0x3a, 0x41, 0x5d, 0xa7, 0x50, 0x2a, 0x9a, 0x95,
-	0x40, 0xb9, 0x9e, 0x66, 0xb3, 0xc1, 0x79, 0xb6, 0x8f, 0x14, 0x38, 0xc5, 0xf2, 0x30, 0x54, 0xcc,
-	0x5e, 0x69, 0x98, 0xed, 0x11, 0x41, 0x0f, 0xb3, 0x7b, 0x8b, 0xda, 0x5d, 0x41, 0x6f, 0xc5, 0x76,
-	0x83, 0xdc, 0xd3, 0xdb, 0x73, 0x39, 0x43, 0xea, 0x41, 0x26, 0xe1, 0x3c, 0x54, 0x0f, 0xb8, 0x2c,
-	0xf5, 0x10, 0xfd, 0x5e, 0x81, 0xfe, 0x64, 0x02, 0x8c, 0x2a, 0xd2, 0xfb, 0x0b, 0x03, 0x1b, 0x93,
-	0x0b, 0x30, 0xbe, 0x0f, 0x28, 0x5f, 0x0d, 0xdd, 0x8f, 0xf9, 0xea, 0x4c, 0x92, 0x96, 0xc4, 0x32,
-	0x9c, 0xd9, 0xfb, 0x43, 0xba, 0x91, 0xf1, 0x7e, 0x0f, 0xce, 0xf0, 0xd7, 0x59, 0x24, 0x9e, 0xa0,
-	0x68, 0xdf, 0x94, 0x65, 0xdd, 0x0c, 0x74, 0x8a, 0x82, 0x62, 0x34, 0x26, 0x9a, 0x40, 0x1e, 0x11,
-	0xd9, 0x70, 0x3a, 0xbc, 0x9f, 0xa2, 0xec, 0xcc, 0x44, 0x06, 0x4b, 0xa2, 0x2e, 0x66, 0x6c, 0x86,
-	0x1a, 0x9b, 0x40, 0x57, 0x52, 0xb3, 0x26, 0x9c, 0x3b, 0xf4, 0xb1, 0x02, 0xe7, 0x52, 0x37, 0x4e,
-	0x24, 0x8d, 0x7c, 0x64, 0x7f, 0x3c, 0x47, 0x82, 0x61, 0x2c, 0x51, 0x8c, 0x2a, 0x9a, 0x49, 0x63,
-	0xe4, 0x4d, 0x11, 0xfa, 0xa3, 0x02, 0x45, 0x59, 0xc9, 0x10, 0x5d, 0xed, 0x58, 0x16, 0x8c, 0x00,
-	0xa7, 0xbb, 0x11, 0x65, 0xa4, 0xaf, 0x53, 0xd2, 0x65, 0xb4, 0x24, 0x9e, 0x9d, 0x44, 0x56, 0x11,
-	0x5c, 0x5f, 0x78, 0xe2, 0x5f, 0x2b, 0x50, 0x10, 0xdd, 0x94, 0xd0, 0xcb, 0xb9, 0xb7, 0xa1, 0x88,
-	0x74, 0xa2, 0x93, 0x18, 0xa3, 0x7c, 0x95, 0x52, 0x2e, 0xa1, 0x05, 0xd1, 0x66, 0xec, 0xc0, 0xf8,
-	0x99, 0x02, 0x17, 0x73, 0xae, 0xb0, 0x68, 0xa6, 0x9b, 0x6b, 0x6a, 0x44, 0x3c, 0xdb, 0xa5, 0xb4,
-	0x3c, 0xbc, 0x71, 0xd5, 0xba, 0x23, 0xfa, 0x6f, 0x14, 0x28, 0x88, 0x2a, 0x4c, 0x7c, 0x78, 0x73,
-	0xaa, 0x5a, 0x7c, 0x78, 0xf3, 0x0a, 0x55, 0xf8, 0x35, 0x4a, 0x79, 0x1d, 0x2d, 0xc6, 0x94, 0xbc,
-	0x9c, 0x7a, 0xc0, 0xf2, 0x92, 0x43, 0x75, 0x87, 0x58, 0x86, 0x69, 0x35, 0x78, 0xc8, 0x9f, 0x28,
-	0x30, 0x90, 0x2e, 0x2f, 0xa1, 0xf1, 0xac, 0xe5, 0xf4, 0x36, 0xc6, 0x79, 0x22, 0x0c, 0x6c, 0x99,
-	0x82, 0xcd, 0xa1, 0x6a, 0x6a, 0xde, 0x49, 0x07, 0xa6, 0x3f, 0x28, 0x71, 0x09, 0x2d, 0xbd, 0xc1,
-	0xa7, 0xb2, 0x76, 0x25, 0x1b, 0xfd, 0x6a, 0x17, 0x92, 0x0c, 0xf4, 0x4d, 0x0a, 0xfa, 0x0a, 0x5a,
-	0x8e, 0x41, 0x53, 0xa2, 0xf9, 0xc0, 0x7f, 0x52, 0xa0, 0x24, 0xbf, 0xb9, 0xa3, 0x6b, 0xc9, 0xd3,
-	0x34, 0xb7, 0x72, 0x50, 0x9a, 0xe9, 0x4e, 0x98, 0x91, 0x7f, 0x93, 0x92, 0x2f, 0xa2, 0xf9, 0x98,
-	0xdc, 0x76, 0xf4, 0x7a, 0x8b, 0xa8, 0x5c, 0x8d, 0x80, 0x83, 0xe7, 0xa0, 0xdb, 0xd0, 0xc7, 0xd5,
-	0xcc, 0xf8, 0xec, 0x27, 0x5b, 0x7b, 0x2b, 0x5d, 0x92, 0xf4, 0x32, 0x8c, 0xab, 0x14, 0xe3, 0x32,
-	0x1a, 0xcf, 0xce, 0xf4, 0x16, 0x49, 0xee, 0x8a, 0x9f, 0x2b, 0x30, 0x98, 0x29, 0x23, 0xf0, 0xf9,
-	0x8f, 0xac, 0x76, 0x51, 0xba, 0x9c, 0x2b, 0xc3, 0x48, 0x5e, 0xa1, 0x24, 0x0b, 0x68, 0x8e, 0x3f,
-	0x58, 0xfd, 0xd4, 0x53, 0xb3, 0x1d, 0x93, 0xa6, 0x96, 0xc4, 0x50, 0xb9, 0x4a, 0x81, 0x9f, 0x07,
-	0x07, 0x95, 0x07, 0x1f, 0x2c, 0x53, 0x5f, 0xe0, 0xc1, 0x64, 0x95, 0x0c, 0x1e, 0x4c, 0x5a, 0xa0,
-	0xe8, 0x0e, 0x8c, 0x92, 0xf0, 0xa9, 0xb9, 0x66, 0x1a, 0xe8, 0xb7, 0x0a, 0x5c, 0x10, 0x5f, 0x84,
-	0xd0, 0x64, 0x6a, 0x5a, 0x64, 0x97, 0x94, 0xd2, 0x54, 0x67, 0x41, 0xf9, 0xa6, 0xa5, 0xf9, 0xba,
-	0xc6, 0xee, 0x15, 0x1a, 0x77, 0x7b, 0xe0, 0xe7, 0xf5, 0x53, 0x05, 0x8a, 0xb2, 0xcb, 0x07, 0x4a,
-	0xec, 0xc5, 0xdc, 0xeb, 0x14, 0x7f, 0xfc, 0x75, 0xba, 0xcb, 0x88, 0x62, 0x1a, 0xb0, 0xb6, 0xad,
-	0x0e, 0xb4, 0x9f, 0x29, 0x30, 0x2c, 0x29, 0xd2, 0xf0, 0x9f, 0x98, 0xfc, 0x8a, 0x11, 0xff, 0x89,
-	0xe9, 0x50, 0xf1, 0x11, 0x25, 0xa4, 0x89, 0x0b, 0xb8, 0x1a, 0x55, 0x05, 0x12, 0x69, 0x5f, 0xa6,
-	0x88, 0x70, 0x88, 0xfe, 0xa2, 0xc0, 0x68, 0x5e, 0xf1, 0x05, 0xcd, 0xca, 0xa8, 0x84, 0x05, 0xa1,
-	0x52, 0xb5, 0x5b, 0x71, 0xe6, 0xc9, 0x4d, 0xea, 0xc9, 0xb7, 0xd0, 0x1b, 0x32, 0x4f, 0xc2, 0xb5,
-	0x2b, 0xce, 0xb3, 0x83, 0xfc, 0xe4, 0x10, 0xfd, 0x55, 0x81, 0x92, 0xbc, 0x90, 0xc2, 0x7f, 0x33,
-	0x3b, 0x56, 0x78, 0xf8, 0x6f, 0x66, 0xe7, 0xda, 0x0c, 0xbe, 0x47, 0x1d, 0xb8, 0x85, 0xd6, 0x64,
-	0x0e, 0xf0, 0x15, 0xa1, 0x84, 0x13, 0xa2, 0x32, 0xd2, 0x21, 0xda, 0x87, 0x33, 0xbc, 0x55, 0x3e,
-	0xe3, 0x16, 0x54, 0x6b, 0xf8, 0x8c, 0x5b, 0x54, 0x9a, 0xc1, 0xd3, 0x14, 0xef, 0x0a, 0xc2, 0x32,
-	0x3c, 0x6e, 0x19, 0x6f, 0x01, 0xc4, 0x4f, 0x7c, 0xd0, 0x45, 0xd1, 0xc3, 0x9f, 0xd0, 0xec, 0xa8,
-	0xb8, 0x93, 0x19, 0xbd, 0x44, 0x8d, 0x0e, 0xa3, 0xf3, 0xb1, 0x51, 0x76, 0x21, 0xa2, 0x23, 0x7f,
-	0xac, 0xc0, 0x60, 0xe6, 0xf1, 0x0f, 0xff, 0x6d, 0x94, 0x3d, 0x27, 0xe2, 0xbf, 0x8d, 0xd2, 0xd7,
-	0x43, 0xa2, 0xab, 0xab, 0x17, 0x0b, 0x6b, 0xc1, 0x8b, 0x21, 0xf5, 0x80, 0x3d, 0x08, 0xa2, 0x57,
-	0xd7, 0x82, 0xe8, 0x11, 0x10, 0x9f, 0x59, 0xe5, 0x3c, 0x2e, 0xe2, 0x33, 0xab, 0xbc, 0xb7, 0x44,
-	0x78, 0x81, 0x72, 0xcd, 0xa0, 0x69, 0x31, 0xd7, 0x16, 0x21, 0x5a, 0xf0, 0x80, 0x88, 0x63, 0xfb,
-	0xb1, 0x7f, 0x8c, 0xa4, 0x5f, 0x05, 0x25, 0x8e, 0x11, 0xc9, 0x43, 0xa3, 0xc4, 0x31, 0x22, 0x7b,
-	0x56, 0x84, 0x55, 0x8a, 0x74, 0x15, 0x4d, 0x72, 0xab, 0x83, 0x09, 0x6b, 0x5b, 0xb6, 0xa3, 0x35,
-	0xa9, 0x78, 0x7c, 0xe2, 0xaf, 0xbc, 0xfb, 0xf9, 0xd3, 0xb2, 0xf2, 0xc5, 0xd3, 0xb2, 0xf2, 0xef,
-	0xa7, 0x65, 0xe5, 0x93, 0x67, 0xe5, 0x63, 0x5f, 0x3c, 0x2b, 0x1f, 0xfb, 0xc7, 0xb3, 0xf2, 0xb1,
-	0xef, 0xcc, 0x71, 0x7f, 0xec, 0xba, 0x6b, 0x5a, 0x1e, 0x71, 0x36, 0x88, 0xbe, 0xcd, 0xc6, 0xdd,
-	0xb6, 0x8d, 0x76, 0x8b, 0xa8, 0x7b, 0xec, 0x27, 0xfd, 0xd3, 0xd7, 0x66, 0x2f, 0x7d, 0x99, 0xb6,
-	0xf8, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xbd, 0xd8, 0xa2, 0x7e, 0x27, 0x00, 0x00,
+func (m *ExternalIdToDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Module parameters query
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	// get info on individual outgoing data
-	SignerSetTx(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
-	LatestSignerSetTx(ctx context.Context, in *LatestSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
-	LastObservedSignerSetTx(ctx context.Context, in *LastObservedSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
-	BatchTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxResponse, error)
-	ContractCallTx(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*ContractCallTxResponse, error)
-	// get collections of outgoing traffic from the bridge
-	SignerSetTxs(ctx context.Context, in *SignerSetTxsRequest, opts ...grpc.CallOption) (*SignerSetTxsResponse, error)
-	BatchTxs(ctx context.Context, in *BatchTxsRequest, opts ...grpc.CallOption) (*BatchTxsResponse, error)
-	ContractCallTxs(ctx context.Context, in *ContractCallTxsRequest, opts ...grpc.CallOption) (*ContractCallTxsResponse, error)
-	// TODO: can/should we group these into one endpoint?
-	SignerSetTxConfirmations(ctx context.Context, in *SignerSetTxConfirmationsRequest, opts ...grpc.CallOption) (*SignerSetTxConfirmationsResponse, error)
-	BatchTxConfirmations(ctx context.Context, in *BatchTxConfirmationsRequest, opts ...grpc.CallOption) (*BatchTxConfirmationsResponse, error)
-	ContractCallTxConfirmations(ctx context.Context, in *ContractCallTxConfirmationsRequest, opts ...grpc.CallOption) (*ContractCallTxConfirmationsResponse, error)
-	// pending external signature queries for orchestrators to figure out which
-	// signatures they are missing
-	// TODO: can/should we group this into one endpoint?
-	UnsignedSignerSetTxs(ctx context.Context, in *UnsignedSignerSetTxsRequest, opts ...grpc.CallOption) (*UnsignedSignerSetTxsResponse, error)
-	UnsignedBatchTxs(ctx context.Context, in *UnsignedBatchTxsRequest, opts ...grpc.CallOption) (*UnsignedBatchTxsResponse, error)
-	UnsignedContractCallTxs(ctx context.Context, in *UnsignedContractCallTxsRequest, opts ...grpc.CallOption) (*UnsignedContractCallTxsResponse, error)
-	LastSubmittedExternalEvent(ctx context.Context, in *LastSubmittedExternalEventRequest, opts ...grpc.CallOption) (*LastSubmittedExternalEventResponse, error)
-	// Queries the fees for all pending batches, results are returned in sdk.Coin
-	// (fee_amount_int)(contract_address) style
-	BatchTxFees(ctx context.Context, in *BatchTxFeesRequest, opts ...grpc.CallOption) (*BatchTxFeesResponse, error)
-	// Query for info about denoms tracked by mhub2
-	ExternalIdToDenom(ctx context.Context, in *ExternalIdToDenomRequest, opts ...grpc.CallOption) (*ExternalIdToDenomResponse, error)
-	// Query for info about denoms tracked by mhub2
-	DenomToExternalId(ctx context.Context, in *DenomToExternalIdRequest, opts ...grpc.CallOption) (*DenomToExternalIdResponse, error)
-	// Query for batch send to externals
-	BatchedSendToExternals(ctx context.Context, in *BatchedSendToExternalsRequest, opts ...grpc.CallOption) (*BatchedSendToExternalsResponse, error)
-	// Query for unbatched send to externals
-	UnbatchedSendToExternals(ctx context.Context, in *UnbatchedSendToExternalsRequest, opts ...grpc.CallOption) (*UnbatchedSendToExternalsResponse, error)
-	// delegate keys
-	DelegateKeysByValidator(ctx context.Context, in *DelegateKeysByValidatorRequest, opts ...grpc.CallOption) (*DelegateKeysByValidatorResponse, error)
-	DelegateKeysByExternalSigner(ctx context.Context, in *DelegateKeysByExternalSignerRequest, opts ...grpc.CallOption) (*DelegateKeysByExternalSignerResponse, error)
-	DelegateKeysByOrchestrator(ctx context.Context, in *DelegateKeysByOrchestratorRequest, opts ...grpc.CallOption) (*DelegateKeysByOrchestratorResponse, error)
-	DelegateKeys(ctx context.Context, in *DelegateKeysRequest, opts ...grpc.CallOption) (*DelegateKeysResponse, error)
-	TokenInfos(ctx context.Context, in *TokenInfosRequest, opts ...grpc.CallOption) (*TokenInfosResponse, error)
-	TransactionStatus(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatusResponse, error)
-	TransactionFeeRecord(ctx context.Context, in *TransactionFeeRecordRequest, opts ...grpc.CallOption) (*TransactionFeeRecordResponse, error)
-	DiscountForHolder(ctx context.Context, in *DiscountForHolderRequest, opts ...grpc.CallOption) (*DiscountForHolderResponse, error)
+func (m *ExternalIdToDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalIdToDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *ExternalIdToDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalIdToDenomResponse.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *ExternalIdToDenomResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ExternalIdToDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalIdToDenomResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) SignerSetTx(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
-	out := new(SignerSetTxResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/SignerSetTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_ExternalIdToDenomResponse proto.InternalMessageInfo
 
-func (c *queryClient) LatestSignerSetTx(ctx context.Context, in *LatestSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
-	out := new(SignerSetTxResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/LatestSignerSetTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ExternalIdToDenomResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) LastObservedSignerSetTx(ctx context.Context, in *LastObservedSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
-	out := new(SignerSetTxResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/LastObservedSignerSetTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DenomToExternalIdRequest struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (c *queryClient) BatchTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxResponse, error) {
-	out := new(BatchTxResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/BatchTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DenomToExternalIdRequest) Reset()         { *m = DenomToExternalIdRequest{} }
+func (m *DenomToExternalIdRequest) String() string { return proto.CompactTextString(m) }
+func (*DenomToExternalIdRequest) ProtoMessage()    {}
+func (*DenomToExternalIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{58}
 }
-
-func (c *queryClient) ContractCallTx(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*ContractCallTxResponse, error) {
-	out := new(ContractCallTxResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/ContractCallTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DenomToExternalIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) SignerSetTxs(ctx context.Context, in *SignerSetTxsRequest, opts ...grpc.CallOption) (*SignerSetTxsResponse, error) {
-	out := new(SignerSetTxsResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/SignerSetTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DenomToExternalIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomToExternalIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DenomToExternalIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomToExternalIdRequest.Merge(m, src)
+}
+func (m *DenomToExternalIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomToExternalIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomToExternalIdRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) BatchTxs(ctx context.Context, in *BatchTxsRequest, opts ...grpc.CallOption) (*BatchTxsResponse, error) {
-	out := new(BatchTxsResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/BatchTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DenomToExternalIdRequest proto.InternalMessageInfo
+
+func (m *DenomToExternalIdRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ContractCallTxs(ctx context.Context, in *ContractCallTxsRequest, opts ...grpc.CallOption) (*ContractCallTxsResponse, error) {
-	out := new(ContractCallTxsResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/ContractCallTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DenomToExternalIdRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) SignerSetTxConfirmations(ctx context.Context, in *SignerSetTxConfirmationsRequest, opts ...grpc.CallOption) (*SignerSetTxConfirmationsResponse, error) {
-	out := new(SignerSetTxConfirmationsResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/SignerSetTxConfirmations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DenomToExternalIdResponse struct {
+	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (c *queryClient) BatchTxConfirmations(ctx context.Context, in *BatchTxConfirmationsRequest, opts ...grpc.CallOption) (*BatchTxConfirmationsResponse, error) {
-	out := new(BatchTxConfirmationsResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/BatchTxConfirmations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DenomToExternalIdResponse) Reset()         { *m = DenomToExternalIdResponse{} }
+func (m *DenomToExternalIdResponse) String() string { return proto.CompactTextString(m) }
+func (*DenomToExternalIdResponse) ProtoMessage()    {}
+func (*DenomToExternalIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{59}
 }
-
-func (c *queryClient) ContractCallTxConfirmations(ctx context.Context, in *ContractCallTxConfirmationsRequest, opts ...grpc.CallOption) (*ContractCallTxConfirmationsResponse, error) {
-	out := new(ContractCallTxConfirmationsResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/ContractCallTxConfirmations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DenomToExternalIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomToExternalIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomToExternalIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DenomToExternalIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomToExternalIdResponse.Merge(m, src)
+}
+func (m *DenomToExternalIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomToExternalIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomToExternalIdResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) UnsignedSignerSetTxs(ctx context.Context, in *UnsignedSignerSetTxsRequest, opts ...grpc.CallOption) (*UnsignedSignerSetTxsResponse, error) {
-	out := new(UnsignedSignerSetTxsResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/UnsignedSignerSetTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DenomToExternalIdResponse proto.InternalMessageInfo
+
+func (m *DenomToExternalIdResponse) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) UnsignedBatchTxs(ctx context.Context, in *UnsignedBatchTxsRequest, opts ...grpc.CallOption) (*UnsignedBatchTxsResponse, error) {
-	out := new(UnsignedBatchTxsResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/UnsignedBatchTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DelegateKeysByValidatorRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ChainId          string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (c *queryClient) UnsignedContractCallTxs(ctx context.Context, in *UnsignedContractCallTxsRequest, opts ...grpc.CallOption) (*UnsignedContractCallTxsResponse, error) {
-	out := new(UnsignedContractCallTxsResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/UnsignedContractCallTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegateKeysByValidatorRequest) Reset()         { *m = DelegateKeysByValidatorRequest{} }
+func (m *DelegateKeysByValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysByValidatorRequest) ProtoMessage()    {}
+func (*DelegateKeysByValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{60}
+}
+func (m *DelegateKeysByValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysByValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysByValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DelegateKeysByValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysByValidatorRequest.Merge(m, src)
+}
+func (m *DelegateKeysByValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysByValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysByValidatorRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) LastSubmittedExternalEvent(ctx context.Context, in *LastSubmittedExternalEventRequest, opts ...grpc.CallOption) (*LastSubmittedExternalEventResponse, error) {
-	out := new(LastSubmittedExternalEventResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/LastSubmittedExternalEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelegateKeysByValidatorRequest proto.InternalMessageInfo
+
+func (m *DelegateKeysByValidatorRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) BatchTxFees(ctx context.Context, in *BatchTxFeesRequest, opts ...grpc.CallOption) (*BatchTxFeesResponse, error) {
-	out := new(BatchTxFeesResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/BatchTxFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegateKeysByValidatorRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ExternalIdToDenom(ctx context.Context, in *ExternalIdToDenomRequest, opts ...grpc.CallOption) (*ExternalIdToDenomResponse, error) {
-	out := new(ExternalIdToDenomResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/ExternalIdToDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DelegateKeysByValidatorResponse struct {
+	EthAddress          string `protobuf:"bytes,1,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
 }
 
-func (c *queryClient) DenomToExternalId(ctx context.Context, in *DenomToExternalIdRequest, opts ...grpc.CallOption) (*DenomToExternalIdResponse, error) {
-	out := new(DenomToExternalIdResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DenomToExternalId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegateKeysByValidatorResponse) Reset()         { *m = DelegateKeysByValidatorResponse{} }
+func (m *DelegateKeysByValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysByValidatorResponse) ProtoMessage()    {}
+func (*DelegateKeysByValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{61}
+}
+func (m *DelegateKeysByValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysByValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysByValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DelegateKeysByValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysByValidatorResponse.Merge(m, src)
+}
+func (m *DelegateKeysByValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysByValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysByValidatorResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BatchedSendToExternals(ctx context.Context, in *BatchedSendToExternalsRequest, opts ...grpc.CallOption) (*BatchedSendToExternalsResponse, error) {
-	out := new(BatchedSendToExternalsResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/BatchedSendToExternals", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelegateKeysByValidatorResponse proto.InternalMessageInfo
+
+func (m *DelegateKeysByValidatorResponse) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) UnbatchedSendToExternals(ctx context.Context, in *UnbatchedSendToExternalsRequest, opts ...grpc.CallOption) (*UnbatchedSendToExternalsResponse, error) {
-	out := new(UnbatchedSendToExternalsResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/UnbatchedSendToExternals", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegateKeysByValidatorResponse) GetOrchestratorAddress() string {
+	if m != nil {
+		return m.OrchestratorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) DelegateKeysByValidator(ctx context.Context, in *DelegateKeysByValidatorRequest, opts ...grpc.CallOption) (*DelegateKeysByValidatorResponse, error) {
-	out := new(DelegateKeysByValidatorResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DelegateKeysByValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DelegateKeysByExternalSignerRequest struct {
+	ExternalSigner string `protobuf:"bytes,1,opt,name=external_signer,json=externalSigner,proto3" json:"external_signer,omitempty"`
+	ChainId        string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (c *queryClient) DelegateKeysByExternalSigner(ctx context.Context, in *DelegateKeysByExternalSignerRequest, opts ...grpc.CallOption) (*DelegateKeysByExternalSignerResponse, error) {
-	out := new(DelegateKeysByExternalSignerResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DelegateKeysByExternalSigner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegateKeysByExternalSignerRequest) Reset()         { *m = DelegateKeysByExternalSignerRequest{} }
+func (m *DelegateKeysByExternalSignerRequest) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysByExternalSignerRequest) ProtoMessage()    {}
+func (*DelegateKeysByExternalSignerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{62}
+}
+func (m *DelegateKeysByExternalSignerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysByExternalSignerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysByExternalSignerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DelegateKeysByExternalSignerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysByExternalSignerRequest.Merge(m, src)
+}
+func (m *DelegateKeysByExternalSignerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysByExternalSignerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysByExternalSignerRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) DelegateKeysByOrchestrator(ctx context.Context, in *DelegateKeysByOrchestratorRequest, opts ...grpc.CallOption) (*DelegateKeysByOrchestratorResponse, error) {
-	out := new(DelegateKeysByOrchestratorResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DelegateKeysByOrchestrator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelegateKeysByExternalSignerRequest proto.InternalMessageInfo
+
+func (m *DelegateKeysByExternalSignerRequest) GetExternalSigner() string {
+	if m != nil {
+		return m.ExternalSigner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) DelegateKeys(ctx context.Context, in *DelegateKeysRequest, opts ...grpc.CallOption) (*DelegateKeysResponse, error) {
-	out := new(DelegateKeysResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DelegateKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegateKeysByExternalSignerRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TokenInfos(ctx context.Context, in *TokenInfosRequest, opts ...grpc.CallOption) (*TokenInfosResponse, error) {
-	out := new(TokenInfosResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/TokenInfos", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DelegateKeysByExternalSignerResponse struct {
+	ValidatorAddress    string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
 }
 
-func (c *queryClient) TransactionStatus(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatusResponse, error) {
-	out := new(TransactionStatusResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/TransactionStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegateKeysByExternalSignerResponse) Reset()         { *m = DelegateKeysByExternalSignerResponse{} }
+func (m *DelegateKeysByExternalSignerResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysByExternalSignerResponse) ProtoMessage()    {}
+func (*DelegateKeysByExternalSignerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{63}
+}
+func (m *DelegateKeysByExternalSignerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysByExternalSignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysByExternalSignerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DelegateKeysByExternalSignerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysByExternalSignerResponse.Merge(m, src)
+}
+func (m *DelegateKeysByExternalSignerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysByExternalSignerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysByExternalSignerResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TransactionFeeRecord(ctx context.Context, in *TransactionFeeRecordRequest, opts ...grpc.CallOption) (*TransactionFeeRecordResponse, error) {
-	out := new(TransactionFeeRecordResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/TransactionFeeRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelegateKeysByExternalSignerResponse proto.InternalMessageInfo
+
+func (m *DelegateKeysByExternalSignerResponse) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) DiscountForHolder(ctx context.Context, in *DiscountForHolderRequest, opts ...grpc.CallOption) (*DiscountForHolderResponse, error) {
-	out := new(DiscountForHolderResponse)
-	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DiscountForHolder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegateKeysByExternalSignerResponse) GetOrchestratorAddress() string {
+	if m != nil {
+		return m.OrchestratorAddress
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Module parameters query
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	// get info on individual outgoing data
-	SignerSetTx(context.Context, *SignerSetTxRequest) (*SignerSetTxResponse, error)
-	LatestSignerSetTx(context.Context, *LatestSignerSetTxRequest) (*SignerSetTxResponse, error)
-	LastObservedSignerSetTx(context.Context, *LastObservedSignerSetTxRequest) (*SignerSetTxResponse, error)
-	BatchTx(context.Context, *BatchTxRequest) (*BatchTxResponse, error)
-	ContractCallTx(context.Context, *ContractCallTxRequest) (*ContractCallTxResponse, error)
-	// get collections of outgoing traffic from the bridge
-	SignerSetTxs(context.Context, *SignerSetTxsRequest) (*SignerSetTxsResponse, error)
-	BatchTxs(context.Context, *BatchTxsRequest) (*BatchTxsResponse, error)
-	ContractCallTxs(context.Context, *ContractCallTxsRequest) (*ContractCallTxsResponse, error)
-	// TODO: can/should we group these into one endpoint?
-	SignerSetTxConfirmations(context.Context, *SignerSetTxConfirmationsRequest) (*SignerSetTxConfirmationsResponse, error)
-	BatchTxConfirmations(context.Context, *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error)
-	ContractCallTxConfirmations(context.Context, *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error)
-	// pending external signature queries for orchestrators to figure out which
-	// signatures they are missing
-	// TODO: can/should we group this into one endpoint?
-	UnsignedSignerSetTxs(context.Context, *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error)
-	UnsignedBatchTxs(context.Context, *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error)
-	UnsignedContractCallTxs(context.Context, *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error)
-	LastSubmittedExternalEvent(context.Context, *LastSubmittedExternalEventRequest) (*LastSubmittedExternalEventResponse, error)
-	// Queries the fees for all pending batches, results are returned in sdk.Coin
-	// (fee_amount_int)(contract_address) style
-	BatchTxFees(context.Context, *BatchTxFeesRequest) (*BatchTxFeesResponse, error)
-	// Query for info about denoms tracked by mhub2
-	ExternalIdToDenom(context.Context, *ExternalIdToDenomRequest) (*ExternalIdToDenomResponse, error)
-	// Query for info about denoms tracked by mhub2
-	DenomToExternalId(context.Context, *DenomToExternalIdRequest) (*DenomToExternalIdResponse, error)
-	// Query for batch send to externals
-	BatchedSendToExternals(context.Context, *BatchedSendToExternalsRequest) (*BatchedSendToExternalsResponse, error)
-	// Query for unbatched send to externals
-	UnbatchedSendToExternals(context.Context, *UnbatchedSendToExternalsRequest) (*UnbatchedSendToExternalsResponse, error)
-	// delegate keys
-	DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
-	DelegateKeysByExternalSigner(context.Context, *DelegateKeysByExternalSignerRequest) (*DelegateKeysByExternalSignerResponse, error)
-	DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
-	DelegateKeys(context.Context, *DelegateKeysRequest) (*DelegateKeysResponse, error)
-	TokenInfos(context.Context, *TokenInfosRequest) (*TokenInfosResponse, error)
-	TransactionStatus(context.Context, *TransactionStatusRequest) (*TransactionStatusResponse, error)
-	TransactionFeeRecord(context.Context, *TransactionFeeRecordRequest) (*TransactionFeeRecordResponse, error)
-	DiscountForHolder(context.Context, *DiscountForHolderRequest) (*DiscountForHolderResponse, error)
+type DelegateKeysByOrchestratorRequest struct {
+	OrchestratorAddress string `protobuf:"bytes,1,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+	ChainId             string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *DelegateKeysByOrchestratorRequest) Reset()         { *m = DelegateKeysByOrchestratorRequest{} }
+func (m *DelegateKeysByOrchestratorRequest) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysByOrchestratorRequest) ProtoMessage()    {}
+func (*DelegateKeysByOrchestratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{64}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *DelegateKeysByOrchestratorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SignerSetTx(ctx context.Context, req *SignerSetTxRequest) (*SignerSetTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTx not implemented")
+func (m *DelegateKeysByOrchestratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysByOrchestratorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LatestSignerSetTx(ctx context.Context, req *LatestSignerSetTxRequest) (*SignerSetTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestSignerSetTx not implemented")
+func (m *DelegateKeysByOrchestratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysByOrchestratorRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LastObservedSignerSetTx(ctx context.Context, req *LastObservedSignerSetTxRequest) (*SignerSetTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastObservedSignerSetTx not implemented")
+func (m *DelegateKeysByOrchestratorRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) BatchTx(ctx context.Context, req *BatchTxRequest) (*BatchTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchTx not implemented")
+func (m *DelegateKeysByOrchestratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysByOrchestratorRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ContractCallTx(ctx context.Context, req *ContractCallTxRequest) (*ContractCallTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTx not implemented")
+
+var xxx_messageInfo_DelegateKeysByOrchestratorRequest proto.InternalMessageInfo
+
+func (m *DelegateKeysByOrchestratorRequest) GetOrchestratorAddress() string {
+	if m != nil {
+		return m.OrchestratorAddress
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) SignerSetTxs(ctx context.Context, req *SignerSetTxsRequest) (*SignerSetTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxs not implemented")
+
+func (m *DelegateKeysByOrchestratorRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) BatchTxs(ctx context.Context, req *BatchTxsRequest) (*BatchTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchTxs not implemented")
+
+type DelegateKeysByOrchestratorResponse struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ExternalSigner   string `protobuf:"bytes,2,opt,name=external_signer,json=externalSigner,proto3" json:"external_signer,omitempty"`
 }
-func (*UnimplementedQueryServer) ContractCallTxs(ctx context.Context, req *ContractCallTxsRequest) (*ContractCallTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxs not implemented")
+
+func (m *DelegateKeysByOrchestratorResponse) Reset()         { *m = DelegateKeysByOrchestratorResponse{} }
+func (m *DelegateKeysByOrchestratorResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysByOrchestratorResponse) ProtoMessage()    {}
+func (*DelegateKeysByOrchestratorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{65}
 }
-func (*UnimplementedQueryServer) SignerSetTxConfirmations(ctx context.Context, req *SignerSetTxConfirmationsRequest) (*SignerSetTxConfirmationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxConfirmations not implemented")
+func (m *DelegateKeysByOrchestratorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BatchTxConfirmations(ctx context.Context, req *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchTxConfirmations not implemented")
+func (m *DelegateKeysByOrchestratorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysByOrchestratorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ContractCallTxConfirmations(ctx context.Context, req *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxConfirmations not implemented")
+func (m *DelegateKeysByOrchestratorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysByOrchestratorResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) UnsignedSignerSetTxs(ctx context.Context, req *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsignedSignerSetTxs not implemented")
+func (m *DelegateKeysByOrchestratorResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) UnsignedBatchTxs(ctx context.Context, req *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsignedBatchTxs not implemented")
+func (m *DelegateKeysByOrchestratorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysByOrchestratorResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) UnsignedContractCallTxs(ctx context.Context, req *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsignedContractCallTxs not implemented")
+
+var xxx_messageInfo_DelegateKeysByOrchestratorResponse proto.InternalMessageInfo
+
+func (m *DelegateKeysByOrchestratorResponse) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) LastSubmittedExternalEvent(ctx context.Context, req *LastSubmittedExternalEventRequest) (*LastSubmittedExternalEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastSubmittedExternalEvent not implemented")
+
+func (m *DelegateKeysByOrchestratorResponse) GetExternalSigner() string {
+	if m != nil {
+		return m.ExternalSigner
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) BatchTxFees(ctx context.Context, req *BatchTxFeesRequest) (*BatchTxFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchTxFees not implemented")
+
+type DelegateKeysRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
-func (*UnimplementedQueryServer) ExternalIdToDenom(ctx context.Context, req *ExternalIdToDenomRequest) (*ExternalIdToDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExternalIdToDenom not implemented")
+
+func (m *DelegateKeysRequest) Reset()         { *m = DelegateKeysRequest{} }
+func (m *DelegateKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysRequest) ProtoMessage()    {}
+func (*DelegateKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{66}
 }
-func (*UnimplementedQueryServer) DenomToExternalId(ctx context.Context, req *DenomToExternalIdRequest) (*DenomToExternalIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomToExternalId not implemented")
+func (m *DelegateKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BatchedSendToExternals(ctx context.Context, req *BatchedSendToExternalsRequest) (*BatchedSendToExternalsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchedSendToExternals not implemented")
+func (m *DelegateKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) UnbatchedSendToExternals(ctx context.Context, req *UnbatchedSendToExternalsRequest) (*UnbatchedSendToExternalsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbatchedSendToExternals not implemented")
+func (m *DelegateKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DelegateKeysByValidator(ctx context.Context, req *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByValidator not implemented")
+func (m *DelegateKeysRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DelegateKeysByExternalSigner(ctx context.Context, req *DelegateKeysByExternalSignerRequest) (*DelegateKeysByExternalSignerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByExternalSigner not implemented")
+func (m *DelegateKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) DelegateKeysByOrchestrator(ctx context.Context, req *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByOrchestrator not implemented")
+
+var xxx_messageInfo_DelegateKeysRequest proto.InternalMessageInfo
+
+func (m *DelegateKeysRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) DelegateKeys(ctx context.Context, req *DelegateKeysRequest) (*DelegateKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeys not implemented")
+
+type DelegateKeysResponse struct {
+	DelegateKeys []*MsgDelegateKeys `protobuf:"bytes,1,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
 }
-func (*UnimplementedQueryServer) TokenInfos(ctx context.Context, req *TokenInfosRequest) (*TokenInfosResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenInfos not implemented")
+
+func (m *DelegateKeysResponse) Reset()         { *m = DelegateKeysResponse{} }
+func (m *DelegateKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysResponse) ProtoMessage()    {}
+func (*DelegateKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{67}
 }
-func (*UnimplementedQueryServer) TransactionStatus(ctx context.Context, req *TransactionStatusRequest) (*TransactionStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransactionStatus not implemented")
+func (m *DelegateKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) TransactionFeeRecord(ctx context.Context, req *TransactionFeeRecordRequest) (*TransactionFeeRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransactionFeeRecord not implemented")
+func (m *DelegateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DiscountForHolder(ctx context.Context, req *DiscountForHolderRequest) (*DiscountForHolderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DiscountForHolder not implemented")
+func (m *DelegateKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *DelegateKeysResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *DelegateKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysResponse.DiscardUnknown(m)
 }
 
-func _Query_SignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignerSetTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignerSetTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/SignerSetTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignerSetTx(ctx, req.(*SignerSetTxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_DelegateKeysResponse proto.InternalMessageInfo
 
-func _Query_LatestSignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LatestSignerSetTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestSignerSetTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/LatestSignerSetTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestSignerSetTx(ctx, req.(*LatestSignerSetTxRequest))
+func (m *DelegateKeysResponse) GetDelegateKeys() []*MsgDelegateKeys {
+	if m != nil {
+		return m.DelegateKeys
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LastObservedSignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastObservedSignerSetTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastObservedSignerSetTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/LastObservedSignerSetTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastObservedSignerSetTx(ctx, req.(*LastObservedSignerSetTxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type DelegateKeysHistoryRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ChainId          string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func _Query_BatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/BatchTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchTx(ctx, req.(*BatchTxRequest))
+func (m *DelegateKeysHistoryRequest) Reset()         { *m = DelegateKeysHistoryRequest{} }
+func (m *DelegateKeysHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysHistoryRequest) ProtoMessage()    {}
+func (*DelegateKeysHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{68}
+}
+func (m *DelegateKeysHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DelegateKeysHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysHistoryRequest.Merge(m, src)
+}
+func (m *DelegateKeysHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_ContractCallTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractCallTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractCallTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/ContractCallTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractCallTx(ctx, req.(*ContractCallTxRequest))
+var xxx_messageInfo_DelegateKeysHistoryRequest proto.InternalMessageInfo
+
+func (m *DelegateKeysHistoryRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_SignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignerSetTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignerSetTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/SignerSetTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignerSetTxs(ctx, req.(*SignerSetTxsRequest))
+func (m *DelegateKeysHistoryRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/BatchTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchTxs(ctx, req.(*BatchTxsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type DelegateKeysHistoryResponse struct {
+	Rotations []DelegateKeysRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations"`
 }
 
-func _Query_ContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractCallTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractCallTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/ContractCallTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractCallTxs(ctx, req.(*ContractCallTxsRequest))
+func (m *DelegateKeysHistoryResponse) Reset()         { *m = DelegateKeysHistoryResponse{} }
+func (m *DelegateKeysHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysHistoryResponse) ProtoMessage()    {}
+func (*DelegateKeysHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{69}
+}
+func (m *DelegateKeysHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DelegateKeysHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysHistoryResponse.Merge(m, src)
+}
+func (m *DelegateKeysHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_SignerSetTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignerSetTxConfirmationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignerSetTxConfirmations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/SignerSetTxConfirmations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignerSetTxConfirmations(ctx, req.(*SignerSetTxConfirmationsRequest))
+var xxx_messageInfo_DelegateKeysHistoryResponse proto.InternalMessageInfo
+
+func (m *DelegateKeysHistoryResponse) GetRotations() []DelegateKeysRotation {
+	if m != nil {
+		return m.Rotations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BatchTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchTxConfirmationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchTxConfirmations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/BatchTxConfirmations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchTxConfirmations(ctx, req.(*BatchTxConfirmationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// NOTE: if there is no sender address, return all
+type BatchedSendToExternalsRequest struct {
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	ChainId       string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func _Query_ContractCallTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractCallTxConfirmationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractCallTxConfirmations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/ContractCallTxConfirmations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractCallTxConfirmations(ctx, req.(*ContractCallTxConfirmationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *BatchedSendToExternalsRequest) Reset()         { *m = BatchedSendToExternalsRequest{} }
+func (m *BatchedSendToExternalsRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchedSendToExternalsRequest) ProtoMessage()    {}
+func (*BatchedSendToExternalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{70}
 }
-
-func _Query_UnsignedSignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsignedSignerSetTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnsignedSignerSetTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/UnsignedSignerSetTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnsignedSignerSetTxs(ctx, req.(*UnsignedSignerSetTxsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *BatchedSendToExternalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_UnsignedBatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsignedBatchTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnsignedBatchTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/UnsignedBatchTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnsignedBatchTxs(ctx, req.(*UnsignedBatchTxsRequest))
+func (m *BatchedSendToExternalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchedSendToExternalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *BatchedSendToExternalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchedSendToExternalsRequest.Merge(m, src)
+}
+func (m *BatchedSendToExternalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchedSendToExternalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchedSendToExternalsRequest.DiscardUnknown(m)
 }
 
-func _Query_UnsignedContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsignedContractCallTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnsignedContractCallTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/UnsignedContractCallTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnsignedContractCallTxs(ctx, req.(*UnsignedContractCallTxsRequest))
+var xxx_messageInfo_BatchedSendToExternalsRequest proto.InternalMessageInfo
+
+func (m *BatchedSendToExternalsRequest) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_LastSubmittedExternalEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastSubmittedExternalEventRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastSubmittedExternalEvent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/LastSubmittedExternalEvent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastSubmittedExternalEvent(ctx, req.(*LastSubmittedExternalEventRequest))
+func (m *BatchedSendToExternalsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BatchTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchTxFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchTxFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/BatchTxFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchTxFees(ctx, req.(*BatchTxFeesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type BatchedSendToExternalsResponse struct {
+	SendToExternals []*SendToExternal `protobuf:"bytes,1,rep,name=send_to_externals,json=sendToExternals,proto3" json:"send_to_externals,omitempty"`
+	ChainId         string            `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func _Query_ExternalIdToDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExternalIdToDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExternalIdToDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/ExternalIdToDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExternalIdToDenom(ctx, req.(*ExternalIdToDenomRequest))
+func (m *BatchedSendToExternalsResponse) Reset()         { *m = BatchedSendToExternalsResponse{} }
+func (m *BatchedSendToExternalsResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchedSendToExternalsResponse) ProtoMessage()    {}
+func (*BatchedSendToExternalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{71}
+}
+func (m *BatchedSendToExternalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchedSendToExternalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchedSendToExternalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *BatchedSendToExternalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchedSendToExternalsResponse.Merge(m, src)
+}
+func (m *BatchedSendToExternalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchedSendToExternalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchedSendToExternalsResponse.DiscardUnknown(m)
 }
 
-func _Query_DenomToExternalId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DenomToExternalIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomToExternalId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/DenomToExternalId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomToExternalId(ctx, req.(*DenomToExternalIdRequest))
+var xxx_messageInfo_BatchedSendToExternalsResponse proto.InternalMessageInfo
+
+func (m *BatchedSendToExternalsResponse) GetSendToExternals() []*SendToExternal {
+	if m != nil {
+		return m.SendToExternals
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BatchedSendToExternals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchedSendToExternalsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchedSendToExternals(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/BatchedSendToExternals",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchedSendToExternals(ctx, req.(*BatchedSendToExternalsRequest))
+func (m *BatchedSendToExternalsResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_UnbatchedSendToExternals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnbatchedSendToExternalsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnbatchedSendToExternals(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/UnbatchedSendToExternals",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnbatchedSendToExternals(ctx, req.(*UnbatchedSendToExternalsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type UnbatchedSendToExternalsRequest struct {
+	SenderAddress string             `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	ChainId       string             `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_DelegateKeysByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateKeysByValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegateKeysByValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/DelegateKeysByValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegateKeysByValidator(ctx, req.(*DelegateKeysByValidatorRequest))
+func (m *UnbatchedSendToExternalsRequest) Reset()         { *m = UnbatchedSendToExternalsRequest{} }
+func (m *UnbatchedSendToExternalsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnbatchedSendToExternalsRequest) ProtoMessage()    {}
+func (*UnbatchedSendToExternalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{72}
+}
+func (m *UnbatchedSendToExternalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbatchedSendToExternalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbatchedSendToExternalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *UnbatchedSendToExternalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbatchedSendToExternalsRequest.Merge(m, src)
+}
+func (m *UnbatchedSendToExternalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbatchedSendToExternalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbatchedSendToExternalsRequest.DiscardUnknown(m)
 }
 
-func _Query_DelegateKeysByExternalSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateKeysByExternalSignerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegateKeysByExternalSigner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/DelegateKeysByExternalSigner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegateKeysByExternalSigner(ctx, req.(*DelegateKeysByExternalSignerRequest))
+var xxx_messageInfo_UnbatchedSendToExternalsRequest proto.InternalMessageInfo
+
+func (m *UnbatchedSendToExternalsRequest) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_DelegateKeysByOrchestrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateKeysByOrchestratorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegateKeysByOrchestrator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/DelegateKeysByOrchestrator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegateKeysByOrchestrator(ctx, req.(*DelegateKeysByOrchestratorRequest))
+func (m *UnbatchedSendToExternalsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_DelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateKeysRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegateKeys(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/DelegateKeys",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegateKeys(ctx, req.(*DelegateKeysRequest))
+func (m *UnbatchedSendToExternalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TokenInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TokenInfosRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TokenInfos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/TokenInfos",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TokenInfos(ctx, req.(*TokenInfosRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type UnbatchedSendToExternalsResponse struct {
+	SendToExternals []*SendToExternal   `protobuf:"bytes,1,rep,name=send_to_externals,json=sendToExternals,proto3" json:"send_to_externals,omitempty"`
+	ChainId         string              `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pagination      *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_TransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TransactionStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/TransactionStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TransactionStatus(ctx, req.(*TransactionStatusRequest))
+func (m *UnbatchedSendToExternalsResponse) Reset()         { *m = UnbatchedSendToExternalsResponse{} }
+func (m *UnbatchedSendToExternalsResponse) String() string { return proto.CompactTextString(m) }
+func (*UnbatchedSendToExternalsResponse) ProtoMessage()    {}
+func (*UnbatchedSendToExternalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_503a4f22a1222790, []int{73}
+}
+func (m *UnbatchedSendToExternalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbatchedSendToExternalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbatchedSendToExternalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *UnbatchedSendToExternalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbatchedSendToExternalsResponse.Merge(m, src)
+}
+func (m *UnbatchedSendToExternalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbatchedSendToExternalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbatchedSendToExternalsResponse.DiscardUnknown(m)
 }
 
-func _Query_TransactionFeeRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionFeeRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TransactionFeeRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/TransactionFeeRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TransactionFeeRecord(ctx, req.(*TransactionFeeRecordRequest))
+var xxx_messageInfo_UnbatchedSendToExternalsResponse proto.InternalMessageInfo
+
+func (m *UnbatchedSendToExternalsResponse) GetSendToExternals() []*SendToExternal {
+	if m != nil {
+		return m.SendToExternals
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DiscountForHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiscountForHolderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DiscountForHolder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mhub2.v1.Query/DiscountForHolder",
+func (m *UnbatchedSendToExternalsResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DiscountForHolder(ctx, req.(*DiscountForHolderRequest))
+	return ""
+}
+
+func (m *UnbatchedSendToExternalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mhub2.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "SignerSetTx",
-			Handler:    _Query_SignerSetTx_Handler,
-		},
-		{
-			MethodName: "LatestSignerSetTx",
-			Handler:    _Query_LatestSignerSetTx_Handler,
-		},
-		{
-			MethodName: "LastObservedSignerSetTx",
-			Handler:    _Query_LastObservedSignerSetTx_Handler,
-		},
-		{
-			MethodName: "BatchTx",
-			Handler:    _Query_BatchTx_Handler,
-		},
-		{
-			MethodName: "ContractCallTx",
-			Handler:    _Query_ContractCallTx_Handler,
-		},
-		{
-			MethodName: "SignerSetTxs",
-			Handler:    _Query_SignerSetTxs_Handler,
-		},
-		{
-			MethodName: "BatchTxs",
-			Handler:    _Query_BatchTxs_Handler,
-		},
-		{
-			MethodName: "ContractCallTxs",
-			Handler:    _Query_ContractCallTxs_Handler,
-		},
-		{
-			MethodName: "SignerSetTxConfirmations",
-			Handler:    _Query_SignerSetTxConfirmations_Handler,
-		},
-		{
-			MethodName: "BatchTxConfirmations",
-			Handler:    _Query_BatchTxConfirmations_Handler,
-		},
-		{
-			MethodName: "ContractCallTxConfirmations",
-			Handler:    _Query_ContractCallTxConfirmations_Handler,
-		},
-		{
-			MethodName: "UnsignedSignerSetTxs",
-			Handler:    _Query_UnsignedSignerSetTxs_Handler,
-		},
-		{
-			MethodName: "UnsignedBatchTxs",
-			Handler:    _Query_UnsignedBatchTxs_Handler,
-		},
-		{
-			MethodName: "UnsignedContractCallTxs",
-			Handler:    _Query_UnsignedContractCallTxs_Handler,
-		},
-		{
-			MethodName: "LastSubmittedExternalEvent",
-			Handler:    _Query_LastSubmittedExternalEvent_Handler,
-		},
-		{
-			MethodName: "BatchTxFees",
-			Handler:    _Query_BatchTxFees_Handler,
-		},
-		{
-			MethodName: "ExternalIdToDenom",
-			Handler:    _Query_ExternalIdToDenom_Handler,
-		},
-		{
-			MethodName: "DenomToExternalId",
-			Handler:    _Query_DenomToExternalId_Handler,
-		},
-		{
-			MethodName: "BatchedSendToExternals",
-			Handler:    _Query_BatchedSendToExternals_Handler,
-		},
-		{
-			MethodName: "UnbatchedSendToExternals",
-			Handler:    _Query_UnbatchedSendToExternals_Handler,
-		},
-		{
-			MethodName: "DelegateKeysByValidator",
-			Handler:    _Query_DelegateKeysByValidator_Handler,
-		},
-		{
-			MethodName: "DelegateKeysByExternalSigner",
-			Handler:    _Query_DelegateKeysByExternalSigner_Handler,
-		},
-		{
-			MethodName: "DelegateKeysByOrchestrator",
-			Handler:    _Query_DelegateKeysByOrchestrator_Handler,
-		},
-		{
-			MethodName: "DelegateKeys",
-			Handler:    _Query_DelegateKeys_Handler,
-		},
-		{
-			MethodName: "TokenInfos",
-			Handler:    _Query_TokenInfos_Handler,
-		},
-		{
-			MethodName: "TransactionStatus",
-			Handler:    _Query_TransactionStatus_Handler,
-		},
-		{
-			MethodName: "TransactionFeeRecord",
-			Handler:    _Query_TransactionFeeRecord_Handler,
-		},
-		{
-			MethodName: "DiscountForHolder",
-			Handler:    _Query_DiscountForHolder_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mhub2/v1/query.proto",
+func init() {
+	proto.RegisterType((*TokenInfosRequest)(nil), "mhub2.v1.TokenInfosRequest")
+	proto.RegisterType((*TokenInfosResponse)(nil), "mhub2.v1.TokenInfosResponse")
+	proto.RegisterType((*TransactionStatusRequest)(nil), "mhub2.v1.TransactionStatusRequest")
+	proto.RegisterType((*TransactionStatusResponse)(nil), "mhub2.v1.TransactionStatusResponse")
+	proto.RegisterType((*TransactionFeeRecordRequest)(nil), "mhub2.v1.TransactionFeeRecordRequest")
+	proto.RegisterType((*TransactionFeeRecordResponse)(nil), "mhub2.v1.TransactionFeeRecordResponse")
+	proto.RegisterType((*DiscountForHolderRequest)(nil), "mhub2.v1.DiscountForHolderRequest")
+	proto.RegisterType((*DiscountForHolderResponse)(nil), "mhub2.v1.DiscountForHolderResponse")
+	proto.RegisterType((*TransferMinimumsRequest)(nil), "mhub2.v1.TransferMinimumsRequest")
+	proto.RegisterType((*TransferMinimumsResponse)(nil), "mhub2.v1.TransferMinimumsResponse")
+	proto.RegisterType((*BlocklistRequest)(nil), "mhub2.v1.BlocklistRequest")
+	proto.RegisterType((*BlocklistResponse)(nil), "mhub2.v1.BlocklistResponse")
+	proto.RegisterType((*QuarantinedDepositsRequest)(nil), "mhub2.v1.QuarantinedDepositsRequest")
+	proto.RegisterType((*QuarantinedDepositsResponse)(nil), "mhub2.v1.QuarantinedDepositsResponse")
+	proto.RegisterType((*TimelockedTransfersRequest)(nil), "mhub2.v1.TimelockedTransfersRequest")
+	proto.RegisterType((*TimelockedTransfersResponse)(nil), "mhub2.v1.TimelockedTransfersResponse")
+	proto.RegisterType((*GuardiansRequest)(nil), "mhub2.v1.GuardiansRequest")
+	proto.RegisterType((*GuardiansResponse)(nil), "mhub2.v1.GuardiansResponse")
+	proto.RegisterType((*SignerSetParamsRequest)(nil), "mhub2.v1.SignerSetParamsRequest")
+	proto.RegisterType((*SignerSetParamsResponse)(nil), "mhub2.v1.SignerSetParamsResponse")
+	proto.RegisterType((*TransferExpiryRequest)(nil), "mhub2.v1.TransferExpiryRequest")
+	proto.RegisterType((*TransferExpiryResponse)(nil), "mhub2.v1.TransferExpiryResponse")
+	proto.RegisterType((*EstimateSendToExternalRequest)(nil), "mhub2.v1.EstimateSendToExternalRequest")
+	proto.RegisterType((*EstimateSendToExternalResponse)(nil), "mhub2.v1.EstimateSendToExternalResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "mhub2.v1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "mhub2.v1.ParamsResponse")
+	proto.RegisterType((*SignerSetTxRequest)(nil), "mhub2.v1.SignerSetTxRequest")
+	proto.RegisterType((*LatestSignerSetTxRequest)(nil), "mhub2.v1.LatestSignerSetTxRequest")
+	proto.RegisterType((*LastObservedSignerSetTxRequest)(nil), "mhub2.v1.LastObservedSignerSetTxRequest")
+	proto.RegisterType((*SignerSetTxResponse)(nil), "mhub2.v1.SignerSetTxResponse")
+	proto.RegisterType((*BatchTxRequest)(nil), "mhub2.v1.BatchTxRequest")
+	proto.RegisterType((*BatchTxResponse)(nil), "mhub2.v1.BatchTxResponse")
+	proto.RegisterType((*ContractCallTxRequest)(nil), "mhub2.v1.ContractCallTxRequest")
+	proto.RegisterType((*ContractCallTxResponse)(nil), "mhub2.v1.ContractCallTxResponse")
+	proto.RegisterType((*SignerSetTxConfirmationsRequest)(nil), "mhub2.v1.SignerSetTxConfirmationsRequest")
+	proto.RegisterType((*SignerSetTxConfirmationsResponse)(nil), "mhub2.v1.SignerSetTxConfirmationsResponse")
+	proto.RegisterType((*SignerSetTxsRequest)(nil), "mhub2.v1.SignerSetTxsRequest")
+	proto.RegisterType((*SignerSetTxsResponse)(nil), "mhub2.v1.SignerSetTxsResponse")
+	proto.RegisterType((*BatchTxsRequest)(nil), "mhub2.v1.BatchTxsRequest")
+	proto.RegisterType((*BatchTxsResponse)(nil), "mhub2.v1.BatchTxsResponse")
+	proto.RegisterType((*ContractCallTxsRequest)(nil), "mhub2.v1.ContractCallTxsRequest")
+	proto.RegisterType((*ContractCallTxsResponse)(nil), "mhub2.v1.ContractCallTxsResponse")
+	proto.RegisterType((*UnsignedSignerSetTxsRequest)(nil), "mhub2.v1.UnsignedSignerSetTxsRequest")
+	proto.RegisterType((*UnsignedSignerSetTxsResponse)(nil), "mhub2.v1.UnsignedSignerSetTxsResponse")
+	proto.RegisterType((*UnsignedBatchTxsRequest)(nil), "mhub2.v1.UnsignedBatchTxsRequest")
+	proto.RegisterType((*UnsignedBatchTxsResponse)(nil), "mhub2.v1.UnsignedBatchTxsResponse")
+	proto.RegisterType((*UnsignedContractCallTxsRequest)(nil), "mhub2.v1.UnsignedContractCallTxsRequest")
+	proto.RegisterType((*UnsignedContractCallTxsResponse)(nil), "mhub2.v1.UnsignedContractCallTxsResponse")
+	proto.RegisterType((*BatchTxFeesRequest)(nil), "mhub2.v1.BatchTxFeesRequest")
+	proto.RegisterType((*BatchTxFeesResponse)(nil), "mhub2.v1.BatchTxFeesResponse")
+	proto.RegisterType((*ContractCallTxConfirmationsRequest)(nil), "mhub2.v1.ContractCallTxConfirmationsRequest")
+	proto.RegisterType((*ContractCallTxConfirmationsResponse)(nil), "mhub2.v1.ContractCallTxConfirmationsResponse")
+	proto.RegisterType((*BatchTxConfirmationsRequest)(nil), "mhub2.v1.BatchTxConfirmationsRequest")
+	proto.RegisterType((*BatchTxConfirmationsResponse)(nil), "mhub2.v1.BatchTxConfirmationsResponse")
+	proto.RegisterType((*LastSubmittedExternalEventRequest)(nil), "mhub2.v1.LastSubmittedExternalEventRequest")
+	proto.RegisterType((*LastSubmittedExternalEventResponse)(nil), "mhub2.v1.LastSubmittedExternalEventResponse")
+	proto.RegisterType((*ExternalIdToDenomRequest)(nil), "mhub2.v1.ExternalIdToDenomRequest")
+	proto.RegisterType((*ExternalIdToDenomResponse)(nil), "mhub2.v1.ExternalIdToDenomResponse")
+	proto.RegisterType((*DenomToExternalIdRequest)(nil), "mhub2.v1.DenomToExternalIdRequest")
+	proto.RegisterType((*DenomToExternalIdResponse)(nil), "mhub2.v1.DenomToExternalIdResponse")
+	proto.RegisterType((*DelegateKeysByValidatorRequest)(nil), "mhub2.v1.DelegateKeysByValidatorRequest")
+	proto.RegisterType((*DelegateKeysByValidatorResponse)(nil), "mhub2.v1.DelegateKeysByValidatorResponse")
+	proto.RegisterType((*DelegateKeysByExternalSignerRequest)(nil), "mhub2.v1.DelegateKeysByExternalSignerRequest")
+	proto.RegisterType((*DelegateKeysByExternalSignerResponse)(nil), "mhub2.v1.DelegateKeysByExternalSignerResponse")
+	proto.RegisterType((*DelegateKeysByOrchestratorRequest)(nil), "mhub2.v1.DelegateKeysByOrchestratorRequest")
+	proto.RegisterType((*DelegateKeysByOrchestratorResponse)(nil), "mhub2.v1.DelegateKeysByOrchestratorResponse")
+	proto.RegisterType((*DelegateKeysRequest)(nil), "mhub2.v1.DelegateKeysRequest")
+	proto.RegisterType((*DelegateKeysResponse)(nil), "mhub2.v1.DelegateKeysResponse")
+	proto.RegisterType((*DelegateKeysHistoryRequest)(nil), "mhub2.v1.DelegateKeysHistoryRequest")
+	proto.RegisterType((*DelegateKeysHistoryResponse)(nil), "mhub2.v1.DelegateKeysHistoryResponse")
+	proto.RegisterType((*BatchedSendToExternalsRequest)(nil), "mhub2.v1.BatchedSendToExternalsRequest")
+	proto.RegisterType((*BatchedSendToExternalsResponse)(nil), "mhub2.v1.BatchedSendToExternalsResponse")
+	proto.RegisterType((*UnbatchedSendToExternalsRequest)(nil), "mhub2.v1.UnbatchedSendToExternalsRequest")
+	proto.RegisterType((*UnbatchedSendToExternalsResponse)(nil), "mhub2.v1.UnbatchedSendToExternalsResponse")
 }
 
-func (m *TokenInfosRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("mhub2/v1/query.proto", fileDescriptor_503a4f22a1222790) }
+
+var fileDescriptor_503a4f22a1222790 = []byte{
+	// 3173 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x37, 0x65, 0x5b, 0xd2, 0x3e, 0x39, 0xfa, 0x18, 0xc9, 0xd6, 0x8a, 0x92, 0x56, 0x12, 0x65,
+	0xcb, 0xb2, 0x2d, 0x2f, 0x2d, 0xd9, 0x71, 0xd2, 0x7c, 0x38, 0xb6, 0x2c, 0x7f, 0x25, 0x71, 0x12,
+	0xaf, 0x15, 0x37, 0x2d, 0x10, 0x10, 0xd4, 0x72, 0xb4, 0x22, 0xbc, 0x4b, 0x2a, 0x24, 0x57, 0x95,
+	0x6a, 0xe8, 0xd0, 0x02, 0x0d, 0x82, 0x22, 0x05, 0xd2, 0x16, 0x6d, 0xd1, 0x43, 0x0f, 0x6d, 0x0f,
+	0x05, 0x12, 0xf4, 0x03, 0x39, 0xe5, 0x0f, 0x28, 0xd0, 0x1c, 0x7a, 0x08, 0xd0, 0x4b, 0xd1, 0x43,
+	0x5a, 0x24, 0xfd, 0x2b, 0x7a, 0x2a, 0x38, 0x1c, 0x0e, 0x87, 0xe4, 0x0c, 0x77, 0xad, 0xb8, 0xe9,
+	0x29, 0xde, 0x99, 0xf7, 0xf1, 0x7b, 0xef, 0xcd, 0xe3, 0xbc, 0xf7, 0x34, 0x81, 0xb1, 0xd6, 0x56,
+	0x7b, 0x63, 0x45, 0xdf, 0x59, 0xd6, 0xdf, 0x69, 0x63, 0x6f, 0xaf, 0xba, 0xed, 0xb9, 0x81, 0x8b,
+	0xfa, 0xc9, 0x6a, 0x75, 0x67, 0x59, 0x3d, 0x5b, 0x77, 0xfd, 0x96, 0xeb, 0xeb, 0x1b, 0xa6, 0x8f,
+	0x23, 0x12, 0x7d, 0x67, 0x79, 0x03, 0x07, 0xe6, 0xb2, 0xbe, 0x6d, 0x36, 0x6c, 0xc7, 0x0c, 0x6c,
+	0xd7, 0x89, 0xb8, 0xd4, 0x0a, 0x4f, 0x1b, 0x53, 0xd5, 0x5d, 0x3b, 0xde, 0x1f, 0x6b, 0xb8, 0x0d,
+	0x97, 0xfc, 0x53, 0x0f, 0xff, 0x45, 0x57, 0xa7, 0x1a, 0xae, 0xdb, 0x68, 0x62, 0xdd, 0xdc, 0xb6,
+	0x75, 0xd3, 0x71, 0xdc, 0x80, 0x88, 0xf4, 0xe9, 0xee, 0x09, 0x86, 0xaf, 0x81, 0x1d, 0xec, 0xdb,
+	0xf1, 0x7a, 0x82, 0x3b, 0x82, 0x1a, 0xad, 0x8e, 0x26, 0xab, 0x7e, 0x83, 0x92, 0x6a, 0xa3, 0x30,
+	0xb2, 0xee, 0x3e, 0xc4, 0xce, 0x1d, 0x67, 0xd3, 0xf5, 0x6b, 0xf8, 0x9d, 0x36, 0xf6, 0x03, 0x6d,
+	0x0d, 0x10, 0xbf, 0xe8, 0x6f, 0xbb, 0x8e, 0x8f, 0x51, 0x15, 0x8e, 0x34, 0x6d, 0x3f, 0x28, 0x2b,
+	0xb3, 0xca, 0xe2, 0xc0, 0xca, 0x58, 0x35, 0x76, 0x43, 0x35, 0xa1, 0x5d, 0x3d, 0xf2, 0xe9, 0xe7,
+	0x33, 0x87, 0x6a, 0x84, 0x4e, 0xbb, 0x08, 0xe5, 0x75, 0xcf, 0x74, 0x7c, 0xb3, 0x1e, 0x62, 0xbe,
+	0x1f, 0x98, 0x41, 0x3b, 0xd6, 0x80, 0xc6, 0xa1, 0x2f, 0xd8, 0x35, 0xb6, 0x4c, 0x7f, 0x8b, 0x88,
+	0x2b, 0xd5, 0x7a, 0x83, 0xdd, 0xdb, 0xa6, 0xbf, 0xa5, 0xdd, 0x85, 0x09, 0x01, 0x13, 0x45, 0x70,
+	0x01, 0x7a, 0x7d, 0xb2, 0x42, 0x31, 0x20, 0x0e, 0xc3, 0x6e, 0x44, 0x4b, 0x10, 0x28, 0x35, 0x4a,
+	0xa7, 0x5d, 0x86, 0x49, 0x4e, 0xdc, 0x4d, 0x8c, 0x6b, 0xb8, 0xee, 0x7a, 0x56, 0x47, 0x18, 0xf7,
+	0x61, 0x4a, 0xcc, 0x47, 0x91, 0x5c, 0x84, 0x5e, 0x8f, 0xac, 0x50, 0x24, 0xc7, 0x79, 0x24, 0x8c,
+	0x3c, 0x06, 0x13, 0x91, 0x6a, 0x97, 0xa0, 0xbc, 0x66, 0xfb, 0x75, 0xb7, 0xed, 0x04, 0x37, 0x5d,
+	0xef, 0xb6, 0xdb, 0xb4, 0xb0, 0x17, 0x23, 0x29, 0x43, 0x9f, 0x69, 0x59, 0x1e, 0xf6, 0x7d, 0x8a,
+	0x24, 0xfe, 0xa9, 0x35, 0x60, 0x42, 0xc0, 0x45, 0x71, 0xbc, 0x0c, 0xfd, 0x16, 0xdd, 0x24, 0x7c,
+	0xc7, 0x56, 0xab, 0x61, 0x04, 0xfe, 0xf1, 0xf9, 0xcc, 0x42, 0xc3, 0x0e, 0xb6, 0xda, 0x1b, 0xd5,
+	0xba, 0xdb, 0xd2, 0xe9, 0xd1, 0x8b, 0xfe, 0x73, 0xde, 0xb7, 0x1e, 0xea, 0xc1, 0xde, 0x36, 0xf6,
+	0xab, 0x6b, 0xb8, 0x5e, 0x63, 0xfc, 0xda, 0xcb, 0x30, 0x4e, 0x6c, 0xde, 0xc4, 0xde, 0x5d, 0xdb,
+	0xb1, 0x5b, 0xed, 0x16, 0x0b, 0xd7, 0x04, 0xf4, 0xd7, 0xb7, 0x4c, 0xdb, 0x31, 0x6c, 0x2b, 0x86,
+	0x47, 0x7e, 0xdf, 0xb1, 0xd0, 0x18, 0x1c, 0xb5, 0xb0, 0xe3, 0xb6, 0xca, 0x3d, 0x64, 0x3d, 0xfa,
+	0xa1, 0x7d, 0xac, 0x40, 0x39, 0x2f, 0x8c, 0x82, 0xbe, 0x0b, 0xd0, 0xb2, 0x1d, 0xc3, 0x6c, 0x31,
+	0xd8, 0xa5, 0xc7, 0x82, 0x7d, 0xc7, 0x09, 0x6a, 0xa5, 0x96, 0xed, 0x5c, 0x23, 0x02, 0xd0, 0x2d,
+	0xe8, 0x0b, 0xc5, 0x6d, 0x62, 0x5c, 0xee, 0x39, 0x90, 0xac, 0xde, 0x96, 0x1d, 0x86, 0x58, 0x43,
+	0x30, 0xbc, 0xda, 0x74, 0xeb, 0x0f, 0xc3, 0xd3, 0x1b, 0xa7, 0xc2, 0x32, 0x8c, 0x70, 0x6b, 0xd4,
+	0x80, 0x29, 0x28, 0xd1, 0xe8, 0xe0, 0x30, 0x5c, 0x87, 0x17, 0x4b, 0xb5, 0x64, 0x41, 0x9b, 0x02,
+	0xf5, 0x5e, 0xdb, 0xf4, 0x4c, 0x27, 0xb0, 0x1d, 0x6c, 0xad, 0xe1, 0x6d, 0xd7, 0xb7, 0x03, 0x96,
+	0x5b, 0x6f, 0xc3, 0xa4, 0x70, 0x97, 0x8a, 0xbe, 0x02, 0xfd, 0x16, 0x5d, 0x23, 0x92, 0x07, 0x56,
+	0xa6, 0x92, 0xa3, 0x95, 0x67, 0xa4, 0x09, 0xc7, 0x78, 0xb4, 0x67, 0x40, 0x5d, 0xb7, 0x5b, 0x38,
+	0x84, 0x8c, 0xad, 0x38, 0x02, 0x5d, 0xc4, 0x51, 0x33, 0x60, 0x52, 0xc8, 0x48, 0x71, 0x5d, 0x85,
+	0x52, 0x10, 0x2f, 0xe6, 0x81, 0xe5, 0x39, 0x29, 0xb0, 0x84, 0x29, 0xf4, 0xee, 0xad, 0xb6, 0xe9,
+	0x59, 0xb6, 0xe9, 0xf8, 0x9c, 0x77, 0xb9, 0xb5, 0xc4, 0xbb, 0x8d, 0x78, 0x31, 0xf6, 0x2e, 0x5b,
+	0xd0, 0x2e, 0xc2, 0x89, 0xfb, 0x76, 0xc3, 0xc1, 0xde, 0x7d, 0x1c, 0xbc, 0x61, 0x7a, 0x66, 0x37,
+	0x87, 0x54, 0xab, 0xc1, 0x78, 0x8e, 0x89, 0x6a, 0x7b, 0x06, 0x7a, 0xb7, 0xc9, 0x0a, 0xcd, 0xe4,
+	0x89, 0xc4, 0xaa, 0x0c, 0x0b, 0x35, 0x89, 0x92, 0x6b, 0xab, 0x70, 0x3c, 0x36, 0xf6, 0xc6, 0xee,
+	0xb6, 0xed, 0xed, 0x75, 0x91, 0x2c, 0x83, 0xd0, 0x63, 0x5b, 0xe4, 0x94, 0x1e, 0xa9, 0xf5, 0xd8,
+	0x96, 0xd6, 0x82, 0x13, 0x59, 0x19, 0x14, 0xd6, 0x25, 0xe8, 0x8f, 0x5d, 0x47, 0x81, 0x95, 0x39,
+	0x60, 0xd8, 0xb1, 0xd6, 0xdd, 0x1b, 0xbb, 0x01, 0xf6, 0x1c, 0xb3, 0x59, 0x63, 0x94, 0x68, 0x1a,
+	0x00, 0x87, 0x72, 0xb0, 0x6f, 0x98, 0x01, 0xd5, 0x53, 0xa2, 0x2b, 0xd7, 0x02, 0xed, 0x3f, 0x0a,
+	0x4c, 0xdf, 0xf0, 0x03, 0xbb, 0x65, 0x06, 0x38, 0x23, 0xa3, 0x33, 0xf6, 0x13, 0xd0, 0xeb, 0x63,
+	0xc7, 0xc2, 0x1e, 0xcd, 0x74, 0xfa, 0x0b, 0x9d, 0x07, 0x84, 0xa9, 0x14, 0xc3, 0xc3, 0x75, 0x7b,
+	0xdb, 0xc6, 0x4e, 0x50, 0x3e, 0x4c, 0x68, 0x46, 0x30, 0x93, 0x4f, 0x37, 0x42, 0x7f, 0xd3, 0xc4,
+	0x3f, 0x42, 0xfd, 0x1d, 0xe5, 0x64, 0x35, 0xbc, 0x18, 0xab, 0xf4, 0x62, 0xac, 0x5e, 0x77, 0x6d,
+	0x27, 0xf6, 0x77, 0x44, 0x8e, 0xae, 0x00, 0x6c, 0x78, 0xb6, 0xd5, 0xc0, 0x24, 0xd3, 0x8f, 0x76,
+	0xc7, 0x5c, 0x8a, 0x58, 0xc2, 0xec, 0xfe, 0xd3, 0x61, 0xa8, 0xc8, 0x8c, 0xa7, 0x4e, 0x7f, 0x09,
+	0xa0, 0xee, 0xb6, 0x5a, 0xb6, 0xef, 0xdb, 0xae, 0x53, 0x56, 0xba, 0x53, 0xc1, 0xb1, 0x64, 0x30,
+	0xf6, 0x3c, 0x2e, 0x46, 0xf4, 0x6c, 0xf2, 0x29, 0x3b, 0xdc, 0xa5, 0x77, 0xa2, 0x6f, 0x17, 0x7a,
+	0x0e, 0xfa, 0x37, 0x4d, 0x3f, 0x20, 0xac, 0x5d, 0x3a, 0xb6, 0x2f, 0x64, 0x08, 0x79, 0xbf, 0x09,
+	0x43, 0x91, 0x8f, 0xc3, 0xf8, 0x61, 0x7b, 0x07, 0x5b, 0xe5, 0xa3, 0x07, 0xfa, 0x90, 0x0e, 0x46,
+	0x62, 0x6a, 0x54, 0x0a, 0xaa, 0x00, 0x04, 0xec, 0xcb, 0x50, 0xee, 0x9d, 0x55, 0x16, 0xfb, 0x6b,
+	0xdc, 0x4a, 0xb8, 0xbf, 0x63, 0xbb, 0xcd, 0xa8, 0xa6, 0x29, 0xf7, 0x91, 0x54, 0xe7, 0x56, 0xb4,
+	0x21, 0x78, 0x2a, 0x95, 0xe2, 0xda, 0x55, 0x18, 0xcc, 0xa4, 0x6f, 0x35, 0x93, 0xbe, 0xc3, 0x49,
+	0x96, 0x08, 0xb3, 0xf6, 0x5b, 0x80, 0x58, 0x5a, 0xaf, 0xef, 0xc6, 0xc7, 0x7e, 0x11, 0x86, 0x7d,
+	0xb2, 0x6a, 0xf8, 0x38, 0x30, 0x1c, 0xd7, 0xa9, 0x63, 0x22, 0xef, 0x48, 0x6d, 0xd0, 0x8f, 0xa9,
+	0x5f, 0x0b, 0x57, 0x53, 0x09, 0xd2, 0x93, 0xfe, 0xc8, 0x3c, 0x0d, 0xe5, 0x57, 0xcd, 0x00, 0xfb,
+	0x81, 0x40, 0x41, 0xc1, 0xb7, 0xe9, 0x79, 0xa8, 0xbc, 0x6a, 0xfa, 0xc1, 0xeb, 0x1b, 0x3e, 0xf6,
+	0x76, 0xb0, 0xf5, 0x78, 0xcc, 0xaf, 0xc0, 0x68, 0x8a, 0x81, 0x7d, 0x3d, 0x20, 0xb1, 0x27, 0x5f,
+	0xa2, 0xf0, 0x2c, 0x25, 0x66, 0xa0, 0xb6, 0x0b, 0x83, 0xab, 0x66, 0x50, 0xdf, 0x4a, 0x34, 0x9f,
+	0x05, 0x96, 0xc1, 0x46, 0x10, 0x56, 0x79, 0x09, 0x84, 0xa1, 0x78, 0x23, 0xaa, 0xfe, 0x2c, 0x34,
+	0x03, 0x03, 0x1b, 0x21, 0x37, 0x75, 0x5f, 0xf4, 0xf1, 0x01, 0xb2, 0x94, 0x77, 0xdd, 0xe1, 0xb4,
+	0x19, 0xcf, 0xc1, 0x10, 0xd3, 0x4c, 0x4d, 0x38, 0x0d, 0x47, 0x09, 0x2f, 0x45, 0x3f, 0x92, 0xa0,
+	0x8f, 0x29, 0xa3, 0x7d, 0xed, 0x03, 0x05, 0x8e, 0x5f, 0x77, 0x9d, 0xc0, 0x33, 0xeb, 0xc1, 0x75,
+	0xb3, 0xd9, 0x4c, 0xd0, 0x9f, 0x07, 0x64, 0x3b, 0x3b, 0x66, 0xd3, 0xb6, 0xc8, 0x79, 0x32, 0xfc,
+	0xba, 0xbb, 0x1d, 0xc5, 0xf5, 0x58, 0x6d, 0x84, 0xdf, 0xb9, 0x1f, 0x6e, 0xe4, 0xc8, 0x79, 0x3b,
+	0x52, 0xe4, 0x1d, 0xcd, 0xb9, 0x07, 0x27, 0xb2, 0x88, 0xd8, 0x6d, 0x03, 0x4d, 0xb7, 0x61, 0xd7,
+	0x8d, 0xba, 0xd9, 0x6c, 0xe6, 0x3f, 0xec, 0x19, 0xae, 0x12, 0xa1, 0x0d, 0x7f, 0x68, 0x9b, 0x30,
+	0xc3, 0x45, 0xed, 0xba, 0xeb, 0x6c, 0xda, 0x5e, 0x2b, 0x4a, 0x93, 0x27, 0x7a, 0x88, 0x31, 0xcc,
+	0xca, 0xf5, 0x50, 0x23, 0xae, 0x45, 0xa7, 0xcb, 0x0c, 0xda, 0x1e, 0x8e, 0x8b, 0x81, 0x39, 0xe1,
+	0xe9, 0xe2, 0xf9, 0x6b, 0x1c, 0x93, 0xb6, 0x9b, 0x3a, 0xb7, 0xcc, 0x84, 0x9b, 0x00, 0x49, 0xe3,
+	0x44, 0xdd, 0xb3, 0x90, 0xfa, 0x8e, 0x45, 0x8d, 0x58, 0xfc, 0x35, 0x7b, 0xc3, 0x6c, 0x60, 0xca,
+	0x5b, 0xe3, 0x38, 0x8b, 0x0c, 0xfc, 0x85, 0x02, 0x63, 0x69, 0xd5, 0xd4, 0xaa, 0xcb, 0x30, 0x90,
+	0xb8, 0x2f, 0x36, 0x4b, 0x92, 0x34, 0xc0, 0x1c, 0xea, 0xa3, 0x5b, 0x29, 0xcc, 0xd1, 0x37, 0xff,
+	0x74, 0x47, 0xcc, 0x91, 0x52, 0x1e, 0xb4, 0x16, 0xb0, 0x24, 0xf8, 0x3a, 0xfd, 0xf1, 0x9e, 0x02,
+	0xc3, 0x89, 0x5a, 0xea, 0x8b, 0x73, 0xd0, 0x47, 0x92, 0x8b, 0x85, 0x57, 0x90, 0x7e, 0x31, 0xc5,
+	0x93, 0x73, 0xc0, 0xa3, 0x6c, 0xda, 0x7c, 0x9d, 0x7e, 0xf8, 0x89, 0x02, 0xe3, 0x39, 0xed, 0xec,
+	0x92, 0x39, 0x1a, 0xe6, 0x6b, 0xec, 0x0c, 0x79, 0xc2, 0x46, 0x64, 0x4f, 0xce, 0x23, 0x35, 0x98,
+	0x7c, 0xd3, 0x21, 0x67, 0xcd, 0x12, 0xa5, 0x8b, 0xb4, 0x69, 0x2c, 0x32, 0xf4, 0x01, 0x4c, 0x89,
+	0x65, 0x7e, 0xb5, 0x3c, 0xd0, 0x5e, 0x83, 0xf1, 0x58, 0x6e, 0xf6, 0x18, 0x1f, 0x08, 0xe7, 0x2d,
+	0x28, 0xe7, 0xe5, 0x1d, 0xe0, 0x7c, 0x6a, 0x6f, 0x42, 0x25, 0x16, 0x24, 0x39, 0x5e, 0x07, 0xc2,
+	0x77, 0x0f, 0x66, 0xa4, 0x62, 0x0f, 0x76, 0x6e, 0x34, 0x1d, 0x10, 0x45, 0x7f, 0x13, 0xe3, 0x6e,
+	0xfa, 0x9a, 0x1d, 0x18, 0x4d, 0x31, 0x50, 0xbd, 0x06, 0x1c, 0xd9, 0xc4, 0xcc, 0x37, 0x05, 0x85,
+	0xe0, 0x85, 0xb0, 0x36, 0xfa, 0xf0, 0x9f, 0x33, 0x8b, 0x5d, 0x14, 0x78, 0x21, 0x83, 0x5f, 0x23,
+	0x82, 0xb5, 0x5f, 0x29, 0xa0, 0xa5, 0x4d, 0x10, 0xde, 0x48, 0xff, 0xb7, 0x0b, 0xf8, 0x21, 0xcc,
+	0x17, 0xc2, 0xa3, 0x7e, 0x5a, 0x13, 0x5c, 0x64, 0x27, 0x65, 0x41, 0x92, 0xde, 0x65, 0x3f, 0x50,
+	0x60, 0x92, 0x46, 0x41, 0xe8, 0x85, 0x4c, 0x61, 0xa4, 0xe4, 0x0a, 0x23, 0x61, 0x95, 0xd5, 0x23,
+	0xae, 0xb2, 0x0a, 0x8c, 0x7e, 0x1b, 0xa6, 0xc4, 0x30, 0xa8, 0xb5, 0x2f, 0x0a, 0xac, 0x9d, 0xce,
+	0xe5, 0x8d, 0xd4, 0xcc, 0xb7, 0x60, 0x2e, 0xac, 0x53, 0xef, 0xb7, 0x37, 0x5a, 0x76, 0x10, 0x60,
+	0x2b, 0xee, 0x9e, 0x6e, 0xec, 0x60, 0x27, 0xf8, 0x4a, 0x99, 0x74, 0x03, 0xb4, 0x22, 0xc9, 0x14,
+	0xfe, 0x0c, 0x0c, 0xe0, 0x70, 0x21, 0xed, 0x46, 0xb2, 0x44, 0xdc, 0xa8, 0x3d, 0x80, 0x72, 0xcc,
+	0x79, 0xc7, 0x5a, 0x77, 0xd7, 0xc2, 0x41, 0x14, 0x17, 0x03, 0xe6, 0x62, 0x96, 0x46, 0x80, 0x19,
+	0x79, 0x11, 0xbc, 0x65, 0x98, 0x10, 0xc8, 0xa5, 0xa8, 0xd8, 0xf8, 0x4b, 0xe1, 0xc7, 0x5f, 0xaf,
+	0x40, 0x99, 0x90, 0x25, 0x3d, 0xe6, 0x1d, 0x36, 0x73, 0x14, 0x72, 0x14, 0xe9, 0x7f, 0x01, 0x26,
+	0x04, 0xc2, 0x38, 0xaf, 0x14, 0x19, 0xa6, 0x6d, 0x41, 0x65, 0x0d, 0x37, 0x71, 0xc3, 0x0c, 0xf0,
+	0x2b, 0x78, 0xcf, 0x5f, 0xdd, 0x7b, 0x10, 0xa5, 0x91, 0xcb, 0x46, 0x8f, 0xe7, 0x60, 0x64, 0x27,
+	0x5e, 0x33, 0xd2, 0xd1, 0x1b, 0x66, 0x1b, 0xd7, 0x3a, 0x87, 0xb1, 0x0d, 0x33, 0x52, 0x4d, 0x1c,
+	0xda, 0x60, 0x2b, 0xa3, 0x04, 0x70, 0xb0, 0x15, 0x8b, 0x5f, 0x86, 0x31, 0xd7, 0x0b, 0xbf, 0xda,
+	0x81, 0x97, 0x82, 0x13, 0xa9, 0x1a, 0xe5, 0xf7, 0x28, 0x8b, 0x66, 0xc3, 0x7c, 0x5a, 0x6d, 0xec,
+	0xa5, 0xe8, 0xa2, 0x8a, 0xad, 0x3c, 0x0d, 0x2c, 0x97, 0x8c, 0xe8, 0xd6, 0xa2, 0xea, 0x07, 0x71,
+	0x8a, 0xbe, 0xc8, 0xc2, 0x77, 0x15, 0x38, 0x59, 0xac, 0x8b, 0xdd, 0x4f, 0x8f, 0xe1, 0xd2, 0x03,
+	0xd8, 0xfc, 0x0e, 0xcc, 0xa5, 0x71, 0xbc, 0xce, 0x11, 0xc5, 0x16, 0xcb, 0xe4, 0x2a, 0x52, 0xb9,
+	0x45, 0xb6, 0x7f, 0x17, 0xb4, 0x22, 0x95, 0x07, 0x31, 0x5c, 0x10, 0x92, 0x1e, 0x51, 0x48, 0xb4,
+	0x0b, 0x30, 0xca, 0xeb, 0xee, 0xe2, 0x62, 0x7c, 0x00, 0x63, 0x69, 0x0e, 0x36, 0x5e, 0x7d, 0xca,
+	0xa2, 0xeb, 0xc6, 0x43, 0xbc, 0x97, 0x5c, 0x91, 0xec, 0x33, 0x78, 0xd7, 0x6f, 0xa4, 0x38, 0x8f,
+	0x59, 0xdc, 0x2f, 0xcd, 0x02, 0x95, 0xdf, 0xbd, 0x6d, 0xfb, 0x81, 0x9b, 0x4c, 0xfe, 0x9e, 0x54,
+	0x26, 0x99, 0x30, 0x29, 0xd4, 0x42, 0x8d, 0x58, 0x85, 0x92, 0x17, 0xff, 0x25, 0x88, 0x1a, 0x50,
+	0x49, 0x0c, 0x48, 0xa1, 0xa7, 0x64, 0xf1, 0xa4, 0x89, 0xb1, 0x69, 0x26, 0x4c, 0x93, 0x0f, 0x3e,
+	0xb6, 0xd2, 0xb3, 0x30, 0xe6, 0xdc, 0x53, 0x30, 0x18, 0x0d, 0xf8, 0x32, 0x86, 0x3c, 0x15, 0xad,
+	0x76, 0x61, 0xc5, 0xf7, 0x14, 0xa8, 0xc8, 0x74, 0xb0, 0x0b, 0x78, 0x24, 0x14, 0x67, 0x04, 0xae,
+	0x11, 0x87, 0x5c, 0x50, 0x2c, 0xa5, 0xb9, 0x6b, 0x43, 0x7e, 0x5a, 0x5a, 0x11, 0x86, 0x8f, 0x94,
+	0xb0, 0x4a, 0xdb, 0xf8, 0xdf, 0x5a, 0x9a, 0x69, 0x4f, 0x0e, 0x1f, 0xb4, 0x3d, 0xd1, 0xfe, 0xaa,
+	0xc0, 0xac, 0x1c, 0xed, 0xd7, 0xe4, 0x33, 0x74, 0x4b, 0x60, 0xcd, 0x41, 0xba, 0x97, 0x95, 0xdf,
+	0x2d, 0xc1, 0xd1, 0x7b, 0x21, 0x29, 0x7a, 0x13, 0x7a, 0xa3, 0x69, 0x1c, 0x1a, 0xcf, 0xce, 0xe7,
+	0xa8, 0x1f, 0xd4, 0x72, 0x7e, 0x23, 0x12, 0xa9, 0x95, 0xbf, 0xff, 0xb7, 0x7f, 0xff, 0xb4, 0x07,
+	0xa1, 0x61, 0x9d, 0xfd, 0x01, 0x33, 0x1a, 0xe6, 0x21, 0x1f, 0x06, 0xb8, 0x6e, 0x04, 0x4d, 0x89,
+	0x9b, 0x14, 0xaa, 0x60, 0x5a, 0xb2, 0x4b, 0xb5, 0x9c, 0x26, 0x5a, 0xe6, 0xd0, 0x4c, 0xa2, 0x25,
+	0x69, 0x83, 0xf4, 0x47, 0xb1, 0xb3, 0xf6, 0xd1, 0xbb, 0x0a, 0x8c, 0xe4, 0xe6, 0x7c, 0x48, 0x4b,
+	0xa4, 0xcb, 0x86, 0x80, 0x9d, 0x10, 0x54, 0x09, 0x82, 0x45, 0xb4, 0x20, 0x44, 0xd0, 0x24, 0x52,
+	0x79, 0x20, 0xbf, 0x54, 0x60, 0x5c, 0x32, 0x39, 0x44, 0x8b, 0x3c, 0x9c, 0xa2, 0xe1, 0x62, 0x27,
+	0x50, 0x4f, 0x13, 0x50, 0x3a, 0x3a, 0x2f, 0x01, 0xe5, 0x07, 0x86, 0x4b, 0x85, 0xf3, 0xd8, 0xde,
+	0x53, 0xa0, 0x8f, 0x16, 0x94, 0xa8, 0x9c, 0xef, 0xcd, 0xa8, 0xee, 0x09, 0xc1, 0x0e, 0xd5, 0x7b,
+	0x9b, 0xe8, 0x5d, 0x45, 0x57, 0x13, 0xbd, 0x51, 0x11, 0x1d, 0xec, 0xfa, 0x9c, 0x22, 0xfd, 0x51,
+	0xae, 0x72, 0xde, 0xd7, 0x1f, 0x71, 0xe5, 0xf6, 0x3e, 0xfa, 0xbd, 0x02, 0x83, 0xe9, 0x4a, 0x1e,
+	0xcd, 0x48, 0x1b, 0x31, 0x0a, 0x6c, 0x56, 0x4e, 0x40, 0xf1, 0xbd, 0x45, 0xf0, 0xd5, 0xd0, 0x1b,
+	0x09, 0xbe, 0x3a, 0xa5, 0x24, 0xb3, 0xbd, 0x1c, 0xce, 0x7c, 0x23, 0x94, 0x5d, 0xa4, 0x78, 0xbf,
+	0x03, 0xc7, 0xf8, 0xbe, 0x1c, 0x89, 0x03, 0xc4, 0xf2, 0xa6, 0x22, 0xdb, 0xa6, 0x40, 0x17, 0x09,
+	0x50, 0x0d, 0xcd, 0x8a, 0x02, 0xc8, 0x43, 0x44, 0x2e, 0xf4, 0xc7, 0x8d, 0x36, 0xca, 0x47, 0x86,
+	0x29, 0x54, 0x45, 0x5b, 0x54, 0xd9, 0x12, 0x51, 0xb6, 0x80, 0x4e, 0x66, 0xa2, 0x26, 0x8c, 0x1d,
+	0x7a, 0x5f, 0x81, 0xa1, 0x4c, 0xeb, 0x8c, 0xa4, 0x9e, 0x67, 0xfa, 0xe7, 0x0a, 0x28, 0x28, 0x8c,
+	0x4b, 0x04, 0x46, 0x15, 0x2d, 0x65, 0x61, 0x14, 0x85, 0x08, 0xfd, 0x51, 0x81, 0xb2, 0x6c, 0xf6,
+	0x89, 0xce, 0x74, 0x9c, 0x6f, 0x32, 0x80, 0x67, 0xbb, 0x21, 0xa5, 0x48, 0x5f, 0x20, 0x48, 0x2f,
+	0xa3, 0x4b, 0xe2, 0xe8, 0xa4, 0xca, 0xa3, 0xa8, 0x0f, 0xe3, 0x11, 0xff, 0x5a, 0x81, 0x31, 0x51,
+	0xcb, 0x87, 0x4e, 0x15, 0xb6, 0x75, 0x0c, 0xe9, 0x42, 0x27, 0x32, 0x8a, 0xf2, 0x39, 0x82, 0xf2,
+	0x12, 0x5a, 0x11, 0x25, 0x63, 0x07, 0x8c, 0x9f, 0x28, 0x30, 0x59, 0xd0, 0x8b, 0xa3, 0xa5, 0x6e,
+	0xfa, 0x6d, 0x86, 0xf8, 0x7c, 0x97, 0xd4, 0x72, 0xf7, 0x26, 0xe3, 0xf7, 0x8e, 0xd0, 0x7f, 0xa3,
+	0xc0, 0x98, 0x68, 0x54, 0xc6, 0xbb, 0xb7, 0x60, 0x3c, 0xa7, 0x2e, 0x74, 0x22, 0xa3, 0x28, 0x9f,
+	0x27, 0x28, 0x9f, 0x46, 0x17, 0x13, 0x94, 0x3c, 0x9d, 0xfe, 0x88, 0xd6, 0x25, 0xfb, 0xfa, 0x36,
+	0x76, 0x2c, 0xdb, 0x69, 0xf0, 0x20, 0x7f, 0xac, 0xc0, 0x70, 0x76, 0x4e, 0x86, 0xe6, 0xf2, 0x9a,
+	0xb3, 0x69, 0xac, 0x15, 0x91, 0x50, 0x60, 0x97, 0x09, 0xb0, 0x0b, 0xa8, 0x9a, 0x89, 0x3b, 0xee,
+	0x80, 0xe9, 0x0f, 0x4a, 0x32, 0x0b, 0xcc, 0x26, 0xf8, 0x62, 0x5e, 0xaf, 0x24, 0xd1, 0xcf, 0x74,
+	0x41, 0x49, 0x81, 0x5e, 0x21, 0x40, 0x9f, 0x45, 0x97, 0x13, 0xa0, 0x19, 0xd2, 0x62, 0xc0, 0x1f,
+	0x2b, 0xa0, 0xca, 0x47, 0x10, 0xe8, 0x5c, 0xfa, 0x36, 0x2d, 0x1c, 0x81, 0xa8, 0x4b, 0xdd, 0x11,
+	0x53, 0xe4, 0xdf, 0x20, 0xc8, 0x2f, 0xa2, 0xe5, 0x04, 0xb9, 0xeb, 0x99, 0xf5, 0x26, 0xd6, 0xb9,
+	0x61, 0x07, 0x07, 0x9e, 0x03, 0xdd, 0x86, 0x01, 0x6e, 0xf8, 0xc7, 0x57, 0x3f, 0xf9, 0x21, 0xa2,
+	0x3a, 0x2d, 0xd9, 0xa5, 0x30, 0xce, 0x10, 0x18, 0xf3, 0x68, 0x2e, 0x1f, 0xe9, 0x70, 0xe0, 0xc7,
+	0xab, 0xfd, 0xb9, 0x02, 0x23, 0xb9, 0x79, 0x08, 0x5f, 0xff, 0xc8, 0x86, 0x30, 0xea, 0x7c, 0x21,
+	0x0d, 0x45, 0xf2, 0x2c, 0x41, 0xb2, 0x82, 0x2e, 0xf0, 0x17, 0x6b, 0x58, 0x7a, 0x1a, 0xae, 0x67,
+	0x93, 0xd2, 0x12, 0x5b, 0x3a, 0x37, 0xf2, 0x08, 0xeb, 0xe0, 0x68, 0x84, 0x12, 0x02, 0xcb, 0x0d,
+	0x4a, 0x78, 0x60, 0xb2, 0x91, 0x8c, 0x3a, 0x5f, 0x48, 0xf3, 0x38, 0xc0, 0x08, 0x12, 0xbe, 0x34,
+	0x37, 0x6c, 0x0b, 0xfd, 0x56, 0x81, 0x13, 0xe2, 0x46, 0x08, 0x9d, 0xce, 0x84, 0x45, 0xd6, 0xa4,
+	0xa8, 0x8b, 0x9d, 0x09, 0xe5, 0x49, 0x4b, 0xea, 0x75, 0x83, 0xf6, 0x15, 0x06, 0xd7, 0x3d, 0xf0,
+	0x71, 0xfd, 0x48, 0x09, 0x07, 0xee, 0xe2, 0xe6, 0x03, 0xa5, 0x72, 0xb1, 0xb0, 0x9d, 0x52, 0xcf,
+	0x76, 0x43, 0x2a, 0xf7, 0x69, 0x84, 0xb5, 0xed, 0x74, 0x40, 0xfb, 0x89, 0x02, 0xe3, 0x92, 0x69,
+	0x13, 0xff, 0x89, 0x29, 0x1e, 0x7d, 0xa9, 0x67, 0xba, 0xa0, 0x94, 0x17, 0xa4, 0xa9, 0x49, 0x82,
+	0xce, 0x1a, 0xfc, 0x54, 0xd9, 0x97, 0x9b, 0x07, 0xec, 0xa3, 0x3f, 0x2b, 0x30, 0x55, 0x34, 0x45,
+	0x42, 0xe7, 0x65, 0xa8, 0x84, 0x93, 0x2d, 0xb5, 0xda, 0x2d, 0x39, 0xb5, 0xe4, 0x06, 0xb1, 0xe4,
+	0x25, 0xf4, 0xa2, 0xcc, 0x92, 0xf8, 0xec, 0x8a, 0xeb, 0xec, 0xa8, 0x3e, 0xd9, 0x47, 0x7f, 0x51,
+	0x40, 0x95, 0x4f, 0x84, 0xf8, 0x6f, 0x66, 0xc7, 0x51, 0x95, 0xba, 0xd4, 0x1d, 0x31, 0x35, 0xe0,
+	0x35, 0x62, 0xc0, 0x6d, 0x74, 0x53, 0x66, 0x00, 0x3f, 0xda, 0x4a, 0x19, 0x21, 0x9a, 0x87, 0xed,
+	0xa3, 0x3d, 0x38, 0xc6, 0x6b, 0xe5, 0x2b, 0x6e, 0xc1, 0xd8, 0x49, 0x95, 0xcd, 0x5a, 0x62, 0x78,
+	0x67, 0x09, 0xbc, 0x93, 0x48, 0x93, 0xc1, 0xe3, 0x8e, 0xf1, 0x87, 0x0a, 0x8c, 0x0a, 0x46, 0x3d,
+	0xe8, 0xa4, 0x58, 0x47, 0x7a, 0xde, 0xa4, 0x9e, 0xea, 0x40, 0x45, 0x01, 0xdd, 0x24, 0x80, 0xae,
+	0xa2, 0x2b, 0x32, 0x40, 0x5b, 0x11, 0x43, 0xa7, 0x83, 0xbb, 0x09, 0x90, 0x3c, 0xf5, 0x45, 0x93,
+	0xa2, 0x07, 0xc0, 0x31, 0xb2, 0x29, 0xf1, 0x26, 0x05, 0x34, 0x4d, 0x00, 0x8d, 0xa3, 0xe3, 0x09,
+	0x20, 0xda, 0xbd, 0x11, 0xc9, 0xef, 0x2b, 0x30, 0x92, 0x7b, 0x04, 0xcc, 0x7f, 0xc8, 0x65, 0xcf,
+	0x8a, 0xd5, 0xf9, 0x42, 0x1a, 0x79, 0x9f, 0x1d, 0x24, 0xc4, 0x46, 0xf4, 0x72, 0x58, 0x7f, 0x44,
+	0x1f, 0x06, 0x93, 0x3e, 0x7b, 0x4c, 0xf4, 0x18, 0x98, 0x2f, 0x03, 0x0b, 0x1e, 0x19, 0xab, 0x0b,
+	0x9d, 0xc8, 0x28, 0xae, 0x15, 0x82, 0x6b, 0x09, 0x9d, 0x15, 0xe3, 0xda, 0xc4, 0xd8, 0x88, 0x1e,
+	0x12, 0x73, 0xd8, 0x7e, 0x14, 0xde, 0x79, 0xd9, 0xd7, 0xc1, 0xa9, 0x3b, 0x4f, 0xf2, 0xe0, 0x58,
+	0x9d, 0x2f, 0xa4, 0xa1, 0x90, 0x74, 0x02, 0xe9, 0x0c, 0x3a, 0xcd, 0x9d, 0x1c, 0x4a, 0x6c, 0x6c,
+	0xba, 0x9e, 0xb1, 0x45, 0xc8, 0x93, 0xf2, 0x84, 0x54, 0xa3, 0xd9, 0x77, 0xbf, 0x7c, 0x35, 0x2a,
+	0x79, 0x60, 0xac, 0x6a, 0x45, 0x24, 0xf2, 0x8b, 0x2d, 0x7e, 0xf8, 0x68, 0xb4, 0x28, 0x71, 0xea,
+	0x08, 0x93, 0xcb, 0x78, 0x1f, 0x99, 0x50, 0x62, 0x4f, 0x78, 0x11, 0xdf, 0xbd, 0x66, 0xde, 0xfa,
+	0xaa, 0x93, 0xc2, 0x3d, 0xaa, 0x7d, 0x92, 0x68, 0x3f, 0x8e, 0x46, 0x13, 0xed, 0x1b, 0x4c, 0xea,
+	0x0f, 0x15, 0x18, 0x15, 0xbc, 0xea, 0xe5, 0xd3, 0x58, 0xfe, 0x24, 0x58, 0x3d, 0xd5, 0x81, 0x8a,
+	0x22, 0x58, 0x20, 0x08, 0x66, 0x51, 0x85, 0xbf, 0x2c, 0x19, 0xb9, 0x11, 0x3f, 0x01, 0x46, 0x3f,
+	0x53, 0x60, 0x54, 0xf0, 0x94, 0x97, 0x07, 0x23, 0x7f, 0x22, 0xac, 0x9e, 0xea, 0x40, 0x45, 0xc1,
+	0x2c, 0x13, 0x30, 0xe7, 0xd0, 0x19, 0x2e, 0x18, 0x8c, 0xdc, 0x88, 0xe3, 0x92, 0xfa, 0xd6, 0x99,
+	0x50, 0x62, 0x8f, 0x7d, 0xf9, 0x38, 0x64, 0x5f, 0x05, 0xab, 0x93, 0xc2, 0x3d, 0x79, 0x1c, 0xd8,
+	0xe3, 0xe0, 0x70, 0x36, 0x37, 0x94, 0x79, 0xb5, 0xcb, 0x4f, 0x14, 0xc4, 0x0f, 0x87, 0xd5, 0xb9,
+	0x02, 0x8a, 0x6e, 0x66, 0x73, 0x46, 0x34, 0x8e, 0xcc, 0x0c, 0x09, 0x07, 0xd3, 0x2f, 0x7b, 0xf9,
+	0xa1, 0x93, 0xf0, 0xdd, 0xb0, 0x3a, 0x2b, 0x27, 0x28, 0x70, 0x7a, 0x9c, 0x01, 0xe4, 0x95, 0x6f,
+	0xfa, 0x13, 0x1e, 0x02, 0x09, 0x6b, 0x4f, 0xf1, 0xab, 0x57, 0xbe, 0xf6, 0x2c, 0x7c, 0x14, 0xac,
+	0x2e, 0x76, 0x26, 0x94, 0xa7, 0x28, 0xa6, 0x1c, 0x46, 0x76, 0x68, 0xcd, 0x41, 0x5d, 0x7d, 0xf9,
+	0xd3, 0x2f, 0x2a, 0xca, 0x67, 0x5f, 0x54, 0x94, 0x7f, 0x7d, 0x51, 0x51, 0x3e, 0xf8, 0xb2, 0x72,
+	0xe8, 0xb3, 0x2f, 0x2b, 0x87, 0xfe, 0xfe, 0x65, 0xe5, 0xd0, 0xb7, 0x2f, 0x70, 0x2f, 0x13, 0xee,
+	0xda, 0x4e, 0x80, 0xbd, 0x75, 0x6c, 0xb6, 0xa8, 0xf8, 0x96, 0x6b, 0xb5, 0x9b, 0x58, 0xdf, 0xa5,
+	0x3f, 0xc9, 0x3b, 0x85, 0x8d, 0x5e, 0xf2, 0x3f, 0xb6, 0x5c, 0xfc, 0xef, 0x00, 0x04, 0x94, 0x64,
+	0xf1, 0xbd, 0x33, 0x00, 0x00,
 }
 
-func (m *TokenInfosRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Module parameters query
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// get info on individual outgoing data
+	SignerSetTx(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
+	LatestSignerSetTx(ctx context.Context, in *LatestSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
+	LastObservedSignerSetTx(ctx context.Context, in *LastObservedSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
+	BatchTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxResponse, error)
+	ContractCallTx(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*ContractCallTxResponse, error)
+	// get collections of outgoing traffic from the bridge
+	SignerSetTxs(ctx context.Context, in *SignerSetTxsRequest, opts ...grpc.CallOption) (*SignerSetTxsResponse, error)
+	BatchTxs(ctx context.Context, in *BatchTxsRequest, opts ...grpc.CallOption) (*BatchTxsResponse, error)
+	ContractCallTxs(ctx context.Context, in *ContractCallTxsRequest, opts ...grpc.CallOption) (*ContractCallTxsResponse, error)
+	// TODO: can/should we group these into one endpoint?
+	SignerSetTxConfirmations(ctx context.Context, in *SignerSetTxConfirmationsRequest, opts ...grpc.CallOption) (*SignerSetTxConfirmationsResponse, error)
+	BatchTxConfirmations(ctx context.Context, in *BatchTxConfirmationsRequest, opts ...grpc.CallOption) (*BatchTxConfirmationsResponse, error)
+	ContractCallTxConfirmations(ctx context.Context, in *ContractCallTxConfirmationsRequest, opts ...grpc.CallOption) (*ContractCallTxConfirmationsResponse, error)
+	// pending external signature queries for orchestrators to figure out which
+	// signatures they are missing
+	// TODO: can/should we group this into one endpoint?
+	UnsignedSignerSetTxs(ctx context.Context, in *UnsignedSignerSetTxsRequest, opts ...grpc.CallOption) (*UnsignedSignerSetTxsResponse, error)
+	UnsignedBatchTxs(ctx context.Context, in *UnsignedBatchTxsRequest, opts ...grpc.CallOption) (*UnsignedBatchTxsResponse, error)
+	UnsignedContractCallTxs(ctx context.Context, in *UnsignedContractCallTxsRequest, opts ...grpc.CallOption) (*UnsignedContractCallTxsResponse, error)
+	LastSubmittedExternalEvent(ctx context.Context, in *LastSubmittedExternalEventRequest, opts ...grpc.CallOption) (*LastSubmittedExternalEventResponse, error)
+	// Queries the fees for all pending batches, results are returned in sdk.Coin
+	// (fee_amount_int)(contract_address) style
+	BatchTxFees(ctx context.Context, in *BatchTxFeesRequest, opts ...grpc.CallOption) (*BatchTxFeesResponse, error)
+	// Query for info about denoms tracked by mhub2
+	ExternalIdToDenom(ctx context.Context, in *ExternalIdToDenomRequest, opts ...grpc.CallOption) (*ExternalIdToDenomResponse, error)
+	// Query for info about denoms tracked by mhub2
+	DenomToExternalId(ctx context.Context, in *DenomToExternalIdRequest, opts ...grpc.CallOption) (*DenomToExternalIdResponse, error)
+	// Query for batch send to externals
+	BatchedSendToExternals(ctx context.Context, in *BatchedSendToExternalsRequest, opts ...grpc.CallOption) (*BatchedSendToExternalsResponse, error)
+	// Query for unbatched send to externals
+	UnbatchedSendToExternals(ctx context.Context, in *UnbatchedSendToExternalsRequest, opts ...grpc.CallOption) (*UnbatchedSendToExternalsResponse, error)
+	// delegate keys
+	DelegateKeysByValidator(ctx context.Context, in *DelegateKeysByValidatorRequest, opts ...grpc.CallOption) (*DelegateKeysByValidatorResponse, error)
+	DelegateKeysByExternalSigner(ctx context.Context, in *DelegateKeysByExternalSignerRequest, opts ...grpc.CallOption) (*DelegateKeysByExternalSignerResponse, error)
+	DelegateKeysByOrchestrator(ctx context.Context, in *DelegateKeysByOrchestratorRequest, opts ...grpc.CallOption) (*DelegateKeysByOrchestratorResponse, error)
+	DelegateKeys(ctx context.Context, in *DelegateKeysRequest, opts ...grpc.CallOption) (*DelegateKeysResponse, error)
+	DelegateKeysHistory(ctx context.Context, in *DelegateKeysHistoryRequest, opts ...grpc.CallOption) (*DelegateKeysHistoryResponse, error)
+	TokenInfos(ctx context.Context, in *TokenInfosRequest, opts ...grpc.CallOption) (*TokenInfosResponse, error)
+	TransactionStatus(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatusResponse, error)
+	TransactionFeeRecord(ctx context.Context, in *TransactionFeeRecordRequest, opts ...grpc.CallOption) (*TransactionFeeRecordResponse, error)
+	DiscountForHolder(ctx context.Context, in *DiscountForHolderRequest, opts ...grpc.CallOption) (*DiscountForHolderResponse, error)
+	TransferMinimums(ctx context.Context, in *TransferMinimumsRequest, opts ...grpc.CallOption) (*TransferMinimumsResponse, error)
+	Blocklist(ctx context.Context, in *BlocklistRequest, opts ...grpc.CallOption) (*BlocklistResponse, error)
+	QuarantinedDeposits(ctx context.Context, in *QuarantinedDepositsRequest, opts ...grpc.CallOption) (*QuarantinedDepositsResponse, error)
+	TimelockedTransfers(ctx context.Context, in *TimelockedTransfersRequest, opts ...grpc.CallOption) (*TimelockedTransfersResponse, error)
+	Guardians(ctx context.Context, in *GuardiansRequest, opts ...grpc.CallOption) (*GuardiansResponse, error)
+	SignerSetParams(ctx context.Context, in *SignerSetParamsRequest, opts ...grpc.CallOption) (*SignerSetParamsResponse, error)
+	TransferExpiry(ctx context.Context, in *TransferExpiryRequest, opts ...grpc.CallOption) (*TransferExpiryResponse, error)
+	EstimateSendToExternal(ctx context.Context, in *EstimateSendToExternalRequest, opts ...grpc.CallOption) (*EstimateSendToExternalResponse, error)
 }
 
-func (m *TokenInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *TokenInfosResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TokenInfosResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *TokenInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.List.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) SignerSetTx(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
+	out := new(SignerSetTxResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/SignerSetTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TransactionStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LatestSignerSetTx(ctx context.Context, in *LatestSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
+	out := new(SignerSetTxResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/LatestSignerSetTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TransactionStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TransactionStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LastObservedSignerSetTx(ctx context.Context, in *LastObservedSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
+	out := new(SignerSetTxResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/LastObservedSignerSetTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TransactionStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BatchTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxResponse, error) {
+	out := new(BatchTxResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/BatchTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TransactionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *TransactionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ContractCallTx(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*ContractCallTxResponse, error) {
+	out := new(ContractCallTxResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/ContractCallTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TransactionFeeRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SignerSetTxs(ctx context.Context, in *SignerSetTxsRequest, opts ...grpc.CallOption) (*SignerSetTxsResponse, error) {
+	out := new(SignerSetTxsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/SignerSetTxs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TransactionFeeRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BatchTxs(ctx context.Context, in *BatchTxsRequest, opts ...grpc.CallOption) (*BatchTxsResponse, error) {
+	out := new(BatchTxsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/BatchTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TransactionFeeRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ContractCallTxs(ctx context.Context, in *ContractCallTxsRequest, opts ...grpc.CallOption) (*ContractCallTxsResponse, error) {
+	out := new(ContractCallTxsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/ContractCallTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TransactionFeeRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SignerSetTxConfirmations(ctx context.Context, in *SignerSetTxConfirmationsRequest, opts ...grpc.CallOption) (*SignerSetTxConfirmationsResponse, error) {
+	out := new(SignerSetTxConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/SignerSetTxConfirmations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TransactionFeeRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BatchTxConfirmations(ctx context.Context, in *BatchTxConfirmationsRequest, opts ...grpc.CallOption) (*BatchTxConfirmationsResponse, error) {
+	out := new(BatchTxConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/BatchTxConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TransactionFeeRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Record != nil {
-		{
-			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ContractCallTxConfirmations(ctx context.Context, in *ContractCallTxConfirmationsRequest, opts ...grpc.CallOption) (*ContractCallTxConfirmationsResponse, error) {
+	out := new(ContractCallTxConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/ContractCallTxConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DiscountForHolderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UnsignedSignerSetTxs(ctx context.Context, in *UnsignedSignerSetTxsRequest, opts ...grpc.CallOption) (*UnsignedSignerSetTxsResponse, error) {
+	out := new(UnsignedSignerSetTxsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/UnsignedSignerSetTxs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DiscountForHolderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UnsignedBatchTxs(ctx context.Context, in *UnsignedBatchTxsRequest, opts ...grpc.CallOption) (*UnsignedBatchTxsResponse, error) {
+	out := new(UnsignedBatchTxsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/UnsignedBatchTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DiscountForHolderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) UnsignedContractCallTxs(ctx context.Context, in *UnsignedContractCallTxsRequest, opts ...grpc.CallOption) (*UnsignedContractCallTxsResponse, error) {
+	out := new(UnsignedContractCallTxsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/UnsignedContractCallTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DiscountForHolderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastSubmittedExternalEvent(ctx context.Context, in *LastSubmittedExternalEventRequest, opts ...grpc.CallOption) (*LastSubmittedExternalEventResponse, error) {
+	out := new(LastSubmittedExternalEventResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/LastSubmittedExternalEvent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DiscountForHolderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BatchTxFees(ctx context.Context, in *BatchTxFeesRequest, opts ...grpc.CallOption) (*BatchTxFeesResponse, error) {
+	out := new(BatchTxFeesResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/BatchTxFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DiscountForHolderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Discount.Size()
-		i -= size
-		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ExternalIdToDenom(ctx context.Context, in *ExternalIdToDenomRequest, opts ...grpc.CallOption) (*ExternalIdToDenomResponse, error) {
+	out := new(ExternalIdToDenomResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/ExternalIdToDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DenomToExternalId(ctx context.Context, in *DenomToExternalIdRequest, opts ...grpc.CallOption) (*DenomToExternalIdResponse, error) {
+	out := new(DenomToExternalIdResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DenomToExternalId", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BatchedSendToExternals(ctx context.Context, in *BatchedSendToExternalsRequest, opts ...grpc.CallOption) (*BatchedSendToExternalsResponse, error) {
+	out := new(BatchedSendToExternalsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/BatchedSendToExternals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) UnbatchedSendToExternals(ctx context.Context, in *UnbatchedSendToExternalsRequest, opts ...grpc.CallOption) (*UnbatchedSendToExternalsResponse, error) {
+	out := new(UnbatchedSendToExternalsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/UnbatchedSendToExternals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegateKeysByValidator(ctx context.Context, in *DelegateKeysByValidatorRequest, opts ...grpc.CallOption) (*DelegateKeysByValidatorResponse, error) {
+	out := new(DelegateKeysByValidatorResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DelegateKeysByValidator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) DelegateKeysByExternalSigner(ctx context.Context, in *DelegateKeysByExternalSignerRequest, opts ...grpc.CallOption) (*DelegateKeysByExternalSignerResponse, error) {
+	out := new(DelegateKeysByExternalSignerResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DelegateKeysByExternalSigner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SignerSetTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegateKeysByOrchestrator(ctx context.Context, in *DelegateKeysByOrchestratorRequest, opts ...grpc.CallOption) (*DelegateKeysByOrchestratorResponse, error) {
+	out := new(DelegateKeysByOrchestratorResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DelegateKeysByOrchestrator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *SignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.SignerSetNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) DelegateKeys(ctx context.Context, in *DelegateKeysRequest, opts ...grpc.CallOption) (*DelegateKeysResponse, error) {
+	out := new(DelegateKeysResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DelegateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LatestSignerSetTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegateKeysHistory(ctx context.Context, in *DelegateKeysHistoryRequest, opts ...grpc.CallOption) (*DelegateKeysHistoryResponse, error) {
+	out := new(DelegateKeysHistoryResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DelegateKeysHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LatestSignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *LatestSignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) TokenInfos(ctx context.Context, in *TokenInfosRequest, opts ...grpc.CallOption) (*TokenInfosResponse, error) {
+	out := new(TokenInfosResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/TokenInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LastObservedSignerSetTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TransactionStatus(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatusResponse, error) {
+	out := new(TransactionStatusResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/TransactionStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LastObservedSignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *LastObservedSignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) TransactionFeeRecord(ctx context.Context, in *TransactionFeeRecordRequest, opts ...grpc.CallOption) (*TransactionFeeRecordResponse, error) {
+	out := new(TransactionFeeRecordResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/TransactionFeeRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SignerSetTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DiscountForHolder(ctx context.Context, in *DiscountForHolderRequest, opts ...grpc.CallOption) (*DiscountForHolderResponse, error) {
+	out := new(DiscountForHolderResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/DiscountForHolder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SignerSetTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *SignerSetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SignerSet != nil {
-		{
-			size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) TransferMinimums(ctx context.Context, in *TransferMinimumsRequest, opts ...grpc.CallOption) (*TransferMinimumsResponse, error) {
+	out := new(TransferMinimumsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/TransferMinimums", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *BatchTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Blocklist(ctx context.Context, in *BlocklistRequest, opts ...grpc.CallOption) (*BlocklistResponse, error) {
+	out := new(BlocklistResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/Blocklist", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BatchTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *BatchTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ExternalTokenId) > 0 {
-		i -= len(m.ExternalTokenId)
-		copy(dAtA[i:], m.ExternalTokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalTokenId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QuarantinedDeposits(ctx context.Context, in *QuarantinedDepositsRequest, opts ...grpc.CallOption) (*QuarantinedDepositsResponse, error) {
+	out := new(QuarantinedDepositsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/QuarantinedDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *BatchTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TimelockedTransfers(ctx context.Context, in *TimelockedTransfersRequest, opts ...grpc.CallOption) (*TimelockedTransfersResponse, error) {
+	out := new(TimelockedTransfersResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/TimelockedTransfers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Guardians(ctx context.Context, in *GuardiansRequest, opts ...grpc.CallOption) (*GuardiansResponse, error) {
+	out := new(GuardiansResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/Guardians", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *BatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Batch != nil {
-		{
-			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) SignerSetParams(ctx context.Context, in *SignerSetParamsRequest, opts ...grpc.CallOption) (*SignerSetParamsResponse, error) {
+	out := new(SignerSetParamsResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/SignerSetParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ContractCallTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TransferExpiry(ctx context.Context, in *TransferExpiryRequest, opts ...grpc.CallOption) (*TransferExpiryResponse, error) {
+	out := new(TransferExpiryResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/TransferExpiry", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ContractCallTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateSendToExternal(ctx context.Context, in *EstimateSendToExternalRequest, opts ...grpc.CallOption) (*EstimateSendToExternalResponse, error) {
+	out := new(EstimateSendToExternalResponse)
+	err := c.cc.Invoke(ctx, "/mhub2.v1.Query/EstimateSendToExternal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ContractCallTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Module parameters query
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// get info on individual outgoing data
+	SignerSetTx(context.Context, *SignerSetTxRequest) (*SignerSetTxResponse, error)
+	LatestSignerSetTx(context.Context, *LatestSignerSetTxRequest) (*SignerSetTxResponse, error)
+	LastObservedSignerSetTx(context.Context, *LastObservedSignerSetTxRequest) (*SignerSetTxResponse, error)
+	BatchTx(context.Context, *BatchTxRequest) (*BatchTxResponse, error)
+	ContractCallTx(context.Context, *ContractCallTxRequest) (*ContractCallTxResponse, error)
+	// get collections of outgoing traffic from the bridge
+	SignerSetTxs(context.Context, *SignerSetTxsRequest) (*SignerSetTxsResponse, error)
+	BatchTxs(context.Context, *BatchTxsRequest) (*BatchTxsResponse, error)
+	ContractCallTxs(context.Context, *ContractCallTxsRequest) (*ContractCallTxsResponse, error)
+	// TODO: can/should we group these into one endpoint?
+	SignerSetTxConfirmations(context.Context, *SignerSetTxConfirmationsRequest) (*SignerSetTxConfirmationsResponse, error)
+	BatchTxConfirmations(context.Context, *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error)
+	ContractCallTxConfirmations(context.Context, *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error)
+	// pending external signature queries for orchestrators to figure out which
+	// signatures they are missing
+	// TODO: can/should we group this into one endpoint?
+	UnsignedSignerSetTxs(context.Context, *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error)
+	UnsignedBatchTxs(context.Context, *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error)
+	UnsignedContractCallTxs(context.Context, *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error)
+	LastSubmittedExternalEvent(context.Context, *LastSubmittedExternalEventRequest) (*LastSubmittedExternalEventResponse, error)
+	// Queries the fees for all pending batches, results are returned in sdk.Coin
+	// (fee_amount_int)(contract_address) style
+	BatchTxFees(context.Context, *BatchTxFeesRequest) (*BatchTxFeesResponse, error)
+	// Query for info about denoms tracked by mhub2
+	ExternalIdToDenom(context.Context, *ExternalIdToDenomRequest) (*ExternalIdToDenomResponse, error)
+	// Query for info about denoms tracked by mhub2
+	DenomToExternalId(context.Context, *DenomToExternalIdRequest) (*DenomToExternalIdResponse, error)
+	// Query for batch send to externals
+	BatchedSendToExternals(context.Context, *BatchedSendToExternalsRequest) (*BatchedSendToExternalsResponse, error)
+	// Query for unbatched send to externals
+	UnbatchedSendToExternals(context.Context, *UnbatchedSendToExternalsRequest) (*UnbatchedSendToExternalsResponse, error)
+	// delegate keys
+	DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
+	DelegateKeysByExternalSigner(context.Context, *DelegateKeysByExternalSignerRequest) (*DelegateKeysByExternalSignerResponse, error)
+	DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
+	DelegateKeys(context.Context, *DelegateKeysRequest) (*DelegateKeysResponse, error)
+	DelegateKeysHistory(context.Context, *DelegateKeysHistoryRequest) (*DelegateKeysHistoryResponse, error)
+	TokenInfos(context.Context, *TokenInfosRequest) (*TokenInfosResponse, error)
+	TransactionStatus(context.Context, *TransactionStatusRequest) (*TransactionStatusResponse, error)
+	TransactionFeeRecord(context.Context, *TransactionFeeRecordRequest) (*TransactionFeeRecordResponse, error)
+	DiscountForHolder(context.Context, *DiscountForHolderRequest) (*DiscountForHolderResponse, error)
+	TransferMinimums(context.Context, *TransferMinimumsRequest) (*TransferMinimumsResponse, error)
+	Blocklist(context.Context, *BlocklistRequest) (*BlocklistResponse, error)
+	QuarantinedDeposits(context.Context, *QuarantinedDepositsRequest) (*QuarantinedDepositsResponse, error)
+	TimelockedTransfers(context.Context, *TimelockedTransfersRequest) (*TimelockedTransfersResponse, error)
+	Guardians(context.Context, *GuardiansRequest) (*GuardiansResponse, error)
+	SignerSetParams(context.Context, *SignerSetParamsRequest) (*SignerSetParamsResponse, error)
+	TransferExpiry(context.Context, *TransferExpiryRequest) (*TransferExpiryResponse, error)
+	EstimateSendToExternal(context.Context, *EstimateSendToExternalRequest) (*EstimateSendToExternalResponse, error)
 }
 
-func (m *ContractCallTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *ContractCallTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *ContractCallTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogicCall != nil {
-		{
-			size, err := m.LogicCall.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) SignerSetTx(ctx context.Context, req *SignerSetTxRequest) (*SignerSetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTx not implemented")
 }
-
-func (m *SignerSetTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) LatestSignerSetTx(ctx context.Context, req *LatestSignerSetTxRequest) (*SignerSetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestSignerSetTx not implemented")
+}
+func (*UnimplementedQueryServer) LastObservedSignerSetTx(ctx context.Context, req *LastObservedSignerSetTxRequest) (*SignerSetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastObservedSignerSetTx not implemented")
+}
+func (*UnimplementedQueryServer) BatchTx(ctx context.Context, req *BatchTxRequest) (*BatchTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTx not implemented")
+}
+func (*UnimplementedQueryServer) ContractCallTx(ctx context.Context, req *ContractCallTxRequest) (*ContractCallTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTx not implemented")
+}
+func (*UnimplementedQueryServer) SignerSetTxs(ctx context.Context, req *SignerSetTxsRequest) (*SignerSetTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxs not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxs(ctx context.Context, req *BatchTxsRequest) (*BatchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxs not implemented")
+}
+func (*UnimplementedQueryServer) ContractCallTxs(ctx context.Context, req *ContractCallTxsRequest) (*ContractCallTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxs not implemented")
+}
+func (*UnimplementedQueryServer) SignerSetTxConfirmations(ctx context.Context, req *SignerSetTxConfirmationsRequest) (*SignerSetTxConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxConfirmations not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxConfirmations(ctx context.Context, req *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxConfirmations not implemented")
+}
+func (*UnimplementedQueryServer) ContractCallTxConfirmations(ctx context.Context, req *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxConfirmations not implemented")
+}
+func (*UnimplementedQueryServer) UnsignedSignerSetTxs(ctx context.Context, req *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsignedSignerSetTxs not implemented")
+}
+func (*UnimplementedQueryServer) UnsignedBatchTxs(ctx context.Context, req *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsignedBatchTxs not implemented")
+}
+func (*UnimplementedQueryServer) UnsignedContractCallTxs(ctx context.Context, req *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsignedContractCallTxs not implemented")
+}
+func (*UnimplementedQueryServer) LastSubmittedExternalEvent(ctx context.Context, req *LastSubmittedExternalEventRequest) (*LastSubmittedExternalEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastSubmittedExternalEvent not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxFees(ctx context.Context, req *BatchTxFeesRequest) (*BatchTxFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxFees not implemented")
+}
+func (*UnimplementedQueryServer) ExternalIdToDenom(ctx context.Context, req *ExternalIdToDenomRequest) (*ExternalIdToDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalIdToDenom not implemented")
+}
+func (*UnimplementedQueryServer) DenomToExternalId(ctx context.Context, req *DenomToExternalIdRequest) (*DenomToExternalIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomToExternalId not implemented")
+}
+func (*UnimplementedQueryServer) BatchedSendToExternals(ctx context.Context, req *BatchedSendToExternalsRequest) (*BatchedSendToExternalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchedSendToExternals not implemented")
+}
+func (*UnimplementedQueryServer) UnbatchedSendToExternals(ctx context.Context, req *UnbatchedSendToExternalsRequest) (*UnbatchedSendToExternalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbatchedSendToExternals not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeysByValidator(ctx context.Context, req *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByValidator not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeysByExternalSigner(ctx context.Context, req *DelegateKeysByExternalSignerRequest) (*DelegateKeysByExternalSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByExternalSigner not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeysByOrchestrator(ctx context.Context, req *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByOrchestrator not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeys(ctx context.Context, req *DelegateKeysRequest) (*DelegateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeys not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeysHistory(ctx context.Context, req *DelegateKeysHistoryRequest) (*DelegateKeysHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysHistory not implemented")
+}
+func (*UnimplementedQueryServer) TokenInfos(ctx context.Context, req *TokenInfosRequest) (*TokenInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenInfos not implemented")
+}
+func (*UnimplementedQueryServer) TransactionStatus(ctx context.Context, req *TransactionStatusRequest) (*TransactionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactionStatus not implemented")
+}
+func (*UnimplementedQueryServer) TransactionFeeRecord(ctx context.Context, req *TransactionFeeRecordRequest) (*TransactionFeeRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactionFeeRecord not implemented")
+}
+func (*UnimplementedQueryServer) DiscountForHolder(ctx context.Context, req *DiscountForHolderRequest) (*DiscountForHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscountForHolder not implemented")
+}
+func (*UnimplementedQueryServer) TransferMinimums(ctx context.Context, req *TransferMinimumsRequest) (*TransferMinimumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferMinimums not implemented")
+}
+func (*UnimplementedQueryServer) Blocklist(ctx context.Context, req *BlocklistRequest) (*BlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Blocklist not implemented")
+}
+func (*UnimplementedQueryServer) QuarantinedDeposits(ctx context.Context, req *QuarantinedDepositsRequest) (*QuarantinedDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantinedDeposits not implemented")
+}
+func (*UnimplementedQueryServer) TimelockedTransfers(ctx context.Context, req *TimelockedTransfersRequest) (*TimelockedTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockedTransfers not implemented")
+}
+func (*UnimplementedQueryServer) Guardians(ctx context.Context, req *GuardiansRequest) (*GuardiansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Guardians not implemented")
+}
+func (*UnimplementedQueryServer) SignerSetParams(ctx context.Context, req *SignerSetParamsRequest) (*SignerSetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetParams not implemented")
+}
+func (*UnimplementedQueryServer) TransferExpiry(ctx context.Context, req *TransferExpiryRequest) (*TransferExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferExpiry not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSendToExternal(ctx context.Context, req *EstimateSendToExternalRequest) (*EstimateSendToExternalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSendToExternal not implemented")
 }
 
-func (m *SignerSetTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *SignerSetTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SignerSetNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SignerSetTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SignerSetTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/SignerSetTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTx(ctx, req.(*SignerSetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SignerSetTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_LatestSignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestSignerSetTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).LatestSignerSetTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/LatestSignerSetTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestSignerSetTx(ctx, req.(*LatestSignerSetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LastObservedSignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastObservedSignerSetTxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).LastObservedSignerSetTx(ctx, in)
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/LastObservedSignerSetTx",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastObservedSignerSetTx(ctx, req.(*LastObservedSignerSetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/BatchTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTx(ctx, req.(*BatchTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ContractCallTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCallTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/ContractCallTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCallTx(ctx, req.(*ContractCallTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_SignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.SignerSets) > 0 {
-		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTxs(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/SignerSetTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTxs(ctx, req.(*SignerSetTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/BatchTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxs(ctx, req.(*BatchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCallTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/ContractCallTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCallTxs(ctx, req.(*ContractCallTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+func _Query_SignerSetTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTxConfirmations(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/SignerSetTxConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTxConfirmations(ctx, req.(*SignerSetTxConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BatchTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxConfirmationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/BatchTxConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxConfirmations(ctx, req.(*BatchTxConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_ContractCallTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallTxConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCallTxConfirmations(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/ContractCallTxConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCallTxConfirmations(ctx, req.(*ContractCallTxConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UnsignedSignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsignedSignerSetTxsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).UnsignedSignerSetTxs(ctx, in)
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/UnsignedSignerSetTxs",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnsignedSignerSetTxs(ctx, req.(*UnsignedSignerSetTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UnsignedBatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsignedBatchTxsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).UnsignedBatchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/UnsignedBatchTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnsignedBatchTxs(ctx, req.(*UnsignedBatchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_UnsignedContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsignedContractCallTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Calls) > 0 {
-		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).UnsignedContractCallTxs(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/UnsignedContractCallTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnsignedContractCallTxs(ctx, req.(*UnsignedContractCallTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedSignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LastSubmittedExternalEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastSubmittedExternalEventRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LastSubmittedExternalEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/LastSubmittedExternalEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastSubmittedExternalEvent(ctx, req.(*LastSubmittedExternalEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedSignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BatchTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/BatchTxFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxFees(ctx, req.(*BatchTxFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedSignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+func _Query_ExternalIdToDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalIdToDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ExternalIdToDenom(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/ExternalIdToDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExternalIdToDenom(ctx, req.(*ExternalIdToDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedSignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DenomToExternalId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomToExternalIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).DenomToExternalId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/DenomToExternalId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomToExternalId(ctx, req.(*DenomToExternalIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedSignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UnsignedSignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SignerSets) > 0 {
-		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_BatchedSendToExternals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchedSendToExternalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).BatchedSendToExternals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/BatchedSendToExternals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchedSendToExternals(ctx, req.(*BatchedSendToExternalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedBatchTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UnbatchedSendToExternals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbatchedSendToExternalsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnsignedBatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).UnbatchedSendToExternals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/UnbatchedSendToExternals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbatchedSendToExternals(ctx, req.(*UnbatchedSendToExternalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedBatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+func _Query_DelegateKeysByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeysByValidator(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/DelegateKeysByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeysByValidator(ctx, req.(*DelegateKeysByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedBatchTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DelegateKeysByExternalSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysByExternalSignerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeysByExternalSigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/DelegateKeysByExternalSigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeysByExternalSigner(ctx, req.(*DelegateKeysByExternalSignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedBatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_DelegateKeysByOrchestrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysByOrchestratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeysByOrchestrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/DelegateKeysByOrchestrator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeysByOrchestrator(ctx, req.(*DelegateKeysByOrchestratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedBatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_DelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/DelegateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeys(ctx, req.(*DelegateKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DelegateKeysHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeysHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/DelegateKeysHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeysHistory(ctx, req.(*DelegateKeysHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TokenInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/TokenInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenInfos(ctx, req.(*TokenInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+func _Query_TransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).TransactionStatus(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/TransactionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransactionStatus(ctx, req.(*TransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TransactionFeeRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionFeeRecordRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TransactionFeeRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/TransactionFeeRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransactionFeeRecord(ctx, req.(*TransactionFeeRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnsignedContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UnsignedContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Calls) > 0 {
-		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_DiscountForHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscountForHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).DiscountForHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/DiscountForHolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DiscountForHolder(ctx, req.(*DiscountForHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TransferMinimums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferMinimumsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BatchTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BatchTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).TransferMinimums(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/TransferMinimums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferMinimums(ctx, req.(*TransferMinimumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Blocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlocklistRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BatchTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BatchTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fees) > 0 {
-		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Blocklist(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/Blocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Blocklist(ctx, req.(*BlocklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractCallTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QuarantinedDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantinedDepositsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractCallTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContractCallTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).QuarantinedDeposits(ctx, in)
 	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/QuarantinedDeposits",
 	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuarantinedDeposits(ctx, req.(*QuarantinedDepositsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ContractCallTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TimelockedTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimelockedTransfersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractCallTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContractCallTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockedTransfers(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/TimelockedTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockedTransfers(ctx, req.(*TimelockedTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Guardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardiansRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BatchTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Guardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/Guardians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Guardians(ctx, req.(*GuardiansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
+func _Query_SignerSetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ExternalTokenId) > 0 {
-		i -= len(m.ExternalTokenId)
-		copy(dAtA[i:], m.ExternalTokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalTokenId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetParams(ctx, in)
 	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/SignerSetParams",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetParams(ctx, req.(*SignerSetParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TransferExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferExpiryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TransferExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/TransferExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferExpiry(ctx, req.(*TransferExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EstimateSendToExternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSendToExternalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSendToExternal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mhub2.v1.Query/EstimateSendToExternal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSendToExternal(ctx, req.(*EstimateSendToExternalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mhub2.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "SignerSetTx",
+			Handler:    _Query_SignerSetTx_Handler,
+		},
+		{
+			MethodName: "LatestSignerSetTx",
+			Handler:    _Query_LatestSignerSetTx_Handler,
+		},
+		{
+			MethodName: "LastObservedSignerSetTx",
+			Handler:    _Query_LastObservedSignerSetTx_Handler,
+		},
+		{
+			MethodName: "BatchTx",
+			Handler:    _Query_BatchTx_Handler,
+		},
+		{
+			MethodName: "ContractCallTx",
+			Handler:    _Query_ContractCallTx_Handler,
+		},
+		{
+			MethodName: "SignerSetTxs",
+			Handler:    _Query_SignerSetTxs_Handler,
+		},
+		{
+			MethodName: "BatchTxs",
+			Handler:    _Query_BatchTxs_Handler,
+		},
+		{
+			MethodName: "ContractCallTxs",
+			Handler:    _Query_ContractCallTxs_Handler,
+		},
+		{
+			MethodName: "SignerSetTxConfirmations",
+			Handler:    _Query_SignerSetTxConfirmations_Handler,
+		},
+		{
+			MethodName: "BatchTxConfirmations",
+			Handler:    _Query_BatchTxConfirmations_Handler,
+		},
+		{
+			MethodName: "ContractCallTxConfirmations",
+			Handler:    _Query_ContractCallTxConfirmations_Handler,
+		},
+		{
+			MethodName: "UnsignedSignerSetTxs",
+			Handler:    _Query_UnsignedSignerSetTxs_Handler,
+		},
+		{
+			MethodName: "UnsignedBatchTxs",
+			Handler:    _Query_UnsignedBatchTxs_Handler,
+		},
+		{
+			MethodName: "UnsignedContractCallTxs",
+			Handler:    _Query_UnsignedContractCallTxs_Handler,
+		},
+		{
+			MethodName: "LastSubmittedExternalEvent",
+			Handler:    _Query_LastSubmittedExternalEvent_Handler,
+		},
+		{
+			MethodName: "BatchTxFees",
+			Handler:    _Query_BatchTxFees_Handler,
+		},
+		{
+			MethodName: "ExternalIdToDenom",
+			Handler:    _Query_ExternalIdToDenom_Handler,
+		},
+		{
+			MethodName: "DenomToExternalId",
+			Handler:    _Query_DenomToExternalId_Handler,
+		},
+		{
+			MethodName: "BatchedSendToExternals",
+			Handler:    _Query_BatchedSendToExternals_Handler,
+		},
+		{
+			MethodName: "UnbatchedSendToExternals",
+			Handler:    _Query_UnbatchedSendToExternals_Handler,
+		},
+		{
+			MethodName: "DelegateKeysByValidator",
+			Handler:    _Query_DelegateKeysByValidator_Handler,
+		},
+		{
+			MethodName: "DelegateKeysByExternalSigner",
+			Handler:    _Query_DelegateKeysByExternalSigner_Handler,
+		},
+		{
+			MethodName: "DelegateKeysByOrchestrator",
+			Handler:    _Query_DelegateKeysByOrchestrator_Handler,
+		},
+		{
+			MethodName: "DelegateKeys",
+			Handler:    _Query_DelegateKeys_Handler,
+		},
+		{
+			MethodName: "DelegateKeysHistory",
+			Handler:    _Query_DelegateKeysHistory_Handler,
+		},
+		{
+			MethodName: "TokenInfos",
+			Handler:    _Query_TokenInfos_Handler,
+		},
+		{
+			MethodName: "TransactionStatus",
+			Handler:    _Query_TransactionStatus_Handler,
+		},
+		{
+			MethodName: "TransactionFeeRecord",
+			Handler:    _Query_TransactionFeeRecord_Handler,
+		},
+		{
+			MethodName: "DiscountForHolder",
+			Handler:    _Query_DiscountForHolder_Handler,
+		},
+		{
+			MethodName: "TransferMinimums",
+			Handler:    _Query_TransferMinimums_Handler,
+		},
+		{
+			MethodName: "Blocklist",
+			Handler:    _Query_Blocklist_Handler,
+		},
+		{
+			MethodName: "QuarantinedDeposits",
+			Handler:    _Query_QuarantinedDeposits_Handler,
+		},
+		{
+			MethodName: "TimelockedTransfers",
+			Handler:    _Query_TimelockedTransfers_Handler,
+		},
+		{
+			MethodName: "Guardians",
+			Handler:    _Query_Guardians_Handler,
+		},
+		{
+			MethodName: "SignerSetParams",
+			Handler:    _Query_SignerSetParams_Handler,
+		},
+		{
+			MethodName: "TransferExpiry",
+			Handler:    _Query_TransferExpiry_Handler,
+		},
+		{
+			MethodName: "EstimateSendToExternal",
+			Handler:    _Query_EstimateSendToExternal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mhub2/v1/query.proto",
 }
 
-func (m *LastSubmittedExternalEventRequest) Marshal() (dAtA []byte, err error) {
+func (m *TokenInfosRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5485,34 +5368,20 @@ func (m *LastSubmittedExternalEventRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LastSubmittedExternalEventRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TokenInfosRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LastSubmittedExternalEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TokenInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LastSubmittedExternalEventResponse) Marshal() (dAtA []byte, err error) {
+func (m *TokenInfosResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5522,25 +5391,30 @@ func (m *LastSubmittedExternalEventResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *LastSubmittedExternalEventResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TokenInfosResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LastSubmittedExternalEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TokenInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.List.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ExternalIdToDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *TransactionStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5550,34 +5424,27 @@ func (m *ExternalIdToDenomRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExternalIdToDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransactionStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExternalIdToDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TransactionStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExternalIdToDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *TransactionStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5587,27 +5454,32 @@ func (m *ExternalIdToDenomResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExternalIdToDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransactionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExternalIdToDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TransactionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DenomToExternalIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *TransactionFeeRecordRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5617,34 +5489,27 @@ func (m *DenomToExternalIdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DenomToExternalIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransactionFeeRecordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DenomToExternalIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TransactionFeeRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DenomToExternalIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *TransactionFeeRecordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5654,27 +5519,32 @@ func (m *DenomToExternalIdResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DenomToExternalIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransactionFeeRecordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DenomToExternalIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TransactionFeeRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysByValidatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *DiscountForHolderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5684,34 +5554,27 @@ func (m *DelegateKeysByValidatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DiscountForHolderRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateKeysByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DiscountForHolderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysByValidatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *DiscountForHolderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5721,34 +5584,30 @@ func (m *DelegateKeysByValidatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysByValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DiscountForHolderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateKeysByValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DiscountForHolderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.EthAddress) > 0 {
-		i -= len(m.EthAddress)
-		copy(dAtA[i:], m.EthAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysByExternalSignerRequest) Marshal() (dAtA []byte, err error) {
+func (m *TransferMinimumsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5758,34 +5617,34 @@ func (m *DelegateKeysByExternalSignerRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysByExternalSignerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferMinimumsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateKeysByExternalSignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TransferMinimumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ExternalSigner) > 0 {
-		i -= len(m.ExternalSigner)
-		copy(dAtA[i:], m.ExternalSigner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalSigner)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysByExternalSignerResponse) Marshal() (dAtA []byte, err error) {
+func (m *TransferMinimumsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5795,34 +5654,40 @@ func (m *DelegateKeysByExternalSignerResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysByExternalSignerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferMinimumsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateKeysByExternalSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TransferMinimumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysByOrchestratorRequest) Marshal() (dAtA []byte, err error) {
+func (m *BlocklistRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5832,34 +5697,20 @@ func (m *DelegateKeysByOrchestratorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysByOrchestratorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlocklistRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateKeysByOrchestratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlocklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysByOrchestratorResponse) Marshal() (dAtA []byte, err error) {
+func (m *BlocklistResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5869,34 +5720,29 @@ func (m *DelegateKeysByOrchestratorResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysByOrchestratorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlocklistResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateKeysByOrchestratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlocklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExternalSigner) > 0 {
-		i -= len(m.ExternalSigner)
-		copy(dAtA[i:], m.ExternalSigner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalSigner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuarantinedDepositsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5906,27 +5752,20 @@ func (m *DelegateKeysRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuarantinedDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuarantinedDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuarantinedDepositsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5936,20 +5775,20 @@ func (m *DelegateKeysResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuarantinedDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuarantinedDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5963,7 +5802,7 @@ func (m *DelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchedSendToExternalsRequest) Marshal() (dAtA []byte, err error) {
+func (m *TimelockedTransfersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5973,12 +5812,12 @@ func (m *BatchedSendToExternalsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchedSendToExternalsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TimelockedTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchedSendToExternalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TimelockedTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5988,19 +5827,12 @@ func (m *BatchedSendToExternalsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		copy(dAtA[i:], m.ChainId)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchedSendToExternalsResponse) Marshal() (dAtA []byte, err error) {
+func (m *TimelockedTransfersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6010,27 +5842,20 @@ func (m *BatchedSendToExternalsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchedSendToExternalsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TimelockedTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchedSendToExternalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TimelockedTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SendToExternals) > 0 {
-		for iNdEx := len(m.SendToExternals) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SendToExternals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6044,7 +5869,7 @@ func (m *BatchedSendToExternalsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *UnbatchedSendToExternalsRequest) Marshal() (dAtA []byte, err error) {
+func (m *GuardiansRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6054,46 +5879,20 @@ func (m *UnbatchedSendToExternalsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnbatchedSendToExternalsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GuardiansRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnbatchedSendToExternalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GuardiansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UnbatchedSendToExternalsResponse) Marshal() (dAtA []byte, err error) {
+func (m *GuardiansResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6103,939 +5902,5015 @@ func (m *UnbatchedSendToExternalsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnbatchedSendToExternalsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GuardiansResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnbatchedSendToExternalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GuardiansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SendToExternals) > 0 {
-		for iNdEx := len(m.SendToExternals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SendToExternals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *TokenInfosRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignerSetParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TokenInfosResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.List.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *SignerSetParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransactionStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SignerSetParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TransactionStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SignerSetParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TransactionFeeRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *SignerSetParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransactionFeeRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SignerSetParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Record != nil {
-		l = m.Record.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *DiscountForHolderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *TransferExpiryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DiscountForHolderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Discount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *TransferExpiryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TransferExpiryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TransferExpiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SignerSetTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignerSetNonce != 0 {
-		n += 1 + sovQuery(uint64(m.SignerSetNonce))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *TransferExpiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LatestSignerSetTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TransferExpiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ExpiresAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Transfer != nil {
+		{
+			size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LastObservedSignerSetTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *EstimateSendToExternalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SignerSetTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignerSet != nil {
-		l = m.SignerSet.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *EstimateSendToExternalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EstimateSendToExternalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ExternalTokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BatchNonce != 0 {
-		n += 1 + sovQuery(uint64(m.BatchNonce))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *BatchTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Batch != nil {
-		l = m.Batch.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ContractCallTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ExternalRecipient) > 0 {
+		i -= len(m.ExternalRecipient)
+		copy(dAtA[i:], m.ExternalRecipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalRecipient)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractCallTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LogicCall != nil {
-		l = m.LogicCall.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *EstimateSendToExternalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SignerSetTxConfirmationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignerSetNonce != 0 {
-		n += 1 + sovQuery(uint64(m.SignerSetNonce))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *EstimateSendToExternalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignerSetTxConfirmationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EstimateSendToExternalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Violations) > 0 {
+		for iNdEx := len(m.Violations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Violations[iNdEx])
+			copy(dAtA[i:], m.Violations[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Violations[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	return n
-}
-
-func (m *SignerSetTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Timelocked {
+		i--
+		if m.Timelocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.AmountReceived.Size()
+		i -= size
+		if _, err := m.AmountReceived.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.FastFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *SignerSetTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if len(m.SignerSets) > 0 {
-		for _, e := range m.SignerSets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractCallTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignerSetTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractCallTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Calls) > 0 {
-		for _, e := range m.Calls {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *LatestSignerSetTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UnsignedSignerSetTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *LatestSignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnsignedSignerSetTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LatestSignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SignerSets) > 0 {
-		for _, e := range m.SignerSets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnsignedBatchTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LastObservedSignerSetTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LastObservedSignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastObservedSignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnsignedBatchTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignerSetTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.SignerSet != nil {
+		{
+			size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnsignedContractCallTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.ExternalTokenId) > 0 {
+		i -= len(m.ExternalTokenId)
+		copy(dAtA[i:], m.ExternalTokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalTokenId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UnsignedContractCallTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Calls) > 0 {
-		for _, e := range m.Calls {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *BatchTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BatchTxFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *BatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchTxFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractCallTxConfirmationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovQuery(uint64(m.InvalidationNonce))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ContractCallTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ContractCallTxConfirmationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *ContractCallTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchTxConfirmationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContractCallTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BatchNonce != 0 {
-		n += 1 + sovQuery(uint64(m.BatchNonce))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ExternalTokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchTxConfirmationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractCallTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.LogicCall != nil {
+		{
+			size, err := m.LogicCall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LastSubmittedExternalEventRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SignerSetTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LastSubmittedExternalEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovQuery(uint64(m.EventNonce))
-	}
-	return n
+func (m *SignerSetTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExternalIdToDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SignerSetTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExternalIdToDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SignerSetTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DenomToExternalIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *SignerSetTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DenomToExternalIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SignerSetTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysByValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysByValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysByExternalSignerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ExternalSigner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysByExternalSignerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *SignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateKeysByOrchestratorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SignerSets) > 0 {
+		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysByOrchestratorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ExternalSigner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegateKeysRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *BatchTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DelegateKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.DelegateKeys) > 0 {
-		for _, e := range m.DelegateKeys {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchedSendToExternalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchedSendToExternalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SendToExternals) > 0 {
-		for _, e := range m.SendToExternals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedSignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedSignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedSignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedSignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedSignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedSignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignerSets) > 0 {
+		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedBatchTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedBatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedBatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedBatchTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedBatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedBatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExternalTokenId) > 0 {
+		i -= len(m.ExternalTokenId)
+		copy(dAtA[i:], m.ExternalTokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalTokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastSubmittedExternalEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastSubmittedExternalEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastSubmittedExternalEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastSubmittedExternalEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastSubmittedExternalEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastSubmittedExternalEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalIdToDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalIdToDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalIdToDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalIdToDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalIdToDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalIdToDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomToExternalIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomToExternalIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomToExternalIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomToExternalIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomToExternalIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomToExternalIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByExternalSignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByExternalSignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByExternalSignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExternalSigner) > 0 {
+		i -= len(m.ExternalSigner)
+		copy(dAtA[i:], m.ExternalSigner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalSigner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByExternalSignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByExternalSignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByExternalSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByOrchestratorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByOrchestratorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByOrchestratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByOrchestratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByOrchestratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByOrchestratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalSigner) > 0 {
+		i -= len(m.ExternalSigner)
+		copy(dAtA[i:], m.ExternalSigner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalSigner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchedSendToExternalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchedSendToExternalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchedSendToExternalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchedSendToExternalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchedSendToExternalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchedSendToExternalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SendToExternals) > 0 {
+		for iNdEx := len(m.SendToExternals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendToExternals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbatchedSendToExternalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbatchedSendToExternalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbatchedSendToExternalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbatchedSendToExternalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbatchedSendToExternalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbatchedSendToExternalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SendToExternals) > 0 {
+		for iNdEx := len(m.SendToExternals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendToExternals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TokenInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.List.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TransactionStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionFeeRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionFeeRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DiscountForHolderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DiscountForHolderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Discount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TransferMinimumsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferMinimumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *BlocklistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BlocklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuarantinedDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuarantinedDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TimelockedTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TimelockedTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GuardiansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GuardiansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignerSetParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TransferExpiryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *TransferExpiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		l = m.Transfer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *EstimateSendToExternalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExternalRecipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateSendToExternalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FastFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AmountReceived.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Timelocked {
+		n += 2
+	}
+	if len(m.Violations) > 0 {
+		for _, s := range m.Violations {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SignerSetTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LatestSignerSetTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LastObservedSignerSetTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSet != nil {
+		l = m.SignerSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExternalTokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogicCall != nil {
+		l = m.LogicCall.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignerSetTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignerSets) > 0 {
+		for _, e := range m.SignerSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedSignerSetTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedSignerSetTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignerSets) > 0 {
+		for _, e := range m.SignerSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnsignedBatchTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedBatchTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnsignedContractCallTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedContractCallTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchTxFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractCallTxConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchTxConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	l = len(m.ExternalTokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LastSubmittedExternalEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LastSubmittedExternalEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *ExternalIdToDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExternalIdToDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomToExternalIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomToExternalIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByExternalSignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExternalSigner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByExternalSignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByOrchestratorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByOrchestratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExternalSigner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelegateKeys) > 0 {
+		for _, e := range m.DelegateKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegateKeysHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchedSendToExternalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchedSendToExternalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendToExternals) > 0 {
+		for _, e := range m.SendToExternals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnbatchedSendToExternalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnbatchedSendToExternalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendToExternals) > 0 {
+		for _, e := range m.SendToExternals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &TxStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionFeeRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionFeeRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionFeeRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionFeeRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionFeeRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionFeeRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &TxFeeRecord{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscountForHolderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscountForHolderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscountForHolderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscountForHolderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscountForHolderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscountForHolderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferMinimumsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferMinimumsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferMinimumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferMinimumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferMinimumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferMinimumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlocklistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlocklistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlocklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlocklistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlocklistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlocklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, QuarantinedDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimelockedTransfersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockedTransfersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockedTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimelockedTransfersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockedTransfersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockedTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, TimelockedTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GuardiansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardiansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardiansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GuardiansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardiansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardiansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *UnbatchedSendToExternalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *UnbatchedSendToExternalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SendToExternals) > 0 {
-		for _, e := range m.SendToExternals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *TokenInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *TransferExpiryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7058,12 +10933,63 @@ func (m *TokenInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferExpiryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferExpiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7088,7 +11014,7 @@ func (m *TokenInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *TransferExpiryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7111,15 +11037,15 @@ func (m *TokenInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferExpiryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferExpiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7146,10 +11072,32 @@ func (m *TokenInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Transfer == nil {
+				m.Transfer = &SendToExternal{}
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7174,7 +11122,7 @@ func (m *TokenInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransactionStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateSendToExternalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7197,17 +11145,146 @@ func (m *TransactionStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSendToExternalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSendToExternalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7217,23 +11294,24 @@ func (m *TransactionStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7259,7 +11337,7 @@ func (m *TransactionStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransactionStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateSendToExternalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7282,15 +11360,15 @@ func (m *TransactionStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSendToExternalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSendToExternalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7317,71 +11395,15 @@ func (m *TransactionStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &TxStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransactionFeeRecordRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionFeeRecordRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionFeeRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7391,80 +11413,28 @@ func (m *TransactionFeeRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransactionFeeRecordResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionFeeRecordResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionFeeRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7491,71 +11461,15 @@ func (m *TransactionFeeRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Record == nil {
-				m.Record = &TxFeeRecord{}
-			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DiscountForHolderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DiscountForHolderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscountForHolderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FastFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7565,82 +11479,84 @@ func (m *DiscountForHolderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.FastFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountReceived", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DiscountForHolderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AmountReceived.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DiscountForHolderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscountForHolderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timelocked = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Violations", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7650,24 +11566,23 @@ func (m *DiscountForHolderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Violations = append(m.Violations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12269,6 +16184,210 @@ func (m *DelegateKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegateKeysHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rotations = append(m.Rotations, DelegateKeysRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BatchedSendToExternalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,8 +3,10 @@ package types
 import (
 	"bytes"
 	"crypto/sha256"
+	"fmt"
 	"math"
 	"sort"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -48,6 +50,17 @@ func (b ExternalSigners) Hash() []byte {
 	return hash[:]
 }
 
+// WeightsHash takes the sha256sum of the addresses and the weights of the signers
+func (b ExternalSigners) WeightsHash() []byte {
+	b.Sort()
+	var out bytes.Buffer
+	for _, s := range b {
+		out.Write(append(common.HexToAddress(s.ExternalAddress).Bytes(), sdk.Uint64ToBigEndian(s.Weight)...))
+	}
+	hash := sha256.Sum256(out.Bytes())
+	return hash[:]
+}
+
 // PowerDiff returns the difference in power between two bridge validator sets
 // note this is Mhub2 bridge power *not* Cosmos voting power. Cosmos voting
 // power is based on the absolute number of tokens in the staking pool at any given
@@ -88,6 +101,60 @@ func (b ExternalSigners) PowerDiff(c ExternalSigners) float64 {
 	return math.Abs(delta / float64(math.MaxUint32))
 }
 
+// NormalizePower converts the Cosmos powers of the signers into the bridge power with a total of u32_max. A positive
+// maxPower limits the share of a single signer, the power above the cap is redistributed pro rata between the signers
+// below it. If there are too few signers to satisfy the cap, the capped signers get equal shares.
+func (b ExternalSigners) NormalizePower(maxPower sdk.Dec) {
+	var totalPower uint64
+	for _, s := range b {
+		totalPower += s.Power
+	}
+	if totalPower == 0 {
+		return
+	}
+
+	remainingBridgePower := uint64(math.MaxUint32)
+	remainingPower := totalPower
+	capped := make([]bool, len(b))
+	if !maxPower.IsNil() && maxPower.IsPositive() {
+		maxBridgePower := maxPower.MulInt64(math.MaxUint32).TruncateInt().Uint64()
+		if equalBridgePower := uint64(math.MaxUint32) / uint64(len(b)); maxBridgePower < equalBridgePower {
+			maxBridgePower = equalBridgePower
+		}
+
+		order := make([]int, len(b))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			return b[order[i]].Power > b[order[j]].Power
+		})
+
+		// the largest signers are capped one by one while their share of the remaining power exceeds the cap
+		for _, i := range order {
+			share := sdk.NewUint(b[i].Power).MulUint64(remainingBridgePower).QuoUint64(remainingPower).Uint64()
+			if share <= maxBridgePower {
+				break
+			}
+
+			remainingBridgePower -= maxBridgePower
+			remainingPower -= b[i].Power
+			b[i].Power = maxBridgePower
+			capped[i] = true
+
+			if remainingPower == 0 {
+				break
+			}
+		}
+	}
+
+	for i := range b {
+		if !capped[i] {
+			b[i].Power = sdk.NewUint(b[i].Power).MulUint64(remainingBridgePower).QuoUint64(remainingPower).Uint64()
+		}
+	}
+}
+
 // TotalPower returns the total power in the bridge validator set
 func (b ExternalSigners) TotalPower() (out uint64) {
 	for _, v := range b {
@@ -105,14 +172,94 @@ func (b ExternalSigners) GetPowers() []uint64 {
 	return r
 }
 
-// NewSignerSetTx returns a new valset
+const (
+	// SignerSetTotalWeight is the sum of the signer weights of a signer set, it fits the 1023 limit of a Minter
+	// multisig weight
+	SignerSetTotalWeight = 1000
+
+	// SignerSetThreshold is the sum of the signer weights required to sign a multisig transaction, it's more than
+	// 2/3 of SignerSetTotalWeight
+	SignerSetThreshold = SignerSetTotalWeight*2/3 + 1
+)
+
+// SetWeights distributes SignerSetTotalWeight between the signers proportionally to their power. The weights are
+// rounded down and the remainder goes to the signers with the largest fractional parts, so the weights always sum
+// up to SignerSetTotalWeight. Ties are resolved by the order of the signers.
+func (b ExternalSigners) SetWeights() {
+	totalPower := b.TotalPower()
+	if totalPower == 0 {
+		return
+	}
+
+	remainders := make([]uint64, len(b))
+	var totalWeight uint64
+	for i, s := range b {
+		weight := sdk.NewUint(s.Power).MulUint64(SignerSetTotalWeight)
+		s.Weight = weight.QuoUint64(totalPower).Uint64()
+		remainders[i] = weight.Mod(sdk.NewUint(totalPower)).Uint64()
+		totalWeight += s.Weight
+	}
+
+	order := make([]int, len(b))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]] > remainders[order[j]]
+	})
+	for _, i := range order[:SignerSetTotalWeight-totalWeight] {
+		b[i].Weight++
+	}
+}
+
+// GetWeights returns only the weight values for all members
+func (b ExternalSigners) GetWeights() []uint64 {
+	r := make([]uint64, len(b))
+	for i := range b {
+		r[i] = b[i].Weight
+	}
+	return r
+}
+
+// ValidateMultisig checks that the multisig set up on the external chain has the threshold and the weights of the
+// signer set, the signer sets created before the hub started to compute them are not checked
+func (u SignerSetTx) ValidateMultisig(threshold uint64, members ExternalSigners) error {
+	if u.Threshold == 0 {
+		return nil
+	}
+	if threshold != u.Threshold {
+		return fmt.Errorf("multisig threshold %d does not match the signer set threshold %d", threshold, u.Threshold)
+	}
+	if len(members) != len(u.Signers) {
+		return fmt.Errorf("multisig has %d members, the signer set has %d signers", len(members), len(u.Signers))
+	}
+
+	weights := make(map[string]uint64, len(members))
+	for _, m := range members {
+		weights[strings.ToLower(m.ExternalAddress)] = m.Weight
+	}
+	for _, s := range u.Signers {
+		weight, ok := weights[strings.ToLower(s.ExternalAddress)]
+		if !ok {
+			return fmt.Errorf("signer %s is not a multisig member", s.ExternalAddress)
+		}
+		if weight != s.Weight {
+			return fmt.Errorf("multisig weight %d of %s does not match the signer weight %d", weight, s.ExternalAddress, s.Weight)
+		}
+	}
+
+	return nil
+}
+
+// NewSignerSetTx returns a new valset with the multisig weights and threshold of the members
 func NewSignerSetTx(nonce, height uint64, members ExternalSigners) *SignerSetTx {
 	members.Sort()
+	members.SetWeights()
 	var mem []*ExternalSigner
 	for _, val := range members {
 		mem = append(mem, val)
 	}
-	return &SignerSetTx{Nonce: nonce, Height: height, Signers: mem}
+	return &SignerSetTx{Nonce: nonce, Height: height, Signers: mem, Threshold: SignerSetThreshold}
 }
 
 // GetFees returns the total fees contained within a given batch
@@ -123,3 +270,21 @@ func (b BatchTx) GetFees() sdk.Int {
 	}
 	return sum
 }
+
+// ValidateTransferMinimums checks the amount and the bridge fee of a transfer to the chain of
+// the token against the minimums set by governance. Unset minimums are not enforced.
+func (ti TokenInfo) ValidateTransferMinimums(amount sdk.Int, fee sdk.Int) error {
+	if !ti.MinAmount.IsNil() && amount.LT(ti.MinAmount) {
+		return sdkerrors.Wrapf(ErrBelowMinimum, "amount %s%s is less than minimum %s%s", amount, ti.Denom, ti.MinAmount, ti.Denom)
+	}
+	if !ti.MinFee.IsNil() && fee.LT(ti.MinFee) {
+		return sdkerrors.Wrapf(ErrBelowMinimum, "fee %s%s is less than minimum %s%s", fee, ti.Denom, ti.MinFee, ti.Denom)
+	}
+	return nil
+}
+
+// RequiresTimelock reports whether a transfer of the amount to the chain of the token has to be held
+// by the withdrawal timelock. Unset or zero threshold disables the timelock.
+func (ti TokenInfo) RequiresTimelock(amount sdk.Int) bool {
+	return !ti.TimelockThreshold.IsNil() && ti.TimelockThreshold.IsPositive() && amount.GTE(ti.TimelockThreshold)
+}
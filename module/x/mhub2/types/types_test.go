@@ -6,6 +6,7 @@ import (
 	mrand "math/rand"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 )
@@ -33,7 +34,7 @@ func TestValsetConfirmHash(t *testing.T) {
 	// TODO: this is hardcoded to foo, replace?
 	hash := v.GetCheckpoint([]byte("foo"))
 	hexHash := hex.EncodeToString(hash)
-	correctHash := "88165860d955aee7dc3e83d9d1156a5864b708841965585d206dbef6e9e1a499"
+	correctHash := "32f52763aa4b5ebbd0708cad8244982aa40215a4551db6b081a20183c924912c"
 	assert.Equal(t, correctHash, hexHash)
 }
 
@@ -100,6 +101,107 @@ func TestExternalSigners_PowerDiff(t *testing.T) {
 	}
 }
 
+func TestExternalSigners_NormalizePower(t *testing.T) {
+	specs := map[string]struct {
+		powers   []uint64
+		maxPower sdk.Dec
+		exp      []uint64
+	}{
+		"no cap": {
+			powers:   []uint64{1, 1, 2},
+			maxPower: sdk.ZeroDec(),
+			exp:      []uint64{1073741823, 1073741823, 2147483647},
+		},
+		"cap is not reached": {
+			powers:   []uint64{1, 1, 2},
+			maxPower: sdk.NewDecWithPrec(50, 2),
+			exp:      []uint64{1073741823, 1073741823, 2147483647},
+		},
+		"excess is redistributed": {
+			powers:   []uint64{2, 6, 2},
+			maxPower: sdk.NewDecWithPrec(40, 2),
+			exp:      []uint64{1288490188, 1717986918, 1288490188},
+		},
+		"redistribution caps the next signer": {
+			powers:   []uint64{5, 3, 1, 1},
+			maxPower: sdk.NewDecWithPrec(35, 2),
+			exp:      []uint64{1503238553, 1503238553, 644245094, 644245094},
+		},
+		"too few signers for the cap": {
+			powers:   []uint64{3, 1},
+			maxPower: sdk.NewDecWithPrec(20, 2),
+			exp:      []uint64{2147483647, 2147483647},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			signers := make(ExternalSigners, len(spec.powers))
+			for i, power := range spec.powers {
+				signers[i] = &ExternalSigner{Power: power}
+			}
+			signers.NormalizePower(spec.maxPower)
+			assert.Equal(t, spec.exp, signers.GetPowers())
+		})
+	}
+}
+
+func TestExternalSigners_SetWeights(t *testing.T) {
+	specs := map[string]struct {
+		powers []uint64
+		exp    []uint64
+	}{
+		"exact": {
+			powers: []uint64{2, 1, 1},
+			exp:    []uint64{500, 250, 250},
+		},
+		"remainder goes to the largest fractions": {
+			powers: []uint64{1, 1, 1},
+			exp:    []uint64{334, 333, 333},
+		},
+		"real world": {
+			powers: []uint64{685294939, 678509841, 671724742, 617443955, 291759231, 6785098},
+			exp:    []uint64{232, 230, 228, 209, 99, 2},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			signers := make(ExternalSigners, len(spec.powers))
+			for i, power := range spec.powers {
+				signers[i] = &ExternalSigner{Power: power}
+			}
+			signers.SetWeights()
+			assert.Equal(t, spec.exp, signers.GetWeights())
+
+			var total uint64
+			for _, weight := range signers.GetWeights() {
+				total += weight
+			}
+			assert.EqualValues(t, SignerSetTotalWeight, total)
+		})
+	}
+}
+
+func TestSignerSetTx_ValidateMultisig(t *testing.T) {
+	sstx := NewSignerSetTx(1, 1, ExternalSigners{
+		{Power: 2, ExternalAddress: "0xAbCd000000000000000000000000000000000001"},
+		{Power: 1, ExternalAddress: "0xabcd000000000000000000000000000000000002"},
+	})
+	multisig := func(weights ...uint64) ExternalSigners {
+		return ExternalSigners{
+			{Power: weights[0], Weight: weights[0], ExternalAddress: "0xabcd000000000000000000000000000000000001"},
+			{Power: weights[1], Weight: weights[1], ExternalAddress: "0xabcd000000000000000000000000000000000002"},
+		}
+	}
+
+	assert.NoError(t, sstx.ValidateMultisig(SignerSetThreshold, multisig(667, 333)))
+	assert.Error(t, sstx.ValidateMultisig(SignerSetThreshold-1, multisig(667, 333)))
+	assert.Error(t, sstx.ValidateMultisig(SignerSetThreshold, multisig(666, 334)))
+	assert.Error(t, sstx.ValidateMultisig(SignerSetThreshold, multisig(667, 333)[:1]))
+
+	// the signer sets without the threshold are not checked
+	assert.NoError(t, SignerSetTx{Signers: sstx.Signers}.ValidateMultisig(SignerSetThreshold-1, nil))
+}
+
 func TestValsetSort(t *testing.T) {
 	specs := map[string]struct {
 		src ExternalSigners
@@ -8,7 +8,7 @@ import (
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// process claims
-	if ctx.BlockHeight()%5 == 0 {
+	if k.IsEpochEnd(ctx) {
 		k.ProcessCurrentEpoch(ctx)
 	}
 }
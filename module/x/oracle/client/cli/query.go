@@ -3,9 +3,12 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/MinterTeam/mhub2/module/x/oracle/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +22,12 @@ func GetQueryCmd(storeKey string) *cobra.Command {
 	}
 	peggyQueryCmd.AddCommand([]*cobra.Command{
 		CmdGetPrices(storeKey),
+		CmdGetHolders(),
+		CmdGetHolder(),
+		CmdGetValidatorOracleStats(),
+		CmdGetChainFee(),
+		CmdGetPriceFeeds(),
+		CmdGetPriceHistory(),
 	}...)
 
 	return peggyQueryCmd
@@ -45,3 +54,169 @@ func CmdGetPrices(storeKey string) *cobra.Command {
 		},
 	}
 }
+
+func CmdGetHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holders",
+		Args:  cobra.NoArgs,
+		Short: "Query current holders",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Holders(cmd.Context(), &types.QueryHoldersRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holders")
+	return cmd
+}
+
+func CmdGetHolder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holder [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query current holder by address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Holder(cmd.Context(), &types.QueryHolderRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetValidatorOracleStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-stats [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query oracle claims stats of the validator in the current signed claims window",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).ValidatorOracleStats(cmd.Context(), &types.QueryValidatorOracleStatsRequest{ValidatorAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetChainFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain-fee [chain-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query transfer and batch fees of the external chain in usd",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).ChainFee(cmd.Context(), &types.QueryChainFeeRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetPriceFeeds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-feeds",
+		Args:  cobra.NoArgs,
+		Short: "Query price feeds required in the price claims",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).PriceFeeds(cmd.Context(), &types.QueryPriceFeedsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [name] [from-epoch] [to-epoch]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Query price values after the updates of the epochs, up to the current epoch if to-epoch is omitted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "from epoch")
+			}
+
+			var to uint64
+			if len(args) == 3 {
+				if to, err = strconv.ParseUint(args[2], 10, 64); err != nil {
+					return sdkerrors.Wrap(err, "to epoch")
+				}
+			}
+
+			res, err := types.NewQueryClient(clientCtx).PriceHistory(cmd.Context(), &types.QueryPriceHistoryRequest{
+				Name:      args[0],
+				FromEpoch: from,
+				ToEpoch:   to,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -1 +1,57 @@
 package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Oracle transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		CmdDelegateFeeder(),
+	)
+
+	return txCmd
+}
+
+func CmdDelegateFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-feeder [feeder-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Authorise the feeder account to submit the oracle claims of the validator of the sender",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "feeder address")
+			}
+
+			msg := types.NewMsgDelegateFeeder(sdk.ValAddress(clientCtx.GetFromAddress()), feeder)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
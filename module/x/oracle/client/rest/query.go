@@ -42,21 +42,3 @@ func coinsHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
 	}
 }
-
-func ethFeeHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/eth_fee", storeName))
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-		if len(res) == 0 {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "prices not found")
-			return
-		}
-
-		var out types.QueryEthFeeResponse
-		cliCtx.Codec.MustUnmarshalJSON(res, &out)
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
-	}
-}
@@ -12,5 +12,4 @@ import (
 func RegisterRoutes(cliCtx client.Context, r *mux.Router, storeName string) {
 	r.HandleFunc(fmt.Sprintf("/%s/coins", storeName), coinsHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/prices", storeName), pricesHandler(cliCtx, storeName)).Methods("GET")
-	r.HandleFunc(fmt.Sprintf("/%s/eth_fee", storeName), ethFeeHandler(cliCtx, storeName)).Methods("GET")
 }
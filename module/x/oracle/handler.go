@@ -19,6 +19,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgPriceClaim:
 			res, err := msgServer.PriceClaim(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgHoldersClaim:
+			res, err := msgServer.HoldersClaim(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDelegateFeeder:
+			res, err := msgServer.DelegateFeeder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("Unrecognized Peggy Msg type: %v", msg.String()))
 		}
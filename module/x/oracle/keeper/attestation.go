@@ -270,6 +270,21 @@ func (k Keeper) deleteHoldersClaim(ctx sdk.Context, valaddr string, epoch uint64
 	store.Delete(types.GetClaimKey(details))
 }
 
+// IterateClaims iterates through all the stored claims
+func (k Keeper) IterateClaims(ctx sdk.Context, cb func(types.GenericClaim) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleClaimKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		claim := types.GenericClaim{}
+		k.cdc.MustUnmarshal(iter.Value(), &claim)
+		// cb returns true to stop early
+		if cb(claim) {
+			break
+		}
+	}
+}
+
 // IterateClaimsByValidatorAndType takes a validator key and a claim type and then iterates over these claims
 func (k Keeper) IterateClaimsByValidatorAndType(ctx sdk.Context, claimType types.ClaimType, validatorKey sdk.ValAddress, cb func([]byte, types.Claim) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleClaimKey)
@@ -1,9 +1,7 @@
 package keeper
 
 import (
-	"fmt"
-	"math"
-	"sort"
+	"strings"
 
 	"github.com/MinterTeam/mhub2/module/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,73 +19,54 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 	switch claim := claim.(type) {
 	case *types.MsgPriceClaim:
 		votes := att.GetVotes()
-		pricesSum := map[string][]sdk.Dec{}
+		pricesSum := map[string][]weightedValue{}
+		claims := map[string]*types.Prices{}
 
 		powers := a.keeper.GetNormalizedValPowers(ctx)
 
 		for _, valaddr := range votes {
 			validator, _ := sdk.ValAddressFromBech32(valaddr)
 			power := powers[valaddr]
+			if power == 0 {
+				continue
+			}
 
 			priceClaim := a.keeper.GetPriceClaim(ctx, sdk.AccAddress(validator).String(), claim.Epoch).(*types.GenericClaim).GetPriceClaim()
 			prices := priceClaim.GetPrices()
+			claims[valaddr] = prices
 			for _, item := range prices.List {
-				for i := uint64(0); i < power; i++ {
-					pricesSum[item.Name] = append(pricesSum[item.Name], item.Value)
-				}
+				pricesSum[item.Name] = append(pricesSum[item.Name], weightedValue{value: item.Value, power: power})
 			}
 		}
 
-		var priceNames []string
-		for name := range pricesSum {
-			priceNames = append(priceNames, name)
-		}
-		sort.Strings(priceNames)
-
-		prices := types.Prices{}
-		for _, name := range priceNames {
-			price := pricesSum[name]
+		prices := types.Prices{List: aggregatePrices(pricesSum, a.keeper.priceFeedsByName(ctx))}
 
-			sort.Slice(price, func(i, j int) bool {
-				return price[i].LT(price[j])
-			})
-
-			var calculatedPrice sdk.Dec
-			if len(price)%2 == 0 {
-				calculatedPrice = price[len(price)/2].Add(price[len(price)/2-1]).QuoInt64(2) // compute average
-			} else {
-				calculatedPrice = price[len(price)/2]
-			}
-
-			prices.List = append(prices.List, &types.Price{
-				Name:  name,
-				Value: calculatedPrice,
-			})
-		}
-
-		a.keeper.storePrices(ctx, &prices)
+		a.keeper.countConflictingClaims(ctx, votes, claims, prices.List)
+		a.keeper.updatePrices(ctx, claim.Epoch, prices.List)
 	case *types.MsgHoldersClaim:
-		holdersTally := map[string]uint64{}
-		holdersVotes := map[string]*types.Holders{}
+		reported := map[string][]weightedValue{}
+		addresses := map[string]string{}
 
 		powers := a.keeper.GetNormalizedValPowers(ctx)
 		for _, valaddr := range att.GetVotes() {
 			validator, _ := sdk.ValAddressFromBech32(valaddr)
+			power := powers[valaddr]
+			if power == 0 {
+				continue
+			}
 
 			holdersClaim := a.keeper.GetHoldersClaim(ctx, sdk.AccAddress(validator).String(), claim.Epoch).(*types.GenericClaim).GetHoldersClaim()
-			hash := fmt.Sprintf("%x", holdersClaim.StabilizedClaimHash())
-			holdersVotes[hash] = holdersClaim.Holders
-			holdersTally[hash] = holdersTally[hash] + powers[valaddr]
-		}
-
-		// todo: should we iterate this in sorted way?
-		for hash, votes := range holdersTally {
-			if votes > math.MaxUint16*2/3 {
-				a.keeper.storeHolders(ctx, holdersVotes[hash])
-				return nil
+			for _, item := range holdersClaim.GetHolders().GetList() {
+				key := strings.ToLower(item.Address)
+				if _, ok := addresses[key]; !ok {
+					addresses[key] = item.Address
+				}
+				reported[key] = append(reported[key], weightedValue{value: item.Value.ToDec(), power: power})
 			}
 		}
 
+		a.keeper.storeHolders(ctx, &types.Holders{List: aggregateHolders(reported, addresses)})
+
 	default:
 		return sdkerrors.Wrapf(types.ErrInvalid, "event type: %s", claim.GetType())
 	}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+func TestEpochLength(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.OracleKeeper
+
+	require.True(t, k.IsEpochEnd(input.Context.WithBlockHeight(10)))
+	require.False(t, k.IsEpochEnd(input.Context.WithBlockHeight(7)))
+
+	input.OracleKeeper.paramSpace.Set(input.Context, types.ParamsStoreKeyEpochLength, uint64(7))
+	require.True(t, k.IsEpochEnd(input.Context.WithBlockHeight(7)))
+	require.False(t, k.IsEpochEnd(input.Context.WithBlockHeight(10)))
+
+	// an unset length falls back to the default
+	input.OracleKeeper.paramSpace.Set(input.Context, types.ParamsStoreKeyEpochLength, uint64(0))
+	require.Equal(t, uint64(types.DefaultEpochLength), k.GetEpochLength(input.Context))
+}
+
+func TestExpectedClaims(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	require.Equal(t, []types.ClaimType{types.CLAIM_TYPE_PRICE}, k.GetExpectedClaims(ctx, 3))
+
+	input.SetHoldersUpdatePeriod(3)
+	require.Equal(t, []types.ClaimType{types.CLAIM_TYPE_PRICE}, k.GetExpectedClaims(ctx, 1))
+	require.Equal(t, []types.ClaimType{types.CLAIM_TYPE_PRICE, types.CLAIM_TYPE_HOLDER}, k.GetExpectedClaims(ctx, 3))
+
+	res, err := k.CurrentEpoch(sdk.WrapSDKContext(ctx), &types.QueryCurrentEpochRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ClaimType{types.CLAIM_TYPE_PRICE}, res.Epoch.ExpectedClaims)
+}
+
+func TestHoldersClaimNotExpected(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+	msgServer := NewMsgServerImpl(k)
+	input.SetHoldersUpdatePeriod(2)
+
+	_, err := msgServer.HoldersClaim(sdk.WrapSDKContext(ctx), holdersClaim(input, sdk.AccAddress(ValAddrs[0])))
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	k.ProcessCurrentEpoch(ctx)
+	_, err = msgServer.HoldersClaim(sdk.WrapSDKContext(ctx), holdersClaim(input, sdk.AccAddress(ValAddrs[0])))
+	require.NoError(t, err)
+	k.ProcessCurrentEpoch(ctx)
+
+	// the holders claims are only missed in the epochs they are expected in
+	require.Equal(t, types.ValidatorOracleStats{
+		Validator:         ValAddrs[0].String(),
+		PriceEpochs:       2,
+		MissedPriceClaims: 2,
+		HoldersEpochs:     1,
+	}, k.GetValidatorOracleStats(ctx, ValAddrs[0]))
+	require.Equal(t, uint64(1), k.GetValidatorOracleStats(ctx, ValAddrs[1]).MissedHoldersClaims)
+}
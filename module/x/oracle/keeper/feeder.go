@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetFeeder delegates the submission of the oracle claims of the validator to the feeder, replacing the
+// previous feeder of the validator
+func (k Keeper) SetFeeder(ctx sdk.Context, validator sdk.ValAddress, feeder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if prev := k.GetFeeder(ctx, validator); prev != nil {
+		store.Delete(types.GetFeederValidatorKey(prev))
+	}
+
+	store.Set(types.GetFeederValidatorKey(feeder), validator.Bytes())
+	store.Set(types.GetValidatorFeederKey(validator), feeder.Bytes())
+}
+
+// GetFeeder returns the feeder delegated by the validator
+func (k Keeper) GetFeeder(ctx sdk.Context, validator sdk.ValAddress) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(types.GetValidatorFeederKey(validator))
+}
+
+// IterateFeeders iterates over the validators which delegated a feeder
+func (k Keeper) IterateFeeders(ctx sdk.Context, cb func(validator sdk.ValAddress, feeder sdk.AccAddress) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorFeederKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// the key is the length prefixed validator address
+		if cb(iter.Key()[1:], iter.Value()) {
+			break
+		}
+	}
+}
+
+// GetFeederValidator returns the validator the account submits the oracle claims for: the validator operated
+// by the account, the validator which delegated the account as its feeder or the validator of the mhub2
+// orchestrator key
+func (k Keeper) GetFeederValidator(ctx sdk.Context, feeder sdk.AccAddress) sdk.ValAddress {
+	if k.StakingKeeper.Validator(ctx, sdk.ValAddress(feeder)) != nil {
+		return sdk.ValAddress(feeder)
+	}
+
+	if validator := ctx.KVStore(k.storeKey).Get(types.GetFeederValidatorKey(feeder)); validator != nil {
+		return validator
+	}
+
+	if k.Mhub2keeper != nil {
+		if validator := k.Mhub2keeper.GetOrchestratorValidator(ctx, feeder); validator != nil {
+			return validator
+		}
+	}
+
+	return sdk.ValAddress(feeder)
+}
+
+// claimValidator returns the bonded validator the claimer submits the claims for
+func (k Keeper) claimValidator(ctx sdk.Context, claimer string) (sdk.ValAddress, error) {
+	feeder, err := sdk.AccAddressFromBech32(claimer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, claimer)
+	}
+
+	validator := k.GetFeederValidator(ctx, feeder)
+	sval := k.StakingKeeper.Validator(ctx, validator)
+	if sval == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
+	}
+	if !sval.IsBonded() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "validator %s is not bonded", validator)
+	}
+
+	return validator, nil
+}
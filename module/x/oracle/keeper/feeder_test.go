@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+// holdersClaim returns a holders claim of the current epoch signed by the account
+func holdersClaim(input TestInput, signer sdk.AccAddress) *types.MsgHoldersClaim {
+	return &types.MsgHoldersClaim{
+		Epoch:        input.OracleKeeper.GetCurrentEpoch(input.Context),
+		Holders:      &types.Holders{List: []*types.Holder{{Address: "aa01", Value: sdk.NewInt(1)}}},
+		Orchestrator: signer.String(),
+	}
+}
+
+func TestDelegatedFeederClaims(t *testing.T) {
+	input := CreateTestEnv(t)
+	input.SetHoldersUpdatePeriod(1)
+	ctx := sdk.WrapSDKContext(input.Context)
+	k := input.OracleKeeper
+	msgServer := NewMsgServerImpl(k)
+
+	feeder := sdk.AccAddress(bytes.Repeat([]byte{0xf}, 20))
+	_, err := msgServer.DelegateFeeder(ctx, types.NewMsgDelegateFeeder(ValAddrs[0], feeder))
+	require.NoError(t, err)
+
+	_, err = msgServer.HoldersClaim(ctx, holdersClaim(input, feeder))
+	require.NoError(t, err)
+
+	// the claim is stored on behalf of the validator
+	claim := holdersClaim(input, sdk.AccAddress(ValAddrs[0]))
+	require.True(t, k.HasClaim(input.Context, claim))
+	att := k.GetAttestation(input.Context, claim.Epoch, claim)
+	require.Equal(t, []string{ValAddrs[0].String()}, att.GetVotes())
+
+	// the validator key can not submit a second claim for the same epoch
+	_, err = msgServer.HoldersClaim(ctx, claim)
+	require.ErrorIs(t, err, types.ErrDuplicate)
+
+	res, err := k.Feeder(ctx, &types.QueryFeederRequest{Address: feeder.String()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryFeederResponse{ValidatorAddress: ValAddrs[0].String(), Bonded: true}, res)
+}
+
+func TestDelegateFeeder(t *testing.T) {
+	input := CreateTestEnv(t)
+	input.SetHoldersUpdatePeriod(1)
+	ctx := sdk.WrapSDKContext(input.Context)
+	k := input.OracleKeeper
+	msgServer := NewMsgServerImpl(k)
+
+	feeder := sdk.AccAddress(bytes.Repeat([]byte{0xf}, 20))
+	_, err := msgServer.DelegateFeeder(ctx, types.NewMsgDelegateFeeder(ValAddrs[0], feeder))
+	require.NoError(t, err)
+
+	// the feeder is already used by the first validator
+	_, err = msgServer.DelegateFeeder(ctx, types.NewMsgDelegateFeeder(ValAddrs[1], feeder))
+	require.ErrorIs(t, err, types.ErrDuplicate)
+
+	// the account of another validator can not become a feeder
+	_, err = msgServer.DelegateFeeder(ctx, types.NewMsgDelegateFeeder(ValAddrs[0], sdk.AccAddress(ValAddrs[1])))
+	require.ErrorIs(t, err, types.ErrDuplicate)
+
+	// replacing the feeder releases the previous one
+	newFeeder := sdk.AccAddress(bytes.Repeat([]byte{0xe}, 20))
+	_, err = msgServer.DelegateFeeder(ctx, types.NewMsgDelegateFeeder(ValAddrs[0], newFeeder))
+	require.NoError(t, err)
+	require.Equal(t, newFeeder, k.GetFeeder(input.Context, ValAddrs[0]))
+	require.Equal(t, ValAddrs[0], k.GetFeederValidator(input.Context, newFeeder))
+	require.Equal(t, sdk.ValAddress(feeder), k.GetFeederValidator(input.Context, feeder))
+
+	_, err = msgServer.HoldersClaim(ctx, holdersClaim(input, feeder))
+	require.ErrorIs(t, err, types.ErrUnknown)
+
+	_, err = msgServer.DelegateFeeder(ctx, types.NewMsgDelegateFeeder(ValAddrs[1], feeder))
+	require.NoError(t, err)
+}
+
+func TestOrchestratorFeeder(t *testing.T) {
+	input := CreateTestEnv(t)
+	input.SetHoldersUpdatePeriod(1)
+	ctx := sdk.WrapSDKContext(input.Context)
+	orchestrator := sdk.AccAddress(bytes.Repeat([]byte{0xa}, 20))
+	k := input.OracleKeeper.SetMhub2Keeper(Mhub2KeeperMock{Orchestrators: map[string]sdk.ValAddress{
+		orchestrator.String(): ValAddrs[2],
+	}})
+
+	_, err := NewMsgServerImpl(k).HoldersClaim(ctx, holdersClaim(input, orchestrator))
+	require.NoError(t, err)
+	require.True(t, k.HasClaim(input.Context, holdersClaim(input, sdk.AccAddress(ValAddrs[2]))))
+}
+
+func TestUnbondedFeederValidator(t *testing.T) {
+	input := CreateTestEnv(t)
+	input.SetHoldersUpdatePeriod(1)
+	ctx := sdk.WrapSDKContext(input.Context)
+	k := input.OracleKeeper
+
+	unbonded := sdk.ValAddress(bytes.Repeat([]byte{0x5}, 20))
+	input.StakingKeeper.BondedValidators = append(input.StakingKeeper.BondedValidators, stakingtypes.Validator{
+		OperatorAddress: unbonded.String(),
+		Status:          stakingtypes.Unbonded,
+	})
+
+	feeder := sdk.AccAddress(bytes.Repeat([]byte{0xf}, 20))
+	_, err := NewMsgServerImpl(k).DelegateFeeder(ctx, types.NewMsgDelegateFeeder(unbonded, feeder))
+	require.NoError(t, err)
+
+	_, err = NewMsgServerImpl(k).HoldersClaim(ctx, holdersClaim(input, feeder))
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	res, err := k.Feeder(ctx, &types.QueryFeederRequest{Address: feeder.String()})
+	require.NoError(t, err)
+	require.False(t, res.Bonded)
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetPriceFeeds returns the registered price feeds
+func (k Keeper) GetPriceFeeds(ctx sdk.Context) []types.PriceFeed {
+	var feeds []types.PriceFeed
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyPriceFeeds, &feeds)
+	return feeds
+}
+
+// GetRequiredPriceFeeds returns the feeds every price claim has to report: the registered feeds, the feeds
+// of the chain gas estimates and the prices of the bridged denoms. The feeds which are not registered are
+// aggregated with the default feed settings.
+func (k Keeper) GetRequiredPriceFeeds(ctx sdk.Context) []types.PriceFeed {
+	feeds := k.GetPriceFeeds(ctx)
+
+	registered := map[string]bool{}
+	for _, feed := range feeds {
+		registered[feed.Name] = true
+	}
+
+	var names []string
+	for _, estimate := range k.GetChainGasEstimates(ctx) {
+		names = append(names, estimate.GasPriceFeed, estimate.BaseCoinFeed)
+	}
+	for _, info := range k.Mhub2keeper.GetTokenInfos(ctx).GetTokenInfos() {
+		names = append(names, info.Denom)
+	}
+
+	for _, name := range names {
+		if registered[name] {
+			continue
+		}
+		registered[name] = true
+		feeds = append(feeds, defaultPriceFeed(name))
+	}
+
+	return feeds
+}
+
+// priceFeedsByName returns the registered price feeds by their names
+func (k Keeper) priceFeedsByName(ctx sdk.Context) map[string]types.PriceFeed {
+	byName := map[string]types.PriceFeed{}
+	for _, feed := range k.GetPriceFeeds(ctx) {
+		byName[feed.Name] = feed
+	}
+
+	return byName
+}
+
+// defaultPriceFeed returns the settings of a price which is not registered: full precision, the default max
+// age and the median aggregation
+func defaultPriceFeed(name string) types.PriceFeed {
+	return types.PriceFeed{Name: name, Decimals: sdk.Precision}
+}
+
+// GetChainGasEstimates returns the gas estimates of the external chains
+func (k Keeper) GetChainGasEstimates(ctx sdk.Context) []types.ChainGasEstimate {
+	var estimates []types.ChainGasEstimate
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyChainGasEstimates, &estimates)
+	return estimates
+}
+
+// GetChainGasEstimate returns the gas estimates of the external chain
+func (k Keeper) GetChainGasEstimate(ctx sdk.Context, chainId string) (types.ChainGasEstimate, error) {
+	for _, estimate := range k.GetChainGasEstimates(ctx) {
+		if estimate.ChainId == chainId {
+			return estimate, nil
+		}
+	}
+
+	return types.ChainGasEstimate{}, sdkerrors.Wrapf(types.ErrUnsupported, "fee of chain %s", chainId)
+}
+
+// GetBaseCoinFeed returns the feed of the price of the coin the fees of the external chain are paid in
+func (k Keeper) GetBaseCoinFeed(ctx sdk.Context, chainId string) (string, error) {
+	estimate, err := k.GetChainGasEstimate(ctx, chainId)
+	if err != nil {
+		return "", err
+	}
+
+	return estimate.BaseCoinFeed, nil
+}
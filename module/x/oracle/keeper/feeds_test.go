@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+func TestAggregatePricesFeeds(t *testing.T) {
+	reported := map[string][]weightedValue{
+		"eth":   {{sdk.NewDec(100), 1}, {sdk.NewDec(101), 1}, {sdk.NewDec(1000), 2}},
+		"gas":   {{sdk.NewDecWithPrec(1234567, 6), 1}},
+		"other": {{sdk.NewDec(1), 1}, {sdk.NewDec(2), 1}, {sdk.NewDec(3), 1}},
+	}
+	feeds := map[string]types.PriceFeed{
+		"eth": {Name: "eth", Decimals: 2, Aggregation: types.PRICE_AGGREGATION_MEAN},
+		"gas": {Name: "gas", Decimals: 3},
+	}
+
+	require.Equal(t, []*types.Price{
+		{Name: "eth", Value: sdk.NewDecWithPrec(55025, 2)},
+		{Name: "gas", Value: sdk.NewDecWithPrec(1234, 3)},
+		{Name: "other", Value: sdk.NewDec(2)},
+	}, aggregatePrices(reported, feeds))
+}
+
+func TestPriceFeedMaxAge(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	params := k.GetParams(ctx)
+	params.PriceFeeds[0].MaxAge = 10
+	k.SetParams(ctx, params)
+
+	k.updatePrices(ctx, 1, []*types.Price{{Name: "eth", Value: sdk.NewDec(100)}, {Name: "bnb", Value: sdk.NewDec(10)}})
+
+	_, err := k.GetTokenPrice(ctx.WithBlockHeight(ctx.BlockHeight()+11), params.PriceFeeds[0].Name)
+	require.ErrorIs(t, err, types.ErrOutdated)
+
+	// the other feeds fall back to the price max age
+	_, err = k.GetTokenPrice(ctx.WithBlockHeight(ctx.BlockHeight()+11), "bnb")
+	require.NoError(t, err)
+}
+
+func TestPriceClaimRequiredFeeds(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	params := k.GetParams(ctx)
+	params.PriceFeeds = append(params.PriceFeeds, types.PriceFeed{Name: "polygon/gas", Decimals: 9})
+	k.SetParams(ctx, params)
+
+	prices := &types.Prices{}
+	for _, feed := range k.GetRequiredPriceFeeds(ctx) {
+		if feed.Name != "polygon/gas" {
+			prices.List = append(prices.List, &types.Price{Name: feed.Name, Value: sdk.NewDec(1)})
+		}
+	}
+
+	msg := &types.MsgPriceClaim{Epoch: 1, Prices: prices, Orchestrator: sdk.AccAddress(ValAddrs[0]).String()}
+	_, err := NewMsgServerImpl(k).PriceClaim(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "polygon/gas")
+
+	prices.List = append(prices.List, &types.Price{Name: "polygon/gas", Value: sdk.NewDec(30)})
+	_, err = NewMsgServerImpl(k).PriceClaim(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+}
+
+func TestRequiredPriceFeedsOfGasEstimates(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	params := k.GetParams(ctx)
+	params.ChainGasEstimates = append(params.ChainGasEstimates, types.ChainGasEstimate{
+		ChainId:      "polygon",
+		GasPriceFeed: "polygon/gas",
+		BaseCoinFeed: "eth",
+		MinGas:       1,
+		FastGas:      1,
+	})
+	k.SetParams(ctx, params)
+
+	var found []types.PriceFeed
+	for _, feed := range k.GetRequiredPriceFeeds(ctx) {
+		if feed.Name == "polygon/gas" || feed.Name == "eth" {
+			found = append(found, feed)
+		}
+	}
+	require.Equal(t, []types.PriceFeed{params.PriceFeeds[0], {Name: "polygon/gas", Decimals: sdk.Precision}}, found)
+}
+
+func TestChainFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	k.updatePrices(ctx, 1, []*types.Price{{Name: "eth", Value: sdk.NewDec(2000)}, {Name: "ethereum/gas", Value: sdk.NewDec(100)}})
+
+	res, err := k.ChainFee(sdk.WrapSDKContext(ctx), &types.QueryChainFeeRequest{ChainId: "ethereum"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryChainFeeResponse{Min: sdk.NewDec(30), Fast: sdk.NewDec(60), Batch: sdk.NewDec(700)}, res)
+
+	_, err = k.ChainFee(sdk.WrapSDKContext(ctx), &types.QueryChainFeeRequest{ChainId: "polygon"})
+	require.ErrorIs(t, err, types.ErrUnsupported)
+}
+
+func TestBaseCoinFeed(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	feed, err := k.GetBaseCoinFeed(ctx, "bsc")
+	require.NoError(t, err)
+	require.Equal(t, "bnb", feed)
+
+	_, err = k.GetBaseCoinFeed(ctx, "polygon")
+	require.ErrorIs(t, err, types.ErrUnsupported)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"math"
+
 	"github.com/MinterTeam/mhub2/module/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -8,15 +10,59 @@ import (
 // InitGenesis starts a chain from a genesis state
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetParams(ctx, *data.Params)
-	k.setCurrentEpoch(ctx, 1)
+
+	epoch := data.Epoch
+	if epoch == 0 {
+		epoch = 1
+	}
+	k.setCurrentEpoch(ctx, epoch)
 
 	if data.Prices != nil {
 		k.storePrices(ctx, data.Prices)
 	}
 
+	if data.PendingPrices != nil {
+		k.storePendingPrices(ctx, data.PendingPrices)
+	}
+
 	if data.Holders != nil {
 		k.storeHolders(ctx, data.Holders)
 	}
+
+	for i := range data.PriceHistory {
+		k.setPriceSnapshot(ctx, &data.PriceHistory[i])
+	}
+
+	for _, stats := range data.ValidatorOracleStats {
+		validator, err := sdk.ValAddressFromBech32(stats.Validator)
+		if err != nil {
+			panic(err)
+		}
+		k.setValidatorOracleStats(ctx, validator, stats)
+	}
+
+	for _, delegation := range data.Feeders {
+		validator, err := sdk.ValAddressFromBech32(delegation.Validator)
+		if err != nil {
+			panic(err)
+		}
+		feeder, err := sdk.AccAddressFromBech32(delegation.Feeder)
+		if err != nil {
+			panic(err)
+		}
+		k.SetFeeder(ctx, validator, feeder)
+	}
+
+	// the claims and attestations of the epochs which were not processed before the export
+	for i := range data.Claims {
+		if err := k.storeClaim(ctx, data.Claims[i].UnpackClaim()); err != nil {
+			panic(err)
+		}
+	}
+
+	for i := range data.Attestations {
+		k.SetAttestationUnsafe(ctx, &data.Attestations[i])
+	}
 }
 
 // ExportGenesis exports all the state needed to restart the chain
@@ -24,21 +70,51 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	var (
 		p            = k.GetParams(ctx)
-		attmap       = k.GetAttestationMapping(ctx)
-		prices       = k.GetPrices(ctx)
-		holders      = k.GetHolders(ctx)
 		attestations = []types.Attestation{}
+		claims       = []types.GenericClaim{}
+		history      = []types.PriceSnapshot{}
+		stats        = []types.ValidatorOracleStats{}
+		feeders      = []types.FeederDelegation{}
 	)
 
-	// export attestations from state
-	for _, atts := range attmap {
-		// TODO: set height = 0?
-		attestations = append(attestations, atts...)
-	}
+	k.IterateAttestaions(ctx, func(_ []byte, att types.Attestation) bool {
+		attestations = append(attestations, att)
+		return false
+	})
+
+	k.IterateClaims(ctx, func(claim types.GenericClaim) bool {
+		claims = append(claims, claim)
+		return false
+	})
+
+	k.IteratePriceSnapshots(ctx, 0, math.MaxUint64, func(snapshot *types.PriceSnapshot) bool {
+		history = append(history, *snapshot)
+		return false
+	})
+
+	k.IterateValidatorOracleStats(ctx, func(s types.ValidatorOracleStats) bool {
+		stats = append(stats, s)
+		return false
+	})
+
+	k.IterateFeeders(ctx, func(validator sdk.ValAddress, feeder sdk.AccAddress) bool {
+		feeders = append(feeders, types.FeederDelegation{
+			Validator: validator.String(),
+			Feeder:    feeder.String(),
+		})
+		return false
+	})
 
 	return types.GenesisState{
-		Params:  &p,
-		Prices:  prices,
-		Holders: holders,
+		Params:               &p,
+		Prices:               k.GetPrices(ctx),
+		Holders:              k.GetHolders(ctx),
+		Epoch:                k.GetCurrentEpoch(ctx),
+		Attestations:         attestations,
+		Claims:               claims,
+		PendingPrices:        k.getPendingPrices(ctx),
+		PriceHistory:         history,
+		ValidatorOracleStats: stats,
+		Feeders:              feeders,
 	}
 }
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	// the second epoch leaves the deviating price pending
+	claimPrices(t, input, map[int]int64{0: 100, 1: 100, 2: 100, 3: 100})
+	k.ProcessCurrentEpoch(ctx)
+	claimPrices(t, input, map[int]int64{0: 200, 1: 200, 2: 200, 3: 200})
+	k.ProcessCurrentEpoch(ctx)
+
+	feeder := sdk.AccAddress(bytes.Repeat([]byte{0xf}, 20))
+	k.SetFeeder(ctx, ValAddrs[0], feeder)
+
+	// the claims of the current epoch are not processed yet
+	claimPrices(t, input, map[int]int64{0: 100, 1: 100})
+	_, err := k.AddClaim(ctx, holdersClaim(input, sdk.AccAddress(ValAddrs[2])))
+	require.NoError(t, err)
+
+	exported := ExportGenesis(ctx, k)
+	require.NoError(t, exported.ValidateBasic())
+	require.Equal(t, uint64(3), exported.Epoch)
+	require.Len(t, exported.Attestations, 2)
+	require.Len(t, exported.Claims, 3)
+	require.Len(t, exported.PendingPrices.GetList(), 1)
+	require.Len(t, exported.PriceHistory, 2)
+	require.Len(t, exported.ValidatorOracleStats, len(ValAddrs))
+	require.Equal(t, []types.FeederDelegation{{Validator: ValAddrs[0].String(), Feeder: feeder.String()}}, exported.Feeders)
+
+	imported := CreateTestEnv(t)
+	InitGenesis(imported.Context, imported.OracleKeeper, exported)
+	require.Equal(t, exported, ExportGenesis(imported.Context, imported.OracleKeeper))
+
+	// the imported chain continues the epoch with the claims submitted before the export
+	require.ErrorIs(t, func() error {
+		_, err := NewMsgServerImpl(imported.OracleKeeper).PriceClaim(sdk.WrapSDKContext(imported.Context), &types.MsgPriceClaim{
+			Epoch:        3,
+			Prices:       &types.Prices{List: []*types.Price{{Name: "eth", Value: sdk.NewDec(100)}}},
+			Orchestrator: feeder.String(),
+		})
+		return err
+	}(), types.ErrDuplicate)
+
+	claimPrices(t, imported, map[int]int64{2: 100, 3: 100})
+	imported.OracleKeeper.ProcessCurrentEpoch(imported.Context)
+
+	require.Equal(t, uint64(4), imported.OracleKeeper.GetCurrentEpoch(imported.Context))
+	price, err := imported.OracleKeeper.GetTokenPrice(imported.Context, "eth")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(100), price)
+	require.Empty(t, ExportGenesis(imported.Context, imported.OracleKeeper).Claims)
+}
@@ -4,18 +4,68 @@ import (
 	"context"
 
 	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
-const gweiInEth = 1e9
-
 var _ types.QueryServer = Keeper{}
 
-func (k Keeper) Holders(context context.Context, _ *types.QueryHoldersRequest) (*types.QueryHoldersResponse, error) {
+func (k Keeper) Holders(context context.Context, req *types.QueryHoldersRequest) (*types.QueryHoldersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(context)
+	res := &types.QueryHoldersResponse{Holders: &types.Holders{}}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.HolderKey)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var holder types.Holder
+		k.cdc.MustUnmarshal(value, &holder)
+		res.Holders.List = append(res.Holders.List, &holder)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) Holder(context context.Context, req *types.QueryHolderRequest) (*types.QueryHolderResponse, error) {
+	holder := k.GetHolder(sdk.UnwrapSDKContext(context), req.Address)
+	if holder == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "holder %s", req.Address)
+	}
+
+	return &types.QueryHolderResponse{Holder: holder}, nil
+}
+
+func (k Keeper) ValidatorOracleStats(context context.Context, req *types.QueryValidatorOracleStatsRequest) (*types.QueryValidatorOracleStatsResponse, error) {
+	validator, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.ValidatorAddress)
+	}
+
+	stats := k.GetValidatorOracleStats(sdk.UnwrapSDKContext(context), validator)
 
-	return &types.QueryHoldersResponse{Holders: k.GetHolders(ctx)}, nil
+	return &types.QueryValidatorOracleStatsResponse{Stats: &stats}, nil
+}
+
+func (k Keeper) Feeder(context context.Context, req *types.QueryFeederRequest) (*types.QueryFeederResponse, error) {
+	ctx := sdk.UnwrapSDKContext(context)
+
+	feeder, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.Address)
+	}
+
+	validator := k.GetFeederValidator(ctx, feeder)
+	sval := k.StakingKeeper.Validator(ctx, validator)
+	if sval == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "validator of feeder %s", feeder)
+	}
+
+	return &types.QueryFeederResponse{ValidatorAddress: validator.String(), Bonded: sval.IsBonded()}, nil
 }
 
 func (k Keeper) Prices(context context.Context, _ *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
@@ -24,12 +74,31 @@ func (k Keeper) Prices(context context.Context, _ *types.QueryPricesRequest) (*t
 	return &types.QueryPricesResponse{Prices: k.GetPrices(ctx)}, nil
 }
 
+func (k Keeper) PriceHistory(context context.Context, req *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(context)
+
+	if req.Name == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty price name")
+	}
+
+	to := req.ToEpoch
+	if to == 0 {
+		to = k.GetCurrentEpoch(ctx)
+	}
+	if req.FromEpoch > to {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "from epoch %d is after to epoch %d", req.FromEpoch, to)
+	}
+
+	return &types.QueryPriceHistoryResponse{Prices: k.GetPriceHistory(ctx, req.Name, req.FromEpoch, to)}, nil
+}
+
 func (k Keeper) CurrentEpoch(context context.Context, _ *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
 	ctx := sdk.UnwrapSDKContext(context)
 
 	currentEpoch := types.Epoch{
 		Nonce: k.GetCurrentEpoch(ctx),
 	}
+	currentEpoch.ExpectedClaims = k.GetExpectedClaims(ctx, currentEpoch.Nonce)
 
 	att := k.GetAttestation(ctx, currentEpoch.Nonce, &types.MsgPriceClaim{})
 	votes := att.GetVotes()
@@ -59,40 +128,22 @@ func (k Keeper) CurrentEpoch(context context.Context, _ *types.QueryCurrentEpoch
 	return &types.QueryCurrentEpochResponse{Epoch: &currentEpoch}, nil
 }
 
-func (k Keeper) EthFee(context context.Context, _ *types.QueryEthFeeRequest) (*types.QueryEthFeeResponse, error) {
+func (k Keeper) ChainFee(context context.Context, req *types.QueryChainFeeRequest) (*types.QueryChainFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(context)
 
-	gasPrice, err := k.GetTokenPrice(ctx, "ethereum/gas")
+	min, fast, err := k.GetExternalFee(ctx, req.ChainId)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "gas price")
+		return nil, err
 	}
 
-	ethPrice, err := k.GetTokenPrice(ctx, "eth")
+	batch, err := k.GetBatchFee(ctx, req.ChainId)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "eth price")
+		return nil, err
 	}
 
-	return &types.QueryEthFeeResponse{
-		Min:  gasPrice.Mul(ethPrice).MulInt64(150000).QuoInt64(gweiInEth),
-		Fast: gasPrice.Mul(ethPrice).MulInt64(300000).QuoInt64(gweiInEth),
-	}, nil
+	return &types.QueryChainFeeResponse{Min: min, Fast: fast, Batch: batch}, nil
 }
 
-func (k Keeper) BscFee(context context.Context, _ *types.QueryBscFeeRequest) (*types.QueryBscFeeResponse, error) {
-	ctx := sdk.UnwrapSDKContext(context)
-
-	gasPrice, err := k.GetTokenPrice(ctx, "bsc/gas")
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "gas price")
-	}
-
-	bnbPrice, err := k.GetTokenPrice(ctx, "bnb")
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "bnb price")
-	}
-
-	return &types.QueryBscFeeResponse{
-		Min:  gasPrice.Mul(bnbPrice).MulInt64(100000).QuoInt64(gweiInEth),
-		Fast: gasPrice.Mul(bnbPrice).MulInt64(200000).QuoInt64(gweiInEth),
-	}, nil
+func (k Keeper) PriceFeeds(context context.Context, _ *types.QueryPriceFeedsRequest) (*types.QueryPriceFeedsResponse, error) {
+	return &types.QueryPriceFeedsResponse{Feeds: k.GetRequiredPriceFeeds(sdk.UnwrapSDKContext(context))}, nil
 }
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPriceHistoryLength returns the number of the latest epochs the prices are kept in the history for
+func (k Keeper) GetPriceHistoryLength(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyPriceHistoryLength, &a)
+	return a
+}
+
+// storePriceSnapshot records the prices after the update of the epoch and prunes the snapshots of the epochs
+// which fell out of the history, so the history works as a ring buffer of PriceHistoryLength epochs
+func (k Keeper) storePriceSnapshot(ctx sdk.Context, epoch uint64, prices *types.Prices) {
+	store := ctx.KVStore(k.storeKey)
+
+	length := k.GetPriceHistoryLength(ctx)
+	if length != 0 {
+		k.setPriceSnapshot(ctx, &types.PriceSnapshot{
+			Epoch:  epoch,
+			Height: ctx.BlockHeight(),
+			Prices: prices.GetList(),
+		})
+	}
+
+	// the history keeps the epochs in (epoch-length, epoch]
+	oldest := uint64(0)
+	if epoch >= length {
+		oldest = epoch - length + 1
+	}
+
+	var keys [][]byte
+	iter := prefix.NewStore(store, types.PriceHistoryKey).Iterator(nil, types.UInt64Bytes(oldest))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	prefixStore := prefix.NewStore(store, types.PriceHistoryKey)
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+func (k Keeper) setPriceSnapshot(ctx sdk.Context, snapshot *types.PriceSnapshot) {
+	ctx.KVStore(k.storeKey).Set(types.GetPriceHistoryKey(snapshot.Epoch), k.cdc.MustMarshal(snapshot))
+}
+
+// IteratePriceSnapshots iterates over the price snapshots of the inclusive range of epochs in ascending order
+func (k Keeper) IteratePriceSnapshots(ctx sdk.Context, from, to uint64, cb func(snapshot *types.PriceSnapshot) (stop bool)) {
+	if from > to {
+		return
+	}
+
+	var end []byte
+	if to != math.MaxUint64 {
+		end = types.UInt64Bytes(to + 1)
+	}
+
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceHistoryKey).Iterator(types.UInt64Bytes(from), end)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.PriceSnapshot
+		k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+		if cb(&snapshot) {
+			break
+		}
+	}
+}
+
+// GetPriceHistory returns the values of the price after the updates of the inclusive range of epochs. The
+// epochs without an update or without the price are skipped.
+func (k Keeper) GetPriceHistory(ctx sdk.Context, name string, from, to uint64) []types.HistoricalPrice {
+	var history []types.HistoricalPrice
+	k.IteratePriceSnapshots(ctx, from, to, func(snapshot *types.PriceSnapshot) bool {
+		for _, price := range snapshot.Prices {
+			if price.Name == name {
+				history = append(history, types.HistoricalPrice{Epoch: snapshot.Epoch, Height: snapshot.Height, Value: price.Value})
+				break
+			}
+		}
+		return false
+	})
+
+	return history
+}
+
+// TWAP returns the average of the price over the last window epochs, each value weighted by the number of
+// blocks it was the current price for. It fails as GetTokenPrice does when the current price is missing or
+// outdated, and falls back to the current price when the history has no values in the window.
+func (k Keeper) TWAP(ctx sdk.Context, name string, window uint64) (sdk.Dec, error) {
+	spot, err := k.GetTokenPrice(ctx, name)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	current := k.GetCurrentEpoch(ctx)
+	from := uint64(0)
+	if current > window {
+		from = current - window
+	}
+
+	history := k.GetPriceHistory(ctx, name, from, current)
+
+	sum := sdk.ZeroDec()
+	var total int64
+	for i, point := range history {
+		// the last value is current up to and including this block
+		end := ctx.BlockHeight() + 1
+		if i+1 < len(history) {
+			end = history[i+1].Height
+		}
+
+		blocks := end - point.Height
+		if blocks <= 0 {
+			continue
+		}
+
+		sum = sum.Add(point.Value.MulInt64(blocks))
+		total += blocks
+	}
+
+	if total == 0 {
+		return spot, nil
+	}
+
+	return sum.QuoInt64(total), nil
+}
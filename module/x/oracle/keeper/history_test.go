@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+// updateEthPrice applies the eth price of the epoch at the height and moves to the next epoch
+func updateEthPrice(ctx sdk.Context, k Keeper, epoch uint64, height int64, value int64) {
+	k.updatePrices(ctx.WithBlockHeight(height), epoch, []*types.Price{{Name: "eth", Value: sdk.NewDec(value)}})
+	k.setCurrentEpoch(ctx, epoch+1)
+}
+
+func TestPriceHistoryRingBuffer(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	for epoch := uint64(1); epoch <= 15; epoch++ {
+		updateEthPrice(ctx, k, epoch, int64(epoch*5), int64(100+epoch))
+	}
+
+	// the history keeps the last PriceHistoryLength epochs
+	history := k.GetPriceHistory(ctx, "eth", 0, 100)
+	require.Len(t, history, int(TestingOracleParams.PriceHistoryLength))
+	require.Equal(t, types.HistoricalPrice{Epoch: 6, Height: 30, Value: sdk.NewDec(106)}, history[0])
+	require.Equal(t, types.HistoricalPrice{Epoch: 15, Height: 75, Value: sdk.NewDec(115)}, history[9])
+
+	res, err := k.PriceHistory(sdk.WrapSDKContext(ctx), &types.QueryPriceHistoryRequest{Name: "eth", FromEpoch: 13})
+	require.NoError(t, err)
+	require.Equal(t, []types.HistoricalPrice{
+		{Epoch: 13, Height: 65, Value: sdk.NewDec(113)},
+		{Epoch: 14, Height: 70, Value: sdk.NewDec(114)},
+		{Epoch: 15, Height: 75, Value: sdk.NewDec(115)},
+	}, res.Prices)
+
+	_, err = k.PriceHistory(sdk.WrapSDKContext(ctx), &types.QueryPriceHistoryRequest{Name: "eth", FromEpoch: 13, ToEpoch: 12})
+	require.Error(t, err)
+
+	// shrinking the history prunes the older epochs with the next update
+	params := k.GetParams(ctx)
+	params.PriceHistoryLength = 2
+	k.SetParams(ctx, params)
+	updateEthPrice(ctx, k, 16, 80, 116)
+	require.Len(t, k.GetPriceHistory(ctx, "eth", 0, 100), 2)
+
+	params.PriceHistoryLength = 0
+	k.SetParams(ctx, params)
+	updateEthPrice(ctx, k, 17, 85, 117)
+	require.Empty(t, k.GetPriceHistory(ctx, "eth", 0, 100))
+}
+
+func TestTWAP(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.OracleKeeper
+	ctx := input.Context.WithBlockHeight(20)
+
+	_, err := k.TWAP(ctx, "eth", 5)
+	require.Error(t, err)
+
+	updateEthPrice(ctx, k, 1, 5, 100)
+	updateEthPrice(ctx, k, 2, 10, 140)
+	// the epoch without an update keeps the price of the previous one
+	updateEthPrice(ctx, k, 4, 18, 180)
+
+	// 100 for blocks 5-9, 140 for blocks 10-17 and 180 for blocks 18-20
+	twap, err := k.TWAP(ctx, "eth", 10)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(100*5+140*8+180*3).QuoInt64(16), twap)
+
+	// the window starts with the update of the second epoch
+	twap, err = k.TWAP(ctx, "eth", 3)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(140*8+180*3).QuoInt64(11), twap)
+
+	// the spot price is used when the window has no history
+	params := k.GetParams(ctx)
+	params.PriceHistoryLength = 0
+	k.SetParams(ctx, params)
+	updateEthPrice(ctx, k, 5, 20, 200)
+	twap, err = k.TWAP(ctx, "eth", 3)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(200), twap)
+
+	// an outdated price has no average
+	_, err = k.TWAP(ctx.WithBlockHeight(20+int64(TestingOracleParams.PriceMaxAge)+1), "eth", 3)
+	require.ErrorIs(t, err, types.ErrOutdated)
+}
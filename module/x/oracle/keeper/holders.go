@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetHolders returns all the holders ordered by their lowercase address
+func (k Keeper) GetHolders(ctx sdk.Context) *types.Holders {
+	var holders types.Holders
+	k.IterateHolders(ctx, func(holder *types.Holder) bool {
+		holders.List = append(holders.List, holder)
+		return false
+	})
+
+	if len(holders.List) == 0 {
+		return nil
+	}
+
+	return &holders
+}
+
+// GetHolder returns the holder with the address, the address is case-insensitive
+func (k Keeper) GetHolder(ctx sdk.Context, address string) *types.Holder {
+	bytes := ctx.KVStore(k.storeKey).Get(types.GetHolderKey(address))
+	if len(bytes) == 0 {
+		return nil
+	}
+
+	var holder types.Holder
+	k.cdc.MustUnmarshal(bytes, &holder)
+
+	return &holder
+}
+
+func (k Keeper) GetHolderValue(ctx sdk.Context, address string) sdk.Int {
+	holder := k.GetHolder(ctx, address)
+	if holder == nil {
+		return sdk.NewInt(0)
+	}
+
+	return holder.Value
+}
+
+// IterateHolders iterates over the holders ordered by their lowercase address
+func (k Keeper) IterateHolders(ctx sdk.Context, cb func(holder *types.Holder) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.HolderKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var holder types.Holder
+		k.cdc.MustUnmarshal(iter.Value(), &holder)
+		if cb(&holder) {
+			break
+		}
+	}
+}
+
+// storeHolders replaces all the holders with the given ones
+func (k Keeper) storeHolders(ctx sdk.Context, holders *types.Holders) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CurrentHoldersKey)
+
+	var keys [][]byte
+	iter := prefix.NewStore(store, types.HolderKey).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	prefixStore := prefix.NewStore(store, types.HolderKey)
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+
+	for _, holder := range holders.GetList() {
+		store.Set(types.GetHolderKey(holder.Address), k.cdc.MustMarshal(holder))
+	}
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+func TestHoldersClaimAggregation(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	reported := [][]*types.Holder{
+		{{Address: "Aa01", Value: sdk.NewInt(100)}, {Address: "bb02", Value: sdk.NewInt(5)}, {Address: "cc03", Value: sdk.NewInt(7)}},
+		{{Address: "aa01", Value: sdk.NewInt(101)}, {Address: "bb02", Value: sdk.NewInt(5)}},
+		{{Address: "aa01", Value: sdk.NewInt(102)}, {Address: "bb02", Value: sdk.NewInt(6)}},
+		{{Address: "aa01", Value: sdk.NewInt(900)}, {Address: "cc03", Value: sdk.NewInt(7)}},
+	}
+
+	att := types.Attestation{Epoch: 1}
+	var claim *types.MsgHoldersClaim
+	for i, holders := range reported {
+		claim = &types.MsgHoldersClaim{
+			Epoch:        1,
+			Holders:      &types.Holders{List: holders},
+			Orchestrator: sdk.AccAddress(ValAddrs[i]).String(),
+		}
+		require.NoError(t, k.storeClaim(ctx, claim))
+		att.Votes = append(att.Votes, ValAddrs[i].String())
+	}
+
+	require.NoError(t, k.AttestationHandler.Handle(ctx, att, claim))
+
+	// cc03 is reported by a half of the power only
+	require.Equal(t, &types.Holders{List: []*types.Holder{
+		{Address: "Aa01", Value: sdk.NewInt(101)},
+		{Address: "bb02", Value: sdk.NewInt(5)},
+	}}, k.GetHolders(ctx))
+
+	require.Equal(t, sdk.NewInt(101), k.GetHolderValue(ctx, "AA01"))
+	require.Equal(t, sdk.NewInt(0), k.GetHolderValue(ctx, "cc03"))
+}
+
+func TestStoreHolders(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	ctx.KVStore(k.storeKey).Set(types.CurrentHoldersKey, k.cdc.MustMarshal(&types.Holders{}))
+
+	k.storeHolders(ctx, &types.Holders{List: []*types.Holder{
+		{Address: "aa01", Value: sdk.NewInt(1)},
+		{Address: "bb02", Value: sdk.NewInt(2)},
+		{Address: "cc03", Value: sdk.NewInt(3)},
+	}})
+	require.False(t, ctx.KVStore(k.storeKey).Has(types.CurrentHoldersKey))
+
+	res, err := k.Holders(sdk.WrapSDKContext(ctx), &types.QueryHoldersRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Holders.List, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = k.Holders(sdk.WrapSDKContext(ctx), &types.QueryHoldersRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, []*types.Holder{{Address: "cc03", Value: sdk.NewInt(3)}}, res.Holders.List)
+
+	// the holders missing in the update are removed
+	k.storeHolders(ctx, &types.Holders{List: []*types.Holder{{Address: "bb02", Value: sdk.NewInt(4)}}})
+
+	holder, err := k.Holder(sdk.WrapSDKContext(ctx), &types.QueryHolderRequest{Address: "BB02"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(4), holder.Holder.Value)
+
+	_, err = k.Holder(sdk.WrapSDKContext(ctx), &types.QueryHolderRequest{Address: "aa01"})
+	require.Error(t, err)
+}
+
+func TestAggregateHoldersThreshold(t *testing.T) {
+	// exactly 2/3 of the normalized power is not enough
+	reported := map[string][]weightedValue{
+		"aa01": {{sdk.NewDec(1), 43690}},
+		"bb02": {{sdk.NewDec(2), 43691}},
+	}
+	addresses := map[string]string{"aa01": "aa01", "bb02": "bb02"}
+
+	require.Equal(t, []*types.Holder{{Address: "bb02", Value: sdk.NewInt(2)}}, aggregateHolders(reported, addresses))
+}
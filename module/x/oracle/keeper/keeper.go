@@ -3,7 +3,6 @@ package keeper
 import (
 	"fmt"
 	"math"
-	"strings"
 
 	"github.com/MinterTeam/mhub2/module/x/oracle/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,6 +12,8 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 )
 
+const gweiInEth = 1e9
+
 // Keeper maintains the link to storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
 	StakingKeeper types.StakingKeeper
@@ -56,61 +57,92 @@ func (k Keeper) SetMhub2Keeper(keeper types.Mhub2Keeper) Keeper {
 	return k
 }
 
-func (k Keeper) GetHolders(ctx sdk.Context) *types.Holders {
+func (k Keeper) GetPrices(ctx sdk.Context) *types.Prices {
 	store := ctx.KVStore(k.storeKey)
-	bytes := store.Get(types.CurrentHoldersKey)
+	bytes := store.Get(types.CurrentPricesKey)
 
 	if len(bytes) == 0 {
 		return nil
 	}
 
-	var holders types.Holders
-	k.cdc.MustUnmarshal(bytes, &holders)
+	var prices types.Prices
+	k.cdc.MustUnmarshal(bytes, &prices)
 
-	return &holders
+	return &prices
 }
 
-func (k Keeper) GetPrices(ctx sdk.Context) *types.Prices {
+// GetTokenPrice returns the current price of the token. Prices which have not been updated for more than
+// the max age of their feed, PriceMaxAge by default, are reported as outdated.
+func (k Keeper) GetTokenPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
 	store := ctx.KVStore(k.storeKey)
 	bytes := store.Get(types.CurrentPricesKey)
 
 	if len(bytes) == 0 {
-		return nil
+		return sdk.Dec{}, sdkerrors.ErrKeyNotFound
 	}
 
 	var prices types.Prices
 	k.cdc.MustUnmarshal(bytes, &prices)
 
-	return &prices
+	for _, price := range prices.GetList() {
+		if price.GetName() != denom {
+			continue
+		}
+
+		maxAge := k.GetPriceMaxAge(ctx)
+		if feed, ok := k.priceFeedsByName(ctx)[denom]; ok && feed.MaxAge != 0 {
+			maxAge = feed.MaxAge
+		}
+
+		if maxAge != 0 && ctx.BlockHeight()-price.Height > int64(maxAge) {
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrOutdated, "price of %s was updated at height %d", denom, price.Height)
+		}
+
+		return price.Value, nil
+	}
+
+	return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "price of %s", denom)
 }
 
-func (k Keeper) MustGetTokenPrice(ctx sdk.Context, denom string) sdk.Dec {
-	price, err := k.GetTokenPrice(ctx, denom)
+// GetExternalFee returns the min and fast fees of a transfer to the external chain in usd, derived from
+// the gas price and the price of the base coin of the chain
+func (k Keeper) GetExternalFee(ctx sdk.Context, chainId string) (min sdk.Dec, fast sdk.Dec, err error) {
+	estimate, gasCost, err := k.getGasCost(ctx, chainId)
 	if err != nil {
-		panic(err)
+		return sdk.Dec{}, sdk.Dec{}, err
 	}
 
-	return price
+	return gasCost.MulInt64(int64(estimate.MinGas)).QuoInt64(gweiInEth), gasCost.MulInt64(int64(estimate.FastGas)).QuoInt64(gweiInEth), nil
 }
 
-func (k Keeper) GetTokenPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
-	store := ctx.KVStore(k.storeKey)
-	bytes := store.Get(types.CurrentPricesKey)
+// GetBatchFee returns the estimated cost of the execution of a full batch on the external chain in usd
+func (k Keeper) GetBatchFee(ctx sdk.Context, chainId string) (sdk.Dec, error) {
+	estimate, gasCost, err := k.getGasCost(ctx, chainId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
 
-	if len(bytes) == 0 {
-		return sdk.Dec{}, sdkerrors.ErrKeyNotFound
+	return gasCost.MulInt64(int64(estimate.BatchGas)).QuoInt64(gweiInEth), nil
+}
+
+// getGasCost returns the gas estimates of the external chain and the cost of a billion units of its gas in usd
+func (k Keeper) getGasCost(ctx sdk.Context, chainId string) (types.ChainGasEstimate, sdk.Dec, error) {
+	estimate, err := k.GetChainGasEstimate(ctx, chainId)
+	if err != nil {
+		return types.ChainGasEstimate{}, sdk.Dec{}, err
 	}
 
-	var prices types.Prices
-	k.cdc.MustUnmarshal(bytes, &prices)
+	gasPrice, err := k.GetTokenPrice(ctx, estimate.GasPriceFeed)
+	if err != nil {
+		return types.ChainGasEstimate{}, sdk.Dec{}, sdkerrors.Wrap(err, "gas price")
+	}
 
-	for _, price := range prices.GetList() {
-		if price.GetName() == denom {
-			return price.Value, nil
-		}
+	basePrice, err := k.GetTokenPrice(ctx, estimate.BaseCoinFeed)
+	if err != nil {
+		return types.ChainGasEstimate{}, sdk.Dec{}, sdkerrors.Wrapf(err, "%s price", estimate.BaseCoinFeed)
 	}
 
-	return sdk.Dec{}, sdkerrors.ErrKeyNotFound
+	return estimate, gasPrice.Mul(basePrice), nil
 }
 
 func (k Keeper) GetCurrentEpoch(ctx sdk.Context) uint64 {
@@ -143,14 +175,69 @@ func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
-func (k Keeper) GetHolderValue(ctx sdk.Context, address string) sdk.Int {
-	for _, item := range k.GetHolders(ctx).GetList() {
-		if strings.ToLower(item.GetAddress()) == strings.ToLower(address) {
-			return item.Value
+// GetPriceMaxAge returns the number of blocks after which a price which has not been updated is outdated
+func (k Keeper) GetPriceMaxAge(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyPriceMaxAge, &a)
+	return a
+}
+
+// GetMaxPriceDeviation returns the relative change of a price in a single epoch above which the price is held
+func (k Keeper) GetMaxPriceDeviation(ctx sdk.Context) sdk.Dec {
+	var a sdk.Dec
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyMaxPriceDeviation, &a)
+	return a
+}
+
+// GetConflictingPriceDeviation returns the relative deviation of a claimed price from the accepted one above which
+// the claim is conflicting
+func (k Keeper) GetConflictingPriceDeviation(ctx sdk.Context) sdk.Dec {
+	var a sdk.Dec
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyConflictingPriceDeviation, &a)
+	return a
+}
+
+// GetEpochLength returns the number of blocks in an epoch
+func (k Keeper) GetEpochLength(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyEpochLength, &a)
+	if a == 0 {
+		return types.DefaultEpochLength
+	}
+	return a
+}
+
+// IsEpochEnd reports whether the epoch is processed at the end of the current block
+func (k Keeper) IsEpochEnd(ctx sdk.Context) bool {
+	return uint64(ctx.BlockHeight())%k.GetEpochLength(ctx) == 0
+}
+
+// GetHoldersUpdatePeriod returns the number of epochs between the holders claims, 0 if they are disabled
+func (k Keeper) GetHoldersUpdatePeriod(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyHoldersUpdatePeriod, &a)
+	return a
+}
+
+// GetExpectedClaims returns the types of the claims the validators have to submit in the epoch
+func (k Keeper) GetExpectedClaims(ctx sdk.Context, epoch uint64) []types.ClaimType {
+	expected := []types.ClaimType{types.CLAIM_TYPE_PRICE}
+	if period := k.GetHoldersUpdatePeriod(ctx); period != 0 && epoch%period == 0 {
+		expected = append(expected, types.CLAIM_TYPE_HOLDER)
+	}
+
+	return expected
+}
+
+// IsClaimExpected reports whether the claims of the type are expected in the epoch
+func (k Keeper) IsClaimExpected(ctx sdk.Context, epoch uint64, claimType types.ClaimType) bool {
+	for _, expected := range k.GetExpectedClaims(ctx, epoch) {
+		if expected == claimType {
+			return true
 		}
 	}
 
-	return sdk.NewInt(0)
+	return false
 }
 
 // logger returns a module-specific logger.
@@ -162,6 +249,8 @@ func (k Keeper) ProcessCurrentEpoch(ctx sdk.Context) {
 	currentEpoch := k.GetCurrentEpoch(ctx)
 	k.setCurrentEpoch(ctx, currentEpoch+1)
 
+	var priceVotes, holdersVotes []string
+
 	{
 		claim := &types.MsgPriceClaim{
 			Epoch: currentEpoch,
@@ -169,6 +258,7 @@ func (k Keeper) ProcessCurrentEpoch(ctx sdk.Context) {
 		att := k.GetAttestation(ctx, currentEpoch, claim)
 		if att != nil {
 			k.tryAttestation(ctx, att, claim)
+			priceVotes = att.GetVotes()
 
 			for _, valaddr := range att.GetVotes() {
 				validator, _ := sdk.ValAddressFromBech32(valaddr)
@@ -186,6 +276,7 @@ func (k Keeper) ProcessCurrentEpoch(ctx sdk.Context) {
 		att := k.GetAttestation(ctx, currentEpoch, claim)
 		if att != nil {
 			k.tryAttestation(ctx, att, claim)
+			holdersVotes = att.GetVotes()
 
 			for _, valaddr := range att.GetVotes() {
 				validator, _ := sdk.ValAddressFromBech32(valaddr)
@@ -194,6 +285,8 @@ func (k Keeper) ProcessCurrentEpoch(ctx sdk.Context) {
 			k.DeleteAttestation(ctx, *att)
 		}
 	}
+
+	k.trackClaims(ctx, currentEpoch, priceVotes, holdersVotes, k.IsClaimExpected(ctx, currentEpoch, types.CLAIM_TYPE_HOLDER))
 }
 
 func (k Keeper) storePrices(ctx sdk.Context, prices *types.Prices) {
@@ -201,11 +294,6 @@ func (k Keeper) storePrices(ctx sdk.Context, prices *types.Prices) {
 	store.Set(types.CurrentPricesKey, k.cdc.MustMarshal(prices))
 }
 
-func (k Keeper) storeHolders(ctx sdk.Context, holders *types.Holders) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.CurrentHoldersKey, k.cdc.MustMarshal(holders))
-}
-
 func (k Keeper) GetNormalizedValPowers(ctx sdk.Context) map[string]uint64 {
 	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	bridgeValidators := map[string]uint64{}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"math"
+	"sort"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// weightedValue is a value reported by a validator together with the normalized power of the validator
+type weightedValue struct {
+	value sdk.Dec
+	power uint64
+}
+
+// aggregatePrices returns the reported prices sorted by name, each aggregated with the method of its feed and
+// truncated to the decimals of the feed. The prices without a registered feed use the default feed settings.
+func aggregatePrices(reported map[string][]weightedValue, feeds map[string]types.PriceFeed) []*types.Price {
+	var names []string
+	for name := range reported {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	prices := make([]*types.Price, 0, len(names))
+	for _, name := range names {
+		feed, ok := feeds[name]
+		if !ok {
+			feed = defaultPriceFeed(name)
+		}
+
+		var value sdk.Dec
+		switch feed.Aggregation {
+		case types.PRICE_AGGREGATION_MEAN:
+			value = weightedMean(reported[name])
+		default:
+			value = weightedMedian(reported[name])
+		}
+
+		prices = append(prices, &types.Price{
+			Name:  name,
+			Value: truncateDecimals(value, feed.Decimals),
+		})
+	}
+
+	return prices
+}
+
+// aggregateHolders returns the weighted medians of the reported balances sorted by the lowercase address.
+// Only the holders reported by more than 2/3 of the normalized power are included. The addresses map the
+// lowercase address to the address as it was reported.
+func aggregateHolders(reported map[string][]weightedValue, addresses map[string]string) []*types.Holder {
+	var keys []string
+	for key := range reported {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var holders []*types.Holder
+	for _, key := range keys {
+		var power uint64
+		for _, v := range reported[key] {
+			power += v.power
+		}
+		if power <= math.MaxUint16*2/3 {
+			continue
+		}
+
+		holders = append(holders, &types.Holder{
+			Address: addresses[key],
+			Value:   weightedMedian(reported[key]).TruncateInt(),
+		})
+	}
+
+	return holders
+}
+
+// weightedMedian returns the median of the values, each counted as many times as its power. For an even
+// total power the median is the average of the two middle values. The values are sorted in place and
+// must have a positive total power.
+func weightedMedian(values []weightedValue) sdk.Dec {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].value.LT(values[j].value)
+	})
+
+	var total uint64
+	for _, v := range values {
+		total += v.power
+	}
+
+	if total%2 == 0 {
+		return nthWeighted(values, total/2-1).Add(nthWeighted(values, total/2)).QuoInt64(2) // compute average
+	}
+
+	return nthWeighted(values, total/2)
+}
+
+// weightedMean returns the mean of the values weighted by their power. The values must have a positive total
+// power.
+func weightedMean(values []weightedValue) sdk.Dec {
+	sum := sdk.ZeroDec()
+	var total uint64
+	for _, v := range values {
+		sum = sum.Add(v.value.MulInt64(int64(v.power)))
+		total += v.power
+	}
+
+	return sum.QuoInt64(int64(total))
+}
+
+// truncateDecimals truncates the value to the given number of decimal places
+func truncateDecimals(value sdk.Dec, decimals uint32) sdk.Dec {
+	if decimals >= sdk.Precision {
+		return value
+	}
+
+	scale := sdk.NewDec(10).Power(uint64(decimals))
+	return value.Mul(scale).TruncateDec().Quo(scale)
+}
+
+// nthWeighted returns the value at the given zero-based position of the sorted values expanded by their power
+func nthWeighted(values []weightedValue, n uint64) sdk.Dec {
+	var cumulative uint64
+	for _, v := range values {
+		cumulative += v.power
+		if n < cumulative {
+			return v.value
+		}
+	}
+
+	panic("position is out of the total power")
+}
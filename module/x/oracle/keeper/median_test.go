@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedMedian(t *testing.T) {
+	specs := map[string]struct {
+		values []weightedValue
+		exp    sdk.Dec
+	}{
+		"single value": {
+			values: []weightedValue{{sdk.NewDec(5), 7}},
+			exp:    sdk.NewDec(5),
+		},
+		"odd total power": {
+			values: []weightedValue{{sdk.NewDec(3), 1}, {sdk.NewDec(1), 1}, {sdk.NewDec(2), 1}},
+			exp:    sdk.NewDec(2),
+		},
+		"even total power": {
+			values: []weightedValue{{sdk.NewDec(4), 1}, {sdk.NewDec(1), 1}, {sdk.NewDec(2), 1}, {sdk.NewDec(3), 1}},
+			exp:    sdk.NewDecWithPrec(25, 1),
+		},
+		"even total power across values": {
+			values: []weightedValue{{sdk.NewDec(1), 3}, {sdk.NewDec(2), 3}},
+			exp:    sdk.NewDecWithPrec(15, 1),
+		},
+		"heavy value": {
+			values: []weightedValue{{sdk.NewDec(1), 1}, {sdk.NewDec(10), 5}, {sdk.NewDec(100), 1}},
+			exp:    sdk.NewDec(10),
+		},
+		"middle inside a heavy value": {
+			values: []weightedValue{{sdk.NewDec(1), 2}, {sdk.NewDec(10), 4}, {sdk.NewDec(100), 2}},
+			exp:    sdk.NewDec(10),
+		},
+		"tied values": {
+			values: []weightedValue{{sdk.NewDec(2), 1}, {sdk.NewDec(1), 2}, {sdk.NewDec(2), 2}, {sdk.NewDec(3), 1}},
+			exp:    sdk.NewDec(2),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			require.Equal(t, spec.exp, weightedMedian(spec.values))
+		})
+	}
+}
+
+// TestWeightedMedianMatchesExpanded checks the weighted median against the median of the values repeated
+// as many times as their power
+func TestWeightedMedianMatchesExpanded(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		values := make([]weightedValue, r.Intn(10)+1)
+		for j := range values {
+			values[j] = weightedValue{value: sdk.NewDec(r.Int63n(20)), power: uint64(r.Intn(50) + 1)}
+		}
+
+		require.Equal(t, expandedMedian(values), weightedMedian(values), "%v", values)
+	}
+}
+
+func expandedMedian(values []weightedValue) sdk.Dec {
+	var expanded []sdk.Dec
+	for _, v := range values {
+		for i := uint64(0); i < v.power; i++ {
+			expanded = append(expanded, v.value)
+		}
+	}
+	sort.Slice(expanded, func(i, j int) bool {
+		return expanded[i].LT(expanded[j])
+	})
+
+	if len(expanded)%2 == 0 {
+		return expanded[len(expanded)/2].Add(expanded[len(expanded)/2-1]).QuoInt64(2)
+	}
+	return expanded[len(expanded)/2]
+}
+
+func BenchmarkAggregatePrices(b *testing.B) {
+	const validators, tokens = 100, 200
+
+	r := rand.New(rand.NewSource(1))
+	reported := make(map[string][]weightedValue, tokens)
+	for v := 0; v < validators; v++ {
+		power := uint64(math.MaxUint16 / validators)
+		for i := 0; i < tokens; i++ {
+			name := fmt.Sprintf("token%d", i)
+			reported[name] = append(reported[name], weightedValue{value: sdk.NewDecWithPrec(r.Int63n(1e6), 3), power: power})
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		aggregatePrices(reported, nil)
+	}
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+// Migrator migrates the oracle store of the chains upgraded in place
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the params added in version 2 to their defaults, stamps the current prices with the upgrade
+// height and moves the holders to the per holder keys
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.setDefaultParam(ctx, types.ParamsStoreKeyPriceMaxAge, &defaults.PriceMaxAge)
+	m.setDefaultParam(ctx, types.ParamsStoreKeyMaxPriceDeviation, &defaults.MaxPriceDeviation)
+	m.setDefaultParam(ctx, types.ParamsStoreKeyPriceFeeds, &defaults.PriceFeeds)
+	m.setDefaultParam(ctx, types.ParamsStoreKeyChainGasEstimates, &defaults.ChainGasEstimates)
+	m.setDefaultParam(ctx, types.ParamsStoreKeyPriceHistoryLength, &defaults.PriceHistoryLength)
+	m.setDefaultParam(ctx, types.ParamsStoreKeyEpochLength, &defaults.EpochLength)
+	m.setDefaultParam(ctx, types.ParamsStoreKeyHoldersUpdatePeriod, &defaults.HoldersUpdatePeriod)
+	m.setDefaultParam(ctx, types.ParamsStoreKeyConflictingPriceDeviation, &defaults.ConflictingPriceDeviation)
+
+	m.stampCurrentPrices(ctx)
+	m.migrateCurrentHolders(ctx)
+
+	return nil
+}
+
+// setDefaultParam sets the param unless the subspace already has it, the getters of the params panic on the
+// missing keys
+func (m Migrator) setDefaultParam(ctx sdk.Context, key []byte, value interface{}) {
+	if !m.keeper.paramSpace.Has(ctx, key) {
+		m.keeper.paramSpace.Set(ctx, key, value)
+	}
+}
+
+// stampCurrentPrices sets the epoch and height of the prices stored without them, otherwise the PriceMaxAge
+// check reports all of them as outdated until the next epoch
+func (m Migrator) stampCurrentPrices(ctx sdk.Context) {
+	prices := m.keeper.GetPrices(ctx)
+	if prices == nil {
+		return
+	}
+
+	epoch := m.keeper.GetCurrentEpoch(ctx)
+	for _, price := range prices.List {
+		price.Epoch = epoch
+		price.Height = ctx.BlockHeight()
+	}
+	m.keeper.storePrices(ctx, prices)
+}
+
+// migrateCurrentHolders moves the holders list stored under CurrentHoldersKey to HolderKey, the holders are
+// read only from HolderKey
+func (m Migrator) migrateCurrentHolders(ctx sdk.Context) {
+	bytes := ctx.KVStore(m.keeper.storeKey).Get(types.CurrentHoldersKey)
+	if len(bytes) == 0 {
+		return
+	}
+
+	var holders types.Holders
+	m.keeper.cdc.MustUnmarshal(bytes, &holders)
+	m.keeper.storeHolders(ctx, &holders)
+}
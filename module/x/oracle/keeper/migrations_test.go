@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+// deleteParams removes the params from the subspace as if the chain started before they were added
+func deleteParams(input TestInput, keys ...[]byte) {
+	store := prefix.NewStore(input.Context.KVStore(input.ParamsKey), append([]byte(types.ModuleName), '/'))
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func TestMigrate1to2Params(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	deleteParams(input,
+		types.ParamsStoreKeyPriceMaxAge, types.ParamsStoreKeyMaxPriceDeviation,
+		types.ParamsStoreKeyPriceFeeds, types.ParamsStoreKeyChainGasEstimates, types.ParamsStoreKeyPriceHistoryLength,
+		types.ParamsStoreKeyEpochLength, types.ParamsStoreKeyHoldersUpdatePeriod, types.ParamsStoreKeyConflictingPriceDeviation,
+	)
+	require.Panics(t, func() { k.GetPriceMaxAge(ctx) })
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+	require.Equal(t, types.DefaultParams().PriceMaxAge, k.GetPriceMaxAge(ctx))
+	require.Equal(t, types.DefaultParams().MaxPriceDeviation, k.GetMaxPriceDeviation(ctx))
+	require.Equal(t, types.DefaultParams().PriceFeeds, k.GetPriceFeeds(ctx))
+	_, err := k.GetChainGasEstimate(ctx, "ethereum")
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams().PriceHistoryLength, k.GetPriceHistoryLength(ctx))
+	require.Equal(t, types.DefaultParams().EpochLength, k.GetEpochLength(ctx))
+	require.Equal(t, types.DefaultParams().HoldersUpdatePeriod, k.GetHoldersUpdatePeriod(ctx))
+	require.Equal(t, types.DefaultParams().ConflictingPriceDeviation, k.GetConflictingPriceDeviation(ctx))
+	require.NotPanics(t, func() { k.GetParams(ctx) })
+}
+
+func TestMigrate1to2Prices(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(int64(types.DefaultParams().PriceMaxAge) + 100)
+	k := input.OracleKeeper
+
+	// the prices of version 1 have neither the epoch nor the height
+	k.storePrices(ctx, &types.Prices{List: []*types.Price{{Name: "eth", Value: sdk.NewDec(3000)}}})
+	_, err := k.GetTokenPrice(ctx, "eth")
+	require.Error(t, err)
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+	price, err := k.GetTokenPrice(ctx, "eth")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3000), price)
+	require.Equal(t, ctx.BlockHeight(), k.GetPrices(ctx).List[0].Height)
+	require.Equal(t, k.GetCurrentEpoch(ctx), k.GetPrices(ctx).List[0].Epoch)
+}
+
+func TestMigrate1to2Holders(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	// the holders of version 1 are stored as a single list
+	ctx.KVStore(k.storeKey).Set(types.CurrentHoldersKey, k.cdc.MustMarshal(&types.Holders{List: []*types.Holder{
+		{Address: "bb02", Value: sdk.NewInt(2)},
+		{Address: "aa01", Value: sdk.NewInt(1)},
+	}}))
+	require.Nil(t, k.GetHolders(ctx))
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+	require.False(t, ctx.KVStore(k.storeKey).Has(types.CurrentHoldersKey))
+	require.Equal(t, &types.Holders{List: []*types.Holder{
+		{Address: "aa01", Value: sdk.NewInt(1)},
+		{Address: "bb02", Value: sdk.NewInt(2)},
+	}}, k.GetHolders(ctx))
+	require.Equal(t, sdk.NewInt(2), k.GetHolderValue(ctx, "bb02"))
+}
@@ -23,18 +23,28 @@ var _ types.MsgServer = msgServer{}
 func (k msgServer) HoldersClaim(c context.Context, msg *types.MsgHoldersClaim) (*types.MsgHoldersClaimResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	orch, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	sval := k.StakingKeeper.Validator(ctx, sdk.ValAddress(orch))
-	if sval == nil {
-		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
+	validator, err := k.claimValidator(ctx, msg.Orchestrator)
+	if err != nil {
+		return nil, err
 	}
 
 	if k.GetCurrentEpoch(ctx) != msg.GetEpoch() {
 		return &types.MsgHoldersClaimResponse{}, nil
 	}
 
+	if !k.IsClaimExpected(ctx, msg.GetEpoch(), types.CLAIM_TYPE_HOLDER) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "holders are not expected in epoch %d", msg.GetEpoch())
+	}
+
+	// the claim is stored on behalf of the validator, whichever of its keys submitted it
+	claim := *msg
+	claim.Orchestrator = sdk.AccAddress(validator).String()
+	if k.HasClaim(ctx, &claim) {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "holders claim of validator %s", validator)
+	}
+
 	// Add the claim to the store
-	att, err := k.AddClaim(ctx, msg)
+	att, err := k.AddClaim(ctx, &claim)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "create attestation")
 	}
@@ -45,7 +55,7 @@ func (k msgServer) HoldersClaim(c context.Context, msg *types.MsgHoldersClaim) (
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
 			// TODO: maybe return something better here? is this the right string representation?
-			sdk.NewAttribute(types.AttributeKeyAttestationID, string(types.GetAttestationKey(att.Epoch, msg))),
+			sdk.NewAttribute(types.AttributeKeyAttestationID, string(types.GetAttestationKey(att.Epoch, &claim))),
 		),
 	)
 
@@ -55,38 +65,38 @@ func (k msgServer) HoldersClaim(c context.Context, msg *types.MsgHoldersClaim) (
 func (k msgServer) PriceClaim(c context.Context, msg *types.MsgPriceClaim) (*types.MsgPriceClaimResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	orch, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	sval := k.StakingKeeper.Validator(ctx, sdk.ValAddress(orch))
-	if sval == nil {
-		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
+	validator, err := k.claimValidator(ctx, msg.Orchestrator)
+	if err != nil {
+		return nil, err
 	}
 
 	if k.GetCurrentEpoch(ctx) != msg.GetEpoch() {
 		return &types.MsgPriceClaimResponse{}, nil
 	}
 
-	tokenInfos := k.Mhub2keeper.GetTokenInfos(ctx)
-	requiredPrices := []string{"eth", "ethereum/gas", "bnb", "bsc/gas"}
-	for _, coin := range tokenInfos.TokenInfos {
-		requiredPrices = append(requiredPrices, coin.Denom)
+	// the claim is stored on behalf of the validator, whichever of its keys submitted it
+	claim := *msg
+	claim.Orchestrator = sdk.AccAddress(validator).String()
+	if k.HasClaim(ctx, &claim) {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "price claim of validator %s", validator)
 	}
 
-	for _, requiredPrice := range requiredPrices {
+	for _, feed := range k.GetRequiredPriceFeeds(ctx) {
 		found := false
 		for _, price := range msg.GetPrices().GetList() {
-			if price.GetName() == requiredPrice && price.Value.IsPositive() {
+			if price.GetName() == feed.Name && !price.Value.IsNil() && price.Value.IsPositive() {
 				found = true
 				break
 			}
 		}
 
 		if !found {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "required price not found or malformed: %s", requiredPrice)
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "required price not found or malformed: %s", feed.Name)
 		}
 	}
 
 	// Add the claim to the store
-	att, err := k.AddClaim(ctx, msg)
+	att, err := k.AddClaim(ctx, &claim)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "create attestation")
 	}
@@ -97,9 +107,45 @@ func (k msgServer) PriceClaim(c context.Context, msg *types.MsgPriceClaim) (*typ
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
 			// TODO: maybe return something better here? is this the right string representation?
-			sdk.NewAttribute(types.AttributeKeyAttestationID, string(types.GetAttestationKey(att.Epoch, msg))),
+			sdk.NewAttribute(types.AttributeKeyAttestationID, string(types.GetAttestationKey(att.Epoch, &claim))),
 		),
 	)
 
 	return &types.MsgPriceClaimResponse{}, nil
 }
+
+func (k msgServer) DelegateFeeder(c context.Context, msg *types.MsgDelegateFeeder) (*types.MsgDelegateFeederResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	validator, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
+	}
+
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Feeder)
+	}
+
+	if k.StakingKeeper.Validator(ctx, validator) == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
+	}
+
+	// the feeder must not submit the claims of another validator
+	if other := k.GetFeederValidator(ctx, feeder); !other.Equals(validator) && k.StakingKeeper.Validator(ctx, other) != nil {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "feeder %s is used by validator %s", feeder, other)
+	}
+
+	k.SetFeeder(ctx, validator, feeder)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeder, feeder.String()),
+		),
+	)
+
+	return &types.MsgDelegateFeederResponse{}, nil
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// updatePrices applies the prices aggregated in the epoch to the current prices. A price which moves by more
+// than MaxPriceDeviation is held as pending and only takes effect once a later epoch confirms it, so a single
+// outlier does not move the price. The prices missing in the epoch keep their value and their age.
+func (k Keeper) updatePrices(ctx sdk.Context, epoch uint64, aggregated []*types.Price) {
+	maxDeviation := k.GetMaxPriceDeviation(ctx)
+	current := pricesByName(k.GetPrices(ctx))
+	pending := pricesByName(k.getPendingPrices(ctx))
+
+	for _, price := range aggregated {
+		update := &types.Price{Name: price.Name, Value: price.Value, Epoch: epoch, Height: ctx.BlockHeight()}
+
+		if last, ok := current[price.Name]; ok && exceedsDeviation(last.Value, price.Value, maxDeviation) {
+			if held, ok := pending[price.Name]; !ok || exceedsDeviation(held.Value, price.Value, maxDeviation) {
+				pending[price.Name] = update
+				ctx.EventManager().EmitEvent(sdk.NewEvent(
+					types.EventTypePriceHeld,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyPriceName, price.Name),
+					sdk.NewAttribute(types.AttributeKeyPriceValue, price.Value.String()),
+				))
+				continue
+			}
+		}
+
+		current[price.Name] = update
+		delete(pending, price.Name)
+	}
+
+	prices := sortedPrices(current)
+	k.storePrices(ctx, prices)
+	k.storePendingPrices(ctx, sortedPrices(pending))
+	k.storePriceSnapshot(ctx, epoch, prices)
+}
+
+func (k Keeper) getPendingPrices(ctx sdk.Context) *types.Prices {
+	bytes := ctx.KVStore(k.storeKey).Get(types.PendingPricesKey)
+	if len(bytes) == 0 {
+		return nil
+	}
+
+	var prices types.Prices
+	k.cdc.MustUnmarshal(bytes, &prices)
+
+	return &prices
+}
+
+func (k Keeper) storePendingPrices(ctx sdk.Context, prices *types.Prices) {
+	store := ctx.KVStore(k.storeKey)
+	if len(prices.List) == 0 {
+		store.Delete(types.PendingPricesKey)
+		return
+	}
+
+	store.Set(types.PendingPricesKey, k.cdc.MustMarshal(prices))
+}
+
+// exceedsDeviation reports whether the value differs from the base by more than the max relative deviation.
+// Zero max deviation disables the check.
+func exceedsDeviation(base sdk.Dec, value sdk.Dec, maxDeviation sdk.Dec) bool {
+	if maxDeviation.IsNil() || !maxDeviation.IsPositive() || !base.IsPositive() {
+		return false
+	}
+
+	return value.Sub(base).Abs().Quo(base).GT(maxDeviation)
+}
+
+func pricesByName(prices *types.Prices) map[string]*types.Price {
+	byName := map[string]*types.Price{}
+	for _, price := range prices.GetList() {
+		byName[price.Name] = price
+	}
+
+	return byName
+}
+
+func sortedPrices(byName map[string]*types.Price) *types.Prices {
+	prices := &types.Prices{}
+	for _, price := range byName {
+		prices.List = append(prices.List, price)
+	}
+	sort.Slice(prices.List, func(i, j int) bool {
+		return prices.List[i].Name < prices.List[j].Name
+	})
+
+	return prices
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+func TestUpdatePricesDeviation(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	update := func(epoch uint64, value int64) {
+		k.updatePrices(ctx, epoch, []*types.Price{{Name: "eth", Value: sdk.NewDec(value)}})
+	}
+	requirePrice := func(value int64, epoch uint64) {
+		price, err := k.GetTokenPrice(ctx, "eth")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(value), price)
+		require.Equal(t, epoch, pricesByName(k.GetPrices(ctx))["eth"].Epoch)
+	}
+
+	update(1, 100)
+	requirePrice(100, 1)
+	require.Nil(t, k.getPendingPrices(ctx))
+
+	// the outlier is held until the next epoch confirms it
+	update(2, 200)
+	requirePrice(100, 1)
+	require.Equal(t, sdk.NewDec(200), k.getPendingPrices(ctx).List[0].Value)
+
+	update(3, 190)
+	requirePrice(190, 3)
+	require.Nil(t, k.getPendingPrices(ctx))
+
+	// the outlier which is not confirmed is dropped once the price is back in range
+	update(4, 1000)
+	requirePrice(190, 3)
+	update(5, 120)
+	requirePrice(120, 5)
+	require.Nil(t, k.getPendingPrices(ctx))
+
+	// two unrelated outliers do not confirm each other
+	update(6, 1000)
+	update(7, 10)
+	requirePrice(120, 5)
+	require.Equal(t, sdk.NewDec(10), k.getPendingPrices(ctx).List[0].Value)
+
+	// the prices missing in the epoch are kept
+	k.updatePrices(ctx, 8, []*types.Price{{Name: "bnb", Value: sdk.NewDec(5)}})
+	requirePrice(120, 5)
+	require.Len(t, k.GetPrices(ctx).List, 2)
+}
+
+func TestGetTokenPriceMaxAge(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	_, err := k.GetTokenPrice(ctx, "eth")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	k.updatePrices(ctx, 1, []*types.Price{{Name: "eth", Value: sdk.NewDec(100)}})
+	require.Equal(t, ctx.BlockHeight(), k.GetPrices(ctx).List[0].Height)
+
+	_, err = k.GetTokenPrice(ctx.WithBlockHeight(ctx.BlockHeight()+100), "eth")
+	require.NoError(t, err)
+
+	_, err = k.GetTokenPrice(ctx.WithBlockHeight(ctx.BlockHeight()+101), "eth")
+	require.ErrorIs(t, err, types.ErrOutdated)
+
+	_, _, err = k.GetExternalFee(ctx.WithBlockHeight(ctx.BlockHeight()+101), "ethereum")
+	require.Error(t, err)
+}
@@ -32,6 +32,7 @@ func queryCurrentEpoch(ctx sdk.Context, keeper Keeper) ([]byte, error) {
 		Nonce: keeper.GetCurrentEpoch(ctx),
 		Votes: nil,
 	}
+	currentEpoch.ExpectedClaims = keeper.GetExpectedClaims(ctx, currentEpoch.Nonce)
 
 	att := keeper.GetAttestation(ctx, currentEpoch.Nonce, &types.MsgPriceClaim{})
 	votes := att.GetVotes()
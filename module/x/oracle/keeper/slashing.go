@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// minSignedClaimsPerWindow is the share of the expected claims a validator has to submit within a signed
+// claims window to avoid being slashed
+var minSignedClaimsPerWindow = sdk.NewDecWithPrec(5, 1)
+
+// maxConflictingClaimsPerWindow is the share of the price claims within a signed claims window which a validator
+// can have conflicting with the accepted prices without being slashed
+var maxConflictingClaimsPerWindow = sdk.NewDecWithPrec(1, 1)
+
+// GetValidatorOracleStats returns the oracle stats of the validator
+func (k Keeper) GetValidatorOracleStats(ctx sdk.Context, validator sdk.ValAddress) types.ValidatorOracleStats {
+	bytes := ctx.KVStore(k.storeKey).Get(types.GetValidatorOracleStatsKey(validator))
+	if len(bytes) == 0 {
+		return types.ValidatorOracleStats{Validator: validator.String()}
+	}
+
+	var stats types.ValidatorOracleStats
+	k.cdc.MustUnmarshal(bytes, &stats)
+
+	return stats
+}
+
+func (k Keeper) setValidatorOracleStats(ctx sdk.Context, validator sdk.ValAddress, stats types.ValidatorOracleStats) {
+	ctx.KVStore(k.storeKey).Set(types.GetValidatorOracleStatsKey(validator), k.cdc.MustMarshal(&stats))
+}
+
+// IterateValidatorOracleStats iterates over the oracle stats of all the validators
+func (k Keeper) IterateValidatorOracleStats(ctx sdk.Context, cb func(stats types.ValidatorOracleStats) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorOracleStatsKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var stats types.ValidatorOracleStats
+		k.cdc.MustUnmarshal(iter.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// trackClaims records the claims of the bonded validators in the processed epoch. At the end of each signed
+// claims window the validators which missed too many claims or had too many conflicting claims are slashed and
+// jailed, and the window is reset.
+func (k Keeper) trackClaims(ctx sdk.Context, epoch uint64, priceVotes []string, holdersVotes []string, holdersExpected bool) {
+	priceVoted := map[string]bool{}
+	for _, valaddr := range priceVotes {
+		priceVoted[valaddr] = true
+	}
+	holdersVoted := map[string]bool{}
+	for _, valaddr := range holdersVotes {
+		holdersVoted[valaddr] = true
+	}
+
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		operator := validator.GetOperator()
+		stats := k.GetValidatorOracleStats(ctx, operator)
+
+		stats.PriceEpochs++
+		if !priceVoted[operator.String()] {
+			stats.MissedPriceClaims++
+		}
+
+		if holdersExpected {
+			stats.HoldersEpochs++
+			if !holdersVoted[operator.String()] {
+				stats.MissedHoldersClaims++
+			}
+		}
+
+		k.setValidatorOracleStats(ctx, operator, stats)
+	}
+
+	window := k.GetParams(ctx).SignedClaimsWindow
+	if window == 0 || epoch%window != 0 {
+		return
+	}
+
+	var windowStats []types.ValidatorOracleStats
+	k.IterateValidatorOracleStats(ctx, func(stats types.ValidatorOracleStats) bool {
+		windowStats = append(windowStats, stats)
+		return false
+	})
+
+	params := k.GetParams(ctx)
+	for _, stats := range windowStats {
+		operator, _ := sdk.ValAddressFromBech32(stats.Validator)
+		if missedTooMany(stats.PriceEpochs, stats.MissedPriceClaims) || missedTooMany(stats.HoldersEpochs, stats.MissedHoldersClaims) {
+			k.slashValidator(ctx, operator, params.SlashFractionClaim, types.AttributeValueMissed)
+		}
+		if conflictedTooMany(stats.PriceEpochs, stats.ConflictingClaims) {
+			k.slashValidator(ctx, operator, params.SlashFractionConflictingClaim, types.AttributeValueConflicted)
+		}
+
+		k.setValidatorOracleStats(ctx, operator, types.ValidatorOracleStats{Validator: stats.Validator})
+	}
+}
+
+// countConflictingClaims counts the claims of the validators which claimed a price deviating from the accepted one
+// by more than the conflicting price deviation, the feeds ignoring the conflicts are not checked
+func (k Keeper) countConflictingClaims(ctx sdk.Context, votes []string, claims map[string]*types.Prices, accepted []*types.Price) {
+	maxDeviation := k.GetConflictingPriceDeviation(ctx)
+	feeds := k.priceFeedsByName(ctx)
+	acceptedByName := map[string]sdk.Dec{}
+	for _, price := range accepted {
+		if feeds[price.Name].IgnoreConflicts {
+			continue
+		}
+		acceptedByName[price.Name] = price.Value
+	}
+
+	counted := map[string]bool{}
+	for _, valaddr := range votes {
+		if counted[valaddr] {
+			continue
+		}
+
+		for _, price := range claims[valaddr].GetList() {
+			value, ok := acceptedByName[price.Name]
+			if !ok || !exceedsDeviation(value, price.Value, maxDeviation) {
+				continue
+			}
+
+			operator, _ := sdk.ValAddressFromBech32(valaddr)
+			stats := k.GetValidatorOracleStats(ctx, operator)
+			stats.ConflictingClaims++
+			k.setValidatorOracleStats(ctx, operator, stats)
+
+			counted[valaddr] = true
+			break
+		}
+	}
+}
+
+// slashValidator slashes and jails the validator unless it is already jailed
+func (k Keeper) slashValidator(ctx sdk.Context, operator sdk.ValAddress, fraction sdk.Dec, reason string) {
+	validator := k.StakingKeeper.Validator(ctx, operator)
+	if validator == nil || validator.IsJailed() {
+		return
+	}
+
+	cons, err := validator.GetConsAddr()
+	if err != nil {
+		k.logger(ctx).Error("can not slash validator", "cause", err.Error(), "validator", operator.String())
+		return
+	}
+
+	k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), validator.GetConsensusPower(k.StakingKeeper.PowerReduction(ctx)), fraction)
+	k.StakingKeeper.Jail(ctx, cons)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOracleSlash,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyValidator, operator.String()),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	))
+}
+
+// missedTooMany reports whether the validator submitted less than minSignedClaimsPerWindow of the expected claims
+func missedTooMany(expected uint64, missed uint64) bool {
+	if expected == 0 {
+		return false
+	}
+
+	return sdk.NewDec(int64(expected - missed)).LT(minSignedClaimsPerWindow.MulInt64(int64(expected)))
+}
+
+// conflictedTooMany reports whether more than maxConflictingClaimsPerWindow of the price claims conflicted with the
+// accepted prices
+func conflictedTooMany(expected uint64, conflicting uint64) bool {
+	if expected == 0 {
+		return false
+	}
+
+	return sdk.NewDec(int64(conflicting)).GT(maxConflictingClaimsPerWindow.MulInt64(int64(expected)))
+}
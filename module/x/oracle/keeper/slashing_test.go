@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+// claimPrices adds the eth price claims of the validators for the current epoch
+func claimPrices(t *testing.T, input TestInput, prices map[int]int64) {
+	lists := map[int][]*types.Price{}
+	for i, value := range prices {
+		lists[i] = []*types.Price{{Name: "eth", Value: sdk.NewDec(value)}}
+	}
+	claimPriceLists(t, input, lists)
+}
+
+// claimPriceLists adds the price claims of the validators for the current epoch
+func claimPriceLists(t *testing.T, input TestInput, prices map[int][]*types.Price) {
+	epoch := input.OracleKeeper.GetCurrentEpoch(input.Context)
+	for i := range ValAddrs {
+		list, ok := prices[i]
+		if !ok {
+			continue
+		}
+
+		_, err := input.OracleKeeper.AddClaim(input.Context, &types.MsgPriceClaim{
+			Epoch:        epoch,
+			Prices:       &types.Prices{List: list},
+			Orchestrator: sdk.AccAddress(ValAddrs[i]).String(),
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestMissedClaimsSlashing(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	// the last validator submits 4 of the 10 claims of the window
+	for epoch := 1; epoch < int(TestingOracleParams.SignedClaimsWindow); epoch++ {
+		prices := map[int]int64{0: 100, 1: 100, 2: 100}
+		if epoch%2 == 0 {
+			prices[3] = 100
+		}
+		claimPrices(t, input, prices)
+		k.ProcessCurrentEpoch(ctx)
+	}
+
+	stats := k.GetValidatorOracleStats(ctx, ValAddrs[3])
+	require.Equal(t, uint64(9), stats.PriceEpochs)
+	require.Equal(t, uint64(5), stats.MissedPriceClaims)
+	require.Empty(t, input.StakingKeeper.Slashed)
+
+	claimPrices(t, input, map[int]int64{0: 100, 1: 100, 2: 100})
+	k.ProcessCurrentEpoch(ctx)
+
+	require.Equal(t, map[string]sdk.Dec{ValAddrs[3].String(): TestingOracleParams.SlashFractionClaim}, input.StakingKeeper.Slashed)
+	require.True(t, input.StakingKeeper.Jailed[ValAddrs[3].String()])
+
+	// the window is reset
+	require.Equal(t, types.ValidatorOracleStats{Validator: ValAddrs[0].String()}, k.GetValidatorOracleStats(ctx, ValAddrs[0]))
+}
+
+func TestConflictingClaimSlashing(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.OracleKeeper
+
+	claimPrices(t, input, map[int]int64{0: 100, 1: 101, 2: 102, 3: 1000})
+	k.ProcessCurrentEpoch(ctx)
+
+	price, err := k.GetTokenPrice(ctx, "eth")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(1015, 1), price)
+
+	// a single conflicting claim is only counted
+	require.Empty(t, input.StakingKeeper.Slashed)
+	res, err := k.ValidatorOracleStats(sdk.WrapSDKContext(ctx), &types.QueryValidatorOracleStatsRequest{ValidatorAddress: ValAddrs[3].String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Stats.ConflictingClaims)
+	require.Equal(t, uint64(0), res.Stats.MissedPriceClaims)
+
+	// the deviations within the conflicting price deviation are not counted
+	claimPrices(t, input, map[int]int64{0: 100, 1: 101, 2: 102, 3: 180})
+	k.ProcessCurrentEpoch(ctx)
+	require.Equal(t, uint64(1), k.GetValidatorOracleStats(ctx, ValAddrs[3]).ConflictingClaims)
+
+	// the gas feeds ignore the conflicts
+	for epoch := 3; epoch < int(TestingOracleParams.SignedClaimsWindow); epoch++ {
+		lists := map[int][]*types.Price{}
+		for i := range ValAddrs {
+			lists[i] = []*types.Price{{Name: "eth", Value: sdk.NewDec(100)}, {Name: "ethereum/gas", Value: sdk.NewDec(30)}}
+		}
+		lists[2][1].Value = sdk.NewDec(300)
+		claimPriceLists(t, input, lists)
+		k.ProcessCurrentEpoch(ctx)
+	}
+	require.Equal(t, uint64(0), k.GetValidatorOracleStats(ctx, ValAddrs[2]).ConflictingClaims)
+
+	// the validator with more than a tenth of the claims of the window conflicting is slashed at the window end
+	claimPrices(t, input, map[int]int64{0: 100, 1: 101, 2: 102, 3: 1000})
+	k.ProcessCurrentEpoch(ctx)
+
+	require.Equal(t, map[string]sdk.Dec{ValAddrs[3].String(): TestingOracleParams.SlashFractionConflictingClaim}, input.StakingKeeper.Slashed)
+	require.True(t, input.StakingKeeper.Jailed[ValAddrs[3].String()])
+	require.Equal(t, uint64(0), k.GetValidatorOracleStats(ctx, ValAddrs[3]).ConflictingClaims)
+}
+
+func TestMissedTooMany(t *testing.T) {
+	require.False(t, missedTooMany(0, 0))
+	require.False(t, missedTooMany(10, 5))
+	require.True(t, missedTooMany(10, 6))
+	require.True(t, missedTooMany(1, 1))
+}
+
+func TestConflictedTooMany(t *testing.T) {
+	require.False(t, conflictedTooMany(0, 0))
+	require.False(t, conflictedTooMany(10, 1))
+	require.True(t, conflictedTooMany(10, 2))
+	require.True(t, conflictedTooMany(1, 1))
+}
@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	mhub2types "github.com/MinterTeam/mhub2/module/x/mhub2/types"
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+var (
+	// ValAddrs holds the operator addresses of the bonded validators of the test env. The oracle claims
+	// of a validator are signed by the account with the same address bytes.
+	ValAddrs = []sdk.ValAddress{
+		sdk.ValAddress(bytes.Repeat([]byte{0x1}, 20)),
+		sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)),
+		sdk.ValAddress(bytes.Repeat([]byte{0x3}, 20)),
+		sdk.ValAddress(bytes.Repeat([]byte{0x4}, 20)),
+	}
+
+	// TestingOracleParams is a set of oracle params for testing
+	TestingOracleParams = types.Params{
+		SignedClaimsWindow:            10,
+		SlashFractionClaim:            sdk.NewDecWithPrec(1, 2),
+		SlashFractionConflictingClaim: sdk.NewDecWithPrec(1, 2),
+		PriceMaxAge:                   100,
+		MaxPriceDeviation:             sdk.NewDecWithPrec(5, 1),
+		PriceFeeds:                    types.DefaultParams().PriceFeeds,
+		ChainGasEstimates:             types.DefaultParams().ChainGasEstimates,
+		PriceHistoryLength:            10,
+		EpochLength:                   5,
+		ConflictingPriceDeviation:     sdk.NewDec(1),
+	}
+)
+
+// TestInput stores the keepers and the context the oracle is tested with
+type TestInput struct {
+	OracleKeeper  Keeper
+	StakingKeeper *StakingKeeperMock
+	Context       sdk.Context
+	Marshaler     codec.Codec
+	ParamsKey     sdk.StoreKey
+}
+
+// CreateTestEnv creates the keeper testing environment for the oracle with the validators of ValAddrs
+// bonded with equal power
+func CreateTestEnv(t testing.TB) TestInput {
+	t.Helper()
+
+	oracleKey := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(oracleKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{
+		Height: 1000,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	}, false, log.TestingLogger())
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(interfaceRegistry)
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	paramsKeeper := paramskeeper.NewKeeper(marshaler, codec.NewLegacyAmino(), keyParams, tkeyParams)
+	paramsKeeper.Subspace(types.ModuleName)
+	subspace, _ := paramsKeeper.GetSubspace(types.ModuleName)
+
+	stakingKeeper := NewStakingKeeperMock(ValAddrs...)
+	k := NewKeeper(marshaler, oracleKey, subspace, stakingKeeper).SetMhub2Keeper(Mhub2KeeperMock{Orchestrators: map[string]sdk.ValAddress{}})
+	k.SetParams(ctx, TestingOracleParams)
+	k.setCurrentEpoch(ctx, 1)
+
+	return TestInput{
+		OracleKeeper:  k,
+		StakingKeeper: stakingKeeper,
+		Context:       ctx,
+		Marshaler:     marshaler,
+		ParamsKey:     keyParams,
+	}
+}
+
+// SetHoldersUpdatePeriod replaces the number of epochs between the holders claims
+func (input TestInput) SetHoldersUpdatePeriod(period uint64) {
+	input.OracleKeeper.paramSpace.Set(input.Context, types.ParamsStoreKeyHoldersUpdatePeriod, period)
+}
+
+// Mhub2KeeperMock is a mock mhub2 keeper with the default token infos and the orchestrators of the validators
+type Mhub2KeeperMock struct {
+	Orchestrators map[string]sdk.ValAddress
+}
+
+// GetTokenInfos implements the interface for mhub2 keeper required by the oracle
+func (Mhub2KeeperMock) GetTokenInfos(sdk.Context) *mhub2types.TokenInfos {
+	return mhub2types.DefaultGenesisState().TokenInfos
+}
+
+// GetOrchestratorValidator implements the interface for mhub2 keeper required by the oracle
+func (m Mhub2KeeperMock) GetOrchestratorValidator(_ sdk.Context, orch sdk.AccAddress) sdk.ValAddress {
+	return m.Orchestrators[orch.String()]
+}
+
+// NewStakingKeeperMock creates a new mock staking keeper with the operators bonded with equal power
+func NewStakingKeeperMock(operators ...sdk.ValAddress) *StakingKeeperMock {
+	s := &StakingKeeperMock{
+		ValidatorPower: map[string]int64{},
+		Slashed:        map[string]sdk.Dec{},
+		Jailed:         map[string]bool{},
+	}
+	for _, operator := range operators {
+		pk, err := codectypes.NewAnyWithValue(ed25519.GenPrivKey().PubKey())
+		if err != nil {
+			panic(err)
+		}
+		s.BondedValidators = append(s.BondedValidators, stakingtypes.Validator{
+			ConsensusPubkey: pk,
+			OperatorAddress: operator.String(),
+			Status:          stakingtypes.Bonded,
+			Tokens:          sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction),
+			DelegatorShares: sdk.NewDec(100),
+		})
+		s.ValidatorPower[operator.String()] = 100
+	}
+
+	return s
+}
+
+// StakingKeeperMock is a mock staking keeper which records the slashes and jails of the validators
+type StakingKeeperMock struct {
+	BondedValidators []stakingtypes.Validator
+	ValidatorPower   map[string]int64
+	Slashed          map[string]sdk.Dec
+	Jailed           map[string]bool
+}
+
+// GetBondedValidatorsByPower implements the interface for staking keeper required by the oracle
+func (s *StakingKeeperMock) GetBondedValidatorsByPower(sdk.Context) []stakingtypes.Validator {
+	var bonded []stakingtypes.Validator
+	for _, val := range s.BondedValidators {
+		if !val.Jailed {
+			bonded = append(bonded, val)
+		}
+	}
+	return bonded
+}
+
+// GetLastValidatorPower implements the interface for staking keeper required by the oracle
+func (s *StakingKeeperMock) GetLastValidatorPower(_ sdk.Context, operator sdk.ValAddress) int64 {
+	return s.ValidatorPower[operator.String()]
+}
+
+// GetLastTotalPower implements the interface for staking keeper required by the oracle
+func (s *StakingKeeperMock) GetLastTotalPower(sdk.Context) sdk.Int {
+	var total int64
+	for _, v := range s.ValidatorPower {
+		total += v
+	}
+	return sdk.NewInt(total)
+}
+
+// IterateValidators implements the interface for staking keeper required by the oracle
+func (s *StakingKeeperMock) IterateValidators(_ sdk.Context, cb func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+	for i, val := range s.BondedValidators {
+		if cb(int64(i), val) {
+			break
+		}
+	}
+}
+
+// IterateBondedValidatorsByPower implements the interface for staking keeper required by the oracle
+func (s *StakingKeeperMock) IterateBondedValidatorsByPower(ctx sdk.Context, cb func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+	for i, val := range s.GetBondedValidatorsByPower(ctx) {
+		if cb(int64(i), val) {
+			break
+		}
+	}
+}
+
+// IterateLastValidators implements the interface for staking keeper required by the oracle
+func (s *StakingKeeperMock) IterateLastValidators(ctx sdk.Context, cb func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+	s.IterateBondedValidatorsByPower(ctx, cb)
+}
+
+// Validator implements the interface for staking keeper required by the oracle
+func (s *StakingKeeperMock) Validator(_ sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI {
+	for _, val := range s.BondedValidators {
+		if val.GetOperator().Equals(addr) {
+			return val
+		}
+	}
+	return nil
+}
+
+// ValidatorByConsAddr implements the interface for staking keeper required by the oracle
+func (s *StakingKeeperMock) ValidatorByConsAddr(_ sdk.Context, addr sdk.ConsAddress) stakingtypes.ValidatorI {
+	for _, val := range s.BondedValidators {
+		cons, err := val.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+		if cons.Equals(addr) {
+			return val
+		}
+	}
+	return nil
+}
+
+// Slash records the slash fraction of the validator
+func (s *StakingKeeperMock) Slash(ctx sdk.Context, addr sdk.ConsAddress, _ int64, _ int64, fraction sdk.Dec) {
+	val := s.ValidatorByConsAddr(ctx, addr)
+	s.Slashed[val.GetOperator().String()] = fraction
+}
+
+// Jail jails the validator, so it is no longer bonded
+func (s *StakingKeeperMock) Jail(ctx sdk.Context, addr sdk.ConsAddress) {
+	for i, val := range s.BondedValidators {
+		if cons, _ := val.GetConsAddr(); cons.Equals(addr) {
+			s.BondedValidators[i].Jailed = true
+			s.Jailed[val.OperatorAddress] = true
+		}
+	}
+}
+
+// PowerReduction implements the interface for staking keeper required by the oracle
+func (s *StakingKeeperMock) PowerReduction(sdk.Context) sdk.Int {
+	return sdk.DefaultPowerReduction
+}
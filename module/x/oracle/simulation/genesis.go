@@ -0,0 +1,56 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+// Simulation parameter constants
+const (
+	EpochLength         = "epoch_length"
+	HoldersUpdatePeriod = "holders_update_period"
+)
+
+// GenEpochLength randomized EpochLength
+func GenEpochLength(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 8))
+}
+
+// GenHoldersUpdatePeriod randomized HoldersUpdatePeriod, short enough for the holders claims to be simulated
+func GenHoldersUpdatePeriod(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 4))
+}
+
+// RandomizedGenState generates a random GenesisState for the oracle
+func RandomizedGenState(simState *module.SimulationState) {
+	var epochLength uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EpochLength, &epochLength, simState.Rand,
+		func(r *rand.Rand) { epochLength = GenEpochLength(r) },
+	)
+
+	var holdersUpdatePeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, HoldersUpdatePeriod, &holdersUpdatePeriod, simState.Rand,
+		func(r *rand.Rand) { holdersUpdatePeriod = GenHoldersUpdatePeriod(r) },
+	)
+
+	oracleGenesis := types.DefaultGenesisState()
+	oracleGenesis.Params.EpochLength = epochLength
+	oracleGenesis.Params.HoldersUpdatePeriod = holdersUpdatePeriod
+
+	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated oracle parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(oracleGenesis)
+}
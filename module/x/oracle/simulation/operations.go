@@ -0,0 +1,213 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/keeper"
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgPriceClaim     = "op_weight_msg_price_claim"
+	OpWeightMsgHoldersClaim   = "op_weight_msg_holders_claim"
+	OpWeightMsgDelegateFeeder = "op_weight_msg_delegate_feeder"
+
+	DefaultWeightMsgPriceClaim     = 50
+	DefaultWeightMsgHoldersClaim   = 30
+	DefaultWeightMsgDelegateFeeder = 5
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgPriceClaim int
+	appParams.GetOrGenerate(cdc, OpWeightMsgPriceClaim, &weightMsgPriceClaim, nil,
+		func(_ *rand.Rand) {
+			weightMsgPriceClaim = DefaultWeightMsgPriceClaim
+		},
+	)
+
+	var weightMsgHoldersClaim int
+	appParams.GetOrGenerate(cdc, OpWeightMsgHoldersClaim, &weightMsgHoldersClaim, nil,
+		func(_ *rand.Rand) {
+			weightMsgHoldersClaim = DefaultWeightMsgHoldersClaim
+		},
+	)
+
+	var weightMsgDelegateFeeder int
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegateFeeder, &weightMsgDelegateFeeder, nil,
+		func(_ *rand.Rand) {
+			weightMsgDelegateFeeder = DefaultWeightMsgDelegateFeeder
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgPriceClaim,
+			SimulateMsgPriceClaim(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgHoldersClaim,
+			SimulateMsgHoldersClaim(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDelegateFeeder,
+			SimulateMsgDelegateFeeder(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgPriceClaim generates a MsgPriceClaim of the current epoch with random prices by a random
+// validator which has not claimed the prices of the epoch yet
+func SimulateMsgPriceClaim(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgPriceClaim{}).Type()
+
+		epoch := k.GetCurrentEpoch(ctx)
+		if epoch == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no epoch"), nil, nil
+		}
+
+		simAccount, found := randomClaimer(r, ctx, k, accs, func(orchestrator string) bool {
+			return k.GetPriceClaim(ctx, orchestrator, epoch) == nil
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no validators without a claim"), nil, nil
+		}
+
+		prices := &types.Prices{}
+		for _, feed := range k.GetRequiredPriceFeeds(ctx) {
+			prices.List = append(prices.List, &types.Price{
+				Name:  feed.Name,
+				Value: sdk.NewDecWithPrec(1+r.Int63n(1e8), 4),
+			})
+		}
+
+		msg := &types.MsgPriceClaim{
+			Epoch:        epoch,
+			Prices:       prices,
+			Orchestrator: simAccount.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType)
+	}
+}
+
+// SimulateMsgHoldersClaim generates a MsgHoldersClaim of the current epoch, if the holders are expected in
+// it, by a random validator which has not claimed the holders of the epoch yet. The holders are derived
+// from the epoch only, so the validators agree on them.
+func SimulateMsgHoldersClaim(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgHoldersClaim{}).Type()
+
+		epoch := k.GetCurrentEpoch(ctx)
+		if epoch == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no epoch"), nil, nil
+		}
+
+		if !k.IsClaimExpected(ctx, epoch, types.CLAIM_TYPE_HOLDER) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "holders are not expected in the epoch"), nil, nil
+		}
+
+		simAccount, found := randomClaimer(r, ctx, k, accs, func(orchestrator string) bool {
+			return k.GetHoldersClaim(ctx, orchestrator, epoch) == nil
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no validators without a claim"), nil, nil
+		}
+
+		holders := &types.Holders{}
+		seen := map[string]bool{}
+		for i := uint64(0); i <= epoch%5; i++ {
+			holder := accs[(epoch*7+i)%uint64(len(accs))].Address.String()
+			if seen[holder] {
+				continue
+			}
+			seen[holder] = true
+			holders.List = append(holders.List, &types.Holder{
+				Address: holder,
+				Value:   sdk.NewIntWithDecimal(1<<i, 18),
+			})
+		}
+
+		msg := &types.MsgHoldersClaim{
+			Epoch:        epoch,
+			Holders:      holders,
+			Orchestrator: simAccount.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType)
+	}
+}
+
+// SimulateMsgDelegateFeeder generates a MsgDelegateFeeder by a random bonded validator delegating a random
+// account which neither operates a validator nor feeds another validator
+func SimulateMsgDelegateFeeder(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgDelegateFeeder{}).Type()
+
+		simAccount, found := randomClaimer(r, ctx, k, accs, func(string) bool { return true })
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no validators"), nil, nil
+		}
+
+		validator := sdk.ValAddress(simAccount.Address)
+		feeder, _ := simtypes.RandomAcc(r, accs)
+		if other := k.GetFeederValidator(ctx, feeder.Address); !other.Equals(validator) && k.StakingKeeper.Validator(ctx, other) != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "feeder is used by another validator"), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, types.NewMsgDelegateFeeder(validator, feeder.Address), msgType)
+	}
+}
+
+// randomClaimer returns the account of a random bonded validator accepted by the filter. The oracle claims
+// are signed by the validator accounts themselves.
+func randomClaimer(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, filter func(orchestrator string) bool,
+) (simtypes.Account, bool) {
+	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	for _, i := range r.Perm(len(validators)) {
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(validators[i].GetOperator()))
+		if found && filter(simAccount.Address.String()) {
+			return simAccount, true
+		}
+	}
+
+	return simtypes.Account{}, false
+}
+
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+	simAccount simtypes.Account, msg sdk.Msg, msgType string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+		Msg:           msg,
+		MsgType:       msgType,
+		Context:       ctx,
+		SimAccount:    simAccount,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    types.ModuleName,
+	})
+}
@@ -19,6 +19,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPriceClaim{},
 		&MsgHoldersClaim{},
+		&MsgDelegateFeeder{},
 	)
 
 	registry.RegisterInterface(
@@ -36,5 +37,6 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*Claim)(nil), nil)
 	cdc.RegisterConcrete(&MsgPriceClaim{}, "oracle/MsgPriceClaim", nil)
 	cdc.RegisterConcrete(&MsgHoldersClaim{}, "oracle/MsgHoldersClaim", nil)
+	cdc.RegisterConcrete(&MsgDelegateFeeder{}, "oracle/MsgDelegateFeeder", nil)
 	cdc.RegisterConcrete(&Attestation{}, "oracle/Attestation", nil)
 }
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLegacyAminoJSONRoundTrip(t *testing.T) {
+	orchestrator := sdk.AccAddress(make([]byte, 20)).String()
+
+	t.Run("price claim", func(t *testing.T) {
+		msg := MsgPriceClaim{
+			Epoch:        1,
+			Prices:       &Prices{List: []*Price{{Name: "eth", Value: sdk.NewDecWithPrec(15, 1)}}},
+			Orchestrator: orchestrator,
+		}
+		requireTypeName(t, msg.GetSignBytes(), "oracle/MsgPriceClaim")
+
+		var decoded MsgPriceClaim
+		require.NoError(t, ModuleCdc.UnmarshalJSON(ModuleCdc.MustMarshalJSON(&msg), &decoded))
+		require.Equal(t, msg.GetSignBytes(), decoded.GetSignBytes())
+	})
+
+	t.Run("holders claim", func(t *testing.T) {
+		msg := MsgHoldersClaim{
+			Epoch:        1,
+			Holders:      &Holders{List: []*Holder{{Address: orchestrator, Value: sdk.NewInt(10)}}},
+			Orchestrator: orchestrator,
+		}
+		requireTypeName(t, msg.GetSignBytes(), "oracle/MsgHoldersClaim")
+
+		var decoded MsgHoldersClaim
+		require.NoError(t, ModuleCdc.UnmarshalJSON(ModuleCdc.MustMarshalJSON(&msg), &decoded))
+		require.Equal(t, msg.GetSignBytes(), decoded.GetSignBytes())
+	})
+}
+
+func requireTypeName(t *testing.T, signBytes []byte, typeName string) {
+	var wrapped struct {
+		Type string `json:"type"`
+	}
+	require.NoError(t, json.Unmarshal(signBytes, &wrapped))
+	require.Equal(t, typeName, wrapped.Type)
+}
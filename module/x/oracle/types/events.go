@@ -5,4 +5,16 @@ const (
 	AttributeKeyAttestationID   = "attestation_id"
 	AttributeKeyAttestationType = "attestation_type"
 	AttributeKeyNonce           = "nonce"
+
+	EventTypePriceHeld     = "price_held"
+	AttributeKeyPriceName  = "price_name"
+	AttributeKeyPriceValue = "price_value"
+
+	EventTypeOracleSlash     = "oracle_slash"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyReason       = "reason"
+	AttributeValueMissed     = "missed_claims"
+	AttributeValueConflicted = "conflicting_claim"
+
+	AttributeKeyFeeder = "feeder"
 )
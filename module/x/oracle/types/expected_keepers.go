@@ -18,10 +18,12 @@ type StakingKeeper interface {
 	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec)
 	Jail(sdk.Context, sdk.ConsAddress)
+	PowerReduction(sdk.Context) sdk.Int
 }
 
 type Mhub2Keeper interface {
 	GetTokenInfos(sdk.Context) *types.TokenInfos
+	GetOrchestratorValidator(ctx sdk.Context, orch sdk.AccAddress) sdk.ValAddress
 }
 
 // BankKeeper defines the expected bank keeper methods
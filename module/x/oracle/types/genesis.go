@@ -3,12 +3,16 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// DefaultEpochLength is the number of blocks in an epoch if the epoch length is not set
+const DefaultEpochLength = 5
+
 var (
 	// AttestationVotesPowerThreshold threshold of votes power to succeed
 	AttestationVotesPowerThreshold = sdk.NewInt(66)
@@ -22,6 +26,30 @@ var (
 	// ParamsStoreSlashFractionConflictingClaim stores the slash fraction ConflictingClaim
 	ParamsStoreSlashFractionConflictingClaim = []byte("SlashFractionConflictingClaim")
 
+	// ParamsStoreKeyPriceMaxAge stores the number of blocks after which a price is outdated
+	ParamsStoreKeyPriceMaxAge = []byte("PriceMaxAge")
+
+	// ParamsStoreKeyMaxPriceDeviation stores the max relative change of a price in a single epoch
+	ParamsStoreKeyMaxPriceDeviation = []byte("MaxPriceDeviation")
+
+	// ParamsStoreKeyPriceFeeds stores the feeds required in the price claims
+	ParamsStoreKeyPriceFeeds = []byte("PriceFeeds")
+
+	// ParamsStoreKeyChainGasEstimates stores the gas estimates of the external chains
+	ParamsStoreKeyChainGasEstimates = []byte("ChainGasEstimates")
+
+	// ParamsStoreKeyPriceHistoryLength stores the number of epochs the prices are kept in the history for
+	ParamsStoreKeyPriceHistoryLength = []byte("PriceHistoryLength")
+
+	// ParamsStoreKeyEpochLength stores the number of blocks in an epoch
+	ParamsStoreKeyEpochLength = []byte("EpochLength")
+
+	// ParamsStoreKeyHoldersUpdatePeriod stores the number of epochs between the holders claims
+	ParamsStoreKeyHoldersUpdatePeriod = []byte("HoldersUpdatePeriod")
+
+	// ParamsStoreKeyConflictingPriceDeviation stores the relative deviation of a conflicting price claim
+	ParamsStoreKeyConflictingPriceDeviation = []byte("ConflictingPriceDeviation")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -32,6 +60,187 @@ func (s GenesisState) ValidateBasic() error {
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	if err := validatePrices(s.Prices); err != nil {
+		return sdkerrors.Wrap(err, "prices")
+	}
+	if err := validatePrices(s.PendingPrices); err != nil {
+		return sdkerrors.Wrap(err, "pending prices")
+	}
+	if err := validateHolders(s.Holders); err != nil {
+		return sdkerrors.Wrap(err, "holders")
+	}
+	if err := validatePriceHistory(s.PriceHistory); err != nil {
+		return sdkerrors.Wrap(err, "price history")
+	}
+	if err := validateValidatorOracleStats(s.ValidatorOracleStats); err != nil {
+		return sdkerrors.Wrap(err, "validator oracle stats")
+	}
+	if err := validateFeeders(s.Feeders); err != nil {
+		return sdkerrors.Wrap(err, "feeders")
+	}
+	if err := s.validateClaims(); err != nil {
+		return sdkerrors.Wrap(err, "claims")
+	}
+	return nil
+}
+
+// validateClaims checks that the claims and attestations belong to the epochs up to the current one and that
+// every claim is counted as a vote of its validator in the attestation of the claim, and vice versa
+func (s GenesisState) validateClaims() error {
+	epoch := s.Epoch
+	if epoch == 0 {
+		epoch = 1
+	}
+
+	votes := map[string]bool{}
+	for _, att := range s.Attestations {
+		if att.Epoch == 0 || att.Epoch > epoch {
+			return fmt.Errorf("attestation of epoch %d, current epoch is %d", att.Epoch, epoch)
+		}
+		if len(att.ClaimHash) == 0 {
+			return fmt.Errorf("attestation of epoch %d has no claim hash", att.Epoch)
+		}
+
+		attKey := string(GetAttestationKeyWithHash(att.Epoch, att.ClaimHash))
+		if votes[attKey] {
+			return fmt.Errorf("duplicate attestation of epoch %d", att.Epoch)
+		}
+		votes[attKey] = true
+
+		for _, vote := range att.Votes {
+			if _, err := sdk.ValAddressFromBech32(vote); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "vote %s", vote)
+			}
+			if votes[attKey+vote] {
+				return fmt.Errorf("duplicate vote %s in attestation of epoch %d", vote, att.Epoch)
+			}
+			votes[attKey+vote] = true
+		}
+	}
+
+	claimed := map[string]bool{}
+	for _, generic := range s.Claims {
+		claim := generic.UnpackClaim()
+		if claim == nil {
+			return fmt.Errorf("claim of epoch %d has no details", generic.Epoch)
+		}
+		if err := claim.ValidateBasic(); err != nil {
+			return err
+		}
+		if claim.GetEpoch() != generic.Epoch || claim.GetType() != generic.GetType() || !bytes.Equal(claim.ClaimHash(), generic.Hash) {
+			return fmt.Errorf("claim of epoch %d does not match its details", generic.Epoch)
+		}
+		if claim.GetEpoch() > epoch {
+			return fmt.Errorf("claim of epoch %d, current epoch is %d", claim.GetEpoch(), epoch)
+		}
+
+		vote := sdk.ValAddress(claim.GetClaimer()).String()
+		voteKey := string(GetAttestationKeyWithHash(claim.GetEpoch(), claim.ClaimHash())) + vote
+		if !votes[voteKey] {
+			return fmt.Errorf("claim of %s in epoch %d has no vote in the attestation", vote, claim.GetEpoch())
+		}
+		if claimed[voteKey] {
+			return fmt.Errorf("duplicate claim of %s in epoch %d", vote, claim.GetEpoch())
+		}
+		claimed[voteKey] = true
+	}
+
+	for _, att := range s.Attestations {
+		attKey := string(GetAttestationKeyWithHash(att.Epoch, att.ClaimHash))
+		for _, vote := range att.Votes {
+			if !claimed[attKey+vote] {
+				return fmt.Errorf("vote of %s in epoch %d has no claim", vote, att.Epoch)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validatePrices(prices *Prices) error {
+	seen := map[string]bool{}
+	for _, price := range prices.GetList() {
+		if price.Name == "" {
+			return fmt.Errorf("empty price name")
+		}
+		if seen[price.Name] {
+			return fmt.Errorf("duplicate price %s", price.Name)
+		}
+		seen[price.Name] = true
+
+		if price.Value.IsNil() || price.Value.IsNegative() {
+			return fmt.Errorf("invalid value of price %s", price.Name)
+		}
+	}
+	return nil
+}
+
+func validateHolders(holders *Holders) error {
+	seen := map[string]bool{}
+	for _, holder := range holders.GetList() {
+		address := strings.ToLower(holder.Address)
+		if address == "" {
+			return fmt.Errorf("empty holder address")
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate holder %s", holder.Address)
+		}
+		seen[address] = true
+
+		if holder.Value.IsNil() || holder.Value.IsNegative() {
+			return fmt.Errorf("invalid value of holder %s", holder.Address)
+		}
+	}
+	return nil
+}
+
+func validatePriceHistory(history []PriceSnapshot) error {
+	seen := map[uint64]bool{}
+	for _, snapshot := range history {
+		if seen[snapshot.Epoch] {
+			return fmt.Errorf("duplicate snapshot of epoch %d", snapshot.Epoch)
+		}
+		seen[snapshot.Epoch] = true
+
+		if err := validatePrices(&Prices{List: snapshot.Prices}); err != nil {
+			return sdkerrors.Wrapf(err, "epoch %d", snapshot.Epoch)
+		}
+	}
+	return nil
+}
+
+func validateValidatorOracleStats(stats []ValidatorOracleStats) error {
+	seen := map[string]bool{}
+	for _, s := range stats {
+		if _, err := sdk.ValAddressFromBech32(s.Validator); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, s.Validator)
+		}
+		if seen[s.Validator] {
+			return fmt.Errorf("duplicate stats of %s", s.Validator)
+		}
+		seen[s.Validator] = true
+	}
+	return nil
+}
+
+func validateFeeders(feeders []FeederDelegation) error {
+	seen := map[string]bool{}
+	for _, delegation := range feeders {
+		if _, err := sdk.ValAddressFromBech32(delegation.Validator); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, delegation.Validator)
+		}
+		if _, err := sdk.AccAddressFromBech32(delegation.Feeder); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, delegation.Feeder)
+		}
+		if seen[delegation.Validator] {
+			return fmt.Errorf("duplicate feeder of %s", delegation.Validator)
+		}
+		if seen[delegation.Feeder] {
+			return fmt.Errorf("feeder %s is delegated by several validators", delegation.Feeder)
+		}
+		seen[delegation.Validator] = true
+		seen[delegation.Feeder] = true
+	}
 	return nil
 }
 
@@ -49,6 +258,22 @@ func DefaultParams() *Params {
 		SignedClaimsWindow:            10000,
 		SlashFractionClaim:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		SlashFractionConflictingClaim: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		PriceMaxAge:                   720,
+		MaxPriceDeviation:             sdk.NewDecWithPrec(5, 1),
+		PriceFeeds: []PriceFeed{
+			{Name: "eth", Decimals: sdk.Precision},
+			{Name: "ethereum/gas", Decimals: sdk.Precision, IgnoreConflicts: true},
+			{Name: "bnb", Decimals: sdk.Precision},
+			{Name: "bsc/gas", Decimals: sdk.Precision, IgnoreConflicts: true},
+		},
+		ChainGasEstimates: []ChainGasEstimate{
+			{ChainId: "ethereum", GasPriceFeed: "ethereum/gas", BaseCoinFeed: "eth", MinGas: 150000, FastGas: 300000, BatchGas: 3500000},
+			{ChainId: "bsc", GasPriceFeed: "bsc/gas", BaseCoinFeed: "bnb", MinGas: 100000, FastGas: 200000, BatchGas: 3500000},
+		},
+		PriceHistoryLength:        2000,
+		EpochLength:               DefaultEpochLength,
+		HoldersUpdatePeriod:       144,
+		ConflictingPriceDeviation: sdk.NewDec(1),
 	}
 }
 
@@ -63,6 +288,43 @@ func (p Params) ValidateBasic() error {
 	if err := validateSlashFractionConflictingClaim(p.SlashFractionConflictingClaim); err != nil {
 		return sdkerrors.Wrap(err, "slash fraction valset")
 	}
+	if err := validatePriceMaxAge(p.PriceMaxAge); err != nil {
+		return sdkerrors.Wrap(err, "price max age")
+	}
+	if err := validateMaxPriceDeviation(p.MaxPriceDeviation); err != nil {
+		return sdkerrors.Wrap(err, "max price deviation")
+	}
+	if err := validatePriceFeeds(p.PriceFeeds); err != nil {
+		return sdkerrors.Wrap(err, "price feeds")
+	}
+	if err := validateChainGasEstimates(p.ChainGasEstimates); err != nil {
+		return sdkerrors.Wrap(err, "chain gas estimates")
+	}
+	if err := validatePriceHistoryLength(p.PriceHistoryLength); err != nil {
+		return sdkerrors.Wrap(err, "price history length")
+	}
+	if err := validateEpochLength(p.EpochLength); err != nil {
+		return sdkerrors.Wrap(err, "epoch length")
+	}
+	if err := validateHoldersUpdatePeriod(p.HoldersUpdatePeriod); err != nil {
+		return sdkerrors.Wrap(err, "holders update period")
+	}
+	if err := validateConflictingPriceDeviation(p.ConflictingPriceDeviation); err != nil {
+		return sdkerrors.Wrap(err, "conflicting price deviation")
+	}
+
+	// the gas estimates are priced with the registered feeds
+	feeds := map[string]bool{}
+	for _, feed := range p.PriceFeeds {
+		feeds[feed.Name] = true
+	}
+	for _, estimate := range p.ChainGasEstimates {
+		for _, feed := range []string{estimate.GasPriceFeed, estimate.BaseCoinFeed} {
+			if !feeds[feed] {
+				return fmt.Errorf("feed %s of chain %s is not registered", feed, estimate.ChainId)
+			}
+		}
+	}
 	return nil
 }
 
@@ -78,6 +340,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamsStoreKeySignedClaimsWindow, &p.SignedClaimsWindow, validateSignedClaimsWindow),
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionClaim, &p.SlashFractionClaim, validateSlashFractionClaim),
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionConflictingClaim, &p.SlashFractionConflictingClaim, validateSlashFractionConflictingClaim),
+		paramtypes.NewParamSetPair(ParamsStoreKeyPriceMaxAge, &p.PriceMaxAge, validatePriceMaxAge),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMaxPriceDeviation, &p.MaxPriceDeviation, validateMaxPriceDeviation),
+		paramtypes.NewParamSetPair(ParamsStoreKeyPriceFeeds, &p.PriceFeeds, validatePriceFeeds),
+		paramtypes.NewParamSetPair(ParamsStoreKeyChainGasEstimates, &p.ChainGasEstimates, validateChainGasEstimates),
+		paramtypes.NewParamSetPair(ParamsStoreKeyPriceHistoryLength, &p.PriceHistoryLength, validatePriceHistoryLength),
+		paramtypes.NewParamSetPair(ParamsStoreKeyEpochLength, &p.EpochLength, validateEpochLength),
+		paramtypes.NewParamSetPair(ParamsStoreKeyHoldersUpdatePeriod, &p.HoldersUpdatePeriod, validateHoldersUpdatePeriod),
+		paramtypes.NewParamSetPair(ParamsStoreKeyConflictingPriceDeviation, &p.ConflictingPriceDeviation, validateConflictingPriceDeviation),
 	}
 }
 
@@ -112,6 +382,108 @@ func validateSlashFractionConflictingClaim(i interface{}) error {
 	return nil
 }
 
+func validatePriceMaxAge(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxPriceDeviation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("max price deviation should not be negative")
+	}
+	return nil
+}
+
+func validatePriceFeeds(i interface{}) error {
+	v, ok := i.([]PriceFeed)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := map[string]bool{}
+	for _, feed := range v {
+		if feed.Name == "" {
+			return fmt.Errorf("empty feed name")
+		}
+		if seen[feed.Name] {
+			return fmt.Errorf("duplicate feed %s", feed.Name)
+		}
+		seen[feed.Name] = true
+
+		if feed.Decimals == 0 || feed.Decimals > sdk.Precision {
+			return fmt.Errorf("feed %s should have from 1 to %d decimals", feed.Name, sdk.Precision)
+		}
+		if _, ok := PriceAggregation_name[int32(feed.Aggregation)]; !ok {
+			return fmt.Errorf("feed %s has unknown aggregation %d", feed.Name, feed.Aggregation)
+		}
+	}
+	return nil
+}
+
+func validateChainGasEstimates(i interface{}) error {
+	v, ok := i.([]ChainGasEstimate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := map[string]bool{}
+	for _, estimate := range v {
+		if estimate.ChainId == "" {
+			return fmt.Errorf("empty chain id")
+		}
+		if seen[estimate.ChainId] {
+			return fmt.Errorf("duplicate chain %s", estimate.ChainId)
+		}
+		seen[estimate.ChainId] = true
+
+		if estimate.GasPriceFeed == "" || estimate.BaseCoinFeed == "" {
+			return fmt.Errorf("chain %s has no price feeds", estimate.ChainId)
+		}
+		if estimate.MinGas == 0 || estimate.FastGas < estimate.MinGas {
+			return fmt.Errorf("chain %s should have positive min gas not above fast gas", estimate.ChainId)
+		}
+	}
+	return nil
+}
+
+func validatePriceHistoryLength(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEpochLength(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateHoldersUpdatePeriod(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateConflictingPriceDeviation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("conflicting price deviation should not be negative")
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
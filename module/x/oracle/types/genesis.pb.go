@@ -24,10 +24,59 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PriceAggregation is the method the claimed values of a feed are aggregated
+// with, each value weighted by the power of its validator
+type PriceAggregation int32
+
+const (
+	PRICE_AGGREGATION_MEDIAN PriceAggregation = 0
+	PRICE_AGGREGATION_MEAN   PriceAggregation = 1
+)
+
+var PriceAggregation_name = map[int32]string{
+	0: "PRICE_AGGREGATION_MEDIAN",
+	1: "PRICE_AGGREGATION_MEAN",
+}
+
+var PriceAggregation_value = map[string]int32{
+	"PRICE_AGGREGATION_MEDIAN": 0,
+	"PRICE_AGGREGATION_MEAN":   1,
+}
+
+func (x PriceAggregation) String() string {
+	return proto.EnumName(PriceAggregation_name, int32(x))
+}
+
+func (PriceAggregation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_14b982a0a6345d1d, []int{0}
+}
+
 type Params struct {
 	SignedClaimsWindow            uint64                                 `protobuf:"varint,1,opt,name=signed_claims_window,json=signedClaimsWindow,proto3" json:"signed_claims_window,omitempty"`
 	SlashFractionClaim            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slash_fraction_claim,json=slashFractionClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_claim"`
 	SlashFractionConflictingClaim github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_fraction_conflicting_claim,json=slashFractionConflictingClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_claim"`
+	// number of blocks after which a price which has not been updated is
+	// considered outdated, 0 disables the check
+	PriceMaxAge uint64 `protobuf:"varint,4,opt,name=price_max_age,json=priceMaxAge,proto3" json:"price_max_age,omitempty"`
+	// relative change of a price in a single epoch above which the new price is
+	// held until a later epoch confirms it, 0 disables the check
+	MaxPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation"`
+	// feeds every price claim has to report besides the prices of the bridged
+	// denoms
+	PriceFeeds []PriceFeed `protobuf:"bytes,6,rep,name=price_feeds,json=priceFeeds,proto3" json:"price_feeds"`
+	// gas estimates of the transfers to the external chains
+	ChainGasEstimates []ChainGasEstimate `protobuf:"bytes,7,rep,name=chain_gas_estimates,json=chainGasEstimates,proto3" json:"chain_gas_estimates"`
+	// number of the latest epochs the accepted prices are kept in the price
+	// history for, 0 disables the history
+	PriceHistoryLength uint64 `protobuf:"varint,8,opt,name=price_history_length,json=priceHistoryLength,proto3" json:"price_history_length,omitempty"`
+	// number of blocks in an epoch, 0 falls back to 5 blocks
+	EpochLength uint64 `protobuf:"varint,9,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// holders claims are expected in the epochs which are multiples of the
+	// period, 0 disables the holders claims
+	HoldersUpdatePeriod uint64 `protobuf:"varint,10,opt,name=holders_update_period,json=holdersUpdatePeriod,proto3" json:"holders_update_period,omitempty"`
+	// relative deviation of a claimed price from the accepted one above which
+	// the claim is counted as conflicting, 0 disables the conflicting claims
+	ConflictingPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=conflicting_price_deviation,json=conflictingPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conflicting_price_deviation"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,18 +119,239 @@ func (m *Params) GetSignedClaimsWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetPriceMaxAge() uint64 {
+	if m != nil {
+		return m.PriceMaxAge
+	}
+	return 0
+}
+
+func (m *Params) GetPriceFeeds() []PriceFeed {
+	if m != nil {
+		return m.PriceFeeds
+	}
+	return nil
+}
+
+func (m *Params) GetChainGasEstimates() []ChainGasEstimate {
+	if m != nil {
+		return m.ChainGasEstimates
+	}
+	return nil
+}
+
+func (m *Params) GetPriceHistoryLength() uint64 {
+	if m != nil {
+		return m.PriceHistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetHoldersUpdatePeriod() uint64 {
+	if m != nil {
+		return m.HoldersUpdatePeriod
+	}
+	return 0
+}
+
+// PriceFeed is a price the oracle requires in every price claim
+type PriceFeed struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// number of decimal places the aggregated price is truncated to
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// number of blocks after which the price is outdated, 0 falls back to
+	// price_max_age
+	MaxAge      uint64           `protobuf:"varint,3,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	Aggregation PriceAggregation `protobuf:"varint,4,opt,name=aggregation,proto3,enum=oracle.v1.PriceAggregation" json:"aggregation,omitempty"`
+	// claims of the feed are never counted as conflicting, for the volatile
+	// feeds like the gas prices
+	IgnoreConflicts bool `protobuf:"varint,5,opt,name=ignore_conflicts,json=ignoreConflicts,proto3" json:"ignore_conflicts,omitempty"`
+}
+
+func (m *PriceFeed) Reset()         { *m = PriceFeed{} }
+func (m *PriceFeed) String() string { return proto.CompactTextString(m) }
+func (*PriceFeed) ProtoMessage()    {}
+func (*PriceFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b982a0a6345d1d, []int{1}
+}
+func (m *PriceFeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceFeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceFeed.Merge(m, src)
+}
+func (m *PriceFeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceFeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceFeed proto.InternalMessageInfo
+
+func (m *PriceFeed) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PriceFeed) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *PriceFeed) GetMaxAge() uint64 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *PriceFeed) GetAggregation() PriceAggregation {
+	if m != nil {
+		return m.Aggregation
+	}
+	return PRICE_AGGREGATION_MEDIAN
+}
+
+func (m *PriceFeed) GetIgnoreConflicts() bool {
+	if m != nil {
+		return m.IgnoreConflicts
+	}
+	return false
+}
+
+// ChainGasEstimate describes the fees of an external chain: the feeds of its
+// gas price in gwei and of its base coin price, and the gas used by a
+// transfer and by a full batch
+type ChainGasEstimate struct {
+	ChainId      string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	GasPriceFeed string `protobuf:"bytes,2,opt,name=gas_price_feed,json=gasPriceFeed,proto3" json:"gas_price_feed,omitempty"`
+	BaseCoinFeed string `protobuf:"bytes,3,opt,name=base_coin_feed,json=baseCoinFeed,proto3" json:"base_coin_feed,omitempty"`
+	MinGas       uint64 `protobuf:"varint,4,opt,name=min_gas,json=minGas,proto3" json:"min_gas,omitempty"`
+	FastGas      uint64 `protobuf:"varint,5,opt,name=fast_gas,json=fastGas,proto3" json:"fast_gas,omitempty"`
+	BatchGas     uint64 `protobuf:"varint,6,opt,name=batch_gas,json=batchGas,proto3" json:"batch_gas,omitempty"`
+}
+
+func (m *ChainGasEstimate) Reset()         { *m = ChainGasEstimate{} }
+func (m *ChainGasEstimate) String() string { return proto.CompactTextString(m) }
+func (*ChainGasEstimate) ProtoMessage()    {}
+func (*ChainGasEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b982a0a6345d1d, []int{2}
+}
+func (m *ChainGasEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainGasEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainGasEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainGasEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainGasEstimate.Merge(m, src)
+}
+func (m *ChainGasEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainGasEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainGasEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainGasEstimate proto.InternalMessageInfo
+
+func (m *ChainGasEstimate) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainGasEstimate) GetGasPriceFeed() string {
+	if m != nil {
+		return m.GasPriceFeed
+	}
+	return ""
+}
+
+func (m *ChainGasEstimate) GetBaseCoinFeed() string {
+	if m != nil {
+		return m.BaseCoinFeed
+	}
+	return ""
+}
+
+func (m *ChainGasEstimate) GetMinGas() uint64 {
+	if m != nil {
+		return m.MinGas
+	}
+	return 0
+}
+
+func (m *ChainGasEstimate) GetFastGas() uint64 {
+	if m != nil {
+		return m.FastGas
+	}
+	return 0
+}
+
+func (m *ChainGasEstimate) GetBatchGas() uint64 {
+	if m != nil {
+		return m.BatchGas
+	}
+	return 0
+}
+
 // GenesisState struct
 type GenesisState struct {
 	Params  *Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 	Prices  *Prices  `protobuf:"bytes,2,opt,name=prices,proto3" json:"prices,omitempty"`
 	Holders *Holders `protobuf:"bytes,3,opt,name=holders,proto3" json:"holders,omitempty"`
+	// nonce of the current epoch, 0 starts the chain from the first epoch
+	Epoch uint64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// attestations and claims of the epochs which are not processed yet
+	Attestations []Attestation  `protobuf:"bytes,5,rep,name=attestations,proto3" json:"attestations"`
+	Claims       []GenericClaim `protobuf:"bytes,6,rep,name=claims,proto3" json:"claims"`
+	// prices held until a later epoch confirms them
+	PendingPrices        *Prices                `protobuf:"bytes,7,opt,name=pending_prices,json=pendingPrices,proto3" json:"pending_prices,omitempty"`
+	PriceHistory         []PriceSnapshot        `protobuf:"bytes,8,rep,name=price_history,json=priceHistory,proto3" json:"price_history"`
+	ValidatorOracleStats []ValidatorOracleStats `protobuf:"bytes,9,rep,name=validator_oracle_stats,json=validatorOracleStats,proto3" json:"validator_oracle_stats"`
+	Feeders              []FeederDelegation     `protobuf:"bytes,10,rep,name=feeders,proto3" json:"feeders"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14b982a0a6345d1d, []int{1}
+	return fileDescriptor_14b982a0a6345d1d, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -131,40 +401,187 @@ func (m *GenesisState) GetHolders() *Holders {
 	return nil
 }
 
+func (m *GenesisState) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaims() []GenericClaim {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingPrices() *Prices {
+	if m != nil {
+		return m.PendingPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceHistory() []PriceSnapshot {
+	if m != nil {
+		return m.PriceHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorOracleStats() []ValidatorOracleStats {
+	if m != nil {
+		return m.ValidatorOracleStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeders() []FeederDelegation {
+	if m != nil {
+		return m.Feeders
+	}
+	return nil
+}
+
+// FeederDelegation is the feeder account a validator delegated its oracle
+// claims to
+type FeederDelegation struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Feeder    string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty"`
+}
+
+func (m *FeederDelegation) Reset()         { *m = FeederDelegation{} }
+func (m *FeederDelegation) String() string { return proto.CompactTextString(m) }
+func (*FeederDelegation) ProtoMessage()    {}
+func (*FeederDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b982a0a6345d1d, []int{4}
+}
+func (m *FeederDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeederDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeederDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeederDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeederDelegation.Merge(m, src)
+}
+func (m *FeederDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeederDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeederDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeederDelegation proto.InternalMessageInfo
+
+func (m *FeederDelegation) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *FeederDelegation) GetFeeder() string {
+	if m != nil {
+		return m.Feeder
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("oracle.v1.PriceAggregation", PriceAggregation_name, PriceAggregation_value)
 	proto.RegisterType((*Params)(nil), "oracle.v1.Params")
+	proto.RegisterType((*PriceFeed)(nil), "oracle.v1.PriceFeed")
+	proto.RegisterType((*ChainGasEstimate)(nil), "oracle.v1.ChainGasEstimate")
 	proto.RegisterType((*GenesisState)(nil), "oracle.v1.GenesisState")
+	proto.RegisterType((*FeederDelegation)(nil), "oracle.v1.FeederDelegation")
 }
 
 func init() { proto.RegisterFile("oracle/v1/genesis.proto", fileDescriptor_14b982a0a6345d1d) }
 
 var fileDescriptor_14b982a0a6345d1d = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x4b, 0xe3, 0x40,
-	0x1c, 0xc5, 0x93, 0x6e, 0xe9, 0xb2, 0xd3, 0x5e, 0x76, 0xe8, 0xee, 0x96, 0x2e, 0x9b, 0x86, 0x1e,
-	0x96, 0x2e, 0xac, 0x19, 0x5b, 0x6f, 0x1e, 0xab, 0xa8, 0x20, 0x82, 0x44, 0x41, 0xf0, 0x52, 0xa7,
-	0x93, 0x69, 0x32, 0x98, 0x64, 0x42, 0x66, 0xda, 0xea, 0xcd, 0x8f, 0x20, 0x5e, 0xfd, 0x42, 0x3d,
-	0xf6, 0x28, 0x22, 0x45, 0xda, 0x2f, 0x22, 0x9d, 0x4c, 0x4d, 0xed, 0xd1, 0x53, 0xc2, 0xff, 0xf7,
-	0xe6, 0xbd, 0x99, 0x37, 0x03, 0x7e, 0xf1, 0x14, 0x93, 0x90, 0xa2, 0x51, 0x1b, 0xf9, 0x34, 0xa6,
-	0x82, 0x09, 0x27, 0x49, 0xb9, 0xe4, 0xf0, 0x5b, 0x06, 0x9c, 0x51, 0xbb, 0x5e, 0xf5, 0xb9, 0xcf,
-	0xd5, 0x14, 0x2d, 0xff, 0x32, 0x41, 0xfd, 0x77, 0xbe, 0x12, 0x4b, 0x49, 0x85, 0xc4, 0x92, 0xf1,
-	0x58, 0xc3, 0x1f, 0x39, 0x94, 0xb7, 0x09, 0xd5, 0xa6, 0xf5, 0x9f, 0xf9, 0x38, 0x49, 0x19, 0x59,
-	0xcd, 0x9b, 0x8f, 0x05, 0x50, 0x3a, 0xc5, 0x29, 0x8e, 0x04, 0xdc, 0x06, 0x55, 0xc1, 0xfc, 0x98,
-	0x7a, 0x3d, 0x12, 0x62, 0x16, 0x89, 0xde, 0x98, 0xc5, 0x1e, 0x1f, 0xd7, 0x4c, 0xdb, 0x6c, 0x15,
-	0x5d, 0x98, 0xb1, 0x3d, 0x85, 0x2e, 0x14, 0x81, 0x57, 0xa0, 0x2a, 0x42, 0x2c, 0x82, 0xde, 0x20,
-	0xc5, 0x64, 0xb9, 0x87, 0x6c, 0x65, 0xad, 0x60, 0x9b, 0xad, 0x4a, 0xd7, 0x99, 0xcc, 0x1a, 0xc6,
-	0xf3, 0xac, 0xf1, 0xd7, 0x67, 0x32, 0x18, 0xf6, 0x1d, 0xc2, 0x23, 0x44, 0xb8, 0x88, 0xb8, 0xd0,
-	0x9f, 0x2d, 0xe1, 0x5d, 0xeb, 0x4d, 0xee, 0x53, 0xe2, 0x42, 0xe5, 0x75, 0xa0, 0xad, 0x54, 0x10,
-	0x1c, 0x03, 0x7b, 0x33, 0x81, 0xc7, 0x83, 0x90, 0x11, 0xc9, 0x62, 0x5f, 0xa7, 0x7d, 0xf9, 0x54,
-	0xda, 0x9f, 0x8f, 0x69, 0xb9, 0xab, 0x0a, 0xde, 0x2d, 0xde, 0xbd, 0xd8, 0x46, 0xf3, 0xc1, 0x04,
-	0x95, 0xc3, 0xec, 0x72, 0xce, 0x24, 0x96, 0x14, 0xfe, 0x03, 0xa5, 0x44, 0xb5, 0xa5, 0x5a, 0x29,
-	0x77, 0xbe, 0x3b, 0xef, 0x97, 0xe5, 0x64, 0x35, 0xba, 0x5a, 0xa0, 0xa4, 0xaa, 0x69, 0x55, 0xc7,
-	0x86, 0x54, 0x01, 0x57, 0x0b, 0xe0, 0x7f, 0xf0, 0x35, 0xe0, 0xa1, 0x47, 0x53, 0xa1, 0x0e, 0x53,
-	0xee, 0xc0, 0x35, 0xed, 0x51, 0x46, 0xdc, 0x95, 0xa4, 0x7b, 0x3c, 0x99, 0x5b, 0xe6, 0x74, 0x6e,
-	0x99, 0xaf, 0x73, 0xcb, 0xbc, 0x5f, 0x58, 0xc6, 0x74, 0x61, 0x19, 0x4f, 0x0b, 0xcb, 0xb8, 0x6c,
-	0xaf, 0x9d, 0xfd, 0x84, 0xc5, 0x92, 0xa6, 0xe7, 0x14, 0x47, 0x28, 0x0a, 0x86, 0xfd, 0x0e, 0x8a,
-	0xb8, 0x37, 0x0c, 0x29, 0xba, 0x41, 0xfa, 0x25, 0xa8, 0x2a, 0xfa, 0x25, 0xf5, 0x0c, 0x76, 0xde,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xae, 0x1f, 0x3f, 0x8e, 0x02, 0x00, 0x00,
+	// 1025 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x23, 0x45,
+	0x13, 0xb6, 0x37, 0x8e, 0x3f, 0xca, 0x49, 0x5e, 0xa7, 0xe3, 0x4d, 0x66, 0x9d, 0x7d, 0x1d, 0x63,
+	0xad, 0x50, 0x16, 0x81, 0x4d, 0x82, 0x40, 0x68, 0x57, 0x48, 0x38, 0x5f, 0x4e, 0x04, 0xc9, 0x86,
+	0xd9, 0x05, 0x24, 0x90, 0x18, 0x3a, 0x33, 0x9d, 0x71, 0x0b, 0xcf, 0xf4, 0x68, 0x7a, 0xf2, 0xb1,
+	0x37, 0x8e, 0x68, 0xc5, 0x81, 0x3f, 0xb0, 0x27, 0x2e, 0xfc, 0x06, 0x0e, 0x9c, 0x57, 0x9c, 0xf6,
+	0x88, 0x10, 0x5a, 0xa1, 0xe4, 0x8f, 0xa0, 0xae, 0xee, 0xb1, 0x27, 0xde, 0xcd, 0x25, 0x27, 0xbb,
+	0xeb, 0x79, 0xaa, 0xaa, 0xab, 0xeb, 0xa9, 0xd2, 0xc0, 0x92, 0x88, 0xa9, 0x3b, 0x64, 0xdd, 0xd3,
+	0xb5, 0xae, 0xcf, 0x42, 0x26, 0xb9, 0xec, 0x44, 0xb1, 0x48, 0x04, 0xa9, 0x68, 0xa0, 0x73, 0xba,
+	0xd6, 0xa8, 0xfb, 0xc2, 0x17, 0x68, 0xed, 0xaa, 0x7f, 0x9a, 0xd0, 0x58, 0x1e, 0x7b, 0xd2, 0x24,
+	0x61, 0x32, 0xa1, 0x09, 0x17, 0xa1, 0x01, 0x6f, 0x8f, 0xc1, 0xe4, 0x69, 0xc4, 0x4c, 0xd0, 0xc6,
+	0xe2, 0xd8, 0x1c, 0xc5, 0xdc, 0x4d, 0xed, 0xed, 0xdf, 0x8a, 0x50, 0x3c, 0xa4, 0x31, 0x0d, 0x24,
+	0x79, 0x1f, 0xea, 0x92, 0xfb, 0x21, 0xf3, 0x1c, 0x77, 0x48, 0x79, 0x20, 0x9d, 0x33, 0x1e, 0x7a,
+	0xe2, 0xcc, 0xca, 0xb7, 0xf2, 0xab, 0x05, 0x9b, 0x68, 0x6c, 0x13, 0xa1, 0xaf, 0x11, 0x21, 0xdf,
+	0x43, 0x5d, 0x0e, 0xa9, 0x1c, 0x38, 0xc7, 0x31, 0x75, 0xd5, 0x1d, 0xb4, 0xa7, 0x75, 0xab, 0x95,
+	0x5f, 0x9d, 0xd9, 0xe8, 0xbc, 0x78, 0xb5, 0x92, 0xfb, 0xfb, 0xd5, 0xca, 0xdb, 0x3e, 0x4f, 0x06,
+	0x27, 0x47, 0x1d, 0x57, 0x04, 0x5d, 0x57, 0xc8, 0x40, 0x48, 0xf3, 0xf3, 0x9e, 0xf4, 0x7e, 0x30,
+	0x97, 0xdc, 0x62, 0xae, 0x4d, 0x30, 0xd6, 0x8e, 0x09, 0x85, 0x89, 0xc8, 0x19, 0xb4, 0x26, 0x33,
+	0x88, 0xf0, 0x78, 0xc8, 0xdd, 0x84, 0x87, 0xbe, 0xc9, 0x36, 0x75, 0xa3, 0x6c, 0xff, 0xbf, 0x9a,
+	0x6d, 0x1c, 0x55, 0x27, 0x6e, 0xc3, 0x2c, 0xbe, 0x93, 0x13, 0xd0, 0x73, 0x87, 0xfa, 0xcc, 0x2a,
+	0xe0, 0x2b, 0x54, 0xd1, 0xb8, 0x4f, 0xcf, 0x7b, 0x3e, 0x23, 0xdf, 0xc1, 0x82, 0x42, 0x35, 0xcf,
+	0x63, 0xa7, 0x1c, 0xfb, 0x60, 0x4d, 0xdf, 0xe8, 0x3e, 0xf3, 0x01, 0x3d, 0x3f, 0x54, 0x91, 0xb6,
+	0xd2, 0x40, 0xe4, 0x21, 0xe8, 0x74, 0xce, 0x31, 0x63, 0x9e, 0xb4, 0x8a, 0xad, 0xa9, 0xd5, 0xea,
+	0x7a, 0xbd, 0x33, 0x92, 0x47, 0x07, 0xf9, 0x3b, 0x8c, 0x79, 0x1b, 0x05, 0x95, 0xcd, 0x86, 0x28,
+	0x35, 0x48, 0xf2, 0x05, 0x2c, 0xb8, 0x03, 0xca, 0x43, 0xc7, 0xa7, 0xd2, 0x61, 0x32, 0xe1, 0x01,
+	0x4d, 0x98, 0xb4, 0x4a, 0x18, 0x64, 0x39, 0x13, 0x64, 0x53, 0xb1, 0xfa, 0x54, 0x6e, 0x1b, 0x8e,
+	0x89, 0x35, 0xef, 0x4e, 0xd8, 0x51, 0x20, 0xfa, 0x3e, 0x03, 0x2e, 0x13, 0x11, 0x3f, 0x75, 0x86,
+	0x2c, 0xf4, 0x93, 0x81, 0x55, 0xd6, 0x02, 0x41, 0x6c, 0x57, 0x43, 0x9f, 0x23, 0x42, 0xde, 0x82,
+	0x19, 0x16, 0x09, 0x77, 0x90, 0x32, 0x2b, 0xfa, 0x11, 0xd1, 0x66, 0x28, 0xeb, 0x70, 0x7b, 0x20,
+	0x86, 0x1e, 0x8b, 0xa5, 0x73, 0x12, 0x79, 0x34, 0x61, 0x4e, 0xc4, 0x62, 0x2e, 0x3c, 0x0b, 0x90,
+	0xbb, 0x60, 0xc0, 0x2f, 0x11, 0x3b, 0x44, 0x88, 0x84, 0xb0, 0x9c, 0x95, 0xc1, 0x64, 0x03, 0xaa,
+	0x37, 0x6a, 0xc0, 0x9d, 0x4c, 0xc8, 0xab, 0x8d, 0x78, 0x50, 0xf8, 0xf1, 0x9f, 0x56, 0xae, 0xfd,
+	0x47, 0x1e, 0x2a, 0xa3, 0x17, 0x27, 0x04, 0x0a, 0x21, 0x0d, 0x18, 0x4e, 0x47, 0xc5, 0xc6, 0xff,
+	0xa4, 0x01, 0x65, 0x8f, 0xb9, 0x3c, 0xa0, 0x43, 0x89, 0x33, 0x30, 0x6b, 0x8f, 0xce, 0x64, 0x09,
+	0x4a, 0xa9, 0x94, 0xa6, 0xb0, 0xb2, 0x62, 0xa0, 0x55, 0xf4, 0x09, 0x54, 0xa9, 0xef, 0xc7, 0xcc,
+	0xd7, 0x97, 0x57, 0x3a, 0x9b, 0xbb, 0xd2, 0x20, 0xcc, 0xd9, 0x1b, 0x53, 0xec, 0x2c, 0x9f, 0xdc,
+	0x87, 0x1a, 0xf7, 0x43, 0x11, 0xb3, 0xd1, 0x64, 0x48, 0x54, 0x60, 0xd9, 0xfe, 0x9f, 0xb6, 0xa7,
+	0xd2, 0x96, 0xed, 0x3f, 0xf3, 0x50, 0x9b, 0xec, 0x36, 0xb9, 0x03, 0x65, 0xad, 0x13, 0xee, 0x99,
+	0x5a, 0x4a, 0x78, 0xde, 0xf3, 0xc8, 0x3d, 0x98, 0x53, 0xe2, 0x19, 0x6b, 0x10, 0x8b, 0xaa, 0xd8,
+	0x33, 0x3e, 0x95, 0xe3, 0x87, 0xb8, 0x07, 0x73, 0x47, 0x54, 0xaa, 0xf4, 0x3c, 0xd4, 0xac, 0x29,
+	0xcd, 0x52, 0xd6, 0x4d, 0xc1, 0x43, 0x64, 0xa9, 0xf2, 0xb5, 0x18, 0xcd, 0x24, 0x15, 0x03, 0xbc,
+	0x87, 0xca, 0x7f, 0x4c, 0x65, 0x82, 0xc8, 0x34, 0x22, 0x25, 0x75, 0x56, 0xd0, 0x32, 0x54, 0x8e,
+	0x68, 0xe2, 0x0e, 0x10, 0x2b, 0x22, 0x56, 0x46, 0x43, 0x9f, 0xca, 0xf6, 0xef, 0x05, 0x98, 0xe9,
+	0xeb, 0xbd, 0xf9, 0x38, 0x51, 0x85, 0xdc, 0x87, 0x62, 0x84, 0x8b, 0x0c, 0xcb, 0xa8, 0xae, 0xcf,
+	0x67, 0x9f, 0x10, 0x01, 0xdb, 0x10, 0x90, 0x8a, 0x4b, 0xd0, 0xba, 0xf5, 0x3a, 0x15, 0x01, 0xdb,
+	0x10, 0xc8, 0xbb, 0x50, 0x32, 0x0a, 0xc4, 0xb2, 0xaa, 0xeb, 0x24, 0xc3, 0xdd, 0xd5, 0x88, 0x9d,
+	0x52, 0x48, 0x1d, 0xa6, 0x51, 0xdb, 0xa6, 0x46, 0x7d, 0x20, 0x9f, 0xc2, 0x4c, 0x66, 0x4f, 0xab,
+	0x32, 0xd5, 0x0c, 0x2e, 0x66, 0x02, 0xf5, 0xc6, 0xb0, 0x19, 0xbf, 0x2b, 0x1e, 0xe4, 0x43, 0x28,
+	0xea, 0x9d, 0x6c, 0x96, 0xc0, 0x52, 0xc6, 0x57, 0x3d, 0x42, 0xcc, 0x5d, 0x5c, 0x5b, 0xc6, 0xd9,
+	0x90, 0xc9, 0xc7, 0x30, 0x17, 0xb1, 0xd0, 0x1b, 0xcd, 0x88, 0x1a, 0xff, 0x6b, 0xea, 0x9d, 0x35,
+	0x44, 0x7d, 0x24, 0x9b, 0x30, 0x7b, 0x65, 0xd4, 0xad, 0x32, 0xe6, 0xb5, 0x26, 0x1d, 0x1f, 0x87,
+	0x34, 0x92, 0x03, 0x91, 0xa4, 0xb7, 0xce, 0xee, 0x00, 0xf2, 0x2d, 0x2c, 0x9e, 0xd2, 0x21, 0xf7,
+	0x68, 0x22, 0x62, 0x47, 0x3b, 0x3a, 0xaa, 0x24, 0x69, 0x55, 0x30, 0xda, 0x4a, 0x26, 0xda, 0x57,
+	0x29, 0xf1, 0x11, 0x9a, 0x54, 0x4b, 0xa5, 0x09, 0x5a, 0x3f, 0x7d, 0x03, 0x46, 0x1e, 0x42, 0x49,
+	0x89, 0x4d, 0x35, 0x06, 0x5e, 0xdb, 0x69, 0x3b, 0x88, 0x6c, 0xb1, 0xa1, 0x99, 0x12, 0x13, 0x29,
+	0xf5, 0x68, 0xef, 0x42, 0x6d, 0x92, 0x42, 0xee, 0x42, 0x65, 0x94, 0xc8, 0x4c, 0xc2, 0xd8, 0x40,
+	0x16, 0xa1, 0xa8, 0x9d, 0xcd, 0x0c, 0x98, 0xd3, 0x3b, 0x3f, 0xe7, 0xa1, 0x36, 0x39, 0xa0, 0xe4,
+	0x01, 0x58, 0x87, 0xf6, 0xde, 0xe6, 0xb6, 0xd3, 0xeb, 0xf7, 0xed, 0xed, 0x7e, 0xef, 0xc9, 0xde,
+	0xa3, 0x03, 0x67, 0x7f, 0x7b, 0x6b, 0xaf, 0x77, 0x50, 0xcb, 0x35, 0xee, 0x3e, 0x7b, 0xde, 0xba,
+	0x16, 0x27, 0x1f, 0xc1, 0xe2, 0x9b, 0xb0, 0xde, 0x41, 0x2d, 0xdf, 0x68, 0x3c, 0x7b, 0xde, 0xba,
+	0x06, 0x6d, 0x14, 0x7e, 0xfa, 0xb5, 0x99, 0xdb, 0xf8, 0xec, 0xc5, 0x45, 0x33, 0xff, 0xf2, 0xa2,
+	0x99, 0xff, 0xf7, 0xa2, 0x99, 0xff, 0xe5, 0xb2, 0x99, 0x7b, 0x79, 0xd9, 0xcc, 0xfd, 0x75, 0xd9,
+	0xcc, 0x7d, 0xb3, 0x96, 0x59, 0x83, 0xfb, 0x3c, 0x4c, 0x58, 0xfc, 0x84, 0xd1, 0xa0, 0x1b, 0x0c,
+	0x4e, 0x8e, 0xd6, 0xbb, 0x81, 0xf0, 0x4e, 0x86, 0xac, 0x7b, 0xde, 0x35, 0x5f, 0x09, 0xb8, 0x15,
+	0x8f, 0x8a, 0xf8, 0x89, 0xf0, 0xc1, 0x7f, 0x03, 0x00, 0xd1, 0xc7, 0x17, 0x7a, 0xaa, 0x08, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -187,6 +604,74 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ConflictingPriceDeviation.Size()
+		i -= size
+		if _, err := m.ConflictingPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.HoldersUpdatePeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HoldersUpdatePeriod))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PriceHistoryLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PriceHistoryLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ChainGasEstimates) > 0 {
+		for iNdEx := len(m.ChainGasEstimates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainGasEstimates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PriceFeeds) > 0 {
+		for iNdEx := len(m.PriceFeeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceFeeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PriceMaxAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PriceMaxAge))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.SlashFractionConflictingClaim.Size()
 		i -= size
@@ -215,7 +700,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *PriceFeed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -225,50 +710,288 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceFeed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Holders != nil {
-		{
-			size, err := m.Holders.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if m.IgnoreConflicts {
+		i--
+		if m.IgnoreConflicts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x28
 	}
-	if m.Prices != nil {
-		{
-			size, err := m.Prices.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if m.Aggregation != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Aggregation))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+	if m.MaxAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainGasEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainGasEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainGasEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchGas))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FastGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FastGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BaseCoinFeed) > 0 {
+		i -= len(m.BaseCoinFeed)
+		copy(dAtA[i:], m.BaseCoinFeed)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BaseCoinFeed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GasPriceFeed) > 0 {
+		i -= len(m.GasPriceFeed)
+		copy(dAtA[i:], m.GasPriceFeed)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GasPriceFeed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Feeders) > 0 {
+		for iNdEx := len(m.Feeders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Feeders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ValidatorOracleStats) > 0 {
+		for iNdEx := len(m.ValidatorOracleStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorOracleStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PriceHistory) > 0 {
+		for iNdEx := len(m.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PendingPrices != nil {
+		{
+			size, err := m.PendingPrices.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Holders != nil {
+		{
+			size, err := m.Holders.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Prices != nil {
+		{
+			size, err := m.Prices.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeederDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeederDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeederDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -298,6 +1021,89 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.SlashFractionConflictingClaim.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.PriceMaxAge != 0 {
+		n += 1 + sovGenesis(uint64(m.PriceMaxAge))
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PriceFeeds) > 0 {
+		for _, e := range m.PriceFeeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainGasEstimates) > 0 {
+		for _, e := range m.ChainGasEstimates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PriceHistoryLength != 0 {
+		n += 1 + sovGenesis(uint64(m.PriceHistoryLength))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochLength))
+	}
+	if m.HoldersUpdatePeriod != 0 {
+		n += 1 + sovGenesis(uint64(m.HoldersUpdatePeriod))
+	}
+	l = m.ConflictingPriceDeviation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PriceFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovGenesis(uint64(m.Decimals))
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxAge))
+	}
+	if m.Aggregation != 0 {
+		n += 1 + sovGenesis(uint64(m.Aggregation))
+	}
+	if m.IgnoreConflicts {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChainGasEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.GasPriceFeed)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BaseCoinFeed)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MinGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MinGas))
+	}
+	if m.FastGas != 0 {
+		n += 1 + sovGenesis(uint64(m.FastGas))
+	}
+	if m.BatchGas != 0 {
+		n += 1 + sovGenesis(uint64(m.BatchGas))
+	}
 	return n
 }
 
@@ -319,6 +1125,60 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Holders.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingPrices != nil {
+		l = m.PendingPrices.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PriceHistory) > 0 {
+		for _, e := range m.PriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorOracleStats) > 0 {
+		for _, e := range m.ValidatorOracleStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Feeders) > 0 {
+		for _, e := range m.Feeders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeederDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -442,33 +1302,611 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenesis
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMaxAge", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			m.PriceMaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceMaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceFeeds = append(m.PriceFeeds, PriceFeed{})
+			if err := m.PriceFeeds[len(m.PriceFeeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainGasEstimates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainGasEstimates = append(m.ChainGasEstimates, ChainGasEstimate{})
+			if err := m.ChainGasEstimates[len(m.ChainGasEstimates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryLength", wireType)
+			}
+			m.PriceHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldersUpdatePeriod", wireType)
+			}
+			m.HoldersUpdatePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldersUpdatePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingPriceDeviation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConflictingPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceFeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceFeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			m.Aggregation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Aggregation |= PriceAggregation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreConflicts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreConflicts = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainGasEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainGasEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainGasEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceFeed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPriceFeed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseCoinFeed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseCoinFeed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGas", wireType)
+			}
+			m.MinGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FastGas", wireType)
+			}
+			m.FastGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FastGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchGas", wireType)
+			}
+			m.BatchGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -476,28 +1914,155 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prices == nil {
+				m.Prices = &Prices{}
+			}
+			if err := m.Prices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Holders == nil {
+				m.Holders = &Holders{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Holders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -524,16 +2089,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, GenericClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -560,16 +2157,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Prices == nil {
-				m.Prices = &Prices{}
+			if m.PendingPrices == nil {
+				m.PendingPrices = &Prices{}
 			}
-			if err := m.Prices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingPrices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -596,12 +2193,195 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Holders == nil {
-				m.Holders = &Holders{}
+			m.PriceHistory = append(m.PriceHistory, PriceSnapshot{})
+			if err := m.PriceHistory[len(m.PriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Holders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOracleStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorOracleStats = append(m.ValidatorOracleStats, ValidatorOracleStats{})
+			if err := m.ValidatorOracleStats[len(m.ValidatorOracleStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeders = append(m.Feeders, FeederDelegation{})
+			if err := m.Feeders[len(m.Feeders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeederDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeederDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
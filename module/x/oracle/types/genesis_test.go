@@ -1,8 +1,10 @@
 package types
 
 import (
+	"bytes"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -13,6 +15,50 @@ func TestGenesisStateValidate(t *testing.T) {
 	}{
 		"default params": {src: DefaultGenesisState(), expErr: false},
 		"empty params":   {src: &GenesisState{Params: &Params{}}, expErr: false},
+		"duplicate feed": {src: withParams(func(p *Params) {
+			p.PriceFeeds = append(p.PriceFeeds, PriceFeed{Name: "eth"})
+		}), expErr: true},
+		"too many decimals": {src: withParams(func(p *Params) {
+			p.PriceFeeds[0].Decimals = 19
+		}), expErr: true},
+		"zero decimals": {src: withParams(func(p *Params) {
+			p.PriceFeeds[0].Decimals = 0
+		}), expErr: true},
+		"unknown aggregation": {src: withParams(func(p *Params) {
+			p.PriceFeeds[0].Aggregation = 2
+		}), expErr: true},
+		"unregistered gas price feed": {src: withParams(func(p *Params) {
+			p.ChainGasEstimates[0].GasPriceFeed = "polygon/gas"
+		}), expErr: true},
+		"fast gas below min gas": {src: withParams(func(p *Params) {
+			p.ChainGasEstimates[0].FastGas = 1
+		}), expErr: true},
+		"in-flight claim": {src: withClaim(func(s *GenesisState) {}), expErr: false},
+		"claim without vote": {src: withClaim(func(s *GenesisState) {
+			s.Attestations[0].Votes = nil
+		}), expErr: true},
+		"vote without claim": {src: withClaim(func(s *GenesisState) {
+			s.Claims = nil
+		}), expErr: true},
+		"attestation of future epoch": {src: withClaim(func(s *GenesisState) {
+			s.Epoch = 1
+		}), expErr: true},
+		"duplicate attestation": {src: withClaim(func(s *GenesisState) {
+			s.Attestations = append(s.Attestations, s.Attestations[0])
+		}), expErr: true},
+		"invalid vote": {src: withClaim(func(s *GenesisState) {
+			s.Attestations[0].Votes = []string{"invalid"}
+		}), expErr: true},
+		"duplicate price": {src: withClaim(func(s *GenesisState) {
+			s.Prices = &Prices{List: []*Price{{Name: "eth", Value: sdk.OneDec()}, {Name: "eth", Value: sdk.OneDec()}}}
+		}), expErr: true},
+		"shared feeder": {src: withClaim(func(s *GenesisState) {
+			feeder := sdk.AccAddress(bytes.Repeat([]byte{0xf}, 20)).String()
+			s.Feeders = []FeederDelegation{
+				{Validator: sdk.ValAddress(bytes.Repeat([]byte{0x1}, 20)).String(), Feeder: feeder},
+				{Validator: sdk.ValAddress(bytes.Repeat([]byte{0x2}, 20)).String(), Feeder: feeder},
+			}
+		}), expErr: true},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -26,6 +72,34 @@ func TestGenesisStateValidate(t *testing.T) {
 	}
 }
 
+// withParams returns the default genesis state with the params modified by the function
+func withParams(modify func(p *Params)) *GenesisState {
+	state := DefaultGenesisState()
+	modify(state.Params)
+	return state
+}
+
+// withClaim returns the default genesis state in the second epoch with a price claim of the epoch, the state
+// is modified by the function
+func withClaim(modify func(s *GenesisState)) *GenesisState {
+	validator := sdk.ValAddress(bytes.Repeat([]byte{0x1}, 20))
+	claim, err := GenericClaimFromInterface(&MsgPriceClaim{
+		Epoch:        2,
+		Prices:       &Prices{List: []*Price{{Name: "eth", Value: sdk.OneDec()}}},
+		Orchestrator: sdk.AccAddress(validator).String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	state := DefaultGenesisState()
+	state.Epoch = 2
+	state.Claims = []GenericClaim{*claim}
+	state.Attestations = []Attestation{{Epoch: 2, Votes: []string{validator.String()}, ClaimHash: claim.Hash}}
+	modify(state)
+	return state
+}
+
 func TestStringToByteArray(t *testing.T) {
 	specs := map[string]struct {
 		testString string
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
@@ -37,10 +40,66 @@ var (
 
 	CurrentEpochKey = []byte{0x3}
 
-	CurrentPricesKey  = []byte{0x4}
+	CurrentPricesKey = []byte{0x4}
+
+	// CurrentHoldersKey stored the whole list of holders, it is replaced by HolderKey and its list is moved
+	// to HolderKey by the v2 migration
 	CurrentHoldersKey = []byte{0x5}
+
+	// PendingPricesKey stores the prices which deviate too much from the current ones until they are confirmed
+	PendingPricesKey = []byte{0x6}
+
+	// HolderKey indexes the holders by their lowercase address
+	HolderKey = []byte{0x7}
+
+	// ValidatorOracleStatsKey indexes the oracle stats by the validator address
+	ValidatorOracleStatsKey = []byte{0x8}
+
+	// FeederValidatorKey indexes the validators by their delegated feeder address
+	FeederValidatorKey = []byte{0x9}
+
+	// ValidatorFeederKey indexes the delegated feeders by the validator address
+	ValidatorFeederKey = []byte{0xa}
+
+	// PriceHistoryKey indexes the price snapshots by the epoch
+	PriceHistoryKey = []byte{0xb}
 )
 
+// GetValidatorOracleStatsKey returns the following key format
+// prefix   validator-address
+// [0x8][0xc783df8a850f42e7f7e57013759c285caa701eb6]
+func GetValidatorOracleStatsKey(validator sdk.ValAddress) []byte {
+	return append(append([]byte{}, ValidatorOracleStatsKey...), address.MustLengthPrefix(validator)...)
+}
+
+// GetHolderKey returns the following key format
+// prefix   lowercase-address
+// [0x7][0x1234...]
+func GetHolderKey(address string) []byte {
+	return append(append([]byte{}, HolderKey...), []byte(strings.ToLower(address))...)
+}
+
+// GetFeederValidatorKey returns the following key format
+// prefix   feeder-address
+// [0x9][0xc783df8a850f42e7f7e57013759c285caa701eb6]
+func GetFeederValidatorKey(feeder sdk.AccAddress) []byte {
+	return append(append([]byte{}, FeederValidatorKey...), address.MustLengthPrefix(feeder)...)
+}
+
+// GetValidatorFeederKey returns the following key format
+// prefix   validator-address
+// [0xa][0xc783df8a850f42e7f7e57013759c285caa701eb6]
+func GetValidatorFeederKey(validator sdk.ValAddress) []byte {
+	return append(append([]byte{}, ValidatorFeederKey...), address.MustLengthPrefix(validator)...)
+}
+
+// GetPriceHistoryKey returns the following key format
+// prefix   epoch
+// [0xb][0 0 0 0 0 0 0 1]
+func GetPriceHistoryKey(epoch uint64) []byte {
+	return append(append([]byte{}, PriceHistoryKey...), UInt64Bytes(epoch)...)
+}
+
 // GetClaimKey returns the following key format
 // prefix type               cosmos-validator-address                       nonce                             attestation-details-hash
 // [0x0][0 0 0 1][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
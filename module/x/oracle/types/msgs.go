@@ -21,11 +21,14 @@ type Claim interface {
 }
 
 func toClaimType(input int32) ClaimType {
-	if input == 1 {
+	switch input {
+	case 1:
 		return CLAIM_TYPE_PRICE
-	} else {
-		return CLAIM_TYPE_UNKNOWN
+	case 2:
+		return CLAIM_TYPE_HOLDER
 	}
+
+	return CLAIM_TYPE_UNKNOWN
 }
 
 func fromClaimType(input ClaimType) int32 {
@@ -58,6 +61,18 @@ func (e *GenericClaim) GetClaimer() sdk.AccAddress {
 	return val
 }
 
+// UnpackClaim returns the price or holders claim wrapped by the generic claim
+func (e *GenericClaim) UnpackClaim() Claim {
+	switch claim := e.Claim.(type) {
+	case *GenericClaim_PriceClaim:
+		return claim.PriceClaim
+	case *GenericClaim_HoldersClaim:
+		return claim.HoldersClaim
+	}
+
+	return nil
+}
+
 func GenericClaimFromInterface(claim Claim) (*GenericClaim, error) {
 	err := claim.ValidateBasic()
 	if err != nil {
@@ -84,6 +99,8 @@ func GenericClaimFromInterface(claim Claim) (*GenericClaim, error) {
 }
 
 var (
+	_ sdk.Msg = &MsgDelegateFeeder{}
+
 	_ Claim = &MsgPriceClaim{}
 	_ Claim = &MsgHoldersClaim{}
 	_ Claim = &GenericClaim{}
@@ -212,3 +229,44 @@ func (msg *MsgHoldersClaim) StabilizedClaimHash() []byte {
 
 	return tmhash.Sum(b)
 }
+
+// NewMsgDelegateFeeder returns a reference to a new MsgDelegateFeeder
+func NewMsgDelegateFeeder(validator sdk.ValAddress, feeder sdk.AccAddress) *MsgDelegateFeeder {
+	return &MsgDelegateFeeder{
+		Validator: validator.String(),
+		Feeder:    feeder.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgDelegateFeeder) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgDelegateFeeder) Type() string { return "delegate_feeder" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgDelegateFeeder) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Feeder); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Feeder)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgDelegateFeeder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgDelegateFeeder) GetSigners() []sdk.AccAddress {
+	val, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(val)}
+}
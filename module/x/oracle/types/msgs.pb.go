@@ -221,41 +221,138 @@ func (m *MsgHoldersClaimResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgHoldersClaimResponse proto.InternalMessageInfo
 
+// MsgDelegateFeeder authorises the feeder account to submit the oracle claims
+// on behalf of the validator, replacing the previous feeder of the validator
+type MsgDelegateFeeder struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Feeder    string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty"`
+}
+
+func (m *MsgDelegateFeeder) Reset()         { *m = MsgDelegateFeeder{} }
+func (m *MsgDelegateFeeder) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateFeeder) ProtoMessage()    {}
+func (*MsgDelegateFeeder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dda9defd295d067, []int{4}
+}
+func (m *MsgDelegateFeeder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateFeeder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateFeeder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateFeeder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateFeeder.Merge(m, src)
+}
+func (m *MsgDelegateFeeder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateFeeder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateFeeder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateFeeder proto.InternalMessageInfo
+
+func (m *MsgDelegateFeeder) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MsgDelegateFeeder) GetFeeder() string {
+	if m != nil {
+		return m.Feeder
+	}
+	return ""
+}
+
+type MsgDelegateFeederResponse struct {
+}
+
+func (m *MsgDelegateFeederResponse) Reset()         { *m = MsgDelegateFeederResponse{} }
+func (m *MsgDelegateFeederResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateFeederResponse) ProtoMessage()    {}
+func (*MsgDelegateFeederResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dda9defd295d067, []int{5}
+}
+func (m *MsgDelegateFeederResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateFeederResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateFeederResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateFeederResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateFeederResponse.Merge(m, src)
+}
+func (m *MsgDelegateFeederResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateFeederResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateFeederResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateFeederResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgPriceClaim)(nil), "oracle.v1.MsgPriceClaim")
 	proto.RegisterType((*MsgPriceClaimResponse)(nil), "oracle.v1.MsgPriceClaimResponse")
 	proto.RegisterType((*MsgHoldersClaim)(nil), "oracle.v1.MsgHoldersClaim")
 	proto.RegisterType((*MsgHoldersClaimResponse)(nil), "oracle.v1.MsgHoldersClaimResponse")
+	proto.RegisterType((*MsgDelegateFeeder)(nil), "oracle.v1.MsgDelegateFeeder")
+	proto.RegisterType((*MsgDelegateFeederResponse)(nil), "oracle.v1.MsgDelegateFeederResponse")
 }
 
 func init() { proto.RegisterFile("oracle/v1/msgs.proto", fileDescriptor_6dda9defd295d067) }
 
 var fileDescriptor_6dda9defd295d067 = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x4b, 0xf3, 0x40,
-	0x10, 0xed, 0xb6, 0xdf, 0x57, 0xe9, 0x5a, 0x11, 0x43, 0x6d, 0x63, 0x90, 0x10, 0x72, 0xaa, 0x20,
-	0x59, 0x5a, 0xff, 0x81, 0x5e, 0x04, 0x09, 0x48, 0xf0, 0xe4, 0x45, 0xd2, 0x74, 0xd9, 0x04, 0x92,
-	0x4c, 0xd8, 0xdd, 0x16, 0x7b, 0xf5, 0x17, 0x08, 0xfe, 0x29, 0x8f, 0x05, 0x2f, 0x1e, 0xb5, 0xf5,
-	0x87, 0x48, 0x93, 0x6d, 0x4d, 0x0a, 0x15, 0x6f, 0x93, 0x37, 0x6f, 0xde, 0x7b, 0x33, 0x59, 0xdc,
-	0x01, 0xee, 0x07, 0x31, 0x25, 0xd3, 0x01, 0x49, 0x04, 0x13, 0x4e, 0xc6, 0x41, 0x82, 0xd6, 0x2a,
-	0x50, 0x67, 0x3a, 0x30, 0x4e, 0x19, 0x00, 0x8b, 0x29, 0xf1, 0xb3, 0x88, 0xf8, 0x69, 0x0a, 0xd2,
-	0x97, 0x11, 0xa4, 0x8a, 0x68, 0x74, 0x18, 0x30, 0xc8, 0x4b, 0xb2, 0xaa, 0x14, 0xda, 0xfd, 0x11,
-	0xcd, 0x78, 0x14, 0x50, 0xc5, 0xb6, 0x25, 0x3e, 0x70, 0x05, 0xbb, 0x5d, 0x41, 0x57, 0xb1, 0x1f,
-	0x25, 0x5a, 0x07, 0xff, 0xa7, 0x19, 0x04, 0xa1, 0x8e, 0x2c, 0xd4, 0xff, 0xe7, 0x15, 0x1f, 0xda,
-	0x19, 0x6e, 0x16, 0x63, 0x7a, 0xdd, 0x42, 0xfd, 0xfd, 0xe1, 0x91, 0xb3, 0x89, 0xe3, 0xe4, 0xc3,
-	0xc2, 0x53, 0x04, 0xcd, 0xc6, 0x6d, 0xe0, 0x41, 0x48, 0x85, 0xe4, 0xbe, 0x04, 0xae, 0x37, 0x2c,
-	0xd4, 0x6f, 0x79, 0x15, 0xcc, 0xee, 0xe1, 0xe3, 0x8a, 0xab, 0x47, 0x45, 0x06, 0xa9, 0xa0, 0xf6,
-	0x0c, 0x1f, 0xba, 0x82, 0x5d, 0x43, 0x3c, 0xa6, 0x5c, 0xfc, 0x16, 0xe8, 0x1c, 0xef, 0x85, 0x05,
-	0x4b, 0x25, 0xd2, 0x4a, 0x89, 0xd4, 0xbc, 0xb7, 0xa6, 0xfc, 0x29, 0xd3, 0x09, 0xee, 0x6d, 0x59,
-	0xaf, 0x53, 0x0d, 0x3f, 0x11, 0x6e, 0xb8, 0x82, 0x69, 0x21, 0xc6, 0xa5, 0x4b, 0xe9, 0x25, 0xc7,
-	0xca, 0x36, 0x86, 0xb5, 0xab, 0xb3, 0xd9, 0xd3, 0x7c, 0x7a, 0xfb, 0x7a, 0xa9, 0xeb, 0x76, 0x97,
-	0x6c, 0xfd, 0x97, 0x87, 0x20, 0xd7, 0xce, 0x70, 0xbb, 0x72, 0x04, 0xa3, 0xaa, 0x58, 0xee, 0x19,
-	0xf6, 0xee, 0xde, 0xc6, 0xcf, 0xca, 0xfd, 0x0c, 0x5b, 0x2f, 0xf9, 0xa9, 0xe3, 0x14, 0x8e, 0x97,
-	0x37, 0xaf, 0x0b, 0x13, 0xcd, 0x17, 0x26, 0xfa, 0x58, 0x98, 0xe8, 0x79, 0x69, 0xd6, 0xe6, 0x4b,
-	0xb3, 0xf6, 0xbe, 0x34, 0x6b, 0xf7, 0x03, 0x16, 0xc9, 0x70, 0x32, 0x72, 0x02, 0x48, 0x88, 0x1b,
-	0xa5, 0x92, 0xf2, 0x3b, 0xea, 0x27, 0x24, 0x09, 0x27, 0xa3, 0x21, 0x49, 0x60, 0x3c, 0x89, 0x29,
-	0x79, 0x5c, 0xeb, 0xca, 0x59, 0x46, 0xc5, 0xa8, 0x99, 0x3f, 0xae, 0x8b, 0xef, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xbf, 0x62, 0xd1, 0xa8, 0xcb, 0x02, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x37, 0x5d, 0xad, 0xf4, 0xb9, 0x2a, 0x1b, 0x6a, 0x37, 0x1b, 0x4b, 0x08, 0x83, 0x87,
+	0x0a, 0x92, 0xa1, 0xf5, 0x1b, 0xa8, 0x88, 0x22, 0x01, 0x09, 0x9e, 0xbc, 0x2c, 0x69, 0xf2, 0x9c,
+	0x04, 0x92, 0x4c, 0x98, 0x99, 0x96, 0xdd, 0xab, 0x9f, 0x40, 0xf0, 0x4b, 0x79, 0x5c, 0xf0, 0x22,
+	0x78, 0x91, 0xd6, 0x0f, 0x22, 0x3b, 0x99, 0x66, 0x93, 0xca, 0x2e, 0xde, 0x66, 0xde, 0xfb, 0xcf,
+	0xff, 0xf7, 0xcf, 0x7b, 0x04, 0xc6, 0x5c, 0xc4, 0x49, 0x81, 0x74, 0x3d, 0xa7, 0xa5, 0x64, 0x32,
+	0xa8, 0x05, 0x57, 0xdc, 0x1e, 0x35, 0xd5, 0x60, 0x3d, 0x77, 0xa7, 0x8c, 0x73, 0x56, 0x20, 0x8d,
+	0xeb, 0x9c, 0xc6, 0x55, 0xc5, 0x55, 0xac, 0x72, 0x5e, 0x19, 0xa1, 0x3b, 0x66, 0x9c, 0x71, 0x7d,
+	0xa4, 0x57, 0x27, 0x53, 0x9d, 0x5c, 0x9b, 0xd6, 0x22, 0x4f, 0xd0, 0xa8, 0x89, 0x82, 0x07, 0xa1,
+	0x64, 0x1f, 0xae, 0x4a, 0xaf, 0x8a, 0x38, 0x2f, 0xed, 0x31, 0xdc, 0xc5, 0x9a, 0x27, 0x99, 0x63,
+	0xf9, 0xd6, 0xec, 0x4e, 0xd4, 0x5c, 0xec, 0x67, 0x30, 0x6c, 0x9e, 0x39, 0x03, 0xdf, 0x9a, 0xdd,
+	0x5f, 0x1c, 0x07, 0x6d, 0x9c, 0x40, 0x3f, 0x96, 0x91, 0x11, 0xd8, 0x04, 0x8e, 0xb8, 0x48, 0x32,
+	0x94, 0x4a, 0xc4, 0x8a, 0x0b, 0xe7, 0xd0, 0xb7, 0x66, 0xa3, 0xa8, 0x57, 0x23, 0x27, 0xf0, 0xb8,
+	0x47, 0x8d, 0x50, 0xd6, 0xbc, 0x92, 0x48, 0x2e, 0xe0, 0x51, 0x28, 0xd9, 0x5b, 0x5e, 0xa4, 0x28,
+	0xe4, 0x6d, 0x81, 0x9e, 0xc3, 0xbd, 0xac, 0x51, 0x99, 0x44, 0x76, 0x27, 0x91, 0x79, 0x1f, 0xed,
+	0x24, 0xff, 0x95, 0xe9, 0x14, 0x4e, 0xf6, 0xd0, 0x6d, 0xaa, 0x77, 0x70, 0x1c, 0x4a, 0xf6, 0x1a,
+	0x0b, 0x64, 0xb1, 0xc2, 0x37, 0x88, 0x29, 0x0a, 0x7b, 0x0a, 0xa3, 0x75, 0x5c, 0xe4, 0xa9, 0x36,
+	0xb4, 0xb4, 0xe1, 0x75, 0xc1, 0x9e, 0xc0, 0xf0, 0xb3, 0xd6, 0xe9, 0x78, 0xa3, 0xc8, 0xdc, 0xc8,
+	0x13, 0x38, 0xfd, 0xc7, 0x6a, 0xc7, 0x59, 0xfc, 0x1a, 0xc0, 0x61, 0x28, 0x99, 0x9d, 0x01, 0x74,
+	0x36, 0xe2, 0x74, 0xbe, 0xac, 0x37, 0x35, 0xd7, 0xbf, 0xa9, 0xd3, 0x26, 0xf7, 0xbe, 0xfc, 0xf8,
+	0xf3, 0x6d, 0xe0, 0x90, 0x09, 0xdd, 0xdb, 0xff, 0x59, 0xa2, 0xbd, 0x6b, 0x38, 0xea, 0x0d, 0xdb,
+	0xed, 0x3b, 0x76, 0x7b, 0x2e, 0xb9, 0xb9, 0xd7, 0xf2, 0x7c, 0xcd, 0x73, 0x89, 0xd3, 0xe1, 0x99,
+	0x25, 0x18, 0xe2, 0x39, 0x3c, 0xdc, 0x1f, 0x64, 0xdf, 0xb7, 0xdf, 0x75, 0x9f, 0xde, 0xd6, 0x6d,
+	0xb9, 0x44, 0x73, 0xa7, 0xc4, 0xed, 0x70, 0x53, 0x23, 0x3d, 0x6b, 0x46, 0xff, 0xf2, 0xfd, 0xf7,
+	0x8d, 0x67, 0x5d, 0x6e, 0x3c, 0xeb, 0xf7, 0xc6, 0xb3, 0xbe, 0x6e, 0xbd, 0x83, 0xcb, 0xad, 0x77,
+	0xf0, 0x73, 0xeb, 0x1d, 0x7c, 0x9a, 0xb3, 0x5c, 0x65, 0xab, 0x65, 0x90, 0xf0, 0x92, 0x86, 0x79,
+	0xa5, 0x50, 0x7c, 0xc4, 0xb8, 0xa4, 0x65, 0xb6, 0x5a, 0x2e, 0x68, 0xc9, 0xd3, 0x55, 0x81, 0xf4,
+	0x7c, 0xe7, 0xac, 0x2e, 0x6a, 0x94, 0xcb, 0xa1, 0xfe, 0x7d, 0x5e, 0xfc, 0x1d, 0x00, 0x7c, 0x3d,
+	0x20, 0xf8, 0xad, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -272,6 +369,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	PriceClaim(ctx context.Context, in *MsgPriceClaim, opts ...grpc.CallOption) (*MsgPriceClaimResponse, error)
 	HoldersClaim(ctx context.Context, in *MsgHoldersClaim, opts ...grpc.CallOption) (*MsgHoldersClaimResponse, error)
+	DelegateFeeder(ctx context.Context, in *MsgDelegateFeeder, opts ...grpc.CallOption) (*MsgDelegateFeederResponse, error)
 }
 
 type msgClient struct {
@@ -300,10 +398,20 @@ func (c *msgClient) HoldersClaim(ctx context.Context, in *MsgHoldersClaim, opts
 	return out, nil
 }
 
+func (c *msgClient) DelegateFeeder(ctx context.Context, in *MsgDelegateFeeder, opts ...grpc.CallOption) (*MsgDelegateFeederResponse, error) {
+	out := new(MsgDelegateFeederResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Msg/DelegateFeeder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	PriceClaim(context.Context, *MsgPriceClaim) (*MsgPriceClaimResponse, error)
 	HoldersClaim(context.Context, *MsgHoldersClaim) (*MsgHoldersClaimResponse, error)
+	DelegateFeeder(context.Context, *MsgDelegateFeeder) (*MsgDelegateFeederResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -316,6 +424,9 @@ func (*UnimplementedMsgServer) PriceClaim(ctx context.Context, req *MsgPriceClai
 func (*UnimplementedMsgServer) HoldersClaim(ctx context.Context, req *MsgHoldersClaim) (*MsgHoldersClaimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HoldersClaim not implemented")
 }
+func (*UnimplementedMsgServer) DelegateFeeder(ctx context.Context, req *MsgDelegateFeeder) (*MsgDelegateFeederResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateFeeder not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -357,6 +468,24 @@ func _Msg_HoldersClaim_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateFeeder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateFeeder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Msg/DelegateFeeder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateFeeder(ctx, req.(*MsgDelegateFeeder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -369,6 +498,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HoldersClaim",
 			Handler:    _Msg_HoldersClaim_Handler,
 		},
+		{
+			MethodName: "DelegateFeeder",
+			Handler:    _Msg_DelegateFeeder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "oracle/v1/msgs.proto",
@@ -514,6 +647,66 @@ func (m *MsgHoldersClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDelegateFeeder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateFeeder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateFeeder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateFeederResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateFeederResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateFeederResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgs(v)
 	base := offset
@@ -583,6 +776,32 @@ func (m *MsgHoldersClaimResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDelegateFeeder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateFeederResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovMsgs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -975,6 +1194,176 @@ func (m *MsgHoldersClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelegateFeeder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateFeeder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateFeeder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateFeederResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateFeederResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateFeederResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -71,6 +71,9 @@ func (m *Prices) GetList() []*Price {
 type Price struct {
 	Name  string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Value github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+	// epoch and block height of the last update of a stored price, unset in claims
+	Epoch  uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height int64  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *Price) Reset()         { *m = Price{} }
@@ -113,6 +116,135 @@ func (m *Price) GetName() string {
 	return ""
 }
 
+func (m *Price) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Price) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PriceSnapshot holds the current prices after the update of an epoch
+type PriceSnapshot struct {
+	Epoch  uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Prices []*Price `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`
+}
+
+func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
+func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PriceSnapshot) ProtoMessage()    {}
+func (*PriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c63c7cc6a0469859, []int{2}
+}
+func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSnapshot.Merge(m, src)
+}
+func (m *PriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceSnapshot proto.InternalMessageInfo
+
+func (m *PriceSnapshot) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *PriceSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PriceSnapshot) GetPrices() []*Price {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+// HistoricalPrice is the value of a price after the update of an epoch
+type HistoricalPrice struct {
+	Epoch  uint64                                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Value  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+}
+
+func (m *HistoricalPrice) Reset()         { *m = HistoricalPrice{} }
+func (m *HistoricalPrice) String() string { return proto.CompactTextString(m) }
+func (*HistoricalPrice) ProtoMessage()    {}
+func (*HistoricalPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c63c7cc6a0469859, []int{3}
+}
+func (m *HistoricalPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricalPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalPrice.Merge(m, src)
+}
+func (m *HistoricalPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalPrice proto.InternalMessageInfo
+
+func (m *HistoricalPrice) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *HistoricalPrice) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type Holders struct {
 	List []*Holder `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
@@ -121,7 +253,7 @@ func (m *Holders) Reset()         { *m = Holders{} }
 func (m *Holders) String() string { return proto.CompactTextString(m) }
 func (*Holders) ProtoMessage()    {}
 func (*Holders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c63c7cc6a0469859, []int{2}
+	return fileDescriptor_c63c7cc6a0469859, []int{4}
 }
 func (m *Holders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,7 +298,7 @@ func (m *Holder) Reset()         { *m = Holder{} }
 func (m *Holder) String() string { return proto.CompactTextString(m) }
 func (*Holder) ProtoMessage()    {}
 func (*Holder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c63c7cc6a0469859, []int{3}
+	return fileDescriptor_c63c7cc6a0469859, []int{5}
 }
 func (m *Holder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -205,6 +337,8 @@ func (m *Holder) GetAddress() string {
 func init() {
 	proto.RegisterType((*Prices)(nil), "oracle.v1.Prices")
 	proto.RegisterType((*Price)(nil), "oracle.v1.Price")
+	proto.RegisterType((*PriceSnapshot)(nil), "oracle.v1.PriceSnapshot")
+	proto.RegisterType((*HistoricalPrice)(nil), "oracle.v1.HistoricalPrice")
 	proto.RegisterType((*Holders)(nil), "oracle.v1.Holders")
 	proto.RegisterType((*Holder)(nil), "oracle.v1.Holder")
 }
@@ -212,26 +346,31 @@ func init() {
 func init() { proto.RegisterFile("oracle/v1/prices.proto", fileDescriptor_c63c7cc6a0469859) }
 
 var fileDescriptor_c63c7cc6a0469859 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0xcf, 0x4a, 0xf4, 0x30,
-	0x14, 0xc5, 0x9b, 0xef, 0x9b, 0x3f, 0x4c, 0xdc, 0x68, 0x10, 0x29, 0x2e, 0x32, 0x43, 0x51, 0x99,
-	0x8d, 0x89, 0x1d, 0xdf, 0x60, 0x98, 0x85, 0x22, 0x82, 0x14, 0x57, 0xee, 0xd2, 0x36, 0xb4, 0xc5,
-	0xa6, 0x29, 0x49, 0x5a, 0xf4, 0x2d, 0x7c, 0xac, 0x59, 0xce, 0x52, 0x5c, 0x0c, 0xd2, 0xbe, 0x88,
-	0x4c, 0x5a, 0x65, 0x5c, 0xbb, 0xca, 0x3d, 0x27, 0xe7, 0x5c, 0x7e, 0x70, 0xe1, 0x89, 0x54, 0x2c,
-	0xca, 0x39, 0xad, 0x7d, 0x5a, 0xaa, 0x2c, 0xe2, 0x9a, 0x94, 0x4a, 0x1a, 0x89, 0x26, 0x9d, 0x4f,
-	0x6a, 0xff, 0xf4, 0x38, 0x91, 0x89, 0xb4, 0x2e, 0xdd, 0x4d, 0x5d, 0xc0, 0x23, 0x70, 0xf4, 0x60,
-	0x0b, 0xe8, 0x0c, 0x0e, 0xf2, 0x4c, 0x1b, 0x17, 0xcc, 0xfe, 0xcf, 0x0f, 0x16, 0x87, 0xe4, 0xa7,
-	0x49, 0x6c, 0x20, 0xb0, 0xbf, 0x1e, 0x83, 0x43, 0x2b, 0x11, 0x82, 0x83, 0x82, 0x09, 0xee, 0x82,
-	0x19, 0x98, 0x4f, 0x02, 0x3b, 0xa3, 0x15, 0x1c, 0xd6, 0x2c, 0xaf, 0xb8, 0xfb, 0x6f, 0x67, 0x2e,
-	0xc9, 0x7a, 0x3b, 0x75, 0x3e, 0xb6, 0xd3, 0x8b, 0x24, 0x33, 0x69, 0x15, 0x92, 0x48, 0x0a, 0x1a,
-	0x49, 0x2d, 0xa4, 0xee, 0x9f, 0x4b, 0x1d, 0x3f, 0x53, 0xf3, 0x5a, 0x72, 0x4d, 0x56, 0x3c, 0x0a,
-	0xba, 0xb2, 0x77, 0x05, 0xc7, 0x37, 0x32, 0x8f, 0xb9, 0xd2, 0xe8, 0xfc, 0x17, 0xd3, 0xd1, 0x1e,
-	0x53, 0x97, 0xe8, 0xa1, 0x52, 0x38, 0xea, 0x34, 0x72, 0xe1, 0x98, 0xc5, 0xb1, 0xe2, 0x5a, 0xf7,
-	0x60, 0xdf, 0xf2, 0x2f, 0x6c, 0xb7, 0x85, 0xe9, 0xd9, 0x96, 0x77, 0xeb, 0x06, 0x83, 0x4d, 0x83,
-	0xc1, 0x67, 0x83, 0xc1, 0x5b, 0x8b, 0x9d, 0x4d, 0x8b, 0x9d, 0xf7, 0x16, 0x3b, 0x4f, 0xfe, 0xde,
-	0xa2, 0xfb, 0xac, 0x30, 0x5c, 0x3d, 0x72, 0x26, 0xa8, 0x48, 0xab, 0x70, 0x41, 0x85, 0x8c, 0xab,
-	0x9c, 0xd3, 0x17, 0xda, 0x9f, 0xc9, 0xee, 0x0d, 0x47, 0xf6, 0x04, 0xd7, 0x5f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xe2, 0x92, 0xf4, 0x5c, 0xbd, 0x01, 0x00, 0x00,
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xc1, 0x6a, 0xe3, 0x30,
+	0x10, 0xb5, 0x62, 0xc7, 0x21, 0x5a, 0x96, 0xdd, 0x15, 0x21, 0x98, 0x3d, 0x38, 0xc6, 0xec, 0x2e,
+	0xbe, 0xac, 0xd4, 0xa4, 0x7f, 0x10, 0x72, 0x48, 0x29, 0x85, 0xe2, 0xf6, 0xd4, 0x9b, 0x63, 0x0b,
+	0xdb, 0xd4, 0xb6, 0x8c, 0x25, 0x87, 0xf6, 0x03, 0x7a, 0x6e, 0x3f, 0x2b, 0xc7, 0x1c, 0x4b, 0x0f,
+	0xa1, 0x24, 0x3f, 0x52, 0x22, 0xb9, 0x69, 0x0a, 0xed, 0xa1, 0xe4, 0xe4, 0x79, 0x33, 0xf3, 0xc6,
+	0xa3, 0xf7, 0x06, 0xf6, 0x59, 0x15, 0x84, 0x19, 0x25, 0xf3, 0x21, 0x29, 0xab, 0x34, 0xa4, 0x1c,
+	0x97, 0x15, 0x13, 0x0c, 0x75, 0x55, 0x1e, 0xcf, 0x87, 0xbf, 0x7b, 0x31, 0x8b, 0x99, 0xcc, 0x92,
+	0x6d, 0xa4, 0x1a, 0x5c, 0x0c, 0xcd, 0x73, 0x49, 0x40, 0x7f, 0xa0, 0x91, 0xa5, 0x5c, 0x58, 0xc0,
+	0xd1, 0xbd, 0x6f, 0xa3, 0x9f, 0x78, 0xc7, 0xc4, 0xb2, 0xc1, 0x97, 0x55, 0xf7, 0x1e, 0xc0, 0xb6,
+	0xc4, 0x08, 0x41, 0xa3, 0x08, 0x72, 0x6a, 0x01, 0x07, 0x78, 0x5d, 0x5f, 0xc6, 0x68, 0x02, 0xdb,
+	0xf3, 0x20, 0xab, 0xa9, 0xd5, 0xda, 0x26, 0xc7, 0x78, 0xb1, 0x1a, 0x68, 0x4f, 0xab, 0xc1, 0xbf,
+	0x38, 0x15, 0x49, 0x3d, 0xc3, 0x21, 0xcb, 0x49, 0xc8, 0x78, 0xce, 0x78, 0xf3, 0xf9, 0xcf, 0xa3,
+	0x6b, 0x22, 0x6e, 0x4b, 0xca, 0xf1, 0x84, 0x86, 0xbe, 0x22, 0xa3, 0x1e, 0x6c, 0xd3, 0x92, 0x85,
+	0x89, 0xa5, 0x3b, 0xc0, 0x33, 0x7c, 0x05, 0x50, 0x1f, 0x9a, 0x09, 0x4d, 0xe3, 0x44, 0x58, 0x86,
+	0x03, 0x3c, 0xdd, 0x6f, 0x90, 0x1b, 0xc3, 0xef, 0x72, 0xa1, 0x8b, 0x22, 0x28, 0x79, 0xc2, 0xc4,
+	0x1b, 0x1d, 0x7c, 0x4c, 0x6f, 0xed, 0xd3, 0x91, 0x07, 0x4d, 0xa5, 0x98, 0xa5, 0x7f, 0xf2, 0xf0,
+	0xa6, 0xee, 0xde, 0x01, 0xf8, 0x63, 0x9a, 0x72, 0xc1, 0xaa, 0x34, 0x0c, 0x32, 0x25, 0xc2, 0xd7,
+	0xfe, 0xb5, 0x93, 0x47, 0x3f, 0x40, 0x1e, 0xf7, 0x08, 0x76, 0xa6, 0x2c, 0x8b, 0x68, 0xc5, 0xd1,
+	0xdf, 0x77, 0x9e, 0xfd, 0xda, 0x5b, 0x5d, 0x75, 0x34, 0xa6, 0x25, 0xd0, 0x54, 0x18, 0x59, 0xb0,
+	0x13, 0x44, 0x51, 0x45, 0x39, 0x6f, 0x7c, 0x7b, 0x85, 0x87, 0x58, 0x77, 0x52, 0x88, 0x66, 0xb7,
+	0xf1, 0xe9, 0x62, 0x6d, 0x83, 0xe5, 0xda, 0x06, 0xcf, 0x6b, 0x1b, 0x3c, 0x6c, 0x6c, 0x6d, 0xb9,
+	0xb1, 0xb5, 0xc7, 0x8d, 0xad, 0x5d, 0x0d, 0xf7, 0x06, 0x9d, 0xa5, 0x85, 0xa0, 0xd5, 0x25, 0x0d,
+	0x72, 0x92, 0x27, 0xf5, 0x6c, 0x44, 0x72, 0x16, 0xd5, 0x19, 0x25, 0x37, 0xa4, 0x39, 0x63, 0x39,
+	0x77, 0x66, 0xca, 0x13, 0x3d, 0x7e, 0x19, 0x00, 0xa6, 0x55, 0xbe, 0x5b, 0xdd, 0x02, 0x00, 0x00,
 }
 
 func (m *Prices) Marshal() (dAtA []byte, err error) {
@@ -291,6 +430,16 @@ func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPrices(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintPrices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -311,6 +460,96 @@ func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPrices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintPrices(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintPrices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPrices(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintPrices(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintPrices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Holders) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -426,6 +665,50 @@ func (m *Price) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovPrices(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovPrices(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPrices(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovPrices(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPrices(uint64(m.Height))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovPrices(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HistoricalPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovPrices(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPrices(uint64(m.Height))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovPrices(uint64(l))
 	return n
 }
 
@@ -647,6 +930,294 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPrices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPrices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, &Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPrices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPrices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPrices(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -190,14 +191,121 @@ func (m *QueryPricesResponse) GetPrices() *Prices {
 	return nil
 }
 
+// QueryPriceHistoryRequest selects the history of the price in the inclusive
+// range of epochs, to_epoch 0 stands for the current epoch
+type QueryPriceHistoryRequest struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FromEpoch uint64 `protobuf:"varint,2,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64 `protobuf:"varint,3,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+}
+
+func (m *QueryPriceHistoryRequest) Reset()         { *m = QueryPriceHistoryRequest{} }
+func (m *QueryPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{4}
+}
+func (m *QueryPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryRequest.Merge(m, src)
+}
+func (m *QueryPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPriceHistoryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryPriceHistoryRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *QueryPriceHistoryRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+type QueryPriceHistoryResponse struct {
+	Prices []HistoricalPrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+}
+
+func (m *QueryPriceHistoryResponse) Reset()         { *m = QueryPriceHistoryResponse{} }
+func (m *QueryPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{5}
+}
+func (m *QueryPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryResponse.Merge(m, src)
+}
+func (m *QueryPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPriceHistoryResponse) GetPrices() []HistoricalPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
 type QueryHoldersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryHoldersRequest) Reset()         { *m = QueryHoldersRequest{} }
 func (m *QueryHoldersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHoldersRequest) ProtoMessage()    {}
 func (*QueryHoldersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{4}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{6}
 }
 func (m *QueryHoldersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,15 +334,23 @@ func (m *QueryHoldersRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryHoldersRequest proto.InternalMessageInfo
 
+func (m *QueryHoldersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryHoldersResponse struct {
-	Holders *Holders `protobuf:"bytes,1,opt,name=holders,proto3" json:"holders,omitempty"`
+	Holders    *Holders            `protobuf:"bytes,1,opt,name=holders,proto3" json:"holders,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryHoldersResponse) Reset()         { *m = QueryHoldersResponse{} }
 func (m *QueryHoldersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHoldersResponse) ProtoMessage()    {}
 func (*QueryHoldersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{5}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{7}
 }
 func (m *QueryHoldersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,21 +386,29 @@ func (m *QueryHoldersResponse) GetHolders() *Holders {
 	return nil
 }
 
-type QueryEthFeeRequest struct {
+func (m *QueryHoldersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryHolderRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryEthFeeRequest) Reset()         { *m = QueryEthFeeRequest{} }
-func (m *QueryEthFeeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEthFeeRequest) ProtoMessage()    {}
-func (*QueryEthFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{6}
+func (m *QueryHolderRequest) Reset()         { *m = QueryHolderRequest{} }
+func (m *QueryHolderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHolderRequest) ProtoMessage()    {}
+func (*QueryHolderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{8}
 }
-func (m *QueryEthFeeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryHolderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEthFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHolderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEthFeeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHolderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -294,35 +418,41 @@ func (m *QueryEthFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryEthFeeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEthFeeRequest.Merge(m, src)
+func (m *QueryHolderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHolderRequest.Merge(m, src)
 }
-func (m *QueryEthFeeRequest) XXX_Size() int {
+func (m *QueryHolderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEthFeeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEthFeeRequest.DiscardUnknown(m)
+func (m *QueryHolderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHolderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEthFeeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryHolderRequest proto.InternalMessageInfo
+
+func (m *QueryHolderRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
 
-type QueryEthFeeResponse struct {
-	Min  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min"`
-	Fast github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fast,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fast"`
+type QueryHolderResponse struct {
+	Holder *Holder `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
 }
 
-func (m *QueryEthFeeResponse) Reset()         { *m = QueryEthFeeResponse{} }
-func (m *QueryEthFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEthFeeResponse) ProtoMessage()    {}
-func (*QueryEthFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{7}
+func (m *QueryHolderResponse) Reset()         { *m = QueryHolderResponse{} }
+func (m *QueryHolderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHolderResponse) ProtoMessage()    {}
+func (*QueryHolderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{9}
 }
-func (m *QueryEthFeeResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryHolderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEthFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHolderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEthFeeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHolderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -332,33 +462,41 @@ func (m *QueryEthFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryEthFeeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEthFeeResponse.Merge(m, src)
+func (m *QueryHolderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHolderResponse.Merge(m, src)
 }
-func (m *QueryEthFeeResponse) XXX_Size() int {
+func (m *QueryHolderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEthFeeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEthFeeResponse.DiscardUnknown(m)
+func (m *QueryHolderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHolderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEthFeeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryHolderResponse proto.InternalMessageInfo
 
-type QueryBscFeeRequest struct {
+func (m *QueryHolderResponse) GetHolder() *Holder {
+	if m != nil {
+		return m.Holder
+	}
+	return nil
 }
 
-func (m *QueryBscFeeRequest) Reset()         { *m = QueryBscFeeRequest{} }
-func (m *QueryBscFeeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBscFeeRequest) ProtoMessage()    {}
-func (*QueryBscFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{8}
+type QueryValidatorOracleStatsRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
-func (m *QueryBscFeeRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryValidatorOracleStatsRequest) Reset()         { *m = QueryValidatorOracleStatsRequest{} }
+func (m *QueryValidatorOracleStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOracleStatsRequest) ProtoMessage()    {}
+func (*QueryValidatorOracleStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{10}
+}
+func (m *QueryValidatorOracleStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBscFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorOracleStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBscFeeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorOracleStatsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -368,35 +506,306 @@ func (m *QueryBscFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryBscFeeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBscFeeRequest.Merge(m, src)
+func (m *QueryValidatorOracleStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOracleStatsRequest.Merge(m, src)
 }
-func (m *QueryBscFeeRequest) XXX_Size() int {
+func (m *QueryValidatorOracleStatsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBscFeeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBscFeeRequest.DiscardUnknown(m)
+func (m *QueryValidatorOracleStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOracleStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBscFeeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorOracleStatsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorOracleStatsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
 
-type QueryBscFeeResponse struct {
-	Min  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min"`
-	Fast github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fast,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fast"`
+type QueryValidatorOracleStatsResponse struct {
+	Stats *ValidatorOracleStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (m *QueryBscFeeResponse) Reset()         { *m = QueryBscFeeResponse{} }
-func (m *QueryBscFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBscFeeResponse) ProtoMessage()    {}
-func (*QueryBscFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{9}
+func (m *QueryValidatorOracleStatsResponse) Reset()         { *m = QueryValidatorOracleStatsResponse{} }
+func (m *QueryValidatorOracleStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOracleStatsResponse) ProtoMessage()    {}
+func (*QueryValidatorOracleStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{11}
+}
+func (m *QueryValidatorOracleStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOracleStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOracleStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorOracleStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOracleStatsResponse.Merge(m, src)
+}
+func (m *QueryValidatorOracleStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOracleStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOracleStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorOracleStatsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorOracleStatsResponse) GetStats() *ValidatorOracleStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// QueryFeederRequest resolves the validator the account submits the oracle
+// claims for
+type QueryFeederRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryFeederRequest) Reset()         { *m = QueryFeederRequest{} }
+func (m *QueryFeederRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeederRequest) ProtoMessage()    {}
+func (*QueryFeederRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{12}
+}
+func (m *QueryFeederRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeederRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeederRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeederRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeederRequest.Merge(m, src)
+}
+func (m *QueryFeederRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeederRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeederRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeederRequest proto.InternalMessageInfo
+
+func (m *QueryFeederRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryFeederResponse struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Bonded           bool   `protobuf:"varint,2,opt,name=bonded,proto3" json:"bonded,omitempty"`
+}
+
+func (m *QueryFeederResponse) Reset()         { *m = QueryFeederResponse{} }
+func (m *QueryFeederResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeederResponse) ProtoMessage()    {}
+func (*QueryFeederResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{13}
+}
+func (m *QueryFeederResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeederResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeederResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeederResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeederResponse.Merge(m, src)
+}
+func (m *QueryFeederResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeederResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeederResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeederResponse proto.InternalMessageInfo
+
+func (m *QueryFeederResponse) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueryFeederResponse) GetBonded() bool {
+	if m != nil {
+		return m.Bonded
+	}
+	return false
+}
+
+// QueryChainFeeResponse holds the fees of the external chain in usd: the min
+// and fast fees of a single transfer and the cost of a full batch
+type QueryChainFeeRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryChainFeeRequest) Reset()         { *m = QueryChainFeeRequest{} }
+func (m *QueryChainFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainFeeRequest) ProtoMessage()    {}
+func (*QueryChainFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{14}
+}
+func (m *QueryChainFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainFeeRequest.Merge(m, src)
+}
+func (m *QueryChainFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainFeeRequest proto.InternalMessageInfo
+
+func (m *QueryChainFeeRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryChainFeeResponse struct {
+	Min   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min"`
+	Fast  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fast,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fast"`
+	Batch github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=batch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"batch"`
+}
+
+func (m *QueryChainFeeResponse) Reset()         { *m = QueryChainFeeResponse{} }
+func (m *QueryChainFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainFeeResponse) ProtoMessage()    {}
+func (*QueryChainFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{15}
+}
+func (m *QueryChainFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainFeeResponse.Merge(m, src)
+}
+func (m *QueryChainFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainFeeResponse proto.InternalMessageInfo
+
+// QueryPriceFeedsResponse lists the feeds required in the price claims, the
+// feeds of the bridged denoms included
+type QueryPriceFeedsRequest struct {
+}
+
+func (m *QueryPriceFeedsRequest) Reset()         { *m = QueryPriceFeedsRequest{} }
+func (m *QueryPriceFeedsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceFeedsRequest) ProtoMessage()    {}
+func (*QueryPriceFeedsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{16}
+}
+func (m *QueryPriceFeedsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceFeedsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceFeedsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceFeedsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceFeedsRequest.Merge(m, src)
+}
+func (m *QueryPriceFeedsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceFeedsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceFeedsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceFeedsRequest proto.InternalMessageInfo
+
+type QueryPriceFeedsResponse struct {
+	Feeds []PriceFeed `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds"`
 }
-func (m *QueryBscFeeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryPriceFeedsResponse) Reset()         { *m = QueryPriceFeedsResponse{} }
+func (m *QueryPriceFeedsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceFeedsResponse) ProtoMessage()    {}
+func (*QueryPriceFeedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{17}
+}
+func (m *QueryPriceFeedsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBscFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceFeedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBscFeeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceFeedsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -406,69 +815,113 @@ func (m *QueryBscFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryBscFeeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBscFeeResponse.Merge(m, src)
+func (m *QueryPriceFeedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceFeedsResponse.Merge(m, src)
 }
-func (m *QueryBscFeeResponse) XXX_Size() int {
+func (m *QueryPriceFeedsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBscFeeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBscFeeResponse.DiscardUnknown(m)
+func (m *QueryPriceFeedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceFeedsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBscFeeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceFeedsResponse proto.InternalMessageInfo
+
+func (m *QueryPriceFeedsResponse) GetFeeds() []PriceFeed {
+	if m != nil {
+		return m.Feeds
+	}
+	return nil
+}
 
 func init() {
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "oracle.v1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "oracle.v1.QueryCurrentEpochResponse")
 	proto.RegisterType((*QueryPricesRequest)(nil), "oracle.v1.QueryPricesRequest")
 	proto.RegisterType((*QueryPricesResponse)(nil), "oracle.v1.QueryPricesResponse")
+	proto.RegisterType((*QueryPriceHistoryRequest)(nil), "oracle.v1.QueryPriceHistoryRequest")
+	proto.RegisterType((*QueryPriceHistoryResponse)(nil), "oracle.v1.QueryPriceHistoryResponse")
 	proto.RegisterType((*QueryHoldersRequest)(nil), "oracle.v1.QueryHoldersRequest")
 	proto.RegisterType((*QueryHoldersResponse)(nil), "oracle.v1.QueryHoldersResponse")
-	proto.RegisterType((*QueryEthFeeRequest)(nil), "oracle.v1.QueryEthFeeRequest")
-	proto.RegisterType((*QueryEthFeeResponse)(nil), "oracle.v1.QueryEthFeeResponse")
-	proto.RegisterType((*QueryBscFeeRequest)(nil), "oracle.v1.QueryBscFeeRequest")
-	proto.RegisterType((*QueryBscFeeResponse)(nil), "oracle.v1.QueryBscFeeResponse")
+	proto.RegisterType((*QueryHolderRequest)(nil), "oracle.v1.QueryHolderRequest")
+	proto.RegisterType((*QueryHolderResponse)(nil), "oracle.v1.QueryHolderResponse")
+	proto.RegisterType((*QueryValidatorOracleStatsRequest)(nil), "oracle.v1.QueryValidatorOracleStatsRequest")
+	proto.RegisterType((*QueryValidatorOracleStatsResponse)(nil), "oracle.v1.QueryValidatorOracleStatsResponse")
+	proto.RegisterType((*QueryFeederRequest)(nil), "oracle.v1.QueryFeederRequest")
+	proto.RegisterType((*QueryFeederResponse)(nil), "oracle.v1.QueryFeederResponse")
+	proto.RegisterType((*QueryChainFeeRequest)(nil), "oracle.v1.QueryChainFeeRequest")
+	proto.RegisterType((*QueryChainFeeResponse)(nil), "oracle.v1.QueryChainFeeResponse")
+	proto.RegisterType((*QueryPriceFeedsRequest)(nil), "oracle.v1.QueryPriceFeedsRequest")
+	proto.RegisterType((*QueryPriceFeedsResponse)(nil), "oracle.v1.QueryPriceFeedsResponse")
 }
 
 func init() { proto.RegisterFile("oracle/v1/query.proto", fileDescriptor_34238c8dfdfcd7ec) }
 
 var fileDescriptor_34238c8dfdfcd7ec = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x37, 0xb5, 0x4d, 0xe9, 0xe8, 0xc1, 0x4e, 0xb7, 0xba, 0x1b, 0x34, 0x5b, 0xa2, 0x14,
-	0x05, 0xcd, 0xb0, 0xeb, 0x17, 0x28, 0xdb, 0x56, 0x04, 0x11, 0xb4, 0x78, 0xf2, 0x22, 0xc9, 0xec,
-	0xdb, 0x24, 0xb8, 0xc9, 0xa4, 0x99, 0x49, 0xb1, 0x78, 0xf3, 0x13, 0x08, 0x1e, 0x3d, 0xf8, 0x75,
-	0x7a, 0x2c, 0x78, 0x11, 0x0f, 0x45, 0x76, 0xfd, 0x20, 0xb2, 0x33, 0x93, 0x34, 0x69, 0xba, 0x7b,
-	0xf0, 0xe4, 0x29, 0xe1, 0x3f, 0x6f, 0x7e, 0xff, 0x7f, 0x78, 0xef, 0x05, 0x6d, 0xb3, 0xcc, 0xa3,
-	0x13, 0x20, 0x27, 0x7d, 0x72, 0x9c, 0x43, 0x76, 0xea, 0xa6, 0x19, 0x13, 0x0c, 0x6f, 0x28, 0xd9,
-	0x3d, 0xe9, 0x5b, 0xf7, 0x02, 0xc6, 0x82, 0x09, 0x10, 0x2f, 0x8d, 0x88, 0x97, 0x24, 0x4c, 0x78,
-	0x22, 0x62, 0x09, 0x57, 0x85, 0x56, 0xe5, 0xbe, 0x38, 0x4d, 0xa1, 0x90, 0xef, 0x5c, 0xca, 0x69,
-	0x16, 0xd1, 0x52, 0x6f, 0x07, 0x2c, 0x60, 0xf2, 0x95, 0xcc, 0xdf, 0x94, 0xea, 0x58, 0xa8, 0xf3,
-	0x66, 0x6e, 0xbe, 0x9f, 0x67, 0x19, 0x24, 0xe2, 0x30, 0x65, 0x34, 0x3c, 0x82, 0xe3, 0x1c, 0xb8,
-	0x70, 0xf6, 0x51, 0xf7, 0x9a, 0x33, 0x9e, 0xb2, 0x84, 0x03, 0xde, 0x45, 0x6b, 0x30, 0x17, 0x3a,
-	0xc6, 0x8e, 0xf1, 0xe8, 0xe6, 0xe0, 0xb6, 0x5b, 0xc6, 0x76, 0x55, 0xa1, 0x3a, 0x76, 0xda, 0x08,
-	0x4b, 0xc8, 0x6b, 0x99, 0xa5, 0x40, 0xef, 0xa1, 0xad, 0x9a, 0xaa, 0xa1, 0x8f, 0x91, 0xa9, 0x32,
-	0x6b, 0xea, 0x66, 0x85, 0xaa, 0x4b, 0x75, 0x81, 0xb3, 0xad, 0x09, 0x2f, 0xd8, 0x64, 0x04, 0x59,
-	0x09, 0x3e, 0x40, 0xed, 0xba, 0xac, 0xc9, 0x4f, 0xd0, 0x7a, 0xa8, 0x24, 0x8d, 0xc6, 0x15, 0x74,
-	0x51, 0x5c, 0x94, 0x94, 0xa1, 0x0f, 0x45, 0xf8, 0x1c, 0xa0, 0x60, 0x7f, 0x33, 0xb4, 0x67, 0x21,
-	0x6b, 0xf6, 0x1e, 0xba, 0x11, 0x47, 0x89, 0xe4, 0x6e, 0x0c, 0xdd, 0xb3, 0x8b, 0x5e, 0xeb, 0xd7,
-	0x45, 0x6f, 0x37, 0x88, 0x44, 0x98, 0xfb, 0x2e, 0x65, 0x31, 0xa1, 0x8c, 0xc7, 0x8c, 0xeb, 0xc7,
-	0x53, 0x3e, 0xfa, 0xa0, 0x1b, 0x76, 0x00, 0xf4, 0x68, 0x7e, 0x15, 0x0f, 0xd1, 0xea, 0xd8, 0xe3,
-	0xa2, 0xb3, 0xf2, 0x4f, 0x08, 0x79, 0xb7, 0xcc, 0x3c, 0xe4, 0xf4, 0xba, 0xcc, 0x85, 0xfc, 0x3f,
-	0x65, 0x1e, 0x7c, 0x5f, 0x45, 0x6b, 0x32, 0x1d, 0xfe, 0x84, 0x6e, 0x55, 0xc7, 0x0c, 0x3f, 0xa8,
-	0xb4, 0x67, 0xd1, 0x80, 0x5a, 0x0f, 0x97, 0x17, 0xa9, 0x4f, 0x75, 0x76, 0x3e, 0xff, 0xf8, 0xf3,
-	0x75, 0xc5, 0xc2, 0x1d, 0x72, 0xb9, 0x19, 0x72, 0x36, 0x09, 0x55, 0xe5, 0x38, 0x44, 0xa6, 0x6a,
-	0x29, 0xbe, 0x7f, 0x95, 0x58, 0x9b, 0x00, 0xcb, 0x5e, 0x74, 0xac, 0xad, 0x7a, 0xd2, 0xaa, 0x8b,
-	0xef, 0x56, 0xad, 0x44, 0x08, 0x19, 0xe4, 0xf1, 0xfb, 0x31, 0x00, 0xa6, 0xc8, 0x54, 0x8d, 0x68,
-	0x3a, 0xd5, 0xfa, 0xd6, 0x74, 0xaa, 0xf7, 0xcf, 0xb1, 0xa4, 0x53, 0x1b, 0xe3, 0x8a, 0x93, 0xcf,
-	0xa9, 0x34, 0xf1, 0x91, 0xa9, 0x96, 0xa5, 0x69, 0x52, 0xdb, 0xc2, 0xa6, 0x49, 0x7d, 0x1d, 0x9d,
-	0xae, 0x34, 0xd9, 0xc2, 0x9b, 0xe4, 0xea, 0x3f, 0x05, 0x8f, 0xd1, 0xba, 0xde, 0x1a, 0xdc, 0xa0,
-	0xd4, 0x57, 0xd2, 0xea, 0x2d, 0x3c, 0x5f, 0xf2, 0x2d, 0x7a, 0x13, 0x87, 0x2f, 0xcf, 0xa6, 0xb6,
-	0x71, 0x3e, 0xb5, 0x8d, 0xdf, 0x53, 0xdb, 0xf8, 0x32, 0xb3, 0x5b, 0xe7, 0x33, 0xbb, 0xf5, 0x73,
-	0x66, 0xb7, 0xde, 0xf5, 0x2b, 0x93, 0xf6, 0x2a, 0x4a, 0x04, 0x64, 0x6f, 0xc1, 0x8b, 0x49, 0x1c,
-	0xe6, 0xfe, 0x80, 0xc4, 0x6c, 0x94, 0x4f, 0x80, 0x7c, 0x2c, 0x88, 0x72, 0xf0, 0x7c, 0x53, 0xfe,
-	0xf3, 0x9e, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x11, 0xf2, 0x1f, 0x4f, 0x7a, 0x05, 0x00, 0x00,
+	// 995 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x6d, 0x4b, 0xb6, 0x27, 0x3d, 0xc4, 0x6b, 0xc5, 0x91, 0x89, 0x84, 0x52, 0x58, 0xc3,
+	0x75, 0x9b, 0x94, 0x5b, 0xb9, 0x28, 0xda, 0x63, 0x6a, 0xa7, 0x6e, 0x8a, 0xa0, 0x48, 0xaa, 0xfe,
+	0x1c, 0x0c, 0x14, 0xc6, 0x8a, 0x5c, 0x49, 0x44, 0x45, 0xae, 0xc2, 0xa5, 0xdc, 0x1a, 0x86, 0x2f,
+	0xbd, 0x17, 0x28, 0xd0, 0xb7, 0xe8, 0x83, 0x14, 0x39, 0x06, 0xe8, 0xa5, 0xe8, 0x21, 0x28, 0xac,
+	0x3e, 0x48, 0xc1, 0xdd, 0x21, 0x45, 0x8a, 0x92, 0x1a, 0xe7, 0x24, 0x72, 0xe6, 0x9b, 0x99, 0x6f,
+	0x67, 0x86, 0xdf, 0x0a, 0x6e, 0x89, 0x88, 0xb9, 0x03, 0x4e, 0xcf, 0x5a, 0xf4, 0xf9, 0x88, 0x47,
+	0xe7, 0xce, 0x30, 0x12, 0xb1, 0x20, 0x1b, 0xda, 0xec, 0x9c, 0xb5, 0xcc, 0x3b, 0x3d, 0x21, 0x7a,
+	0x03, 0x4e, 0xd9, 0xd0, 0xa7, 0x2c, 0x0c, 0x45, 0xcc, 0x62, 0x5f, 0x84, 0x52, 0x03, 0xcd, 0x5c,
+	0x7c, 0x7c, 0x3e, 0xe4, 0xa9, 0x79, 0x7b, 0x62, 0x1e, 0x46, 0xbe, 0x9b, 0xd9, 0x6f, 0x4f, 0xec,
+	0x3d, 0x1e, 0x72, 0xe9, 0xa7, 0x8e, 0x5a, 0x4f, 0xf4, 0x84, 0x7a, 0xa4, 0xc9, 0x13, 0x5a, 0xdf,
+	0x73, 0x85, 0x0c, 0x84, 0xa4, 0x1d, 0x26, 0xb9, 0xe6, 0x47, 0xcf, 0x5a, 0x1d, 0x1e, 0xb3, 0x16,
+	0x1d, 0xb2, 0x9e, 0x1f, 0x2a, 0x2a, 0x1a, 0x6b, 0x9b, 0x50, 0xff, 0x2a, 0x41, 0x1c, 0x8d, 0xa2,
+	0x88, 0x87, 0xf1, 0x67, 0x43, 0xe1, 0xf6, 0xdb, 0xfc, 0xf9, 0x88, 0xcb, 0xd8, 0x3e, 0x82, 0x9d,
+	0x19, 0x3e, 0x39, 0x14, 0xa1, 0xe4, 0x64, 0x0f, 0x2a, 0x3c, 0x31, 0xd4, 0x8d, 0xa6, 0xb1, 0x7f,
+	0xe3, 0xe0, 0xa6, 0x93, 0x9d, 0xdd, 0xd1, 0x40, 0xed, 0xb6, 0x6b, 0x40, 0x54, 0x92, 0x67, 0xea,
+	0x40, 0x69, 0xea, 0x87, 0xb0, 0x55, 0xb0, 0x62, 0xd2, 0x77, 0xa1, 0xaa, 0x0f, 0x8e, 0x59, 0x37,
+	0x73, 0x59, 0x11, 0x8a, 0x00, 0xbb, 0x8f, 0xc4, 0x95, 0xf9, 0xb1, 0x2f, 0x63, 0x11, 0x9d, 0x63,
+	0x76, 0x42, 0x60, 0x35, 0x64, 0x01, 0x57, 0x49, 0x36, 0xda, 0xea, 0x99, 0xdc, 0x05, 0xe8, 0x46,
+	0x22, 0x38, 0xd5, 0xa4, 0x97, 0x9b, 0xc6, 0xfe, 0x6a, 0x7b, 0x23, 0xb1, 0x28, 0xb6, 0x64, 0x07,
+	0xd6, 0x63, 0x81, 0xce, 0x15, 0xe5, 0x5c, 0x8b, 0x85, 0x72, 0xd9, 0xdf, 0xc2, 0xce, 0x8c, 0x4a,
+	0xc8, 0xf8, 0x93, 0x1c, 0xe3, 0x95, 0xfd, 0x1b, 0x07, 0x66, 0x8e, 0xb1, 0xc6, 0xfa, 0x2e, 0x1b,
+	0xa8, 0xd0, 0xc3, 0xd5, 0x17, 0xaf, 0x1a, 0x4b, 0xd9, 0x01, 0xbe, 0xc7, 0x16, 0x3c, 0x16, 0x03,
+	0x8f, 0x47, 0x69, 0x67, 0xc8, 0x31, 0xc0, 0x64, 0x48, 0xd8, 0x86, 0x3d, 0x47, 0x4f, 0xd4, 0x49,
+	0x26, 0xea, 0xe8, 0x8d, 0xc3, 0x89, 0x3a, 0xcf, 0x58, 0x8f, 0x63, 0x6c, 0x3b, 0x17, 0x69, 0xff,
+	0x62, 0x40, 0xad, 0x98, 0x1f, 0x19, 0x3f, 0x80, 0xb5, 0xbe, 0x36, 0x61, 0x76, 0x92, 0xa7, 0x8c,
+	0xe0, 0x14, 0x42, 0x3e, 0x2f, 0xd0, 0x59, 0x56, 0x01, 0xef, 0xfc, 0x2f, 0x1d, 0x5d, 0xaa, 0xc0,
+	0xc7, 0xc1, 0x3d, 0xd0, 0x15, 0xd2, 0xd3, 0xd6, 0x61, 0x8d, 0x79, 0x5e, 0xc4, 0xa5, 0xc4, 0x61,
+	0xa5, 0xaf, 0xd9, 0x86, 0xa4, 0xf8, 0xc9, 0x86, 0x68, 0x6a, 0x33, 0x36, 0x04, 0xa1, 0x08, 0xb0,
+	0x9f, 0x42, 0x53, 0x65, 0xf8, 0x8e, 0x0d, 0x7c, 0x8f, 0xc5, 0x22, 0x7a, 0xaa, 0x90, 0x5f, 0xc7,
+	0x2c, 0xce, 0xba, 0x7d, 0x1f, 0x36, 0xcf, 0x52, 0xf7, 0x69, 0x91, 0xc9, 0xcd, 0xcc, 0xf1, 0x29,
+	0x52, 0x3a, 0x81, 0x7b, 0x0b, 0x12, 0x22, 0xc1, 0x8f, 0xa0, 0x22, 0x13, 0x03, 0xf2, 0x6b, 0xe4,
+	0xf8, 0xcd, 0x8c, 0xd3, 0xe8, 0xac, 0x3d, 0xc7, 0x9c, 0xbf, 0x56, 0x7b, 0x4e, 0x60, 0xab, 0x80,
+	0xc7, 0xea, 0xd7, 0x39, 0x0f, 0xd9, 0x86, 0x6a, 0x47, 0x84, 0x1e, 0xf7, 0xd4, 0x5c, 0xd7, 0xdb,
+	0xf8, 0x66, 0xb7, 0x70, 0x73, 0x8e, 0xfa, 0xcc, 0x0f, 0x8f, 0x79, 0xba, 0x5e, 0xc9, 0x37, 0xe2,
+	0x26, 0xa6, 0x53, 0xdf, 0x4b, 0xe9, 0xa8, 0xf7, 0x2f, 0x3c, 0x7b, 0x6c, 0xc0, 0xad, 0xa9, 0x18,
+	0x64, 0xf4, 0x10, 0x56, 0x02, 0x5f, 0x2f, 0xf2, 0xc6, 0xa1, 0x93, 0x7c, 0x01, 0x7f, 0xbf, 0x6a,
+	0xec, 0xf5, 0xfc, 0xb8, 0x3f, 0xea, 0x38, 0xae, 0x08, 0x28, 0x8a, 0x95, 0xfe, 0x79, 0x5f, 0x7a,
+	0x3f, 0xa0, 0x24, 0x3e, 0xe2, 0x6e, 0x3b, 0x09, 0x25, 0x87, 0xb0, 0xda, 0x65, 0x32, 0xae, 0x2f,
+	0xbf, 0x51, 0x0a, 0x15, 0x4b, 0x1e, 0x41, 0xa5, 0xc3, 0x62, 0xfc, 0xb6, 0xaf, 0x9f, 0x44, 0x07,
+	0xdb, 0x75, 0xd8, 0x9e, 0x28, 0x41, 0xd2, 0xf9, 0x4c, 0xcf, 0x9e, 0xc0, 0xed, 0x92, 0x07, 0x1b,
+	0xf0, 0x01, 0x54, 0xba, 0x89, 0x01, 0x05, 0xa2, 0x36, 0x2d, 0x69, 0x09, 0x1a, 0xa5, 0x41, 0x03,
+	0x0f, 0xfe, 0x58, 0x87, 0x8a, 0xca, 0x46, 0x2e, 0xe0, 0xad, 0xbc, 0xf8, 0x92, 0xb7, 0x73, 0xc1,
+	0xf3, 0x64, 0xdb, 0xdc, 0x5d, 0x0c, 0xd2, 0xb4, 0xec, 0xe6, 0xcf, 0x7f, 0xfe, 0xfb, 0xdb, 0xb2,
+	0x49, 0xea, 0x74, 0x72, 0xb9, 0x28, 0xf9, 0xa3, 0xae, 0x86, 0x93, 0x1f, 0x61, 0x3d, 0x9d, 0x26,
+	0x69, 0x94, 0x72, 0x16, 0x77, 0xc3, 0x6c, 0xce, 0x07, 0x60, 0xc1, 0x7d, 0x55, 0xd0, 0x26, 0xcd,
+	0x5c, 0x41, 0xb5, 0x3e, 0x92, 0x5e, 0xa4, 0x6b, 0x75, 0x49, 0xbb, 0x9c, 0x13, 0x09, 0x30, 0xe9,
+	0x23, 0xb9, 0x37, 0x9d, 0xb9, 0xd4, 0x7d, 0xd3, 0x5e, 0x04, 0xc1, 0xf2, 0x96, 0x2a, 0x5f, 0x27,
+	0xdb, 0x74, 0xea, 0x92, 0x3d, 0x55, 0x4d, 0x27, 0x1d, 0xa8, 0xaa, 0x28, 0x49, 0xee, 0xce, 0xcc,
+	0x96, 0x15, 0xb3, 0xe6, 0xb9, 0xb1, 0xd0, 0x8e, 0x2a, 0xb4, 0x45, 0x36, 0xa7, 0x0b, 0xc9, 0x64,
+	0x9c, 0xf9, 0x4b, 0xa4, 0x3c, 0xce, 0x19, 0x97, 0x99, 0xb9, 0xbb, 0x18, 0xb4, 0x60, 0x9c, 0xfa,
+	0x78, 0x7d, 0x2c, 0xd6, 0x85, 0x35, 0x54, 0x77, 0x52, 0x3a, 0x42, 0xf1, 0x0e, 0x32, 0x1b, 0x73,
+	0xfd, 0x58, 0xcd, 0x54, 0xd5, 0x6a, 0x84, 0xe4, 0xaa, 0xa5, 0x37, 0x46, 0x00, 0x55, 0x0d, 0x2f,
+	0x37, 0xb2, 0xa0, 0xfd, 0xa6, 0x35, 0xcf, 0x8d, 0x45, 0x76, 0x55, 0x11, 0x8b, 0xdc, 0x29, 0x17,
+	0xa1, 0x17, 0xa8, 0x6d, 0x97, 0xe4, 0x77, 0x03, 0x6a, 0xb3, 0x84, 0x95, 0xdc, 0x9f, 0x4e, 0xbf,
+	0xe0, 0x1e, 0x30, 0x1f, 0xbc, 0x1e, 0x18, 0x99, 0x7d, 0xac, 0x98, 0xb5, 0x08, 0xcd, 0x31, 0xcb,
+	0xd4, 0x55, 0xd2, 0x8b, 0x92, 0x04, 0x5f, 0x52, 0xa5, 0xf2, 0x49, 0x6f, 0xb4, 0x60, 0x97, 0x7b,
+	0x53, 0x10, 0x7e, 0xd3, 0x9a, 0xe7, 0x5e, 0xd0, 0x9b, 0x2e, 0xe7, 0xc5, 0xde, 0x1c, 0x3e, 0x79,
+	0x71, 0x65, 0x19, 0x2f, 0xaf, 0x2c, 0xe3, 0x9f, 0x2b, 0xcb, 0xf8, 0x75, 0x6c, 0x2d, 0xbd, 0x1c,
+	0x5b, 0x4b, 0x7f, 0x8d, 0xad, 0xa5, 0x93, 0x56, 0x4e, 0xf8, 0xbe, 0xf4, 0xc3, 0x98, 0x47, 0xdf,
+	0x70, 0x16, 0xd0, 0xa0, 0x3f, 0xea, 0x1c, 0xd0, 0x40, 0x78, 0xa3, 0x01, 0xa7, 0x3f, 0xa5, 0xb9,
+	0x95, 0x0e, 0x76, 0xaa, 0xea, 0x0f, 0xe3, 0x87, 0xff, 0x0d, 0x00, 0xbe, 0x17, 0x57, 0x32, 0xfc,
+	0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -484,10 +937,14 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
-	EthFee(ctx context.Context, in *QueryEthFeeRequest, opts ...grpc.CallOption) (*QueryEthFeeResponse, error)
-	BscFee(ctx context.Context, in *QueryBscFeeRequest, opts ...grpc.CallOption) (*QueryBscFeeResponse, error)
+	ChainFee(ctx context.Context, in *QueryChainFeeRequest, opts ...grpc.CallOption) (*QueryChainFeeResponse, error)
+	PriceFeeds(ctx context.Context, in *QueryPriceFeedsRequest, opts ...grpc.CallOption) (*QueryPriceFeedsResponse, error)
 	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
 	Holders(ctx context.Context, in *QueryHoldersRequest, opts ...grpc.CallOption) (*QueryHoldersResponse, error)
+	Holder(ctx context.Context, in *QueryHolderRequest, opts ...grpc.CallOption) (*QueryHolderResponse, error)
+	ValidatorOracleStats(ctx context.Context, in *QueryValidatorOracleStatsRequest, opts ...grpc.CallOption) (*QueryValidatorOracleStatsResponse, error)
+	Feeder(ctx context.Context, in *QueryFeederRequest, opts ...grpc.CallOption) (*QueryFeederResponse, error)
 }
 
 type queryClient struct {
@@ -507,18 +964,18 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
-func (c *queryClient) EthFee(ctx context.Context, in *QueryEthFeeRequest, opts ...grpc.CallOption) (*QueryEthFeeResponse, error) {
-	out := new(QueryEthFeeResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/EthFee", in, out, opts...)
+func (c *queryClient) ChainFee(ctx context.Context, in *QueryChainFeeRequest, opts ...grpc.CallOption) (*QueryChainFeeResponse, error) {
+	out := new(QueryChainFeeResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/ChainFee", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) BscFee(ctx context.Context, in *QueryBscFeeRequest, opts ...grpc.CallOption) (*QueryBscFeeResponse, error) {
-	out := new(QueryBscFeeResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/BscFee", in, out, opts...)
+func (c *queryClient) PriceFeeds(ctx context.Context, in *QueryPriceFeedsRequest, opts ...grpc.CallOption) (*QueryPriceFeedsResponse, error) {
+	out := new(QueryPriceFeedsResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/PriceFeeds", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -534,6 +991,15 @@ func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/PriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Holders(ctx context.Context, in *QueryHoldersRequest, opts ...grpc.CallOption) (*QueryHoldersResponse, error) {
 	out := new(QueryHoldersResponse)
 	err := c.cc.Invoke(ctx, "/oracle.v1.Query/Holders", in, out, opts...)
@@ -543,13 +1009,44 @@ func (c *queryClient) Holders(ctx context.Context, in *QueryHoldersRequest, opts
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
-	EthFee(context.Context, *QueryEthFeeRequest) (*QueryEthFeeResponse, error)
-	BscFee(context.Context, *QueryBscFeeRequest) (*QueryBscFeeResponse, error)
+func (c *queryClient) Holder(ctx context.Context, in *QueryHolderRequest, opts ...grpc.CallOption) (*QueryHolderResponse, error) {
+	out := new(QueryHolderResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/Holder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorOracleStats(ctx context.Context, in *QueryValidatorOracleStatsRequest, opts ...grpc.CallOption) (*QueryValidatorOracleStatsResponse, error) {
+	out := new(QueryValidatorOracleStatsResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/ValidatorOracleStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Feeder(ctx context.Context, in *QueryFeederRequest, opts ...grpc.CallOption) (*QueryFeederResponse, error) {
+	out := new(QueryFeederResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/Feeder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	ChainFee(context.Context, *QueryChainFeeRequest) (*QueryChainFeeResponse, error)
+	PriceFeeds(context.Context, *QueryPriceFeedsRequest) (*QueryPriceFeedsResponse, error)
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
 	Holders(context.Context, *QueryHoldersRequest) (*QueryHoldersResponse, error)
+	Holder(context.Context, *QueryHolderRequest) (*QueryHolderResponse, error)
+	ValidatorOracleStats(context.Context, *QueryValidatorOracleStatsRequest) (*QueryValidatorOracleStatsResponse, error)
+	Feeder(context.Context, *QueryFeederRequest) (*QueryFeederResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -559,18 +1056,30 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
-func (*UnimplementedQueryServer) EthFee(ctx context.Context, req *QueryEthFeeRequest) (*QueryEthFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EthFee not implemented")
+func (*UnimplementedQueryServer) ChainFee(ctx context.Context, req *QueryChainFeeRequest) (*QueryChainFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainFee not implemented")
 }
-func (*UnimplementedQueryServer) BscFee(ctx context.Context, req *QueryBscFeeRequest) (*QueryBscFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BscFee not implemented")
+func (*UnimplementedQueryServer) PriceFeeds(ctx context.Context, req *QueryPriceFeedsRequest) (*QueryPriceFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceFeeds not implemented")
 }
 func (*UnimplementedQueryServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
 }
+func (*UnimplementedQueryServer) PriceHistory(ctx context.Context, req *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
 func (*UnimplementedQueryServer) Holders(ctx context.Context, req *QueryHoldersRequest) (*QueryHoldersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Holders not implemented")
 }
+func (*UnimplementedQueryServer) Holder(ctx context.Context, req *QueryHolderRequest) (*QueryHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Holder not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorOracleStats(ctx context.Context, req *QueryValidatorOracleStatsRequest) (*QueryValidatorOracleStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOracleStats not implemented")
+}
+func (*UnimplementedQueryServer) Feeder(ctx context.Context, req *QueryFeederRequest) (*QueryFeederResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Feeder not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -594,38 +1103,38 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_EthFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEthFeeRequest)
+func _Query_ChainFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainFeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).EthFee(ctx, in)
+		return srv.(QueryServer).ChainFee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/oracle.v1.Query/EthFee",
+		FullMethod: "/oracle.v1.Query/ChainFee",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EthFee(ctx, req.(*QueryEthFeeRequest))
+		return srv.(QueryServer).ChainFee(ctx, req.(*QueryChainFeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_BscFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBscFeeRequest)
+func _Query_PriceFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceFeedsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).BscFee(ctx, in)
+		return srv.(QueryServer).PriceFeeds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/oracle.v1.Query/BscFee",
+		FullMethod: "/oracle.v1.Query/PriceFeeds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BscFee(ctx, req.(*QueryBscFeeRequest))
+		return srv.(QueryServer).PriceFeeds(ctx, req.(*QueryPriceFeedsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -648,6 +1157,24 @@ func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/PriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Holders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryHoldersRequest)
 	if err := dec(in); err != nil {
@@ -666,6 +1193,60 @@ func _Query_Holders_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Holder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Holder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/Holder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Holder(ctx, req.(*QueryHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorOracleStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOracleStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOracleStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/ValidatorOracleStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOracleStats(ctx, req.(*QueryValidatorOracleStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Feeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeederRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Feeder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/Feeder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Feeder(ctx, req.(*QueryFeederRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "oracle.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -675,21 +1256,37 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
 		{
-			MethodName: "EthFee",
-			Handler:    _Query_EthFee_Handler,
+			MethodName: "ChainFee",
+			Handler:    _Query_ChainFee_Handler,
 		},
 		{
-			MethodName: "BscFee",
-			Handler:    _Query_BscFee_Handler,
+			MethodName: "PriceFeeds",
+			Handler:    _Query_PriceFeeds_Handler,
 		},
 		{
 			MethodName: "Prices",
 			Handler:    _Query_Prices_Handler,
 		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Query_PriceHistory_Handler,
+		},
 		{
 			MethodName: "Holders",
 			Handler:    _Query_Holders_Handler,
 		},
+		{
+			MethodName: "Holder",
+			Handler:    _Query_Holder_Handler,
+		},
+		{
+			MethodName: "ValidatorOracleStats",
+			Handler:    _Query_ValidatorOracleStats_Handler,
+		},
+		{
+			MethodName: "Feeder",
+			Handler:    _Query_Feeder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "oracle/v1/query.proto",
@@ -811,6 +1408,83 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryHoldersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -831,6 +1505,18 @@ func (m *QueryHoldersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -854,6 +1540,18 @@ func (m *QueryHoldersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Holders != nil {
 		{
 			size, err := m.Holders.MarshalToSizedBuffer(dAtA[:i])
@@ -869,7 +1567,7 @@ func (m *QueryHoldersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEthFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHolderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -879,20 +1577,27 @@ func (m *QueryEthFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEthFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHolderRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEthFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHolderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEthFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHolderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -902,40 +1607,32 @@ func (m *QueryEthFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEthFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHolderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEthFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHolderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Fast.Size()
-		i -= size
-		if _, err := m.Fast.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Min.Size()
-		i -= size
-		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Holder != nil {
+		{
+			size, err := m.Holder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBscFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorOracleStatsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -945,20 +1642,27 @@ func (m *QueryBscFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBscFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorOracleStatsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBscFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorOracleStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBscFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorOracleStatsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -968,77 +1672,282 @@ func (m *QueryBscFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBscFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorOracleStatsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBscFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorOracleStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Fast.Size()
-		i -= size
-		if _, err := m.Fast.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Min.Size()
-		i -= size
-		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFeederRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCurrentEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryFeederRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeederRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != nil {
-		l = m.Epoch.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryFeederResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bonded {
+		i--
+		if m.Bonded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Batch.Size()
+		i -= size
+		if _, err := m.Batch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Fast.Size()
+		i -= size
+		if _, err := m.Fast.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Min.Size()
+		i -= size
+		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceFeedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceFeedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceFeedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceFeedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceFeedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceFeedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Feeds) > 0 {
+		for iNdEx := len(m.Feeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Feeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCurrentEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != nil {
+		l = m.Epoch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	return n
 }
@@ -1056,12 +1965,50 @@ func (m *QueryPricesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
+}
+
+func (m *QueryPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryHoldersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1075,60 +2022,861 @@ func (m *QueryHoldersResponse) Size() (n int) {
 		l = m.Holders.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryEthFeeRequest) Size() (n int) {
+func (m *QueryHolderRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryEthFeeResponse) Size() (n int) {
+func (m *QueryHolderResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Min.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Fast.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if m.Holder != nil {
+		l = m.Holder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryBscFeeRequest) Size() (n int) {
+func (m *QueryValidatorOracleStatsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryBscFeeResponse) Size() (n int) {
+func (m *QueryValidatorOracleStatsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Min.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Fast.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryFeederRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryFeederResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Bonded {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryChainFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Min.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fast.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Batch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceFeedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPriceFeedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Feeds) > 0 {
+		for _, e := range m.Feeds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Epoch == nil {
+				m.Epoch = &Epoch{}
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prices == nil {
+				m.Prices = &Prices{}
+			}
+			if err := m.Prices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, HistoricalPrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Holders == nil {
+				m.Holders = &Holders{}
+			}
+			if err := m.Holders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHolderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1151,12 +2899,44 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHolderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHolderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1181,7 +2961,7 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHolderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1204,15 +2984,15 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHolderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHolderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1239,10 +3019,10 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Epoch == nil {
-				m.Epoch = &Epoch{}
+			if m.Holder == nil {
+				m.Holder = &Holder{}
 			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Holder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1270,7 +3050,7 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorOracleStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1293,12 +3073,44 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorOracleStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorOracleStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1323,7 +3135,7 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorOracleStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1346,15 +3158,15 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorOracleStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorOracleStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1381,10 +3193,10 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Prices == nil {
-				m.Prices = &Prices{}
+			if m.Stats == nil {
+				m.Stats = &ValidatorOracleStats{}
 			}
-			if err := m.Prices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1412,7 +3224,7 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHoldersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1435,12 +3247,44 @@ func (m *QueryHoldersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHoldersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHoldersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1465,7 +3309,7 @@ func (m *QueryHoldersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHoldersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1488,17 +3332,17 @@ func (m *QueryHoldersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHoldersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHoldersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1508,28 +3352,44 @@ func (m *QueryHoldersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Holders == nil {
-				m.Holders = &Holders{}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonded", wireType)
 			}
-			if err := m.Holders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Bonded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1554,7 +3414,7 @@ func (m *QueryHoldersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEthFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChainFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1577,12 +3437,44 @@ func (m *QueryEthFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEthFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEthFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1607,7 +3499,7 @@ func (m *QueryEthFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEthFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChainFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1630,10 +3522,10 @@ func (m *QueryEthFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEthFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEthFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1704,6 +3596,40 @@ func (m *QueryEthFeeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1728,7 +3654,7 @@ func (m *QueryEthFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBscFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceFeedsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1751,10 +3677,10 @@ func (m *QueryBscFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBscFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceFeedsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBscFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceFeedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1781,7 +3707,7 @@ func (m *QueryBscFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBscFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceFeedsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1804,51 +3730,17 @@ func (m *QueryBscFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBscFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceFeedsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBscFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceFeedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1858,23 +3750,23 @@ func (m *QueryBscFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Feeds = append(m.Feeds, PriceFeed{})
+			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
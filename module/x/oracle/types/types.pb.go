@@ -148,6 +148,8 @@ func (*GenericClaim) XXX_OneofWrappers() []interface{} {
 type Epoch struct {
 	Nonce uint64  `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	Votes []*Vote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	// types of the claims the validators have to submit in the epoch
+	ExpectedClaims []ClaimType `protobuf:"varint,3,rep,packed,name=expected_claims,json=expectedClaims,proto3,enum=oracle.v1.ClaimType" json:"expected_claims,omitempty"`
 }
 
 func (m *Epoch) Reset()         { *m = Epoch{} }
@@ -197,6 +199,13 @@ func (m *Epoch) GetVotes() []*Vote {
 	return nil
 }
 
+func (m *Epoch) GetExpectedClaims() []ClaimType {
+	if m != nil {
+		return m.ExpectedClaims
+	}
+	return nil
+}
+
 type Vote struct {
 	Oracle       string           `protobuf:"bytes,1,opt,name=oracle,proto3" json:"oracle,omitempty"`
 	PriceClaim   *MsgPriceClaim   `protobuf:"bytes,2,opt,name=price_claim,json=priceClaim,proto3" json:"price_claim,omitempty"`
@@ -257,41 +266,140 @@ func (m *Vote) GetHoldersClaim() *MsgHoldersClaim {
 	return nil
 }
 
+// ValidatorOracleStats tracks the claims of a validator in the current signed
+// claims window. Holders claims are counted only in the epochs in which
+// holders were attested.
+type ValidatorOracleStats struct {
+	Validator           string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	PriceEpochs         uint64 `protobuf:"varint,2,opt,name=price_epochs,json=priceEpochs,proto3" json:"price_epochs,omitempty"`
+	MissedPriceClaims   uint64 `protobuf:"varint,3,opt,name=missed_price_claims,json=missedPriceClaims,proto3" json:"missed_price_claims,omitempty"`
+	HoldersEpochs       uint64 `protobuf:"varint,4,opt,name=holders_epochs,json=holdersEpochs,proto3" json:"holders_epochs,omitempty"`
+	MissedHoldersClaims uint64 `protobuf:"varint,5,opt,name=missed_holders_claims,json=missedHoldersClaims,proto3" json:"missed_holders_claims,omitempty"`
+	// number of the price claims conflicting with the accepted prices
+	ConflictingClaims uint64 `protobuf:"varint,6,opt,name=conflicting_claims,json=conflictingClaims,proto3" json:"conflicting_claims,omitempty"`
+}
+
+func (m *ValidatorOracleStats) Reset()         { *m = ValidatorOracleStats{} }
+func (m *ValidatorOracleStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOracleStats) ProtoMessage()    {}
+func (*ValidatorOracleStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b54af2de77c923e3, []int{3}
+}
+func (m *ValidatorOracleStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOracleStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOracleStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOracleStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOracleStats.Merge(m, src)
+}
+func (m *ValidatorOracleStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOracleStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOracleStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOracleStats proto.InternalMessageInfo
+
+func (m *ValidatorOracleStats) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorOracleStats) GetPriceEpochs() uint64 {
+	if m != nil {
+		return m.PriceEpochs
+	}
+	return 0
+}
+
+func (m *ValidatorOracleStats) GetMissedPriceClaims() uint64 {
+	if m != nil {
+		return m.MissedPriceClaims
+	}
+	return 0
+}
+
+func (m *ValidatorOracleStats) GetHoldersEpochs() uint64 {
+	if m != nil {
+		return m.HoldersEpochs
+	}
+	return 0
+}
+
+func (m *ValidatorOracleStats) GetMissedHoldersClaims() uint64 {
+	if m != nil {
+		return m.MissedHoldersClaims
+	}
+	return 0
+}
+
+func (m *ValidatorOracleStats) GetConflictingClaims() uint64 {
+	if m != nil {
+		return m.ConflictingClaims
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenericClaim)(nil), "oracle.v1.GenericClaim")
 	proto.RegisterType((*Epoch)(nil), "oracle.v1.Epoch")
 	proto.RegisterType((*Vote)(nil), "oracle.v1.Vote")
+	proto.RegisterType((*ValidatorOracleStats)(nil), "oracle.v1.ValidatorOracleStats")
 }
 
 func init() { proto.RegisterFile("oracle/v1/types.proto", fileDescriptor_b54af2de77c923e3) }
 
 var fileDescriptor_b54af2de77c923e3 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0xca, 0xd3, 0x40,
-	0x14, 0x85, 0x33, 0x69, 0x52, 0xc9, 0x6d, 0x7e, 0x84, 0x21, 0x4a, 0x28, 0x18, 0x42, 0x45, 0xc8,
-	0x2a, 0x43, 0xeb, 0x4a, 0x5c, 0x88, 0xfe, 0x8a, 0x05, 0x29, 0x48, 0x28, 0x2e, 0xdc, 0x94, 0x34,
-	0xbd, 0x24, 0x81, 0x24, 0x13, 0x92, 0x34, 0xd8, 0x07, 0x70, 0xef, 0xda, 0x27, 0x72, 0xd9, 0xa5,
-	0x4b, 0x69, 0x5f, 0x44, 0x32, 0x93, 0xd6, 0xb6, 0x0b, 0xff, 0xdd, 0x3d, 0x67, 0xce, 0x99, 0xcb,
-	0x07, 0x17, 0x9e, 0xf0, 0x2a, 0x8c, 0x32, 0x64, 0xed, 0x94, 0x35, 0xbb, 0x12, 0x6b, 0xbf, 0xac,
-	0x78, 0xc3, 0xa9, 0x21, 0x6d, 0xbf, 0x9d, 0x8e, 0xad, 0x7f, 0x89, 0xbc, 0x8e, 0xfb, 0xc0, 0xd8,
-	0x8a, 0x79, 0xcc, 0xc5, 0xc8, 0xba, 0x49, 0xba, 0x93, 0xef, 0x2a, 0x98, 0x1f, 0xb1, 0xc0, 0x2a,
-	0x8d, 0xee, 0xb3, 0x30, 0xcd, 0xa9, 0x05, 0x3a, 0x96, 0x3c, 0x4a, 0x6c, 0xe2, 0x12, 0x4f, 0x0b,
-	0xa4, 0xa0, 0xcf, 0x00, 0xa2, 0xee, 0x79, 0xd5, 0xad, 0xb4, 0x55, 0x97, 0x78, 0x7a, 0x60, 0x08,
-	0x67, 0xb9, 0x2b, 0x91, 0x52, 0xd0, 0x92, 0xb0, 0x4e, 0xec, 0x81, 0x4b, 0x3c, 0x33, 0x10, 0x33,
-	0x7d, 0x0e, 0x77, 0xd8, 0x62, 0xd1, 0xac, 0x44, 0x0c, 0x2b, 0x5b, 0x73, 0x89, 0x67, 0x04, 0xa6,
-	0x30, 0xef, 0xa5, 0x47, 0x5f, 0xc3, 0xa8, 0xac, 0xd2, 0x08, 0x65, 0xc8, 0xd6, 0x5d, 0xe2, 0x8d,
-	0x66, 0xb6, 0x7f, 0x66, 0xf1, 0x17, 0x75, 0xfc, 0xb9, 0x0b, 0x88, 0xc2, 0x5c, 0x09, 0xa0, 0x3c,
-	0x2b, 0xfa, 0x16, 0xee, 0x12, 0x9e, 0x6d, 0xb0, 0xaa, 0xfb, 0xfa, 0x50, 0xd4, 0xc7, 0xd7, 0xf5,
-	0xb9, 0x8c, 0x9c, 0x3e, 0x30, 0x93, 0x0b, 0xfd, 0xee, 0x11, 0xe8, 0xa2, 0x3a, 0x79, 0x0f, 0xfa,
-	0x07, 0x41, 0x6a, 0x81, 0x5e, 0xf0, 0x22, 0xc2, 0x13, 0xbf, 0x10, 0xf4, 0x05, 0xe8, 0x2d, 0x6f,
-	0xb0, 0xb6, 0x55, 0x77, 0xe0, 0x8d, 0x66, 0x8f, 0x2f, 0x56, 0x7c, 0xe1, 0x0d, 0x06, 0xf2, 0x75,
-	0xf2, 0x93, 0x80, 0xd6, 0x69, 0xfa, 0x14, 0x86, 0x32, 0x21, 0xbe, 0x31, 0x82, 0x5e, 0xd1, 0x57,
-	0xd7, 0xbc, 0xea, 0xff, 0x79, 0xaf, 0x68, 0xdf, 0xdc, 0xd2, 0x0e, 0x1e, 0xa2, 0xbd, 0x61, 0xfd,
-	0xf4, 0xeb, 0xe0, 0x90, 0xfd, 0xc1, 0x21, 0x7f, 0x0e, 0x0e, 0xf9, 0x71, 0x74, 0x94, 0xfd, 0xd1,
-	0x51, 0x7e, 0x1f, 0x1d, 0xe5, 0xeb, 0x34, 0x4e, 0x9b, 0x64, 0xbb, 0xf6, 0x23, 0x9e, 0xb3, 0x45,
-	0x5a, 0x34, 0x58, 0x2d, 0x31, 0xcc, 0x59, 0x9e, 0x6c, 0xd7, 0x33, 0x96, 0xf3, 0xcd, 0x36, 0x43,
-	0xf6, 0x8d, 0xf5, 0x57, 0x25, 0x8e, 0x6e, 0x3d, 0x14, 0xe7, 0xf3, 0xf2, 0x6f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x1d, 0x23, 0x89, 0x7a, 0x8e, 0x02, 0x00, 0x00,
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0x6e, 0xda, 0xa4, 0xd2, 0xb7, 0xdd, 0x2e, 0x8e, 0x59, 0x09, 0x55, 0x43, 0xac, 0x2c, 0xe4,
+	0x62, 0x42, 0xeb, 0x49, 0x44, 0xc4, 0x2d, 0xe2, 0x82, 0x2c, 0xca, 0xb8, 0xec, 0xc1, 0x4b, 0x49,
+	0xd3, 0x67, 0x12, 0x48, 0x32, 0x21, 0x33, 0x2d, 0xbb, 0x67, 0xf1, 0xae, 0x57, 0x7f, 0x91, 0xc7,
+	0x3d, 0x7a, 0x94, 0xf6, 0x8f, 0x48, 0x67, 0x92, 0x6d, 0xea, 0x41, 0x6f, 0xf3, 0xbe, 0xf7, 0xbd,
+	0x37, 0xdf, 0xf7, 0x31, 0x03, 0xc7, 0xac, 0x0c, 0xc2, 0x14, 0xfd, 0xd5, 0xd8, 0x17, 0xd7, 0x05,
+	0x72, 0xaf, 0x28, 0x99, 0x60, 0xa4, 0xa7, 0x60, 0x6f, 0x35, 0x1e, 0x9a, 0x3b, 0x46, 0xc6, 0xa3,
+	0x8a, 0x30, 0x7c, 0xb0, 0x43, 0x03, 0x21, 0x90, 0x8b, 0x40, 0x24, 0x2c, 0xaf, 0x9a, 0x66, 0xc4,
+	0x22, 0x26, 0x8f, 0xfe, 0xf6, 0xa4, 0xd0, 0xd1, 0xd7, 0x36, 0xf4, 0xdf, 0x62, 0x8e, 0x65, 0x12,
+	0x4e, 0xd3, 0x20, 0xc9, 0x88, 0x09, 0x06, 0x16, 0x2c, 0x8c, 0x2d, 0xcd, 0xd1, 0x5c, 0x9d, 0xaa,
+	0x82, 0x3c, 0x02, 0x08, 0xb7, 0xed, 0xd9, 0x56, 0x8f, 0xd5, 0x76, 0x34, 0xd7, 0xa0, 0x3d, 0x89,
+	0x5c, 0x5c, 0x17, 0x48, 0x08, 0xe8, 0x71, 0xc0, 0x63, 0xab, 0xe3, 0x68, 0x6e, 0x9f, 0xca, 0x33,
+	0x79, 0x02, 0x87, 0xb8, 0xc2, 0x5c, 0xcc, 0x24, 0x0d, 0x4b, 0x4b, 0x77, 0x34, 0xb7, 0x47, 0xfb,
+	0x12, 0x9c, 0x2a, 0x8c, 0xbc, 0x80, 0x83, 0xa2, 0x4c, 0x42, 0x54, 0x24, 0xcb, 0x70, 0x34, 0xf7,
+	0x60, 0x62, 0x79, 0xb7, 0x46, 0xbd, 0x73, 0x1e, 0x7d, 0xd8, 0x12, 0xe4, 0xc0, 0x59, 0x8b, 0x42,
+	0x71, 0x5b, 0x91, 0xd7, 0x70, 0x18, 0xb3, 0x74, 0x81, 0x25, 0xaf, 0xc6, 0xbb, 0x72, 0x7c, 0xb8,
+	0x3f, 0x7e, 0xa6, 0x28, 0xf5, 0x82, 0x7e, 0xdc, 0xa8, 0x4f, 0xef, 0x80, 0x21, 0x47, 0x47, 0x5f,
+	0x34, 0x30, 0xde, 0x48, 0xab, 0x26, 0x18, 0x39, 0xcb, 0x43, 0xac, 0x03, 0x90, 0x05, 0x39, 0x01,
+	0x63, 0xc5, 0x04, 0x72, 0xab, 0xed, 0x74, 0xdc, 0x83, 0xc9, 0x51, 0xe3, 0x8e, 0x4b, 0x26, 0x90,
+	0xaa, 0x2e, 0x79, 0x09, 0x47, 0x78, 0x55, 0x60, 0x28, 0x70, 0xa1, 0x34, 0x71, 0xab, 0xe3, 0x74,
+	0xdc, 0xc1, 0xc4, 0x6c, 0x0c, 0x4c, 0xeb, 0xdc, 0xe8, 0xa0, 0x26, 0x4b, 0x88, 0x8f, 0x7e, 0x68,
+	0xa0, 0x6f, 0xd7, 0x91, 0xfb, 0xd0, 0x55, 0x7c, 0xa9, 0xa2, 0x47, 0xab, 0x8a, 0x3c, 0xdf, 0xcf,
+	0xab, 0xfd, 0xef, 0xbc, 0xf6, 0xd2, 0x7a, 0xf5, 0x77, 0x5a, 0x9d, 0xff, 0xa5, 0xb5, 0x9f, 0xd5,
+	0xe8, 0x7b, 0x1b, 0xcc, 0xcb, 0x20, 0x4d, 0x16, 0x81, 0x60, 0xe5, 0x7b, 0x39, 0xf4, 0x51, 0x04,
+	0x82, 0x93, 0x87, 0xd0, 0x5b, 0xd5, 0x78, 0xa5, 0x77, 0x07, 0x90, 0xc7, 0xd0, 0x57, 0x92, 0xe5,
+	0x4b, 0xe2, 0x52, 0xb3, 0x4e, 0x95, 0x0d, 0x99, 0x38, 0x27, 0x1e, 0xdc, 0xcb, 0x12, 0xce, 0x71,
+	0x31, 0x6b, 0x98, 0xe3, 0x52, 0xa0, 0x4e, 0xef, 0xaa, 0xd6, 0xce, 0x15, 0x27, 0x27, 0x30, 0xa8,
+	0xad, 0x54, 0x4b, 0x75, 0x49, 0xad, 0x0d, 0x56, 0x6b, 0x27, 0x70, 0x5c, 0xad, 0xdd, 0x33, 0xce,
+	0xe5, 0x33, 0xd3, 0x69, 0x75, 0x67, 0xd3, 0x31, 0x27, 0x4f, 0x81, 0x84, 0x2c, 0xff, 0x9c, 0x26,
+	0xa1, 0x48, 0xf2, 0xa8, 0x1e, 0xe8, 0x2a, 0x25, 0x8d, 0x8e, 0xa2, 0x9f, 0xbe, 0xfb, 0xb9, 0xb6,
+	0xb5, 0x9b, 0xb5, 0xad, 0xfd, 0x5e, 0xdb, 0xda, 0xb7, 0x8d, 0xdd, 0xba, 0xd9, 0xd8, 0xad, 0x5f,
+	0x1b, 0xbb, 0xf5, 0x69, 0x1c, 0x25, 0x22, 0x5e, 0xce, 0xbd, 0x90, 0x65, 0xfe, 0x79, 0x92, 0x0b,
+	0x2c, 0x2f, 0x30, 0xc8, 0xfc, 0x2c, 0x5e, 0xce, 0x27, 0x7e, 0xc6, 0x16, 0xcb, 0x14, 0xfd, 0x2b,
+	0xbf, 0xfa, 0xb0, 0xf2, 0x97, 0xcf, 0xbb, 0xf2, 0x4b, 0x3e, 0xfb, 0x33, 0x00, 0x5c, 0x02, 0xed,
+	0xb5, 0xff, 0x03, 0x00, 0x00,
 }
 
 func (m *GenericClaim) Marshal() (dAtA []byte, err error) {
@@ -412,6 +520,24 @@ func (m *Epoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExpectedClaims) > 0 {
+		dAtA4 := make([]byte, len(m.ExpectedClaims)*10)
+		var j3 int
+		for _, num := range m.ExpectedClaims {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTypes(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -488,6 +614,61 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorOracleStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOracleStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOracleStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConflictingClaims != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ConflictingClaims))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MissedHoldersClaims != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedHoldersClaims))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HoldersEpochs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HoldersEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedPriceClaims != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedPriceClaims))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PriceEpochs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PriceEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -564,6 +745,13 @@ func (m *Epoch) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.ExpectedClaims) > 0 {
+		l = 0
+		for _, e := range m.ExpectedClaims {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
 	return n
 }
 
@@ -588,6 +776,34 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorOracleStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PriceEpochs != 0 {
+		n += 1 + sovTypes(uint64(m.PriceEpochs))
+	}
+	if m.MissedPriceClaims != 0 {
+		n += 1 + sovTypes(uint64(m.MissedPriceClaims))
+	}
+	if m.HoldersEpochs != 0 {
+		n += 1 + sovTypes(uint64(m.HoldersEpochs))
+	}
+	if m.MissedHoldersClaims != 0 {
+		n += 1 + sovTypes(uint64(m.MissedHoldersClaims))
+	}
+	if m.ConflictingClaims != 0 {
+		n += 1 + sovTypes(uint64(m.ConflictingClaims))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -903,6 +1119,75 @@ func (m *Epoch) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v ClaimType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ClaimType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExpectedClaims = append(m.ExpectedClaims, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.ExpectedClaims) == 0 {
+					m.ExpectedClaims = make([]ClaimType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ClaimType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ClaimType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExpectedClaims = append(m.ExpectedClaims, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedClaims", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1084,6 +1369,186 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorOracleStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOracleStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOracleStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceEpochs", wireType)
+			}
+			m.PriceEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedPriceClaims", wireType)
+			}
+			m.MissedPriceClaims = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedPriceClaims |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldersEpochs", wireType)
+			}
+			m.HoldersEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldersEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedHoldersClaims", wireType)
+			}
+			m.MissedHoldersClaims = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedHoldersClaims |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingClaims", wireType)
+			}
+			m.ConflictingClaims = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictingClaims |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,21 +14,21 @@ import (
 	"github.com/MinterTeam/mhub2/module/x/oracle/types"
 	"github.com/MinterTeam/mhub2/oracle/config"
 	"github.com/MinterTeam/mhub2/oracle/cosmos"
+	"github.com/MinterTeam/mhub2/oracle/prices"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
 )
 
-var holdersUpdatePeriod uint64 = 144
-
 func main() {
 	logger := log.NewTMLogger(os.Stdout)
-	cfg, isTestnet := config.Get()
+	cfg := config.Get()
 	cosmos.Setup(cfg)
 
-	if isTestnet {
-		holdersUpdatePeriod = 1
+	priceAggregator, err := prices.NewAggregator(cfg, logger)
+	if err != nil {
+		panic(err)
 	}
 
 	txCommitterConn, err := grpc.Dial("127.0.0.1:7070", grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -56,13 +56,20 @@ func main() {
 	defer cosmosConn.Close()
 
 	for {
-		relayPricesAndHolders(cfg, orcAddress.GetAddress(), cosmosConn, txCommitter, logger)
+		relayPricesAndHolders(cfg, orcAddress.GetAddress(), cosmosConn, txCommitter, priceAggregator, logger)
 
 		time.Sleep(1 * time.Second)
 	}
 }
 
-func relayPricesAndHolders(cfg *config.Config, address string, cosmosConn *grpc.ClientConn, txCommitter tx_committer.TxCommitterClient, logger log.Logger) {
+var (
+	// skippedEpoch is the last epoch the prices could not be aggregated in, its price claim is not retried
+	skippedEpoch uint64
+	// holdersClaimedEpoch is the last epoch the holders claim was broadcast in
+	holdersClaimedEpoch uint64
+)
+
+func relayPricesAndHolders(cfg *config.Config, address string, cosmosConn *grpc.ClientConn, txCommitter tx_committer.TxCommitterClient, priceAggregator *prices.Aggregator, logger log.Logger) {
 	orcAddress, err := sdk.AccAddressFromBech32(address)
 	if err != nil {
 		panic(err)
@@ -77,18 +84,48 @@ func relayPricesAndHolders(cfg *config.Config, address string, cosmosConn *grpc.
 		return
 	}
 
-	if response.GetEpoch().GetNonce() == 0 {
+	epoch := response.GetEpoch().GetNonce()
+	if epoch == 0 {
+		return
+	}
+
+	// the holders claim is still sent in the epochs the price claim is skipped in
+	holdersPending := holdersExpected(response.GetEpoch()) && holdersClaimedEpoch != epoch
+	if epoch == skippedEpoch && !holdersPending {
+		return
+	}
+
+	// the claims are counted on behalf of the validator the key submits them for
+	feeder, err := oracleClient.Feeder(context.Background(), &types.QueryFeederRequest{Address: orcAddress.String()})
+	if err != nil {
+		logger.Error("Error getting validator of the feeder", "err", err.Error())
+		time.Sleep(time.Second)
+		return
+	}
+
+	if !feeder.GetBonded() {
+		logger.Error("Validator is not bonded", "validator", feeder.GetValidatorAddress())
+		time.Sleep(time.Second)
 		return
 	}
 
+	validator, err := sdk.ValAddressFromBech32(feeder.GetValidatorAddress())
+	if err != nil {
+		panic(err)
+	}
+
 	// check if already voted
+	pricesClaimed := false
 	for _, vote := range response.GetEpoch().GetVotes() {
-		if vote.Oracle == orcAddress.String() {
-			return
+		if vote.Oracle == sdk.AccAddress(validator).String() {
+			pricesClaimed = vote.PriceClaim != nil
+			if vote.HoldersClaim != nil {
+				holdersPending = false
+			}
 		}
 	}
 
-	if response.GetEpoch().Nonce%holdersUpdatePeriod == 0 {
+	if holdersPending {
 		holders := getHolders(cfg)
 		jsonHolders, _ := json.Marshal(holders.List)
 		logger.Info("Holders", "val", string(jsonHolders))
@@ -102,16 +139,40 @@ func relayPricesAndHolders(cfg *config.Config, address string, cosmosConn *grpc.
 		_, err = txCommitter.CommitTx(context.TODO(), &tx_committer.CommitTxRequest{Msgs: tx_committer.MarshalMsgs([]sdk.Msg{holdersClaim})})
 		if err != nil {
 			logger.Error("Failed to broadcast holders", "err", err.Error())
+		} else {
+			holdersClaimedEpoch = epoch
 		}
 	}
 
-	prices := getPrices(cfg)
-	jsonPrices, _ := json.Marshal(prices.List)
+	if pricesClaimed || epoch == skippedEpoch {
+		return
+	}
+
+	feedsResponse, err := oracleClient.PriceFeeds(context.Background(), &types.QueryPriceFeedsRequest{})
+	if err != nil {
+		logger.Error("Error getting price feeds", "err", err.Error())
+		time.Sleep(time.Second)
+		return
+	}
+
+	var feeds []string
+	for _, feed := range feedsResponse.GetFeeds() {
+		feeds = append(feeds, feed.Name)
+	}
+
+	claimPrices, err := priceAggregator.Prices(context.Background(), feeds)
+	if err != nil {
+		logger.Error("Skipping price claim of the epoch", "epoch", response.Epoch.Nonce, "err", err.Error())
+		skippedEpoch = response.Epoch.Nonce
+		return
+	}
+
+	jsonPrices, _ := json.Marshal(claimPrices.List)
 	logger.Info("Prices", "val", string(jsonPrices))
 
 	priceClaim := &types.MsgPriceClaim{
 		Epoch:        response.Epoch.Nonce,
-		Prices:       prices,
+		Prices:       claimPrices,
 		Orchestrator: orcAddress.String(),
 	}
 
@@ -121,6 +182,17 @@ func relayPricesAndHolders(cfg *config.Config, address string, cosmosConn *grpc.
 	}
 }
 
+// holdersExpected reports whether the chain expects the holders claims in the epoch
+func holdersExpected(epoch *types.Epoch) bool {
+	for _, claimType := range epoch.GetExpectedClaims() {
+		if claimType == types.CLAIM_TYPE_HOLDER {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getHolders(cfg *config.Config) *types.Holders {
 	holders := &types.Holders{List: []*types.Holder{}}
 
@@ -175,60 +247,9 @@ func getHolders(cfg *config.Config) *types.Holders {
 	return holders
 }
 
-func getPrices(cfg *config.Config) *types.Prices {
-	prices := &types.Prices{List: []*types.Price{}}
-
-	if cfg.PricesUrl == "" {
-		println("Prices url is not set")
-		return prices
-	}
-
-	pricesResponse, err := http.Get(cfg.PricesUrl)
-	if err != nil {
-		println(err.Error())
-		time.Sleep(time.Second)
-		return getPrices(cfg)
-	}
-	data, err := ioutil.ReadAll(pricesResponse.Body)
-	if err != nil {
-		println(err.Error())
-		time.Sleep(time.Second)
-		return getPrices(cfg)
-	}
-
-	pricesList := PricesResult{}
-	if err := json.Unmarshal(data, &pricesList); err != nil {
-		println(err.Error())
-		time.Sleep(time.Second)
-		return getPrices(cfg)
-	}
-
-	for _, item := range pricesList.Data {
-		v, err := sdk.NewDecFromStr(item.Price)
-		if err != nil {
-			println(err.Error())
-			time.Sleep(time.Second)
-			return getPrices(cfg)
-		}
-		prices.List = append(prices.List, &types.Price{
-			Name:  item.Denom,
-			Value: v,
-		})
-	}
-
-	return prices
-}
-
 type HoldersResult struct {
 	Data []struct {
 		Address string `json:"address"`
 		Balance string `json:"balance"`
 	} `json:"data"`
 }
-
-type PricesResult struct {
-	Data []struct {
-		Denom string `json:"denom"`
-		Price string `json:"price"`
-	} `json:"data"`
-}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"flag"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -11,17 +12,41 @@ type CosmosConfig struct {
 	RpcAddr  string `mapstructure:"rpc_addr"`
 }
 
+// PriceSourceConfig describes a source of the prices: the "explorer" API at the url, a "json" document at
+// the url with the feeds at their paths, or a "file" with a JSON object of the prices at the path
+type PriceSourceConfig struct {
+	Name    string            `mapstructure:"name"`
+	Type    string            `mapstructure:"type"`
+	Url     string            `mapstructure:"url"`
+	Path    string            `mapstructure:"path"`
+	Feeds   map[string]string `mapstructure:"feeds"`
+	Timeout time.Duration     `mapstructure:"timeout"`
+}
+
+type PricesConfig struct {
+	// number of the sources which have to report a price, 1 by default
+	MinSources int `mapstructure:"min_sources"`
+	// max relative difference between the prices reported by the sources, empty disables the check
+	MaxSpread string `mapstructure:"max_spread"`
+	// default timeout of a source
+	Timeout time.Duration       `mapstructure:"timeout"`
+	Sources []PriceSourceConfig `mapstructure:"sources"`
+}
+
 type Config struct {
 	Cosmos     CosmosConfig
 	HoldersUrl string `mapstructure:"holders_url"`
-	PricesUrl  string `mapstructure:"prices_url"`
+	// PricesUrl is the explorer prices API used when no price sources are configured
+	PricesUrl string       `mapstructure:"prices_url"`
+	Prices    PricesConfig `mapstructure:"prices"`
 }
 
-func Get() (*Config, bool) {
+func Get() *Config {
 	cfg := &Config{}
 
 	configPath := flag.String("config", "config.toml", "path to the configuration file")
-	testnet := flag.Bool("testnet", false, "")
+	// the holders update period is an oracle param now, the flag is kept for the existing setups
+	flag.Bool("testnet", false, "deprecated, has no effect")
 
 	flag.Parse()
 
@@ -36,5 +61,5 @@ func Get() (*Config, bool) {
 		panic(err)
 	}
 
-	return cfg, *testnet
+	return cfg
 }
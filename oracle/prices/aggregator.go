@@ -0,0 +1,154 @@
+package prices
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+	"github.com/MinterTeam/mhub2/oracle/config"
+)
+
+// defaultTimeout limits the fetch of a source which has no timeout configured
+const defaultTimeout = 10 * time.Second
+
+type timedSource struct {
+	PriceSource
+	timeout time.Duration
+}
+
+// Aggregator fetches the prices from all the configured sources and aggregates each feed to the median of
+// the sources which reported it
+type Aggregator struct {
+	sources []timedSource
+	// number of the sources which have to report a feed
+	minSources int
+	// max relative difference between the highest and the lowest reported price of a feed, zero disables
+	// the check
+	maxSpread sdk.Dec
+	logger    log.Logger
+}
+
+// NewAggregator creates the aggregator of the configured sources. The legacy prices url is used as an
+// explorer source when no sources are configured.
+func NewAggregator(cfg *config.Config, logger log.Logger) (*Aggregator, error) {
+	sourceConfigs := cfg.Prices.Sources
+	if len(sourceConfigs) == 0 && cfg.PricesUrl != "" {
+		sourceConfigs = []config.PriceSourceConfig{{Name: "explorer", Type: "explorer", Url: cfg.PricesUrl}}
+	}
+	if len(sourceConfigs) == 0 {
+		return nil, fmt.Errorf("no price sources configured")
+	}
+
+	a := &Aggregator{
+		minSources: cfg.Prices.MinSources,
+		maxSpread:  sdk.ZeroDec(),
+		logger:     logger,
+	}
+
+	if a.minSources <= 0 {
+		a.minSources = 1
+	}
+	if a.minSources > len(sourceConfigs) {
+		return nil, fmt.Errorf("min sources %d exceeds the number of the sources %d", a.minSources, len(sourceConfigs))
+	}
+
+	if cfg.Prices.MaxSpread != "" {
+		maxSpread, err := sdk.NewDecFromStr(cfg.Prices.MaxSpread)
+		if err != nil || maxSpread.IsNegative() {
+			return nil, fmt.Errorf("invalid max spread %s", cfg.Prices.MaxSpread)
+		}
+		a.maxSpread = maxSpread
+	}
+
+	for _, sourceConfig := range sourceConfigs {
+		source, err := NewSource(sourceConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		timeout := sourceConfig.Timeout
+		if timeout == 0 {
+			timeout = cfg.Prices.Timeout
+		}
+		if timeout == 0 {
+			timeout = defaultTimeout
+		}
+
+		a.sources = append(a.sources, timedSource{PriceSource: source, timeout: timeout})
+	}
+
+	return a, nil
+}
+
+// Prices fetches all the sources in parallel and aggregates the feeds. It fails if any of the feeds is
+// reported by less than the min sources or its reported prices differ by more than the max spread, as the
+// chain rejects the claims which miss a feed.
+func (a *Aggregator) Prices(ctx context.Context, feeds []string) (*types.Prices, error) {
+	results := make([]map[string]sdk.Dec, len(a.sources))
+	done := make(chan struct{})
+	for i, source := range a.sources {
+		go func(i int, source timedSource) {
+			defer func() { done <- struct{}{} }()
+
+			sourceCtx, cancel := context.WithTimeout(ctx, source.timeout)
+			defer cancel()
+
+			prices, err := source.Fetch(sourceCtx)
+			if err != nil {
+				a.logger.Error("Failed to fetch prices", "source", source.Name(), "err", err.Error())
+				return
+			}
+			results[i] = prices
+		}(i, source)
+	}
+	for range a.sources {
+		<-done
+	}
+
+	prices := &types.Prices{List: []*types.Price{}}
+	for _, feed := range feeds {
+		var values []sdk.Dec
+		for _, result := range results {
+			if value, ok := result[feed]; ok && !value.IsNil() && value.IsPositive() {
+				values = append(values, value)
+			}
+		}
+
+		value, err := a.aggregate(feed, values)
+		if err != nil {
+			return nil, err
+		}
+
+		prices.List = append(prices.List, &types.Price{Name: feed, Value: value})
+	}
+
+	return prices, nil
+}
+
+// aggregate returns the median of the prices of the feed reported by the sources
+func (a *Aggregator) aggregate(feed string, values []sdk.Dec) (sdk.Dec, error) {
+	if len(values) < a.minSources {
+		return sdk.Dec{}, fmt.Errorf("price %s is reported by %d sources, %d required", feed, len(values), a.minSources)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].LT(values[j])
+	})
+
+	median := values[len(values)/2]
+	if len(values)%2 == 0 {
+		median = median.Add(values[len(values)/2-1]).QuoInt64(2)
+	}
+
+	lowest, highest := values[0], values[len(values)-1]
+	if a.maxSpread.IsPositive() && highest.Sub(lowest).Quo(median).GT(a.maxSpread) {
+		return sdk.Dec{}, fmt.Errorf("prices of %s differ too much between the sources: %s - %s", feed, lowest, highest)
+	}
+
+	return median, nil
+}
@@ -0,0 +1,187 @@
+package prices
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/MinterTeam/mhub2/module/x/oracle/types"
+	"github.com/MinterTeam/mhub2/oracle/config"
+)
+
+// fileSources writes the JSON objects of the prices to the files of the file sources
+func fileSources(t *testing.T, documents ...string) []config.PriceSourceConfig {
+	dir := t.TempDir()
+
+	var sources []config.PriceSourceConfig
+	for i, document := range documents {
+		path := filepath.Join(dir, fmt.Sprintf("prices%d.json", i))
+		require.NoError(t, ioutil.WriteFile(path, []byte(document), 0644))
+		sources = append(sources, config.PriceSourceConfig{Name: fmt.Sprintf("file%d", i), Type: "file", Path: path})
+	}
+
+	return sources
+}
+
+func TestAggregator_aggregate(t *testing.T) {
+	specs := map[string]struct {
+		values     []int64
+		minSources int
+		maxSpread  sdk.Dec
+		exp        sdk.Dec
+		expErr     bool
+	}{
+		"single source": {
+			values:     []int64{100},
+			minSources: 1,
+			maxSpread:  sdk.ZeroDec(),
+			exp:        sdk.NewDec(100),
+		},
+		"odd number of sources": {
+			values:     []int64{120, 100, 1000},
+			minSources: 1,
+			maxSpread:  sdk.ZeroDec(),
+			exp:        sdk.NewDec(120),
+		},
+		"even number of sources averages the middle two": {
+			values:     []int64{130, 100, 1000, 105},
+			minSources: 1,
+			maxSpread:  sdk.ZeroDec(),
+			exp:        sdk.NewDecWithPrec(1175, 1),
+		},
+		"below min sources": {
+			values:     []int64{100, 101},
+			minSources: 3,
+			maxSpread:  sdk.ZeroDec(),
+			expErr:     true,
+		},
+		"no sources": {
+			minSources: 1,
+			maxSpread:  sdk.ZeroDec(),
+			expErr:     true,
+		},
+		"within max spread": {
+			values:     []int64{100, 104, 102},
+			minSources: 1,
+			maxSpread:  sdk.NewDecWithPrec(5, 2),
+			exp:        sdk.NewDec(102),
+		},
+		"above max spread": {
+			values:     []int64{100, 110, 102},
+			minSources: 1,
+			maxSpread:  sdk.NewDecWithPrec(5, 2),
+			expErr:     true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			a := &Aggregator{minSources: spec.minSources, maxSpread: spec.maxSpread, logger: log.NewNopLogger()}
+
+			var values []sdk.Dec
+			for _, value := range spec.values {
+				values = append(values, sdk.NewDec(value))
+			}
+
+			value, err := a.aggregate("eth", values)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.exp, value)
+		})
+	}
+}
+
+func TestAggregator_Prices(t *testing.T) {
+	// the slow source responds after the timeout of its source
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		_, _ = w.Write([]byte(`{"eth": "1000", "bnb": "30"}`))
+	}))
+	defer slow.Close()
+	slowSource := config.PriceSourceConfig{Name: "slow", Type: "json", Url: slow.URL, Feeds: map[string]string{"eth": "eth", "bnb": "bnb"}, Timeout: 50 * time.Millisecond}
+
+	specs := map[string]struct {
+		sources    []config.PriceSourceConfig
+		minSources int
+		maxSpread  string
+		exp        []*types.Price
+		expErr     bool
+	}{
+		"single source": {
+			sources: fileSources(t, `{"eth": "100", "bnb": 3.5}`),
+			exp:     []*types.Price{{Name: "eth", Value: sdk.NewDec(100)}, {Name: "bnb", Value: sdk.NewDecWithPrec(35, 1)}},
+		},
+		"even number of sources": {
+			sources: fileSources(t, `{"eth": 100, "bnb": 3}`, `{"eth": 101, "bnb": 4}`),
+			exp:     []*types.Price{{Name: "eth", Value: sdk.NewDecWithPrec(1005, 1)}, {Name: "bnb", Value: sdk.NewDecWithPrec(35, 1)}},
+		},
+		"feed missing in a source": {
+			sources:    fileSources(t, `{"eth": 100, "bnb": 3}`, `{"eth": 102}`),
+			minSources: 1,
+			exp:        []*types.Price{{Name: "eth", Value: sdk.NewDec(101)}, {Name: "bnb", Value: sdk.NewDec(3)}},
+		},
+		"feed below min sources": {
+			sources:    fileSources(t, `{"eth": 100, "bnb": 3}`, `{"eth": 102}`),
+			minSources: 2,
+			expErr:     true,
+		},
+		"failed source below min sources": {
+			sources:    append(fileSources(t, `{"eth": 100, "bnb": 3}`), config.PriceSourceConfig{Name: "missing", Type: "file", Path: "missing.json"}),
+			minSources: 2,
+			expErr:     true,
+		},
+		"non positive prices are ignored": {
+			sources:    fileSources(t, `{"eth": 100, "bnb": 3}`, `{"eth": 0, "bnb": -3}`),
+			minSources: 1,
+			exp:        []*types.Price{{Name: "eth", Value: sdk.NewDec(100)}, {Name: "bnb", Value: sdk.NewDec(3)}},
+		},
+		"within max spread": {
+			sources:   fileSources(t, `{"eth": 100, "bnb": 3}`, `{"eth": 102, "bnb": 3}`, `{"eth": 101, "bnb": 3}`),
+			maxSpread: "0.05",
+			exp:       []*types.Price{{Name: "eth", Value: sdk.NewDec(101)}, {Name: "bnb", Value: sdk.NewDec(3)}},
+		},
+		"above max spread": {
+			sources:   fileSources(t, `{"eth": 100, "bnb": 3}`, `{"eth": 120, "bnb": 3}`, `{"eth": 101, "bnb": 3}`),
+			maxSpread: "0.05",
+			expErr:    true,
+		},
+		"source timeout": {
+			sources: append(fileSources(t, `{"eth": 100, "bnb": 3}`), slowSource),
+			exp:     []*types.Price{{Name: "eth", Value: sdk.NewDec(100)}, {Name: "bnb", Value: sdk.NewDec(3)}},
+		},
+		"source timeout below min sources": {
+			sources:    append(fileSources(t, `{"eth": 100, "bnb": 3}`), slowSource),
+			minSources: 2,
+			expErr:     true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			cfg := &config.Config{Prices: config.PricesConfig{MinSources: spec.minSources, MaxSpread: spec.maxSpread, Sources: spec.sources}}
+			a, err := NewAggregator(cfg, log.NewNopLogger())
+			require.NoError(t, err)
+
+			prices, err := a.Prices(context.Background(), []string{"eth", "bnb"})
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.exp, prices.List)
+		})
+	}
+}
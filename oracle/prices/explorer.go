@@ -0,0 +1,50 @@
+package prices
+
+import (
+	"context"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExplorerSource fetches the prices from the Minter Hub explorer API
+type ExplorerSource struct {
+	name string
+	url  string
+}
+
+type explorerResult struct {
+	Data []struct {
+		Denom string `json:"denom"`
+		Price string `json:"price"`
+	} `json:"data"`
+}
+
+// Name implements PriceSource
+func (s *ExplorerSource) Name() string {
+	return s.name
+}
+
+// Fetch implements PriceSource
+func (s *ExplorerSource) Fetch(ctx context.Context) (map[string]sdk.Dec, error) {
+	data, err := httpGet(ctx, s.url)
+	if err != nil {
+		return nil, err
+	}
+
+	result := explorerResult{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	prices := map[string]sdk.Dec{}
+	for _, item := range result.Data {
+		v, err := sdk.NewDecFromStr(item.Price)
+		if err != nil {
+			return nil, err
+		}
+		prices[item.Denom] = v
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,50 @@
+package prices
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FileSource reads the prices from a local JSON object of the feed names and their prices. The file is read
+// on every fetch, so the prices can be changed while the oracle is running, which is useful in the tests.
+type FileSource struct {
+	name string
+	path string
+}
+
+// Name implements PriceSource
+func (s *FileSource) Name() string {
+	return s.name
+}
+
+// Fetch implements PriceSource
+func (s *FileSource) Fetch(context.Context) (map[string]sdk.Dec, error) {
+	data, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	object, ok := document.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s is not a JSON object", s.path)
+	}
+
+	prices := map[string]sdk.Dec{}
+	for feed, value := range object {
+		price, err := decFromValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("feed %s: %w", feed, err)
+		}
+		prices[feed] = price
+	}
+
+	return prices, nil
+}
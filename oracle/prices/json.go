@@ -0,0 +1,92 @@
+package prices
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// JSONSource fetches a JSON document from an HTTP API and reads each feed at its path. The path is a dot
+// separated list of the object keys and the array indexes, e.g. "data.0.price".
+type JSONSource struct {
+	name  string
+	url   string
+	feeds map[string]string
+}
+
+// Name implements PriceSource
+func (s *JSONSource) Name() string {
+	return s.name
+}
+
+// Fetch implements PriceSource
+func (s *JSONSource) Fetch(ctx context.Context) (map[string]sdk.Dec, error) {
+	data, err := httpGet(ctx, s.url)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := map[string]sdk.Dec{}
+	for feed, path := range s.feeds {
+		value, err := lookupPath(document, path)
+		if err != nil {
+			return nil, fmt.Errorf("feed %s: %w", feed, err)
+		}
+
+		price, err := decFromValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("feed %s: %w", feed, err)
+		}
+		prices[feed] = price
+	}
+
+	return prices, nil
+}
+
+// decodeJSON decodes the document keeping the numbers as they are written
+func decodeJSON(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// lookupPath returns the value at the dot separated path of the document
+func lookupPath(document interface{}, path string) (interface{}, error) {
+	value := document
+	for _, key := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			next, ok := v[key]
+			if !ok {
+				return nil, fmt.Errorf("key %s of path %s not found", key, path)
+			}
+			value = next
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, fmt.Errorf("index %s of path %s not found", key, path)
+			}
+			value = v[i]
+		default:
+			return nil, fmt.Errorf("path %s not found", path)
+		}
+	}
+
+	return value, nil
+}
@@ -0,0 +1,98 @@
+package prices
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/MinterTeam/mhub2/oracle/config"
+)
+
+// PriceSource provides the prices the oracle claims
+type PriceSource interface {
+	// Name identifies the source in the logs
+	Name() string
+	// Fetch returns the prices the source reports by their feed names
+	Fetch(ctx context.Context) (map[string]sdk.Dec, error)
+}
+
+// NewSource creates the price source described by the config
+func NewSource(cfg config.PriceSourceConfig) (PriceSource, error) {
+	name := cfg.Name
+	if name == "" {
+		name = cfg.Type
+	}
+
+	switch cfg.Type {
+	case "explorer":
+		if cfg.Url == "" {
+			return nil, fmt.Errorf("source %s: url is not set", name)
+		}
+		return &ExplorerSource{name: name, url: cfg.Url}, nil
+	case "json":
+		if cfg.Url == "" {
+			return nil, fmt.Errorf("source %s: url is not set", name)
+		}
+		if len(cfg.Feeds) == 0 {
+			return nil, fmt.Errorf("source %s: feeds are not set", name)
+		}
+		return &JSONSource{name: name, url: cfg.Url, feeds: cfg.Feeds}, nil
+	case "file":
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("source %s: path is not set", name)
+		}
+		return &FileSource{name: name, path: cfg.Path}, nil
+	}
+
+	return nil, fmt.Errorf("source %s: unknown type %q", name, cfg.Type)
+}
+
+// httpGet fetches the body of the url, the request is cancelled with the context
+func httpGet(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s responded with status %d", url, resp.StatusCode)
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+// decFromValue converts a decoded JSON number or string to a decimal. The APIs report the small prices in
+// the exponent notation, which the decimals do not parse.
+func decFromValue(value interface{}) (sdk.Dec, error) {
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	default:
+		return sdk.Dec{}, fmt.Errorf("%v is not a number", value)
+	}
+
+	if dec, err := sdk.NewDecFromStr(s); err == nil {
+		return dec, nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("%s is not a number", s)
+	}
+
+	return sdk.NewDecFromStr(strconv.FormatFloat(f, 'f', sdk.Precision, 64))
+}
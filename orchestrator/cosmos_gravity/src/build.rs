@@ -180,6 +180,7 @@ pub fn ethereum_event_messages(
             external_height: downcast_uint256(valset.block_height).unwrap(),
             members: valset.members.iter().map(|v| v.into()).collect(),
             tx_hash: valset.tx_hash,
+            threshold: 0,
         };
         let msg = proto::MsgSubmitExternalEvent {
             signer: cosmos_address.to_string(),
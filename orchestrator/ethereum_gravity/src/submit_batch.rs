@@ -1,4 +1,4 @@
-use crate::utils::{get_tx_batch_nonce, GasCost};
+use crate::utils::{encode_valset_args, get_tx_batch_nonce, GasCost};
 use clarity::PrivateKey as EthPrivateKey;
 use clarity::{Address as EthAddress, Uint256};
 use mhub2_utils::error::GravityError;
@@ -175,8 +175,7 @@ fn encode_batch_payload(
     confirms: &[BatchConfirmResponse],
     gravity_id: String,
 ) -> Result<Vec<u8>, GravityError> {
-    let (current_addresses, current_powers) = current_valset.filter_empty_addresses();
-    let current_valset_nonce = current_valset.nonce;
+    let current_valset_args = encode_valset_args(&current_valset);
     let new_batch_nonce = batch.nonce;
     let hash = encode_tx_batch_confirm_hashed(gravity_id, batch.clone());
     let sig_data = current_valset.order_sigs(&hash, confirms)?;
@@ -186,9 +185,7 @@ fn encode_batch_payload(
     // Solidity function signature
     // function submitBatch(
     // // The validators that approve the batch and new valset
-    // address[] memory _currentValidators,
-    // uint256[] memory _currentPowers,
-    // uint256 _currentValsetNonce,
+    // ValsetArgs memory _currentValset,
     // // These are arrays of the parts of the validators signatures
     // uint8[] memory _v,
     // bytes32[] memory _r,
@@ -201,9 +198,7 @@ fn encode_batch_payload(
     // address _tokenContract,
     // uint256 _batchTimeout
     let tokens = &[
-        current_addresses.into(),
-        current_powers.into(),
-        current_valset_nonce.into(),
+        current_valset_args,
         sig_arrays.v,
         sig_arrays.r,
         sig_arrays.s,
@@ -214,7 +209,7 @@ fn encode_batch_payload(
         batch.token_contract.into(),
         batch.batch_timeout.into(),
     ];
-    let payload = clarity::abi::encode_call("submitBatch(address[],uint256[],uint256,uint8[],bytes32[],bytes32[],uint256[],address[],uint256[],uint256,address,uint256)",
+    let payload = clarity::abi::encode_call("submitBatch((address[],uint256[],uint256[],uint256,uint256),uint8[],bytes32[],bytes32[],uint256[],address[],uint256[],uint256,address,uint256)",
     tokens).unwrap();
     trace!("Tokens {:?}", tokens);
 
@@ -29,15 +29,37 @@ pub fn get_checkpoint_abi_encode(
     gravity_id: &str,
 ) -> Result<Vec<u8>, GravityError> {
     let (eth_addresses, powers) = valset.filter_empty_addresses();
+    let weights = valset.get_weights();
     Ok(encode_tokens(&[
         Token::FixedString(gravity_id.to_string()),
         Token::FixedString("checkpoint".to_string()),
         valset.nonce.into(),
         eth_addresses.into(),
         powers.into(),
+        weights.into(),
+        valset.threshold.into(),
     ]))
 }
 
+/// Encodes the valset as the ValsetArgs struct argument of the Gravity contract
+/// struct ValsetArgs {
+///     address[] validators;
+///     uint256[] powers;
+///     uint256[] weights;
+///     uint256 threshold;
+///     uint256 valsetNonce;
+/// }
+pub fn encode_valset_args(valset: &Valset) -> Token {
+    let (eth_addresses, powers) = valset.filter_empty_addresses();
+    Token::Struct(vec![
+        eth_addresses.into(),
+        powers.into(),
+        valset.get_weights().into(),
+        valset.threshold.into(),
+        valset.nonce.into(),
+    ])
+}
+
 pub fn get_checkpoint_hash(valset: &Valset, gravity_id: &str) -> Result<Vec<u8>, GravityError> {
     let locally_computed_abi_encode = get_checkpoint_abi_encode(&valset, &gravity_id);
     let locally_computed_digest = Keccak256::digest(&locally_computed_abi_encode?);
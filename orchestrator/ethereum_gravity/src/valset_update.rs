@@ -1,4 +1,4 @@
-use crate::utils::{get_valset_nonce, GasCost};
+use crate::utils::{encode_valset_args, get_valset_nonce, GasCost};
 use clarity::PrivateKey as EthPrivateKey;
 use clarity::{Address as EthAddress, Uint256};
 use mhub2_utils::types::*;
@@ -133,10 +133,8 @@ pub fn encode_valset_payload(
     confirms: &[ValsetConfirmResponse],
     gravity_id: String,
 ) -> Result<Vec<u8>, GravityError> {
-    let (old_addresses, old_powers) = old_valset.filter_empty_addresses();
-    let (new_addresses, new_powers) = new_valset.filter_empty_addresses();
-    let old_nonce = old_valset.nonce;
-    let new_nonce = new_valset.nonce;
+    let new_valset_args = encode_valset_args(&new_valset);
+    let old_valset_args = encode_valset_args(&old_valset);
 
     // remember the signatures are over the new valset and therefore this is the value we must encode
     // the old valset exists only as a hash in the ethereum store
@@ -149,31 +147,23 @@ pub fn encode_valset_payload(
     // Solidity function signature
     // function updateValset(
     // // The new version of the validator set
-    // address[] memory _newValidators,
-    // uint256[] memory _newPowers,
-    // uint256 _newValsetNonce,
+    // ValsetArgs memory _newValset,
     // // The current validators that approve the change
-    // address[] memory _currentValidators,
-    // uint256[] memory _currentPowers,
-    // uint256 _currentValsetNonce,
+    // ValsetArgs memory _currentValset,
     // // These are arrays of the parts of the current validator's signatures
     // uint8[] memory _v,
     // bytes32[] memory _r,
     // bytes32[] memory _s
     let tokens = &[
-        new_addresses.into(),
-        new_powers.into(),
-        new_nonce.into(),
-        old_addresses.into(),
-        old_powers.into(),
-        old_nonce.into(),
+        new_valset_args,
+        old_valset_args,
         sig_arrays.v,
         sig_arrays.r,
         sig_arrays.s,
     ];
 
     let payload = clarity::abi::encode_call(
-        "updateValset(address[],uint256[],uint256,address[],uint256[],uint256,uint8[],bytes32[],bytes32[])",
+        "updateValset((address[],uint256[],uint256[],uint256,uint256),(address[],uint256[],uint256[],uint256,uint256),uint8[],bytes32[],bytes32[])",
         tokens,
     ).unwrap();
 
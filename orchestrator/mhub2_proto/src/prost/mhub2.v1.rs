@@ -27,6 +27,8 @@ pub struct ExternalSigner {
     pub power: u64,
     #[prost(string, tag = "2")]
     pub external_address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub weight: u64,
 }
 /// SignerSetTx is the Bridge multisig set that relays
 /// transactions the two chains. The staking validators keep external keys which
@@ -42,6 +44,9 @@ pub struct SignerSetTx {
     pub signers: ::prost::alloc::vec::Vec<ExternalSigner>,
     #[prost(uint64, tag = "4")]
     pub sequence: u64,
+    /// sum of the signer weights required to sign a transaction of the multisig
+    #[prost(uint64, tag = "5")]
+    pub threshold: u64,
 }
 /// BatchTx represents a batch of transactions going from Cosmos to External Chain.
 /// Batch txs are are identified by a unique hash and the token contract that is
@@ -422,6 +427,10 @@ pub struct SignerSetTxExecutedEvent {
     pub members: ::prost::alloc::vec::Vec<ExternalSigner>,
     #[prost(string, tag = "5")]
     pub tx_hash: ::prost::alloc::string::String,
+    /// threshold of the multisig set up on the external chain, it is 0 for the
+    /// chains which don't report the multisig weights
+    #[prost(uint64, tag = "6")]
+    pub threshold: u64,
 }
 #[doc = r" Generated client implementations."]
 pub mod msg_client {
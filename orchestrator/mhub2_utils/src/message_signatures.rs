@@ -9,12 +9,15 @@ use clarity::utils::get_ethereum_msg_hash;
 /// digest that is normally signed or may be used as a 'hash of the message'
 pub fn encode_valset_confirm(gravity_id: String, valset: Valset) -> Vec<u8> {
     let (eth_addresses, powers) = valset.filter_empty_addresses();
+    let weights = valset.get_weights();
     encode_tokens(&[
         Token::FixedString(gravity_id),
         Token::FixedString("checkpoint".to_string()),
         valset.nonce.into(),
         eth_addresses.into(),
         powers.into(),
+        weights.into(),
+        valset.threshold.into(),
     ])
 }
 
@@ -30,7 +33,7 @@ fn test_valset_signature() {
     use sha3::{Digest, Keccak256};
 
     let correct_hash: Vec<u8> =
-        hex_str_to_bytes("0x88165860d955aee7dc3e83d9d1156a5864b708841965585d206dbef6e9e1a499")
+        hex_str_to_bytes("0x32f52763aa4b5ebbd0708cad8244982aa40215a4551db6b081a20183c924912c")
             .unwrap();
 
     // a validator set
@@ -44,6 +47,7 @@ fn test_valset_signature() {
                         .unwrap(),
                 ),
                 power: 3333,
+                weight: 0,
             },
             ValsetMember {
                 eth_address: Some(
@@ -52,6 +56,7 @@ fn test_valset_signature() {
                         .unwrap(),
                 ),
                 power: 3333,
+                weight: 0,
             },
             ValsetMember {
                 eth_address: Some(
@@ -60,8 +65,10 @@ fn test_valset_signature() {
                         .unwrap(),
                 ),
                 power: 3333,
+                weight: 0,
             },
         ],
+        threshold: 0,
     };
     let checkpoint = encode_valset_confirm("foo".to_string(), valset);
     let checkpoint_hash = Keccak256::digest(&checkpoint);
@@ -78,6 +85,7 @@ fn test_valset_signature() {
                         .unwrap(),
                 ),
                 power: 3333,
+                weight: 0,
             },
             ValsetMember {
                 eth_address: Some(
@@ -86,6 +94,7 @@ fn test_valset_signature() {
                         .unwrap(),
                 ),
                 power: 3333,
+                weight: 0,
             },
             ValsetMember {
                 eth_address: Some(
@@ -94,8 +103,10 @@ fn test_valset_signature() {
                         .unwrap(),
                 ),
                 power: 3333,
+                weight: 0,
             },
         ],
+        threshold: 0,
     };
     let checkpoint = encode_valset_confirm("foo".to_string(), valset);
     let checkpoint_hash = Keccak256::digest(&checkpoint);
@@ -105,7 +105,11 @@ impl ValsetUpdatedEvent {
                 ));
             }
             let power: u64 = power.to_string().parse().unwrap();
-            validators.push(ValsetMember { power, eth_address })
+            validators.push(ValsetMember {
+                power,
+                eth_address,
+                weight: 0,
+            })
         }
         let mut check = validators.clone();
         check.sort();
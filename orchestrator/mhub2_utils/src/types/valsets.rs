@@ -85,6 +85,8 @@ impl Confirm for ValsetConfirmResponse {
 pub struct Valset {
     pub nonce: u64,
     pub members: Vec<ValsetMember>,
+    /// sum of the member weights required to sign
+    pub threshold: u64,
 }
 
 impl Valset {
@@ -109,6 +111,11 @@ impl Valset {
         (addresses, powers)
     }
 
+    /// returns the multisig weights of the members in the same order as filter_empty_addresses
+    pub fn get_weights(&self) -> Vec<u64> {
+        self.members.iter().map(|val| val.weight).collect()
+    }
+
     pub fn get_power(&self, address: EthAddress) -> Result<u64, CosmosGrpcError> {
         for val in self.members.iter() {
             if val.eth_address == Some(address) {
@@ -328,6 +335,7 @@ impl From<mhub2_proto::mhub2::SignerSetTxResponse> for Valset {
     fn from(input: mhub2_proto::mhub2::SignerSetTxResponse) -> Self {
         Valset {
             nonce: input.signer_set.clone().unwrap().nonce,
+            threshold: input.signer_set.clone().unwrap().threshold,
             members: input
                 .signer_set
                 .unwrap()
@@ -343,6 +351,7 @@ impl From<mhub2_proto::mhub2::SignerSetTx> for Valset {
     fn from(input: mhub2_proto::mhub2::SignerSetTx) -> Self {
         Valset {
             nonce: input.clone().nonce,
+            threshold: input.threshold,
             members: input.signers.iter().map(|i| i.into()).collect(),
         }
     }
@@ -352,6 +361,7 @@ impl From<&mhub2_proto::mhub2::SignerSetTxResponse> for Valset {
     fn from(input: &mhub2_proto::mhub2::SignerSetTxResponse) -> Self {
         Valset {
             nonce: input.signer_set.clone().unwrap().nonce,
+            threshold: input.signer_set.clone().unwrap().threshold,
             members: input
                 .signer_set
                 .clone()
@@ -370,6 +380,7 @@ pub struct ValsetMember {
     // ord sorts on the first member first, so this produces the correct sorting
     pub power: u64,
     pub eth_address: Option<EthAddress>,
+    pub weight: u64,
 }
 
 impl Ord for ValsetMember {
@@ -424,6 +435,7 @@ impl From<mhub2_proto::mhub2::ExternalSigner> for ValsetMember {
         ValsetMember {
             power: input.power as u64,
             eth_address,
+            weight: input.weight,
         }
     }
 }
@@ -437,6 +449,7 @@ impl From<&mhub2_proto::mhub2::ExternalSigner> for ValsetMember {
         ValsetMember {
             power: input.power as u64,
             eth_address,
+            weight: input.weight,
         }
     }
 }
@@ -450,6 +463,7 @@ impl From<&ValsetMember> for mhub2_proto::mhub2::ExternalSigner {
         mhub2_proto::mhub2::ExternalSigner {
             power: input.power,
             external_address,
+            weight: input.weight,
         }
     }
 }
@@ -27,6 +27,20 @@ struct LogicCallArgs {
 	uint256 invalidationNonce;
 }
 
+// This represents a validator set that is stored in the checkpoint
+struct ValsetArgs {
+	// the validators in this set, represented by an Ethereum address
+	address[] validators;
+	// the powers of the given validators in the same order as above
+	uint256[] powers;
+	// the multisig weights of the given validators in the same order as above
+	uint256[] weights;
+	// the sum of the weights required to approve an operation
+	uint256 threshold;
+	// the nonce of this validator set
+	uint256 valsetNonce;
+}
+
 interface IWETH {
     function deposit() external payable;
     function withdraw(uint) external;
@@ -48,7 +62,6 @@ contract Hub2 is ReentrancyGuard {
 
 	// These are set once at initialization
 	bytes32 public state_gravityId;
-	uint256 public state_powerThreshold;
 
 	address public wethAddress;
 
@@ -116,63 +129,112 @@ contract Hub2 is ReentrancyGuard {
 	// A checkpoint is a hash of all relevant information about the valset. This is stored by the contract,
 	// instead of storing the information directly. This saves on storage and gas.
 	// The format of the checkpoint is:
-	// h(gravityId, "checkpoint", valsetNonce, validators[], powers[])
+	// h(gravityId, "checkpoint", valsetNonce, validators[], powers[], weights[], threshold)
 	// Where h is the keccak256 hash function.
 	// The validator powers must be decreasing or equal. This is important for checking the signatures on the
 	// next valset, since it allows the caller to stop verifying signatures once a quorum of signatures have been verified.
-	function makeCheckpoint(
-		address[] memory _validators,
-		uint256[] memory _powers,
-		uint256 _valsetNonce,
-		bytes32 _gravityId
-	) private pure returns (bytes32) {
+	function makeCheckpoint(ValsetArgs memory _valsetArgs, bytes32 _gravityId)
+		private
+		pure
+		returns (bytes32)
+	{
 		// bytes32 encoding of the string "checkpoint"
 		bytes32 methodName = 0x636865636b706f696e7400000000000000000000000000000000000000000000;
 
 		bytes32 checkpoint =
-			keccak256(abi.encode(_gravityId, methodName, _valsetNonce, _validators, _powers));
+			keccak256(
+				abi.encode(
+					_gravityId,
+					methodName,
+					_valsetArgs.valsetNonce,
+					_valsetArgs.validators,
+					_valsetArgs.powers,
+					_valsetArgs.weights,
+					_valsetArgs.threshold
+				)
+			);
 
 		return checkpoint;
 	}
 
+	// Checks that the validator set is well-formed and that its weights can reach the threshold
+	function checkValset(ValsetArgs memory _valsetArgs) private pure {
+		require(
+			_valsetArgs.validators.length == _valsetArgs.powers.length &&
+				_valsetArgs.validators.length == _valsetArgs.weights.length,
+			"Malformed validator set"
+		);
+		require(_valsetArgs.threshold > 0, "Validator set threshold must be positive");
+
+		uint256 cumulativeWeight = 0;
+		for (uint256 i = 0; i < _valsetArgs.weights.length; i++) {
+			cumulativeWeight = cumulativeWeight.add(_valsetArgs.weights[i]);
+		}
+		require(
+			cumulativeWeight >= _valsetArgs.threshold,
+			"Validator set weights do not reach the threshold."
+		);
+	}
+
+	// Checks that the current validator set and the signatures (v,r,s) are well-formed and that the
+	// validator set matches the saved checkpoint
+	function checkCurrentValset(
+		ValsetArgs memory _currentValset,
+		uint8[] memory _v,
+		bytes32[] memory _r,
+		bytes32[] memory _s
+	) private view {
+		require(
+			_currentValset.validators.length == _currentValset.powers.length &&
+				_currentValset.validators.length == _currentValset.weights.length &&
+				_currentValset.validators.length == _v.length &&
+				_currentValset.validators.length == _r.length &&
+				_currentValset.validators.length == _s.length,
+			"Malformed current validator set"
+		);
+
+		require(
+			makeCheckpoint(_currentValset, state_gravityId) == state_lastValsetCheckpoint,
+			"Supplied current validators and powers do not match checkpoint."
+		);
+	}
+
 	function checkValidatorSignatures(
-		// The current validator set and their powers
-		address[] memory _currentValidators,
-		uint256[] memory _currentPowers,
+		// The current validator set and their weights
+		ValsetArgs memory _currentValset,
 		// The current validator's signatures
 		uint8[] memory _v,
 		bytes32[] memory _r,
 		bytes32[] memory _s,
 		// This is what we are checking they have signed
-		bytes32 _theHash,
-		uint256 _powerThreshold
+		bytes32 _theHash
 	) private pure {
-		uint256 cumulativePower = 0;
+		uint256 cumulativeWeight = 0;
 
-		for (uint256 i = 0; i < _currentValidators.length; i++) {
+		for (uint256 i = 0; i < _currentValset.validators.length; i++) {
 			// If v is set to 0, this signifies that it was not possible to get a signature from this validator and we skip evaluation
 			// (In a valid signature, it is either 27 or 28)
 			if (_v[i] != 0) {
 				// Check that the current validator has signed off on the hash
 				require(
-					verifySig(_currentValidators[i], _theHash, _v[i], _r[i], _s[i]),
+					verifySig(_currentValset.validators[i], _theHash, _v[i], _r[i], _s[i]),
 					"Validator signature does not match."
 				);
 
-				// Sum up cumulative power
-				cumulativePower = cumulativePower + _currentPowers[i];
+				// Sum up cumulative weight
+				cumulativeWeight = cumulativeWeight + _currentValset.weights[i];
 
 				// Break early to avoid wasting gas
-				if (cumulativePower > _powerThreshold) {
+				if (cumulativeWeight >= _currentValset.threshold) {
 					break;
 				}
 			}
 		}
 
-		// Check that there was enough power
+		// Check that there was enough weight
 		require(
-			cumulativePower > _powerThreshold,
-			"Submitted validator set signatures do not have enough power."
+			cumulativeWeight >= _currentValset.threshold,
+			"Submitted validator set signatures do not have enough weight."
 		);
 		// Success
 	}
@@ -180,17 +242,13 @@ contract Hub2 is ReentrancyGuard {
 	// This updates the valset by checking that the validators in the current valset have signed off on the
 	// new valset. The signatures supplied are the signatures of the current valset over the checkpoint hash
 	// generated from the new valset.
-	// Anyone can call this function, but they must supply valid signatures of state_powerThreshold of the current valset over
+	// Anyone can call this function, but they must supply valid signatures of the threshold of the current valset over
 	// the new valset.
 	function updateValset(
 		// The new version of the validator set
-		address[] memory _newValidators,
-		uint256[] memory _newPowers,
-		uint256 _newValsetNonce,
+		ValsetArgs memory _newValset,
 		// The current validators that approve the change
-		address[] memory _currentValidators,
-		uint256[] memory _currentPowers,
-		uint256 _currentValsetNonce,
+		ValsetArgs memory _currentValset,
 		// These are arrays of the parts of the current validator's signatures
 		uint8[] memory _v,
 		bytes32[] memory _r,
@@ -200,46 +258,20 @@ contract Hub2 is ReentrancyGuard {
 
 		// Check that the valset nonce is greater than the old one
 		require(
-			_newValsetNonce > _currentValsetNonce,
+			_newValset.valsetNonce > _currentValset.valsetNonce,
 			"New valset nonce must be greater than the current nonce"
 		);
 
-		// Check that new validators and powers set is well-formed
-		require(_newValidators.length == _newPowers.length, "Malformed new validator set");
+		// Check that new validators, powers and weights set is well-formed
+		checkValset(_newValset);
 
-		// Check that current validators, powers, and signatures (v,r,s) set is well-formed
-		require(
-			_currentValidators.length == _currentPowers.length &&
-				_currentValidators.length == _v.length &&
-				_currentValidators.length == _r.length &&
-				_currentValidators.length == _s.length,
-			"Malformed current validator set"
-		);
-
-		// Check that the supplied current validator set matches the saved checkpoint
-		require(
-			makeCheckpoint(
-				_currentValidators,
-				_currentPowers,
-				_currentValsetNonce,
-				state_gravityId
-			) == state_lastValsetCheckpoint,
-			"Supplied current validators and powers do not match checkpoint."
-		);
+		// Check that the supplied current validator set is well-formed and matches the saved checkpoint
+		checkCurrentValset(_currentValset, _v, _r, _s);
 
 		// Check that enough current validators have signed off on the new validator set
-		bytes32 newCheckpoint =
-			makeCheckpoint(_newValidators, _newPowers, _newValsetNonce, state_gravityId);
-
-		checkValidatorSignatures(
-			_currentValidators,
-			_currentPowers,
-			_v,
-			_r,
-			_s,
-			newCheckpoint,
-			state_powerThreshold
-		);
+		bytes32 newCheckpoint = makeCheckpoint(_newValset, state_gravityId);
+
+		checkValidatorSignatures(_currentValset, _v, _r, _s, newCheckpoint);
 
 		// ACTIONS
 
@@ -248,22 +280,25 @@ contract Hub2 is ReentrancyGuard {
 		state_lastValsetCheckpoint = newCheckpoint;
 
 		// Store new nonce
-		state_lastValsetNonce = _newValsetNonce;
+		state_lastValsetNonce = _newValset.valsetNonce;
 
 		// LOGS
 		state_lastEventNonce = state_lastEventNonce.add(1);
-		emit ValsetUpdatedEvent(_newValsetNonce, state_lastEventNonce, _newValidators, _newPowers);
+		emit ValsetUpdatedEvent(
+			_newValset.valsetNonce,
+			state_lastEventNonce,
+			_newValset.validators,
+			_newValset.powers
+		);
 	}
 
 	// submitBatch processes a batch of Cosmos -> Ethereum transactions by sending the tokens in the transactions
 	// to the destination addresses. It is approved by the current Cosmos validator set.
-	// Anyone can call this function, but they must supply valid signatures of state_powerThreshold of the current valset over
+	// Anyone can call this function, but they must supply valid signatures of the threshold of the current valset over
 	// the batch.
 	function submitBatch(
 		// The validators that approve the batch
-		address[] memory _currentValidators,
-		uint256[] memory _currentPowers,
-		uint256 _currentValsetNonce,
+		ValsetArgs memory _currentValset,
 		// These are arrays of the parts of the validators signatures
 		uint8[] memory _v,
 		bytes32[] memory _r,
@@ -292,25 +327,8 @@ contract Hub2 is ReentrancyGuard {
 				"Batch timeout must be greater than the current block height"
 			);
 
-			// Check that current validators, powers, and signatures (v,r,s) set is well-formed
-			require(
-				_currentValidators.length == _currentPowers.length &&
-					_currentValidators.length == _v.length &&
-					_currentValidators.length == _r.length &&
-					_currentValidators.length == _s.length,
-				"Malformed current validator set"
-			);
-
-			// Check that the supplied current validator set matches the saved checkpoint
-			require(
-				makeCheckpoint(
-					_currentValidators,
-					_currentPowers,
-					_currentValsetNonce,
-					state_gravityId
-				) == state_lastValsetCheckpoint,
-				"Supplied current validators and powers do not match checkpoint."
-			);
+			// Check that the supplied current validator set is well-formed and matches the saved checkpoint
+			checkCurrentValset(_currentValset, _v, _r, _s);
 
 			// Check that the transaction batch is well-formed
 			require(
@@ -320,8 +338,7 @@ contract Hub2 is ReentrancyGuard {
 
 			// Check that enough current validators have signed off on the transaction batch and valset
 			checkValidatorSignatures(
-				_currentValidators,
-				_currentPowers,
+				_currentValset,
 				_v,
 				_r,
 				_s,
@@ -338,8 +355,7 @@ contract Hub2 is ReentrancyGuard {
 						_tokenContract,
 						_batchTimeout
 					)
-				),
-				state_powerThreshold
+				)
 			);
 
 			// ACTIONS
@@ -378,9 +394,7 @@ contract Hub2 is ReentrancyGuard {
 	// for each call.
 	function submitLogicCall(
 		// The validators that approve the call
-		address[] memory _currentValidators,
-		uint256[] memory _currentPowers,
-		uint256 _currentValsetNonce,
+		ValsetArgs memory _currentValset,
 		// These are arrays of the parts of the validators signatures
 		uint8[] memory _v,
 		bytes32[] memory _r,
@@ -398,25 +412,8 @@ contract Hub2 is ReentrancyGuard {
 				"New invalidation nonce must be greater than the current nonce"
 			);
 
-			// Check that current validators, powers, and signatures (v,r,s) set is well-formed
-			require(
-				_currentValidators.length == _currentPowers.length &&
-					_currentValidators.length == _v.length &&
-					_currentValidators.length == _r.length &&
-					_currentValidators.length == _s.length,
-				"Malformed current validator set"
-			);
-
-			// Check that the supplied current validator set matches the saved checkpoint
-			require(
-				makeCheckpoint(
-					_currentValidators,
-					_currentPowers,
-					_currentValsetNonce,
-					state_gravityId
-				) == state_lastValsetCheckpoint,
-				"Supplied current validators and powers do not match checkpoint."
-			);
+			// Check that the supplied current validator set is well-formed and matches the saved checkpoint
+			checkCurrentValset(_currentValset, _v, _r, _s);
 
 			// Check that the token transfer list is well-formed
 			require(
@@ -452,14 +449,12 @@ contract Hub2 is ReentrancyGuard {
 		{
 			// Check that enough current validators have signed off on the transaction batch and valset
 			checkValidatorSignatures(
-				_currentValidators,
-				_currentPowers,
+				_currentValset,
 				_v,
 				_r,
 				_s,
 				// Get hash of the transaction batch and checkpoint
-				argsHash,
-				state_powerThreshold
+				argsHash
 			);
 		}
 
@@ -552,39 +547,23 @@ contract Hub2 is ReentrancyGuard {
 	constructor(
 		// A unique identifier for this gravity instance to use in signatures
 		bytes32 _gravityId,
-		// How much voting power is needed to approve operations
-		uint256 _powerThreshold,
-		// The validator set
-		address[] memory _validators,
-		uint256[] memory _powers,
+		// The validator set, its nonce must be zero
+		ValsetArgs memory _valset,
 		address _wethAddress,
 		address _guardian
 	) public {
 		// CHECKS
 
-		// Check that validators, powers, and signatures (v,r,s) set is well-formed
-		require(_validators.length == _powers.length, "Malformed current validator set");
+		// Check that validators, powers and weights set is well-formed and that its weights
+		// are sufficient to actually pass a vote
+		checkValset(_valset);
+		require(_valset.valsetNonce == 0, "Initial validator set nonce must be zero");
 
-		// Check cumulative power to ensure the contract has sufficient power to actually
-		// pass a vote
-		uint256 cumulativePower = 0;
-		for (uint256 i = 0; i < _powers.length; i++) {
-			cumulativePower = cumulativePower + _powers[i];
-			if (cumulativePower > _powerThreshold) {
-				break;
-			}
-		}
-		require(
-			cumulativePower > _powerThreshold,
-			"Submitted validator set signatures do not have enough power."
-		);
-
-		bytes32 newCheckpoint = makeCheckpoint(_validators, _powers, 0, _gravityId);
+		bytes32 newCheckpoint = makeCheckpoint(_valset, _gravityId);
 
 		// ACTIONS
 
 		state_gravityId = _gravityId;
-		state_powerThreshold = _powerThreshold;
 		state_lastValsetCheckpoint = newCheckpoint;
 
 		wethAddress = _wethAddress;
@@ -592,7 +571,12 @@ contract Hub2 is ReentrancyGuard {
 
 		// LOGS
 
-		emit ValsetUpdatedEvent(state_lastValsetNonce, state_lastEventNonce, _validators, _powers);
+		emit ValsetUpdatedEvent(
+			state_lastValsetNonce,
+			state_lastEventNonce,
+			_valset.validators,
+			_valset.powers
+		);
 	}
 }
 